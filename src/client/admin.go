@@ -0,0 +1,306 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// User roles
+const (
+	UserRoleAdmin    = "admin"    // Manages users
+	UserRoleUser     = "user"     // The default
+	UserRoleReadOnly = "readonly" // Cannot change files
+)
+
+// AdminUser is an account as seen by admins
+type AdminUser struct {
+	ID            int        `json:"id"`
+	Username      string     `json:"username"`
+	Email         string     `json:"email"`
+	EmailVerified bool       `json:"email_verified"`
+	Role          string     `json:"role"`
+	IsDisabled    bool       `json:"is_disabled"`
+	AuthSource    string     `json:"auth_source"`
+	TwoFactor     bool       `json:"two_factor"`
+	TotalQuota    int64      `json:"total_quota"`
+	TotalUsed     int64      `json:"total_used"`
+	CreatedAt     time.Time  `json:"created_at"`
+	LastLoginAt   *time.Time `json:"last_login_at"`
+}
+
+// AdminUserList is a page of the accounts
+type AdminUserList struct {
+	Users    []AdminUser `json:"users"`
+	Total    int         `json:"total"`
+	Page     int         `json:"page"`
+	PageSize int         `json:"page_size"`
+}
+
+// UserFilter selects a page of the accounts. Zero values match all
+// accounts and leave the server defaults of the first page of 50.
+type UserFilter struct {
+	Query    string // Part of the username or email address
+	Role     string
+	Disabled *bool
+	Page     int
+	PageSize int
+}
+
+// CreateUserRequest is a request to create an account
+type CreateUserRequest struct {
+	Username   string `json:"username"`
+	Password   string `json:"password"`
+	Email      string `json:"email,omitempty"`
+	Role       string `json:"role,omitempty"`        // user when empty
+	TotalQuota int64  `json:"total_quota,omitempty"` // Bytes, at least 1 GiB, the default quota when 0
+}
+
+// UpdateUserRequest changes an account. Nil fields are left alone.
+type UpdateUserRequest struct {
+	Email      *string `json:"email,omitempty"`
+	Role       *string `json:"role,omitempty"`
+	TotalQuota *int64  `json:"total_quota,omitempty"`
+}
+
+// UserUsage is the storage an account uses
+type UserUsage struct {
+	TotalQuota     int64 `json:"total_quota"`
+	TotalUsed      int64 `json:"total_used"`
+	FileCount      int   `json:"file_count"`
+	FolderCount    int   `json:"folder_count"`
+	FilesSize      int64 `json:"files_size"`
+	TrashCount     int   `json:"trash_count"`
+	TrashSize      int64 `json:"trash_size"`
+	ShareCount     int   `json:"share_count"`
+	ActiveSessions int   `json:"active_sessions"`
+}
+
+// Lockout tells whether logins to an account are blocked after failed
+// attempts
+type Lockout struct {
+	Failures      int        `json:"failures"`
+	Locked        bool       `json:"locked"`
+	BlockedUntil  *time.Time `json:"blocked_until"`
+	LastFailureAt *time.Time `json:"last_failure_at"`
+}
+
+// AuthFailure is a failed login, two-factor or share password attempt
+type AuthFailure struct {
+	ID        int       `json:"id"`
+	Kind      string    `json:"kind"`    // login, two_factor or share
+	Subject   string    `json:"subject"` // Username or share code
+	UserID    *int      `json:"user_id"`
+	Reason    string    `json:"reason"`
+	IP        string    `json:"ip"`
+	UserAgent string    `json:"user_agent"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// AuthFailureList is a page of the failed attempts
+type AuthFailureList struct {
+	Failures []AuthFailure `json:"failures"`
+	Total    int           `json:"total"`
+	Page     int           `json:"page"`
+	PageSize int           `json:"page_size"`
+}
+
+// AuthFailureFilter selects a page of the failed attempts. Zero values
+// match all attempts.
+type AuthFailureFilter struct {
+	Kind     string
+	Subject  string
+	IP       string
+	Page     int
+	PageSize int
+}
+
+// Invite is an invite code for registering
+type Invite struct {
+	ID         int        `json:"id"`
+	Code       string     `json:"code"`
+	Note       string     `json:"note"`
+	Role       string     `json:"role"`
+	TotalQuota *int64     `json:"total_quota"` // Nil for the default quota
+	MaxUses    int        `json:"max_uses"`    // 0 for unlimited
+	Uses       int        `json:"uses"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	CreatedBy  *int       `json:"created_by"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// CreateInviteRequest is a request for an invite code
+type CreateInviteRequest struct {
+	Note       string     `json:"note,omitempty"`
+	Role       string     `json:"role,omitempty"`        // user when empty
+	TotalQuota *int64     `json:"total_quota,omitempty"` // Bytes, at least 1 GiB
+	MaxUses    *int       `json:"max_uses,omitempty"`    // 0 for unlimited, 1 when nil
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+}
+
+// adminUserPath returns the path of an account, followed by suffix
+func adminUserPath(id int, suffix string) string {
+	return "/api/admin/users/" + strconv.Itoa(id) + suffix
+}
+
+// ListUsers returns a page of the accounts
+func (c *Client) ListUsers(ctx context.Context, filter UserFilter) (*AdminUserList, error) {
+	query := url.Values{}
+	if filter.Query != "" {
+		query.Set("q", filter.Query)
+	}
+	if filter.Role != "" {
+		query.Set("role", filter.Role)
+	}
+	if filter.Disabled != nil {
+		query.Set("disabled", strconv.FormatBool(*filter.Disabled))
+	}
+	pageQuery(query, filter.Page, filter.PageSize)
+	var list AdminUserList
+	err := c.do(ctx, &request{method: http.MethodGet, path: "/api/admin/users", query: query}, &list)
+	if err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+// CreateUser creates an account
+func (c *Client) CreateUser(ctx context.Context, req CreateUserRequest) (*AdminUser, error) {
+	return c.adminUser(ctx, &request{method: http.MethodPost, path: "/api/admin/users", body: req})
+}
+
+// GetUser returns an account
+func (c *Client) GetUser(ctx context.Context, id int) (*AdminUser, error) {
+	return c.adminUser(ctx, &request{method: http.MethodGet, path: adminUserPath(id, "")})
+}
+
+// UpdateUser changes an account
+func (c *Client) UpdateUser(ctx context.Context, id int, req UpdateUserRequest) (*AdminUser, error) {
+	return c.adminUser(ctx, &request{method: http.MethodPut, path: adminUserPath(id, ""), body: req})
+}
+
+// DeleteUser deletes an account with its files, shares and groups
+func (c *Client) DeleteUser(ctx context.Context, id int) error {
+	return c.do(ctx, &request{method: http.MethodDelete, path: adminUserPath(id, "")}, nil)
+}
+
+// UserUsage returns the storage an account uses
+func (c *Client) UserUsage(ctx context.Context, id int) (*UserUsage, error) {
+	var usage UserUsage
+	err := c.do(ctx, &request{method: http.MethodGet, path: adminUserPath(id, "/usage")}, &usage)
+	if err != nil {
+		return nil, err
+	}
+	return &usage, nil
+}
+
+// DisableUser disables an account and signs out its sessions
+func (c *Client) DisableUser(ctx context.Context, id int) (*AdminUser, error) {
+	return c.adminUser(ctx, &request{method: http.MethodPost, path: adminUserPath(id, "/disable")})
+}
+
+// EnableUser enables a disabled account
+func (c *Client) EnableUser(ctx context.Context, id int) (*AdminUser, error) {
+	return c.adminUser(ctx, &request{method: http.MethodPost, path: adminUserPath(id, "/enable")})
+}
+
+// ResetUserPassword sets the password of an account and signs out its
+// sessions
+func (c *Client) ResetUserPassword(ctx context.Context, id int, password string) error {
+	return c.do(ctx, &request{
+		method: http.MethodPost,
+		path:   adminUserPath(id, "/password"),
+		body:   map[string]string{"password": password},
+	}, nil)
+}
+
+// SetUserCapacity sets the storage quota of an account in bytes, at least
+// 1 GiB
+func (c *Client) SetUserCapacity(ctx context.Context, id int, totalQuota int64) error {
+	return c.do(ctx, &request{
+		method: http.MethodPut,
+		path:   adminUserPath(id, "/capacity"),
+		body:   map[string]int64{"total_quota": totalQuota},
+	}, nil)
+}
+
+// ResetUserTwoFactor disables two-factor authentication of an account that
+// lost its authenticator
+func (c *Client) ResetUserTwoFactor(ctx context.Context, id int) error {
+	return c.do(ctx, &request{method: http.MethodDelete, path: adminUserPath(id, "/2fa")}, nil)
+}
+
+// UserLockout returns whether logins to an account are blocked
+func (c *Client) UserLockout(ctx context.Context, id int) (*Lockout, error) {
+	var l Lockout
+	err := c.do(ctx, &request{method: http.MethodGet, path: adminUserPath(id, "/lockout")}, &l)
+	if err != nil {
+		return nil, err
+	}
+	return &l, nil
+}
+
+// UnlockUser clears the failed login attempts of an account
+func (c *Client) UnlockUser(ctx context.Context, id int) error {
+	return c.do(ctx, &request{method: http.MethodDelete, path: adminUserPath(id, "/lockout")}, nil)
+}
+
+// AuthFailures returns a page of the failed attempts, most recent first
+func (c *Client) AuthFailures(ctx context.Context, filter AuthFailureFilter) (*AuthFailureList, error) {
+	query := url.Values{}
+	if filter.Kind != "" {
+		query.Set("kind", filter.Kind)
+	}
+	if filter.Subject != "" {
+		query.Set("subject", filter.Subject)
+	}
+	if filter.IP != "" {
+		query.Set("ip", filter.IP)
+	}
+	pageQuery(query, filter.Page, filter.PageSize)
+	var list AuthFailureList
+	err := c.do(ctx, &request{method: http.MethodGet, path: "/api/admin/auth-failures", query: query}, &list)
+	if err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+// Invites returns the invite codes, and the registration mode they are for
+func (c *Client) Invites(ctx context.Context) ([]Invite, string, error) {
+	var resp struct {
+		Invites          []Invite `json:"invites"`
+		RegistrationMode string   `json:"registration_mode"`
+	}
+	err := c.do(ctx, &request{method: http.MethodGet, path: "/api/admin/invites"}, &resp)
+	if err != nil {
+		return nil, "", err
+	}
+	return resp.Invites, resp.RegistrationMode, nil
+}
+
+// CreateInvite creates an invite code
+func (c *Client) CreateInvite(ctx context.Context, req CreateInviteRequest) (*Invite, error) {
+	var inv Invite
+	err := c.do(ctx, &request{method: http.MethodPost, path: "/api/admin/invites", body: req}, &inv)
+	if err != nil {
+		return nil, err
+	}
+	return &inv, nil
+}
+
+// DeleteInvite deletes an invite code
+func (c *Client) DeleteInvite(ctx context.Context, id int) error {
+	return c.do(ctx, &request{method: http.MethodDelete, path: "/api/admin/invites/" + strconv.Itoa(id)}, nil)
+}
+
+// adminUser performs a request answered with an account
+func (c *Client) adminUser(ctx context.Context, r *request) (*AdminUser, error) {
+	var u AdminUser
+	if err := c.do(ctx, r, &u); err != nil {
+		return nil, err
+	}
+	return &u, nil
+}
@@ -0,0 +1,249 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+)
+
+// User is the account a session belongs to
+type User struct {
+	ID       int    `json:"id"`
+	Username string `json:"username"`
+	Email    string `json:"email"`
+	Role     string `json:"role"` // admin, user or readonly
+
+	// Only set by Me
+	EmailVerified bool       `json:"email_verified"`
+	AuthSource    string     `json:"auth_source"` // local or ldap
+	TwoFactor     bool       `json:"two_factor"`
+	CreatedAt     time.Time  `json:"created_at"`
+	LastLoginAt   *time.Time `json:"last_login_at"`
+}
+
+// RegisterRequest is a request to create an account
+type RegisterRequest struct {
+	Username   string `json:"username"`
+	Password   string `json:"password"`
+	Email      string `json:"email,omitempty"`
+	InviteCode string `json:"invite_code,omitempty"` // Required when registration is invite-only
+}
+
+// RegistrationConfig tells who may create an account
+type RegistrationConfig struct {
+	Mode           string   `json:"mode"` // open, invite or closed
+	AllowedDomains []string `json:"allowed_domains"`
+}
+
+// OIDCConfig tells whether single sign-on is enabled
+type OIDCConfig struct {
+	Enabled    bool   `json:"enabled"`
+	ButtonText string `json:"button_text"`
+}
+
+// Session holds the tokens of a login session
+type Session struct {
+	Token        string `json:"token"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int    `json:"expires_in"` // Seconds until the access token expires
+	User         User   `json:"user"`
+}
+
+// loginResponse is the response of the first login step
+type loginResponse struct {
+	Session
+	TwoFactorRequired bool   `json:"two_factor_required"`
+	ChallengeToken    string `json:"challenge_token"`
+}
+
+// ErrTwoFactorRequired is returned by Login when the account has two-factor
+// authentication enabled. Call LoginTwoFactor with the challenge.
+var ErrTwoFactorRequired = errors.New("two-factor code required")
+
+// Login signs in with a username and password. The client uses the session
+// from then on. For accounts with two-factor authentication it returns the
+// challenge token with ErrTwoFactorRequired.
+func (c *Client) Login(ctx context.Context, username, password string) (*Session, string, error) {
+	var resp loginResponse
+	err := c.do(ctx, &request{
+		method: http.MethodPost,
+		path:   "/api/auth/login",
+		body:   map[string]string{"username": username, "password": password},
+	}, &resp)
+	if err != nil {
+		return nil, "", err
+	}
+	if resp.TwoFactorRequired {
+		return nil, resp.ChallengeToken, ErrTwoFactorRequired
+	}
+	c.useSession(&resp.Session)
+	return &resp.Session, "", nil
+}
+
+// LoginTwoFactor completes a login with a TOTP or recovery code
+func (c *Client) LoginTwoFactor(ctx context.Context, challengeToken, code string) (*Session, error) {
+	var s Session
+	err := c.do(ctx, &request{
+		method: http.MethodPost,
+		path:   "/api/auth/login/2fa",
+		body:   map[string]string{"challenge_token": challengeToken, "code": code},
+	}, &s)
+	if err != nil {
+		return nil, err
+	}
+	c.useSession(&s)
+	return &s, nil
+}
+
+// RegistrationConfig returns who may create an account
+func (c *Client) RegistrationConfig(ctx context.Context) (*RegistrationConfig, error) {
+	var rc RegistrationConfig
+	err := c.do(ctx, &request{method: http.MethodGet, path: "/api/auth/registration", public: true}, &rc)
+	if err != nil {
+		return nil, err
+	}
+	return &rc, nil
+}
+
+// Register creates an account and signs in to it. The client uses the
+// session from then on.
+func (c *Client) Register(ctx context.Context, req RegisterRequest) (*Session, error) {
+	var s Session
+	err := c.do(ctx, &request{method: http.MethodPost, path: "/api/auth/register", body: req, public: true}, &s)
+	if err != nil {
+		return nil, err
+	}
+	c.useSession(&s)
+	return &s, nil
+}
+
+// Refresh replaces the access token of the session, as is done when it
+// expires, and returns the new tokens
+func (c *Client) Refresh(ctx context.Context) (*Session, error) {
+	if err := c.refresh(ctx, c.Token()); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return &Session{Token: c.token, RefreshToken: c.refreshToken}, nil
+}
+
+// ForgotPassword emails a password reset link to the account with the
+// username or email address. The server answers the same whether or not
+// the account exists.
+func (c *Client) ForgotPassword(ctx context.Context, login string) error {
+	return c.do(ctx, &request{
+		method: http.MethodPost,
+		path:   "/api/auth/password/forgot",
+		body:   map[string]string{"login": login},
+		public: true,
+	}, nil)
+}
+
+// ResetPassword sets a new password with the token of a reset link
+func (c *Client) ResetPassword(ctx context.Context, token, newPassword string) error {
+	return c.do(ctx, &request{
+		method: http.MethodPost,
+		path:   "/api/auth/password/reset",
+		body:   map[string]string{"token": token, "new_password": newPassword},
+		public: true,
+	}, nil)
+}
+
+// VerifyEmail confirms an email address with the token of a verification
+// link, and returns the address
+func (c *Client) VerifyEmail(ctx context.Context, token string) (string, error) {
+	var resp struct {
+		Email string `json:"email"`
+	}
+	err := c.do(ctx, &request{
+		method: http.MethodPost,
+		path:   "/api/auth/email/verify",
+		body:   map[string]string{"token": token},
+		public: true,
+	}, &resp)
+	if err != nil {
+		return "", err
+	}
+	return resp.Email, nil
+}
+
+// OIDCConfig returns whether single sign-on is enabled
+func (c *Client) OIDCConfig(ctx context.Context) (*OIDCConfig, error) {
+	var oc OIDCConfig
+	err := c.do(ctx, &request{method: http.MethodGet, path: "/api/auth/oidc/config", public: true}, &oc)
+	if err != nil {
+		return nil, err
+	}
+	return &oc, nil
+}
+
+// OIDCLoginURL returns the page that starts a single sign-on login in a
+// browser, which ends up signed in to the web UI
+func (c *Client) OIDCLoginURL() string {
+	return c.baseURL + "/api/auth/oidc/login"
+}
+
+// Logout ends the login session
+func (c *Client) Logout(ctx context.Context) error {
+	err := c.do(ctx, &request{method: http.MethodPost, path: "/api/auth/logout"}, nil)
+	if err != nil {
+		return err
+	}
+	c.SetSession("", "", nil)
+	return nil
+}
+
+// Me returns the current user
+func (c *Client) Me(ctx context.Context) (*User, error) {
+	var u User
+	err := c.do(ctx, &request{method: http.MethodGet, path: "/api/auth/me"}, &u)
+	if err != nil {
+		return nil, err
+	}
+	return &u, nil
+}
+
+func (c *Client) useSession(s *Session) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.token = s.Token
+	c.refreshToken = s.RefreshToken
+}
+
+// refresh replaces the expired access token of the session. Refresh tokens
+// are single use, so concurrent requests refresh only once.
+func (c *Client) refresh(ctx context.Context, expired string) error {
+	c.refreshMu.Lock()
+	defer c.refreshMu.Unlock()
+
+	c.mu.Lock()
+	token, refreshToken := c.token, c.refreshToken
+	c.mu.Unlock()
+	if token != expired {
+		// Another request refreshed the session meanwhile
+		return nil
+	}
+
+	var s Session
+	err := c.do(ctx, &request{
+		method:    http.MethodPost,
+		path:      "/api/auth/refresh",
+		body:      map[string]string{"refresh_token": refreshToken},
+		noRefresh: true,
+	}, &s)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	c.token = s.Token
+	c.refreshToken = s.RefreshToken
+	onRefresh := c.onRefresh
+	c.mu.Unlock()
+	if onRefresh != nil {
+		onRefresh(s.Token, s.RefreshToken)
+	}
+	return nil
+}
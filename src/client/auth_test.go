@@ -0,0 +1,69 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"gopan-server/client"
+	"testing"
+)
+
+func TestLogin(t *testing.T) {
+	s := newTestServer(t)
+	s.newClient(t, "alice")
+	c := client.New(s.URL)
+	ctx := context.Background()
+
+	if _, _, err := c.Login(ctx, "alice", "wrong"); !errors.Is(err, client.ErrUnauthorized) {
+		t.Errorf("wrong password: err = %v, want ErrUnauthorized", err)
+	}
+	session, _, err := c.Login(ctx, "alice", "alice-password")
+	if err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+	if session.Token == "" || session.RefreshToken == "" || session.User.Username != "alice" || c.Token() != session.Token {
+		t.Errorf("session = %+v", session)
+	}
+	u, err := c.Me(ctx)
+	if err != nil || u.Username != "alice" || u.AuthSource != "local" {
+		t.Errorf("Me = %+v, %v", u, err)
+	}
+
+	if err := c.Logout(ctx); err != nil {
+		t.Fatalf("Logout: %v", err)
+	}
+	if _, err := c.Me(ctx); !errors.Is(err, client.ErrUnauthorized) {
+		t.Errorf("Me after logging out: err = %v, want ErrUnauthorized", err)
+	}
+}
+
+func TestSessionRefresh(t *testing.T) {
+	s := newTestServer(t)
+	session, _, err := s.newClient(t, "alice").Login(context.Background(), "alice", "alice-password")
+	if err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+
+	// An expired access token is replaced before the request is sent again
+	var saved []string
+	c := client.New(s.URL)
+	c.SetSession("expired", session.RefreshToken, func(token, refreshToken string) {
+		saved = append(saved, token, refreshToken)
+	})
+	u, err := c.Me(context.Background())
+	if err != nil {
+		t.Fatalf("Me with an expired access token: %v", err)
+	}
+	if u.Username != "alice" {
+		t.Errorf("Me = %+v", u)
+	}
+	if len(saved) != 2 || saved[0] != c.Token() || saved[1] == session.RefreshToken {
+		t.Errorf("onRefresh got %v, want the new tokens", saved)
+	}
+
+	// Refresh tokens are single use
+	stale := client.New(s.URL)
+	stale.SetSession("expired", session.RefreshToken, nil)
+	if _, err := stale.Me(context.Background()); !errors.Is(err, client.ErrUnauthorized) {
+		t.Errorf("Me after the refresh token was used: err = %v, want ErrUnauthorized", err)
+	}
+}
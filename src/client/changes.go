@@ -0,0 +1,73 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Change kinds
+const (
+	ChangeCreate  = "create"
+	ChangeUpdate  = "update"
+	ChangeMove    = "move"
+	ChangeRename  = "rename"
+	ChangeTrash   = "trash"
+	ChangeRestore = "restore"
+	ChangePurge   = "purge" // Deleted for good
+)
+
+// Change is an entry of the change journal of the current user
+type Change struct {
+	Seq       int64     `json:"seq"`
+	Kind      string    `json:"kind"`
+	NodeID    int       `json:"node_id"`
+	ParentID  *int      `json:"parent_id"` // Nil at the top level
+	Name      string    `json:"name"`
+	Type      int       `json:"type"`
+	Size      int64     `json:"size"`
+	FileHash  string    `json:"file_hash"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ChangePage is a page of the change journal
+type ChangePage struct {
+	Changes []Change `json:"changes"`
+	Cursor  string   `json:"cursor"` // Pass to Changes for the next page
+	HasMore bool     `json:"has_more"`
+}
+
+// LatestCursor returns the cursor at the end of the change journal. Take it
+// before listing all files, then follow the changes from there.
+func (c *Client) LatestCursor(ctx context.Context) (string, error) {
+	page, err := c.Changes(ctx, "", 0, 0)
+	if err != nil {
+		return "", err
+	}
+	return page.Cursor, nil
+}
+
+// Changes returns up to limit changes (0 for the server default) following
+// cursor. When there are none yet it waits up to wait for some. A cursor
+// too old for the journal fails with ErrGone, after which all files have to
+// be listed again.
+func (c *Client) Changes(ctx context.Context, cursor string, limit int, wait time.Duration) (*ChangePage, error) {
+	query := url.Values{}
+	if cursor != "" {
+		query.Set("cursor", cursor)
+	}
+	if limit > 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+	if wait > 0 {
+		query.Set("timeout", strconv.Itoa(int(wait.Seconds())))
+	}
+	var page ChangePage
+	err := c.do(ctx, &request{method: http.MethodGet, path: "/api/changes", query: query}, &page)
+	if err != nil {
+		return nil, err
+	}
+	return &page, nil
+}
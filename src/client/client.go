@@ -0,0 +1,231 @@
+// Package client is a Go client for the GoPan REST API, as used by the
+// gopan-cli command. It authenticates with a personal access token or a
+// login session, whose access token is refreshed when it expires.
+//
+// The routes of the API have methods taking a context, with typed requests
+// and responses, except for the ones only browsers use, such as the single
+// sign-on callback. Failed requests are retried as set by the
+// RetryPolicy, and error responses are returned as *Error, which matches
+// ErrNotFound and the other sentinel errors with errors.Is.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Client calls the API of a GoPan server
+type Client struct {
+	baseURL string
+	http    *http.Client
+
+	mu           sync.Mutex
+	refreshMu    sync.Mutex // Held while refreshing the session
+	token        string
+	refreshToken string
+	onRefresh    func(token, refreshToken string)
+	retry        RetryPolicy
+}
+
+// New returns a client for the server at baseURL, such as
+// https://pan.example.com
+func New(baseURL string) *Client {
+	return &Client{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		http:    http.DefaultClient,
+		retry:   DefaultRetryPolicy,
+	}
+}
+
+// SetHTTPClient sets the HTTP client used for requests
+func (c *Client) SetHTTPClient(hc *http.Client) {
+	c.http = hc
+}
+
+// SetToken sets the access token or personal access token sent with requests
+func (c *Client) SetToken(token string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.token = token
+}
+
+// SetSession sets the tokens of a login session. When the access token
+// expires it is refreshed, and onRefresh, if not nil, is called with the
+// new tokens so they can be saved.
+func (c *Client) SetSession(token, refreshToken string, onRefresh func(token, refreshToken string)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.token = token
+	c.refreshToken = refreshToken
+	c.onRefresh = onRefresh
+}
+
+// Token returns the access token sent with requests
+func (c *Client) Token() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.token
+}
+
+// request describes an API call
+type request struct {
+	method string
+	path   string // Already escaped
+	query  url.Values
+	header http.Header
+	body   any // Encoded as JSON unless an io.Reader
+	size   int64
+
+	noRefresh bool // Do not refresh the session on 401
+	noRetry   bool // Not safe to repeat despite the method
+	public    bool // Sent without the token, such as for shared links
+}
+
+// send performs a request and returns the response if it succeeded,
+// retrying as set by the retry policy
+func (c *Client) send(ctx context.Context, r *request) (*http.Response, error) {
+	policy := c.retryPolicy()
+	for n := 1; ; n++ {
+		resp, err := c.sendAuthorized(ctx, r)
+		if err == nil {
+			return resp, nil
+		}
+		delay, ok := policy.retryDelay(r, n, err)
+		if !ok {
+			return nil, err
+		}
+		if err := sleep(ctx, delay); err != nil {
+			return nil, err
+		}
+		if err := r.rewind(); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// sendAuthorized performs a request once. An expired access token is
+// refreshed once, which needs the body to be sent again, so only bodies
+// that can seek back are.
+func (c *Client) sendAuthorized(ctx context.Context, r *request) (*http.Response, error) {
+	token := ""
+	if !r.public {
+		token = c.Token()
+	}
+	resp, err := c.sendOnce(ctx, r, token)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusUnauthorized && c.canRefresh(r) {
+		resp.Body.Close()
+		if err := c.refresh(ctx, token); err != nil {
+			return nil, err
+		}
+		if err := r.rewind(); err != nil {
+			return nil, err
+		}
+		resp, err = c.sendOnce(ctx, r, c.Token())
+		if err != nil {
+			return nil, err
+		}
+	}
+	if resp.StatusCode >= 400 {
+		defer resp.Body.Close()
+		return nil, decodeError(resp)
+	}
+	return resp, nil
+}
+
+func (c *Client) canRefresh(r *request) bool {
+	if r.noRefresh || r.public || !r.replayable() {
+		return false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.refreshToken != ""
+}
+
+func (c *Client) sendOnce(ctx context.Context, r *request, token string) (*http.Response, error) {
+	u := c.baseURL + r.path
+	if len(r.query) > 0 {
+		u += "?" + r.query.Encode()
+	}
+
+	var body io.Reader
+	contentType := ""
+	switch b := r.body.(type) {
+	case nil:
+	case io.Reader:
+		// Keep files open for a retry, the transport would close them
+		body = io.NopCloser(b)
+	default:
+		data, err := json.Marshal(b)
+		if err != nil {
+			return nil, err
+		}
+		body = bytes.NewReader(data)
+		contentType = "application/json"
+	}
+
+	req, err := http.NewRequestWithContext(ctx, r.method, u, body)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := r.body.(io.Reader); ok {
+		req.ContentLength = r.size
+		if r.size == 0 {
+			req.Body = http.NoBody
+		}
+	}
+	for k, v := range r.header {
+		req.Header[k] = v
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	return c.http.Do(req)
+}
+
+// do performs a request and decodes the JSON response into out, if not nil
+func (c *Client) do(ctx context.Context, r *request, out any) error {
+	resp, err := c.send(ctx, r)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if out == nil {
+		_, err = io.Copy(io.Discard, resp.Body)
+		return err
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// escapePath percent-encodes each element of a file path, which is made
+// absolute
+func escapePath(p string) string {
+	elems := strings.Split(strings.Trim(p, "/"), "/")
+	for i, e := range elems {
+		elems[i] = url.PathEscape(e)
+	}
+	return "/" + strings.Join(elems, "/")
+}
+
+// pageQuery sets the page parameters of a listing, leaving the server
+// defaults for zero values
+func pageQuery(query url.Values, page, pageSize int) {
+	if page > 0 {
+		query.Set("page", strconv.Itoa(page))
+	}
+	if pageSize > 0 {
+		query.Set("page_size", strconv.Itoa(pageSize))
+	}
+}
@@ -0,0 +1,119 @@
+package client_test
+
+import (
+	"context"
+	"gopan-server/client"
+	"gopan-server/config"
+	"gopan-server/internal/api"
+	"gopan-server/internal/dbtest"
+	"gopan-server/internal/storage/storagetest"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestMain(m *testing.M) {
+	gin.SetMode(gin.TestMode)
+	os.Exit(m.Run())
+}
+
+// fastRetries keeps the retry behavior but not the waiting
+var fastRetries = client.RetryPolicy{
+	MaxAttempts: 3,
+	MinDelay:    time.Millisecond,
+	MaxDelay:    10 * time.Millisecond,
+}
+
+// fault is a plain text error response, as a proxy sends, instead of the
+// API's
+type fault struct {
+	method, path string
+	status       int
+	retryAfter   int // Seconds, 0 for none
+	count        int // Requests left to fail
+}
+
+// testServer serves the API with an in-memory database and object store.
+// It counts requests and can fail them before they reach the API, as a
+// proxy in front of the server would.
+type testServer struct {
+	*httptest.Server
+	objects *storagetest.Server
+
+	mu       sync.Mutex
+	requests map[string]int // By method and path
+	faults   []*fault
+}
+
+func newTestServer(t *testing.T) *testServer {
+	t.Helper()
+	dbtest.Open(t)
+	objects, minioCfg := storagetest.Open(t)
+	cfg, err := config.Parse([]byte(`{"jwt": {"secret": "test"}}`))
+	if err != nil {
+		t.Fatalf("parse config: %v", err)
+	}
+	cfg.MinIO = minioCfg
+
+	s := &testServer{objects: objects, requests: map[string]int{}}
+	router := api.SetupRouter(cfg)
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if s.intercept(w, r) {
+			return
+		}
+		router.ServeHTTP(w, r)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// fail makes the next count requests of method to path fail with status
+func (s *testServer) fail(method, path string, status, count, retryAfter int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &fault{method, path, status, retryAfter, count})
+}
+
+// count returns how many requests of method to path were received
+func (s *testServer) count(method, path string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests[method+" "+path]
+}
+
+// intercept counts a request and answers it when it should fail
+func (s *testServer) intercept(w http.ResponseWriter, r *http.Request) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests[r.Method+" "+r.URL.Path]++
+	for _, f := range s.faults {
+		if f.count == 0 || f.method != r.Method || f.path != r.URL.Path {
+			continue
+		}
+		f.count--
+		if f.retryAfter > 0 {
+			w.Header().Set("Retry-After", strconv.Itoa(f.retryAfter))
+		}
+		http.Error(w, "proxy error", f.status)
+		return true
+	}
+	return false
+}
+
+// newClient registers a user and returns a client signed in as that user
+func (s *testServer) newClient(t *testing.T, username string) *client.Client {
+	t.Helper()
+	c := client.New(s.URL)
+	c.SetRetryPolicy(fastRetries)
+	_, err := c.Register(context.Background(), client.RegisterRequest{Username: username, Password: username + "-password"})
+	if err != nil {
+		t.Fatalf("register %s: %v", username, err)
+	}
+	return c
+}
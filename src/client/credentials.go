@@ -0,0 +1,203 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Access token scopes
+const (
+	ScopeFull   = "full"
+	ScopeRead   = "read"   // GET requests only
+	ScopeUpload = "upload" // Uploads only
+)
+
+// AccessToken is a personal access token, for scripts and other programs
+type AccessToken struct {
+	ID          int        `json:"id"`
+	Name        string     `json:"name"`
+	TokenPrefix string     `json:"token_prefix"` // Start of the token, to tell tokens apart
+	Scope       string     `json:"scope"`
+	FolderID    *int       `json:"folder_id"` // Folder the token is restricted to
+	ExpiresAt   *time.Time `json:"expires_at"`
+	LastUsedAt  *time.Time `json:"last_used_at"`
+	LastUsedIP  string     `json:"last_used_ip"`
+	CreatedAt   time.Time  `json:"created_at"`
+	Token       string     `json:"token"` // Only set by CreateToken, the token is not shown again
+}
+
+// CreateTokenRequest is a request for a personal access token
+type CreateTokenRequest struct {
+	Name      string     `json:"name"`
+	Scope     string     `json:"scope,omitempty"`     // Full access when empty
+	FolderID  *int       `json:"folder_id,omitempty"` // Restricts the token to a folder and its contents
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+// Identity is an account of an identity provider linked for single sign-on
+type Identity struct {
+	ID          int        `json:"id"`
+	Provider    string     `json:"provider"`
+	Issuer      string     `json:"issuer"`
+	Subject     string     `json:"subject"`
+	Email       string     `json:"email"`
+	CreatedAt   time.Time  `json:"created_at"`
+	LastLoginAt *time.Time `json:"last_login_at"`
+}
+
+// S3Key is an access key for the S3 compatible API
+type S3Key struct {
+	ID              int        `json:"id"`
+	Name            string     `json:"name"`
+	AccessKeyID     string     `json:"access_key_id"`
+	ReadOnly        bool       `json:"read_only"`
+	LastUsedAt      *time.Time `json:"last_used_at"`
+	LastUsedIP      string     `json:"last_used_ip"`
+	CreatedAt       time.Time  `json:"created_at"`
+	SecretAccessKey string     `json:"secret_access_key"` // Only set by CreateS3Key, the secret is not shown again
+}
+
+// S3Keys are the S3 access keys of a user, with where to use them
+type S3Keys struct {
+	Keys   []S3Key `json:"keys"`
+	Port   int     `json:"port"`
+	Region string  `json:"region"`
+}
+
+// SSHKey is a public key for signing in to the SFTP server
+type SSHKey struct {
+	ID          int        `json:"id"`
+	Name        string     `json:"name"`
+	Type        string     `json:"type"` // Such as ssh-ed25519
+	Fingerprint string     `json:"fingerprint"`
+	LastUsedAt  *time.Time `json:"last_used_at"`
+	LastUsedIP  string     `json:"last_used_ip"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// SSHKeys are the SSH keys of a user, with the port of the SFTP server
+type SSHKeys struct {
+	Keys []SSHKey `json:"keys"`
+	Port int      `json:"port"`
+}
+
+// Tokens returns the personal access tokens of the current user
+func (c *Client) Tokens(ctx context.Context) ([]AccessToken, error) {
+	var resp struct {
+		Tokens []AccessToken `json:"tokens"`
+	}
+	err := c.do(ctx, &request{method: http.MethodGet, path: "/api/user/tokens"}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Tokens, nil
+}
+
+// CreateToken creates a personal access token
+func (c *Client) CreateToken(ctx context.Context, req CreateTokenRequest) (*AccessToken, error) {
+	var t AccessToken
+	err := c.do(ctx, &request{method: http.MethodPost, path: "/api/user/tokens", body: req}, &t)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// DeleteToken revokes a personal access token
+func (c *Client) DeleteToken(ctx context.Context, id int) error {
+	return c.do(ctx, &request{method: http.MethodDelete, path: "/api/user/tokens/" + strconv.Itoa(id)}, nil)
+}
+
+// Identities returns the identities linked to the current user, and whether
+// single sign-on is enabled
+func (c *Client) Identities(ctx context.Context) ([]Identity, bool, error) {
+	var resp struct {
+		Identities  []Identity `json:"identities"`
+		OIDCEnabled bool       `json:"oidc_enabled"`
+	}
+	err := c.do(ctx, &request{method: http.MethodGet, path: "/api/user/identities"}, &resp)
+	if err != nil {
+		return nil, false, err
+	}
+	return resp.Identities, resp.OIDCEnabled, nil
+}
+
+// LinkIdentity starts linking an identity and returns the page of the
+// identity provider to open in a browser
+func (c *Client) LinkIdentity(ctx context.Context) (string, error) {
+	var resp struct {
+		URL string `json:"url"`
+	}
+	err := c.do(ctx, &request{method: http.MethodPost, path: "/api/user/identities/oidc"}, &resp)
+	if err != nil {
+		return "", err
+	}
+	return resp.URL, nil
+}
+
+// UnlinkIdentity unlinks an identity
+func (c *Client) UnlinkIdentity(ctx context.Context, id int) error {
+	return c.do(ctx, &request{method: http.MethodDelete, path: "/api/user/identities/" + strconv.Itoa(id)}, nil)
+}
+
+// S3Keys returns the S3 access keys of the current user. It fails with
+// ErrNotFound when the S3 API is disabled.
+func (c *Client) S3Keys(ctx context.Context) (*S3Keys, error) {
+	var keys S3Keys
+	err := c.do(ctx, &request{method: http.MethodGet, path: "/api/user/s3-keys"}, &keys)
+	if err != nil {
+		return nil, err
+	}
+	return &keys, nil
+}
+
+// CreateS3Key creates an S3 access key
+func (c *Client) CreateS3Key(ctx context.Context, name string, readOnly bool) (*S3Key, error) {
+	var k S3Key
+	err := c.do(ctx, &request{
+		method: http.MethodPost,
+		path:   "/api/user/s3-keys",
+		body:   map[string]any{"name": name, "read_only": readOnly},
+	}, &k)
+	if err != nil {
+		return nil, err
+	}
+	return &k, nil
+}
+
+// DeleteS3Key revokes an S3 access key
+func (c *Client) DeleteS3Key(ctx context.Context, id int) error {
+	return c.do(ctx, &request{method: http.MethodDelete, path: "/api/user/s3-keys/" + strconv.Itoa(id)}, nil)
+}
+
+// SSHKeys returns the SSH keys of the current user. It fails with
+// ErrNotFound when the SFTP server is disabled.
+func (c *Client) SSHKeys(ctx context.Context) (*SSHKeys, error) {
+	var keys SSHKeys
+	err := c.do(ctx, &request{method: http.MethodGet, path: "/api/user/ssh-keys"}, &keys)
+	if err != nil {
+		return nil, err
+	}
+	return &keys, nil
+}
+
+// AddSSHKey adds a public key in authorized_keys format
+func (c *Client) AddSSHKey(ctx context.Context, name, publicKey string) (*SSHKey, error) {
+	var k SSHKey
+	err := c.do(ctx, &request{
+		method: http.MethodPost,
+		path:   "/api/user/ssh-keys",
+		body:   map[string]string{"name": name, "public_key": publicKey},
+	}, &k)
+	if err != nil {
+		return nil, err
+	}
+	return &k, nil
+}
+
+// DeleteSSHKey removes an SSH key
+func (c *Client) DeleteSSHKey(ctx context.Context, id int) error {
+	return c.do(ctx, &request{method: http.MethodDelete, path: "/api/user/ssh-keys/" + strconv.Itoa(id)}, nil)
+}
@@ -0,0 +1,63 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"gopan-server/client"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestErrorResponse(t *testing.T) {
+	s := newTestServer(t)
+	c := s.newClient(t, "alice")
+	ctx := context.Background()
+
+	_, err := c.GetFile(ctx, 12345)
+	var e *client.Error
+	if !errors.As(err, &e) {
+		t.Fatalf("err = %v, want an *Error", err)
+	}
+	if !client.IsNotFound(err) || errors.Is(err, client.ErrForbidden) || errors.Is(err, client.ErrServer) {
+		t.Errorf("status %d matches the wrong sentinel errors", e.StatusCode)
+	}
+	if e.Code == "" || client.ErrorCode(err) != e.Code || e.Message == "" || e.RequestID == "" {
+		t.Errorf("error = %+v, want a code, message and request ID", e)
+	}
+
+	// Validation errors list the fields that failed
+	_, err = c.CreateFolder(ctx, 0, "")
+	if !errors.Is(err, client.ErrBadRequest) || client.ErrorCode(err) != "VALIDATION_FAILED" {
+		t.Errorf("folder without a name: err = %v, want VALIDATION_FAILED", err)
+	}
+	if errors.As(err, &e) && e.Details["fields"] == nil {
+		t.Errorf("details = %v, want the failed fields", e.Details)
+	}
+
+	if _, err := client.New(s.URL).Me(ctx); !errors.Is(err, client.ErrUnauthorized) {
+		t.Errorf("request without a token: err = %v, want ErrUnauthorized", err)
+	}
+	if client.ErrorCode(context.Canceled) != "" {
+		t.Error("ErrorCode of a non-API error is not empty")
+	}
+}
+
+func TestProxyErrorResponse(t *testing.T) {
+	s := newTestServer(t)
+	c := client.New(s.URL)
+	c.SetRetryPolicy(client.RetryPolicy{MaxAttempts: 1})
+	s.fail(http.MethodGet, "/api/auth/registration", http.StatusServiceUnavailable, 1, 30)
+
+	_, err := c.RegistrationConfig(context.Background())
+	var e *client.Error
+	if !errors.As(err, &e) {
+		t.Fatalf("err = %v, want an *Error", err)
+	}
+	if e.StatusCode != http.StatusServiceUnavailable || e.Code != "" || e.Message != "Service Unavailable" || e.RetryAfter != 30*time.Second {
+		t.Errorf("error = %+v", e)
+	}
+	if !errors.Is(err, client.ErrServer) {
+		t.Errorf("err = %v, want ErrServer", err)
+	}
+}
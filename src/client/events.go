@@ -0,0 +1,120 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Event types
+const (
+	EventChange = "change" // Data is a Change
+	EventShare  = "share"  // Data is a ShareEvent
+	EventQuota  = "quota"  // Data is a QuotaEvent
+	EventJob    = "job"    // Data is a JobEvent, sent to admins
+)
+
+// Event is a notification pushed by the server
+type Event struct {
+	Type string          `json:"type"`
+	Time time.Time       `json:"time"`
+	Data json.RawMessage `json:"data"`
+}
+
+// Decode decodes the data of the event into v, such as a *Change for
+// change events
+func (e *Event) Decode(v any) error {
+	return json.Unmarshal(e.Data, v)
+}
+
+// ShareEvent is the data of a share event: a share was accessed, with
+// Action set, or disabled, with Status set
+type ShareEvent struct {
+	ShareID int    `json:"share_id"`
+	Code    string `json:"code"`
+	Action  string `json:"action"` // view, list, download or preview
+	NodeID  int    `json:"node_id"`
+	IP      string `json:"ip"`
+	Status  string `json:"status"` // expired or exhausted
+}
+
+// QuotaEvent is the data of a quota event, sent when the storage in use is
+// close to or over the quota
+type QuotaEvent struct {
+	TotalUsed  int64 `json:"total_used"`
+	TotalQuota int64 `json:"total_quota"`
+	Exceeded   bool  `json:"exceeded"`
+}
+
+// JobEvent is the data of a job event, the progress of a background job
+type JobEvent struct {
+	Job      string `json:"job"`   // directory_sync
+	State    string `json:"state"` // running, done or failed
+	Updated  int    `json:"updated"`
+	Disabled int    `json:"disabled"`
+}
+
+// EventStream reads the events of the current user as they happen
+type EventStream struct {
+	body io.ReadCloser
+	r    *bufio.Reader
+}
+
+// Events subscribes to the events of the current user. Events that happen
+// while not subscribed are missed, so reconnecting clients follow the
+// change feed to catch up. The caller closes the stream.
+func (c *Client) Events(ctx context.Context) (*EventStream, error) {
+	resp, err := c.send(ctx, &request{
+		method: http.MethodGet,
+		path:   "/api/events",
+		header: http.Header{"Accept": {"text/event-stream"}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &EventStream{body: resp.Body, r: bufio.NewReader(resp.Body)}, nil
+}
+
+// Next waits for the next event. It returns io.EOF when the server ends the
+// stream, and the error of the context when it is done.
+func (s *EventStream) Next() (*Event, error) {
+	var data strings.Builder
+	for {
+		line, err := s.r.ReadString('\n')
+		if err != nil {
+			if err == io.ErrUnexpectedEOF {
+				err = io.EOF
+			}
+			return nil, err
+		}
+		line = strings.TrimRight(line, "\r\n")
+
+		switch {
+		case line == "":
+			// A blank line ends an event; comments and the retry
+			// interval come without data
+			if data.Len() == 0 {
+				continue
+			}
+			var e Event
+			if err := json.Unmarshal([]byte(data.String()), &e); err != nil {
+				return nil, err
+			}
+			return &e, nil
+		case strings.HasPrefix(line, "data:"):
+			if data.Len() > 0 {
+				data.WriteByte('\n')
+			}
+			data.WriteString(strings.TrimPrefix(strings.TrimPrefix(line, "data:"), " "))
+		}
+	}
+}
+
+// Close ends the subscription
+func (s *EventStream) Close() error {
+	return s.body.Close()
+}
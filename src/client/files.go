@@ -0,0 +1,321 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// File is a file or folder found by ID. Responses that change a file set
+// only some of the fields, such as the ID and name.
+type File struct {
+	ID          int       `json:"id"`
+	Name        string    `json:"name"`
+	Type        int       `json:"type"`
+	Size        int64     `json:"size"`
+	MimeType    string    `json:"mime_type"`
+	ParentID    *int      `json:"parent_id"` // Nil at the top level
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	QuickUpload bool      `json:"quick_upload"` // Created from content the server stored already
+}
+
+// IsFolder reports whether f is a folder
+func (f *File) IsFolder() bool {
+	return f.Type == TypeFolder
+}
+
+// FileList is a page of a folder listing
+type FileList struct {
+	Files    []File `json:"files"`
+	Total    int    `json:"total"`
+	Page     int    `json:"page"`
+	PageSize int    `json:"page_size"`
+}
+
+// ListOptions select a page of a folder listing. Zero values leave the
+// server defaults: the first page of 50, ordered by name.
+type ListOptions struct {
+	Page     int
+	PageSize int
+	SortBy   string // name, size or updated_at
+	Desc     bool
+}
+
+// FolderTree is a folder with its subfolders
+type FolderTree struct {
+	ID       int          `json:"id"`
+	Name     string       `json:"name"`
+	Children []FolderTree `json:"children"`
+}
+
+// TrashedNode is a file or folder in the trash
+type TrashedNode struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	Type      int       `json:"type"`
+	Size      int64     `json:"size"`
+	DeletedAt time.Time `json:"deleted_at"`
+}
+
+// parentParam returns the parent_id parameter for a folder ID, where 0 is
+// the top level
+func parentParam(parentID int) string {
+	if parentID == 0 {
+		return ""
+	}
+	return strconv.Itoa(parentID)
+}
+
+// idParams returns IDs as the strings the server expects
+func idParams(ids []int) []string {
+	strIDs := make([]string, len(ids))
+	for i, id := range ids {
+		strIDs[i] = strconv.Itoa(id)
+	}
+	return strIDs
+}
+
+// ListFiles returns a page of the contents of a folder (0 for the top
+// level)
+func (c *Client) ListFiles(ctx context.Context, parentID int, opts ListOptions) (*FileList, error) {
+	query := url.Values{}
+	if parentID != 0 {
+		query.Set("parent_id", strconv.Itoa(parentID))
+	}
+	pageQuery(query, opts.Page, opts.PageSize)
+	if opts.SortBy != "" {
+		query.Set("sort_by", opts.SortBy)
+	}
+	if opts.Desc {
+		query.Set("order", "desc")
+	}
+	var list FileList
+	err := c.do(ctx, &request{method: http.MethodGet, path: "/api/files", query: query}, &list)
+	if err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+// GetFile returns a file or folder
+func (c *Client) GetFile(ctx context.Context, id int) (*File, error) {
+	var f File
+	err := c.do(ctx, &request{method: http.MethodGet, path: "/api/files/" + strconv.Itoa(id)}, &f)
+	if err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
+
+// UploadFile uploads the content read from r as a new file in a folder (0
+// for the top level). The content is streamed, so unlike Upload of a file
+// by path, the upload is neither retried nor sent again after the session
+// is refreshed.
+func (c *Client) UploadFile(ctx context.Context, parentID int, name string, r io.Reader) (*File, error) {
+	pr, pw := io.Pipe()
+	mw := multipart.NewWriter(pw)
+	go func() {
+		err := mw.WriteField("parent_id", parentParam(parentID))
+		if err == nil {
+			var part io.Writer
+			part, err = mw.CreateFormFile("file", name)
+			if err == nil {
+				_, err = io.Copy(part, r)
+			}
+		}
+		if err == nil {
+			err = mw.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+	defer pr.Close()
+
+	var f File
+	err := c.do(ctx, &request{
+		method: http.MethodPost,
+		path:   "/api/files/upload",
+		header: http.Header{"Content-Type": {mw.FormDataContentType()}},
+		body:   pr,
+		size:   -1,
+	}, &f)
+	if err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
+
+// QuickUpload creates a file in a folder (0 for the top level) from content
+// the server stores already, without sending it. It fails with a 404 Error
+// when the server does not have the content.
+func (c *Client) QuickUpload(ctx context.Context, parentID int, name, hash string, size int64) (*File, error) {
+	var f File
+	err := c.do(ctx, &request{
+		method: http.MethodPost,
+		path:   "/api/files/quick-upload",
+		body: map[string]any{
+			"hash":      hash,
+			"name":      name,
+			"size":      size,
+			"parent_id": parentParam(parentID),
+		},
+	}, &f)
+	if err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
+
+// CreateFolder creates a folder in a folder (0 for the top level)
+func (c *Client) CreateFolder(ctx context.Context, parentID int, name string) (*File, error) {
+	var f File
+	err := c.do(ctx, &request{
+		method: http.MethodPost,
+		path:   "/api/files/folder",
+		body:   map[string]string{"name": name, "parent_id": parentParam(parentID)},
+	}, &f)
+	if err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
+
+// FolderTree returns all folders, nested from the top level down
+func (c *Client) FolderTree(ctx context.Context) ([]FolderTree, error) {
+	var resp struct {
+		Tree []FolderTree `json:"tree"`
+	}
+	err := c.do(ctx, &request{method: http.MethodGet, path: "/api/files/tree"}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Tree, nil
+}
+
+// DownloadFile returns the content of a file. The caller closes the reader.
+func (c *Client) DownloadFile(ctx context.Context, id int) (io.ReadCloser, error) {
+	return c.stream(ctx, &request{method: http.MethodGet, path: "/api/files/" + strconv.Itoa(id) + "/download"})
+}
+
+// OpenFile returns the content of a file as served for previews, without
+// counting as a download. The caller closes the reader.
+func (c *Client) OpenFile(ctx context.Context, id int) (io.ReadCloser, error) {
+	return c.stream(ctx, &request{method: http.MethodGet, path: "/api/files/" + strconv.Itoa(id) + "/proxy"})
+}
+
+// RenameFile renames a file or folder
+func (c *Client) RenameFile(ctx context.Context, id int, name string) (*File, error) {
+	var f File
+	err := c.do(ctx, &request{
+		method: http.MethodPut,
+		path:   "/api/files/" + strconv.Itoa(id),
+		body:   map[string]string{"name": name},
+	}, &f)
+	if err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
+
+// MoveFiles moves files and folders into a folder (0 for the top level).
+// Files that cannot be moved are left out of the result.
+func (c *Client) MoveFiles(ctx context.Context, ids []int, parentID int) ([]File, error) {
+	var resp struct {
+		Moved []File `json:"moved"`
+	}
+	err := c.do(ctx, &request{
+		method: http.MethodPut,
+		path:   "/api/files/move",
+		body:   map[string]any{"ids": idParams(ids), "parent_id": parentParam(parentID)},
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Moved, nil
+}
+
+// Copy copies files into a folder (0 for the top level). Names that exist
+// get a number appended. Folders are copied without their contents.
+func (c *Client) Copy(ctx context.Context, ids []int, parentID int) ([]File, error) {
+	var resp struct {
+		Copied []File `json:"copied"`
+	}
+	err := c.do(ctx, &request{
+		method:  http.MethodPut,
+		path:    "/api/files/copy",
+		body:    map[string]any{"ids": idParams(ids), "parent_id": parentParam(parentID)},
+		noRetry: true,
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Copied, nil
+}
+
+// DeleteFile moves a file or folder to the trash
+func (c *Client) DeleteFile(ctx context.Context, id int) error {
+	return c.do(ctx, &request{method: http.MethodDelete, path: "/api/files/" + strconv.Itoa(id)}, nil)
+}
+
+// SearchFiles returns the files and folders whose name contains query.
+// fileType limits the results to "file" or "folder" unless empty.
+func (c *Client) SearchFiles(ctx context.Context, query, fileType string) ([]File, error) {
+	params := url.Values{"q": {query}}
+	if fileType != "" {
+		params.Set("type", fileType)
+	}
+	var resp struct {
+		Files []File `json:"files"`
+	}
+	err := c.do(ctx, &request{method: http.MethodGet, path: "/api/files/search", query: params}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Files, nil
+}
+
+// Trash returns the files and folders in the trash, most recently deleted
+// first
+func (c *Client) Trash(ctx context.Context) ([]TrashedNode, error) {
+	var resp struct {
+		Files []TrashedNode `json:"files"`
+	}
+	err := c.do(ctx, &request{method: http.MethodGet, path: "/api/files/trash"}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Files, nil
+}
+
+// Restore moves a file or folder back out of the trash
+func (c *Client) Restore(ctx context.Context, id int) error {
+	return c.do(ctx, &request{
+		method: http.MethodPost,
+		path:   "/api/files/restore",
+		body:   map[string]string{"id": strconv.Itoa(id)},
+	}, nil)
+}
+
+// DeletePermanently deletes a file or folder in the trash for good
+func (c *Client) DeletePermanently(ctx context.Context, id int) error {
+	return c.do(ctx, &request{method: http.MethodDelete, path: "/api/files/trash/" + strconv.Itoa(id)}, nil)
+}
+
+// stream performs a request and returns the response body, which the
+// caller closes
+func (c *Client) stream(ctx context.Context, r *request) (io.ReadCloser, error) {
+	resp, err := c.send(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusPartialContent {
+		resp.Body.Close()
+		return nil, fmt.Errorf("unexpected response %s", resp.Status)
+	}
+	return resp.Body, nil
+}
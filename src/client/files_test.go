@@ -0,0 +1,238 @@
+package client_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"gopan-server/client"
+	"io"
+	"strings"
+	"testing"
+)
+
+// readAll reads and closes a download, returning the error in its place
+func readAll(body io.ReadCloser, err error) string {
+	if err != nil {
+		return "error: " + err.Error()
+	}
+	defer body.Close()
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return "error: " + err.Error()
+	}
+	return string(data)
+}
+
+func TestFiles(t *testing.T) {
+	s := newTestServer(t)
+	c := s.newClient(t, "alice")
+	ctx := context.Background()
+
+	folder, err := c.CreateFolder(ctx, 0, "docs")
+	if err != nil {
+		t.Fatalf("CreateFolder: %v", err)
+	}
+	f, err := c.UploadFile(ctx, folder.ID, "notes.txt", strings.NewReader("some notes"))
+	if err != nil {
+		t.Fatalf("UploadFile: %v", err)
+	}
+	if f.Name != "notes.txt" || f.Size != 10 {
+		t.Errorf("uploaded file = %+v", f)
+	}
+
+	list, err := c.ListFiles(ctx, folder.ID, client.ListOptions{})
+	if err != nil {
+		t.Fatalf("ListFiles: %v", err)
+	}
+	if list.Total != 1 || len(list.Files) != 1 || list.Files[0].ID != f.ID {
+		t.Errorf("listing = %+v, want the uploaded file", list)
+	}
+	if got := readAll(c.DownloadFile(ctx, f.ID)); got != "some notes" {
+		t.Errorf("DownloadFile = %q", got)
+	}
+	if got := readAll(c.OpenFile(ctx, f.ID)); got != "some notes" {
+		t.Errorf("OpenFile = %q", got)
+	}
+
+	// Content the server has is not sent again
+	sum := sha256.Sum256([]byte("some notes"))
+	copied, err := c.QuickUpload(ctx, 0, "copy.txt", hex.EncodeToString(sum[:]), 10)
+	if err != nil || !copied.QuickUpload {
+		t.Errorf("QuickUpload = %+v, %v", copied, err)
+	}
+	sum = sha256.Sum256([]byte("unknown"))
+	if _, err := c.QuickUpload(ctx, 0, "unknown.txt", hex.EncodeToString(sum[:]), 7); !client.IsNotFound(err) {
+		t.Errorf("QuickUpload of unknown content: err = %v, want ErrNotFound", err)
+	}
+
+	if _, err := c.RenameFile(ctx, f.ID, "renamed.txt"); err != nil {
+		t.Fatalf("RenameFile: %v", err)
+	}
+	moved, err := c.MoveFiles(ctx, []int{f.ID}, 0)
+	if err != nil || len(moved) != 1 {
+		t.Fatalf("MoveFiles = %v, %v", moved, err)
+	}
+	found, err := c.SearchFiles(ctx, "renamed", "file")
+	if err != nil || len(found) != 1 || found[0].ID != f.ID {
+		t.Errorf("SearchFiles = %v, %v", found, err)
+	}
+	tree, err := c.FolderTree(ctx)
+	if err != nil || len(tree) != 1 || tree[0].Name != "docs" {
+		t.Errorf("FolderTree = %v, %v", tree, err)
+	}
+
+	if err := c.DeleteFile(ctx, f.ID); err != nil {
+		t.Fatalf("DeleteFile: %v", err)
+	}
+	trash, err := c.Trash(ctx)
+	if err != nil || len(trash) != 1 || trash[0].ID != f.ID {
+		t.Fatalf("Trash = %v, %v", trash, err)
+	}
+	if err := c.Restore(ctx, f.ID); err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+	if _, err := c.GetFile(ctx, f.ID); err != nil {
+		t.Errorf("GetFile after restoring: %v", err)
+	}
+
+	if err := c.DeleteFile(ctx, f.ID); err != nil {
+		t.Fatalf("DeleteFile: %v", err)
+	}
+	if err := c.DeletePermanently(ctx, f.ID); err != nil {
+		t.Fatalf("DeletePermanently: %v", err)
+	}
+	if _, err := c.GetFile(ctx, f.ID); !client.IsNotFound(err) {
+		t.Errorf("GetFile after deleting: err = %v, want ErrNotFound", err)
+	}
+}
+
+func TestMoveIntoOwnSubtree(t *testing.T) {
+	s := newTestServer(t)
+	c := s.newClient(t, "alice")
+	ctx := context.Background()
+
+	parent, err := c.Mkdir(ctx, "/a")
+	if err != nil {
+		t.Fatalf("Mkdir: %v", err)
+	}
+	child, err := c.Mkdir(ctx, "/a/b/c")
+	if err != nil {
+		t.Fatalf("Mkdir: %v", err)
+	}
+
+	for _, target := range []int{parent.ID, child.ID} {
+		moved, err := c.MoveFiles(ctx, []int{parent.ID}, target)
+		if err != nil || len(moved) != 0 {
+			t.Errorf("moving a folder into folder %d = %v, %v, want nothing moved", target, moved, err)
+		}
+	}
+	if n, err := c.Stat(ctx, "/a/b/c"); err != nil || n.ID != child.ID {
+		t.Errorf("Stat after the rejected moves = %+v, %v", n, err)
+	}
+}
+
+func TestUploadAndDownloadByPath(t *testing.T) {
+	s := newTestServer(t)
+	c := s.newClient(t, "alice")
+	ctx := context.Background()
+
+	n, err := c.Upload(ctx, "/docs/2024/report.txt", strings.NewReader("0123456789"), 10, true)
+	if err != nil {
+		t.Fatalf("Upload: %v", err)
+	}
+	if n.Path != "/docs/2024/report.txt" || n.Size != 10 || n.FileHash == "" {
+		t.Errorf("uploaded node = %+v", n)
+	}
+	if got := readAll(c.Download(ctx, "/docs/2024/report.txt", 0)); got != "0123456789" {
+		t.Errorf("Download = %q", got)
+	}
+	if got := readAll(c.Download(ctx, "/docs/2024/report.txt", 4)); got != "456789" {
+		t.Errorf("Download from offset 4 = %q", got)
+	}
+
+	// Replacing requires overwrite
+	_, err = c.Upload(ctx, "/docs/2024/report.txt", strings.NewReader("new"), 3, false)
+	if !errors.Is(err, client.ErrConflict) {
+		t.Errorf("Upload without overwrite: err = %v, want ErrConflict", err)
+	}
+	if _, err := c.Upload(ctx, "/docs/2024/report.txt", strings.NewReader("new"), 3, true); err != nil {
+		t.Fatalf("Upload with overwrite: %v", err)
+	}
+	if got := readAll(c.Download(ctx, "/docs/2024/report.txt", 0)); got != "new" {
+		t.Errorf("Download after overwrite = %q", got)
+	}
+
+	if _, err := c.Mkdir(ctx, "/docs/empty"); err != nil {
+		t.Fatalf("Mkdir: %v", err)
+	}
+	if _, err := c.Move(ctx, "/docs/2024/report.txt", "/docs/empty/final.txt"); err != nil {
+		t.Fatalf("Move: %v", err)
+	}
+	var paths []string
+	err = c.Walk(ctx, "/docs", func(n *client.Node) error {
+		paths = append(paths, n.Path)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Walk: %v", err)
+	}
+	if got := strings.Join(paths, " "); got != "/docs /docs/2024 /docs/empty /docs/empty/final.txt" {
+		t.Errorf("Walk visited %s", got)
+	}
+
+	if err := c.Delete(ctx, "/docs/empty"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, err := c.Stat(ctx, "/docs/empty/final.txt"); !client.IsNotFound(err) {
+		t.Errorf("Stat in a deleted folder: err = %v, want ErrNotFound", err)
+	}
+	if _, err := c.Download(ctx, "/missing.txt", 0); !client.IsNotFound(err) {
+		t.Errorf("Download of a missing file: err = %v, want ErrNotFound", err)
+	}
+}
+
+func TestShares(t *testing.T) {
+	s := newTestServer(t)
+	c := s.newClient(t, "alice")
+	ctx := context.Background()
+
+	n, err := c.Upload(ctx, "/shared.txt", strings.NewReader("shared content"), 14, true)
+	if err != nil {
+		t.Fatalf("Upload: %v", err)
+	}
+	share, err := c.CreateShare(ctx, n.ID, client.ShareOptions{Password: "letmein"})
+	if err != nil {
+		t.Fatalf("CreateShare: %v", err)
+	}
+	if len(share.Code) < 8 || !share.HasPassword {
+		t.Errorf("share = %+v", share)
+	}
+
+	// Anyone with the link, signed in or not
+	anonymous := client.New(s.URL)
+	if _, err := anonymous.PublicShare(ctx, share.Code, ""); !errors.Is(err, client.ErrUnauthorized) {
+		t.Errorf("PublicShare without the password: err = %v, want ErrUnauthorized", err)
+	}
+	public, err := anonymous.PublicShare(ctx, share.Code, "letmein")
+	if err != nil {
+		t.Fatalf("PublicShare: %v", err)
+	}
+	if public.Node.Name != "shared.txt" {
+		t.Errorf("shared node = %+v", public.Node)
+	}
+	if got := readAll(anonymous.DownloadShare(ctx, share.Code, 0, "letmein")); got != "shared content" {
+		t.Errorf("DownloadShare = %q", got)
+	}
+
+	shares, err := c.Shares(ctx)
+	if err != nil || len(shares) != 1 || shares[0].Node == nil || shares[0].Node.ID != n.ID {
+		t.Fatalf("Shares = %v, %v", shares, err)
+	}
+	if err := c.DeleteShare(ctx, share.ID); err != nil {
+		t.Fatalf("DeleteShare: %v", err)
+	}
+	if _, err := anonymous.PublicShare(ctx, share.Code, "letmein"); !client.IsNotFound(err) {
+		t.Errorf("PublicShare after deleting: err = %v, want ErrNotFound", err)
+	}
+}
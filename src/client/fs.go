@@ -0,0 +1,153 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Node types
+const (
+	TypeFolder = 0
+	TypeFile   = 1
+)
+
+// Node is a file or folder found by path
+type Node struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	Path      string    `json:"path"`
+	Type      int       `json:"type"`
+	Size      int64     `json:"size"`
+	MimeType  string    `json:"mime_type"`
+	FileHash  string    `json:"file_hash"` // SHA-256 of the content, hex encoded
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// IsFolder reports whether n is a folder
+func (n *Node) IsFolder() bool {
+	return n.Type == TypeFolder
+}
+
+// Stat returns the file or folder at path p
+func (c *Client) Stat(ctx context.Context, p string) (*Node, error) {
+	var n Node
+	err := c.do(ctx, &request{method: http.MethodGet, path: "/api/fs/stat" + escapePath(p)}, &n)
+	if err != nil {
+		return nil, err
+	}
+	return &n, nil
+}
+
+// List returns the contents of the folder at path p, ordered by name
+func (c *Client) List(ctx context.Context, p string) ([]*Node, error) {
+	var resp struct {
+		Files []*Node `json:"files"`
+	}
+	err := c.do(ctx, &request{method: http.MethodGet, path: "/api/fs/list" + escapePath(p)}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Files, nil
+}
+
+// Mkdir creates the folder at path p and missing parents, and returns it
+func (c *Client) Mkdir(ctx context.Context, p string) (*Node, error) {
+	var n Node
+	err := c.do(ctx, &request{method: http.MethodPost, path: "/api/fs/mkdir" + escapePath(p)}, &n)
+	if err != nil {
+		return nil, err
+	}
+	return &n, nil
+}
+
+// Move moves or renames the file or folder at path from to path to
+func (c *Client) Move(ctx context.Context, from, to string) (*Node, error) {
+	var n Node
+	err := c.do(ctx, &request{
+		method: http.MethodPost,
+		path:   "/api/fs/move" + escapePath(from),
+		body:   map[string]string{"to": to},
+	}, &n)
+	if err != nil {
+		return nil, err
+	}
+	return &n, nil
+}
+
+// Delete moves the file or folder at path p to the trash
+func (c *Client) Delete(ctx context.Context, p string) error {
+	return c.do(ctx, &request{method: http.MethodDelete, path: "/api/fs/delete" + escapePath(p)}, nil)
+}
+
+// Upload stores size bytes read from r as the file at path p, creating
+// missing parent folders. An existing file is replaced unless overwrite is
+// false. When r is an io.Seeker, such as a file, the upload is retried
+// after the session is refreshed.
+func (c *Client) Upload(ctx context.Context, p string, r io.Reader, size int64, overwrite bool) (*Node, error) {
+	query := url.Values{}
+	if !overwrite {
+		query.Set("overwrite", "false")
+	}
+	var n Node
+	err := c.do(ctx, &request{
+		method: http.MethodPut,
+		path:   "/api/fs/upload" + escapePath(p),
+		query:  query,
+		header: http.Header{"Content-Type": {"application/octet-stream"}},
+		body:   r,
+		size:   size,
+	}, &n)
+	if err != nil {
+		return nil, err
+	}
+	return &n, nil
+}
+
+// Download returns the content of the file at path p from offset on. The
+// caller closes the reader.
+func (c *Client) Download(ctx context.Context, p string, offset int64) (io.ReadCloser, error) {
+	r := &request{method: http.MethodGet, path: "/api/fs/download" + escapePath(p)}
+	if offset > 0 {
+		r.header = http.Header{"Range": {fmt.Sprintf("bytes=%d-", offset)}}
+	}
+	resp, err := c.send(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	if offset > 0 && resp.StatusCode != http.StatusPartialContent {
+		resp.Body.Close()
+		return nil, fmt.Errorf("server ignored the range request")
+	}
+	return resp.Body, nil
+}
+
+// Walk calls fn for the file or folder at path p and everything in it,
+// folders before their contents. An error returned by fn stops the walk.
+func (c *Client) Walk(ctx context.Context, p string, fn func(n *Node) error) error {
+	n, err := c.Stat(ctx, p)
+	if err != nil {
+		return err
+	}
+	return c.walk(ctx, n, fn)
+}
+
+func (c *Client) walk(ctx context.Context, n *Node, fn func(n *Node) error) error {
+	if err := fn(n); err != nil || !n.IsFolder() {
+		return err
+	}
+	children, err := c.List(ctx, n.Path)
+	if err != nil {
+		return err
+	}
+	for _, child := range children {
+		if err := c.walk(ctx, child, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
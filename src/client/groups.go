@@ -0,0 +1,66 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Group is a group of users files can be shared with
+type Group struct {
+	ID          int       `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Owner       *UserRef  `json:"owner"`
+	Members     []UserRef `json:"members"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// Groups returns the groups the current user owns or belongs to
+func (c *Client) Groups(ctx context.Context) ([]Group, error) {
+	var resp struct {
+		Groups []Group `json:"groups"`
+	}
+	err := c.do(ctx, &request{method: http.MethodGet, path: "/api/groups"}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Groups, nil
+}
+
+// CreateGroup creates a group owned by the current user
+func (c *Client) CreateGroup(ctx context.Context, name, description string) (*Group, error) {
+	var g Group
+	err := c.do(ctx, &request{
+		method: http.MethodPost,
+		path:   "/api/groups",
+		body:   map[string]string{"name": name, "description": description},
+	}, &g)
+	if err != nil {
+		return nil, err
+	}
+	return &g, nil
+}
+
+// DeleteGroup deletes a group the current user owns
+func (c *Client) DeleteGroup(ctx context.Context, id int) error {
+	return c.do(ctx, &request{method: http.MethodDelete, path: "/api/groups/" + strconv.Itoa(id)}, nil)
+}
+
+// AddGroupMember adds a user, by username, to a group
+func (c *Client) AddGroupMember(ctx context.Context, id int, username string) error {
+	return c.do(ctx, &request{
+		method: http.MethodPost,
+		path:   "/api/groups/" + strconv.Itoa(id) + "/members",
+		body:   map[string]string{"username": username},
+	}, nil)
+}
+
+// RemoveGroupMember removes a user from a group
+func (c *Client) RemoveGroupMember(ctx context.Context, id, userID int) error {
+	return c.do(ctx, &request{
+		method: http.MethodDelete,
+		path:   "/api/groups/" + strconv.Itoa(id) + "/members/" + strconv.Itoa(userID),
+	}, nil)
+}
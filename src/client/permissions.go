@@ -0,0 +1,116 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Permission roles
+const (
+	RoleRead  = "read"  // View and download
+	RoleWrite = "write" // Also upload, rename and delete
+)
+
+// UserRef names a user
+type UserRef struct {
+	ID       int    `json:"id"`
+	Username string `json:"username"`
+}
+
+// GroupRef names a group
+type GroupRef struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// Permission is access to a file or folder granted to a user or group
+type Permission struct {
+	ID        int       `json:"id"`
+	Role      string    `json:"role"`
+	User      *UserRef  `json:"user"`  // Set when granted to a user
+	Group     *GroupRef `json:"group"` // Set when granted to a group
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// GrantPermissionRequest grants access to a user, by username, or to a
+// group
+type GrantPermissionRequest struct {
+	Username string `json:"username,omitempty"`
+	GroupID  int    `json:"group_id,omitempty"`
+	Role     string `json:"role"`
+}
+
+// SharedFile is a file or folder another user shared with the current user
+type SharedFile struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	Type      int       `json:"type"`
+	Size      int64     `json:"size"`
+	MimeType  string    `json:"mime_type"`
+	Role      string    `json:"role"` // The strongest role granted
+	Owner     *UserRef  `json:"owner"`
+	Group     *GroupRef `json:"group"` // Set when shared through a group
+	SharedAt  time.Time `json:"shared_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Permissions returns the users and groups a file or folder is shared with
+func (c *Client) Permissions(ctx context.Context, nodeID int) ([]Permission, error) {
+	var resp struct {
+		Permissions []Permission `json:"permissions"`
+	}
+	err := c.do(ctx, &request{method: http.MethodGet, path: "/api/files/" + strconv.Itoa(nodeID) + "/permissions"}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Permissions, nil
+}
+
+// GrantPermission shares a file or folder with a user or group
+func (c *Client) GrantPermission(ctx context.Context, nodeID int, req GrantPermissionRequest) (*Permission, error) {
+	var p Permission
+	err := c.do(ctx, &request{
+		method: http.MethodPost,
+		path:   "/api/files/" + strconv.Itoa(nodeID) + "/permissions",
+		body:   req,
+	}, &p)
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// UpdatePermission changes the role of a permission
+func (c *Client) UpdatePermission(ctx context.Context, id int, role string) (*Permission, error) {
+	var p Permission
+	err := c.do(ctx, &request{
+		method: http.MethodPut,
+		path:   "/api/permissions/" + strconv.Itoa(id),
+		body:   map[string]string{"role": role},
+	}, &p)
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// RevokePermission stops sharing a file or folder with a user or group
+func (c *Client) RevokePermission(ctx context.Context, id int) error {
+	return c.do(ctx, &request{method: http.MethodDelete, path: "/api/permissions/" + strconv.Itoa(id)}, nil)
+}
+
+// SharedWithMe returns the files and folders other users shared with the
+// current user
+func (c *Client) SharedWithMe(ctx context.Context) ([]SharedFile, error) {
+	var resp struct {
+		Files []SharedFile `json:"files"`
+	}
+	err := c.do(ctx, &request{method: http.MethodGet, path: "/api/files/shared-with-me"}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Files, nil
+}
@@ -0,0 +1,28 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+)
+
+// Preview tells how to show a file. Text files come with their content,
+// other files with a URL to open in a browser.
+type Preview struct {
+	Type     string `json:"type"` // text, office, pdf, kkfileview or url
+	Content  string `json:"content"`
+	URL      string `json:"url"`
+	MimeType string `json:"mime_type"`
+	FileName string `json:"file_name"`
+	Editable bool   `json:"editable"`
+}
+
+// Preview returns how to preview a file
+func (c *Client) Preview(ctx context.Context, id int) (*Preview, error) {
+	var p Preview
+	err := c.do(ctx, &request{method: http.MethodGet, path: "/api/preview/" + strconv.Itoa(id)}, &p)
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
@@ -0,0 +1,129 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// RetryPolicy controls how failed requests are retried. Requests are
+// retried after network errors and 429, 502, 503 and 504 responses when
+// they are safe to repeat. 429 responses of authentication routes are
+// not retried: they mean too many failed attempts, which retrying only
+// prolongs. Delays grow exponentially from MinDelay up to MaxDelay, or are
+// what the server asks for with Retry-After.
+type RetryPolicy struct {
+	MaxAttempts int // Including the first, 1 disables retries
+	MinDelay    time.Duration
+	MaxDelay    time.Duration // Retry-After longer than this is not waited for
+}
+
+// DefaultRetryPolicy is the retry policy of new clients
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	MinDelay:    500 * time.Millisecond,
+	MaxDelay:    10 * time.Second,
+}
+
+// SetRetryPolicy sets how failed requests are retried
+func (c *Client) SetRetryPolicy(p RetryPolicy) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.retry = p
+}
+
+func (c *Client) retryPolicy() RetryPolicy {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.retry
+}
+
+// replayable reports whether the body of r can be sent again, seeking
+// back when it is a reader
+func (r *request) replayable() bool {
+	if _, ok := r.body.(io.Reader); ok {
+		_, ok := r.body.(io.Seeker)
+		return ok
+	}
+	return true
+}
+
+// rewind seeks a reader body back to its start before it is sent again
+func (r *request) rewind() error {
+	if s, ok := r.body.(io.Seeker); ok {
+		_, err := s.Seek(0, io.SeekStart)
+		return err
+	}
+	return nil
+}
+
+// idempotent reports whether repeating r has the same effect as sending it
+// once
+func (r *request) idempotent() bool {
+	if r.noRetry {
+		return false
+	}
+	switch r.method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// retryDelay returns how long to wait before the attempt following attempt
+// n (from 1), which failed with err, and whether to retry at all
+func (p RetryPolicy) retryDelay(r *request, n int, err error) (time.Duration, bool) {
+	if n >= p.MaxAttempts || !r.replayable() {
+		return 0, false
+	}
+
+	var e *Error
+	switch {
+	case errors.As(err, &e):
+		switch e.StatusCode {
+		case http.StatusTooManyRequests:
+			if !r.idempotent() || strings.HasPrefix(r.path, "/api/auth/") {
+				return 0, false
+			}
+		case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			if !r.idempotent() {
+				return 0, false
+			}
+		default:
+			return 0, false
+		}
+		if e.RetryAfter > 0 {
+			return e.RetryAfter, e.RetryAfter <= p.MaxDelay
+		}
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		// The caller gave up
+		return 0, false
+	case !r.idempotent():
+		// The server may have acted on the request before the connection failed
+		return 0, false
+	}
+
+	// Exponential backoff with jitter, so that clients failing together do
+	// not retry together
+	delay := p.MinDelay << (n - 1)
+	if delay <= 0 || delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	return delay/2 + rand.N(delay/2+1), true
+}
+
+// sleep waits for d or until ctx is done
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,195 @@
+package client_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"gopan-server/client"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRetry(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		status     int
+		failures   int
+		retryAfter int
+		call       func(ctx context.Context, c *client.Client) error
+		requests   int
+		want       error // Nil when the call succeeds
+	}{
+		{
+			name:   "GET after 503",
+			method: http.MethodGet, path: "/api/files", status: http.StatusServiceUnavailable, failures: 2,
+			call: func(ctx context.Context, c *client.Client) error {
+				_, err := c.ListFiles(ctx, 0, client.ListOptions{})
+				return err
+			},
+			requests: 3,
+		},
+		{
+			name:   "GET gives up after MaxAttempts",
+			method: http.MethodGet, path: "/api/files", status: http.StatusBadGateway, failures: 5,
+			call: func(ctx context.Context, c *client.Client) error {
+				_, err := c.ListFiles(ctx, 0, client.ListOptions{})
+				return err
+			},
+			requests: 3,
+			want:     client.ErrServer,
+		},
+		{
+			name:   "GET after 429",
+			method: http.MethodGet, path: "/api/files", status: http.StatusTooManyRequests, failures: 1,
+			call: func(ctx context.Context, c *client.Client) error {
+				_, err := c.ListFiles(ctx, 0, client.ListOptions{})
+				return err
+			},
+			requests: 2,
+		},
+		{
+			name:   "Retry-After longer than MaxDelay",
+			method: http.MethodGet, path: "/api/files", status: http.StatusTooManyRequests, failures: 1, retryAfter: 60,
+			call: func(ctx context.Context, c *client.Client) error {
+				_, err := c.ListFiles(ctx, 0, client.ListOptions{})
+				return err
+			},
+			requests: 1,
+			want:     client.ErrTooManyRequests,
+		},
+		{
+			name:   "POST after 503",
+			method: http.MethodPost, path: "/api/files/folder", status: http.StatusServiceUnavailable, failures: 1,
+			call: func(ctx context.Context, c *client.Client) error {
+				_, err := c.CreateFolder(ctx, 0, "docs")
+				return err
+			},
+			requests: 1,
+			want:     client.ErrServer,
+		},
+		{
+			name:   "POST after 429",
+			method: http.MethodPost, path: "/api/files/folder", status: http.StatusTooManyRequests, failures: 1,
+			call: func(ctx context.Context, c *client.Client) error {
+				_, err := c.CreateFolder(ctx, 0, "docs")
+				return err
+			},
+			requests: 1,
+			want:     client.ErrTooManyRequests,
+		},
+		{
+			name:   "copy after 503",
+			method: http.MethodPut, path: "/api/files/copy", status: http.StatusServiceUnavailable, failures: 1,
+			call: func(ctx context.Context, c *client.Client) error {
+				_, err := c.Copy(ctx, []int{1}, 0)
+				return err
+			},
+			requests: 1,
+			want:     client.ErrServer,
+		},
+		{
+			name:   "authentication route after 429",
+			method: http.MethodGet, path: "/api/auth/me", status: http.StatusTooManyRequests, failures: 1,
+			call: func(ctx context.Context, c *client.Client) error {
+				_, err := c.Me(ctx)
+				return err
+			},
+			requests: 1,
+			want:     client.ErrTooManyRequests,
+		},
+		{
+			name:   "seekable upload after 503",
+			method: http.MethodPut, path: "/api/fs/upload/a.txt", status: http.StatusServiceUnavailable, failures: 1,
+			call: func(ctx context.Context, c *client.Client) error {
+				_, err := c.Upload(ctx, "/a.txt", bytes.NewReader([]byte("hello")), 5, true)
+				return err
+			},
+			requests: 2,
+		},
+		{
+			name:   "streamed upload after 503",
+			method: http.MethodPut, path: "/api/fs/upload/a.txt", status: http.StatusServiceUnavailable, failures: 1,
+			call: func(ctx context.Context, c *client.Client) error {
+				_, err := c.Upload(ctx, "/a.txt", io.MultiReader(bytes.NewReader([]byte("hello"))), 5, true)
+				return err
+			},
+			requests: 1,
+			want:     client.ErrServer,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t)
+			c := s.newClient(t, "alice")
+			s.fail(tt.method, tt.path, tt.status, tt.failures, tt.retryAfter)
+
+			err := tt.call(context.Background(), c)
+			if tt.want == nil && err != nil {
+				t.Errorf("err = %v, want success", err)
+			}
+			if tt.want != nil && !errors.Is(err, tt.want) {
+				t.Errorf("err = %v, want %v", err, tt.want)
+			}
+			if n := s.count(tt.method, tt.path); n != tt.requests {
+				t.Errorf("%d requests, want %d", n, tt.requests)
+			}
+		})
+	}
+}
+
+func TestRetryRewindsUploads(t *testing.T) {
+	s := newTestServer(t)
+	c := s.newClient(t, "alice")
+	s.fail(http.MethodPut, "/api/fs/upload/a.txt", http.StatusBadGateway, 1, 0)
+	ctx := context.Background()
+
+	if _, err := c.Upload(ctx, "/a.txt", bytes.NewReader([]byte("hello")), 5, true); err != nil {
+		t.Fatalf("Upload: %v", err)
+	}
+	body, err := c.Download(ctx, "/a.txt", 0)
+	if err != nil {
+		t.Fatalf("Download: %v", err)
+	}
+	defer body.Close()
+	if data, _ := io.ReadAll(body); string(data) != "hello" {
+		t.Errorf("content after a retried upload = %q, want hello", data)
+	}
+}
+
+func TestThrottledLoginIsNotRetried(t *testing.T) {
+	s := newTestServer(t)
+	s.newClient(t, "alice")
+	c := client.New(s.URL)
+	c.SetRetryPolicy(client.RetryPolicy{MaxAttempts: 3, MinDelay: time.Millisecond, MaxDelay: time.Hour})
+	ctx := context.Background()
+
+	// Failed logins back off after the free attempts
+	var err error
+	for i := 0; i < 10 && !errors.Is(err, client.ErrTooManyRequests); i++ {
+		_, _, err = c.Login(ctx, "alice", "wrong")
+	}
+	var e *client.Error
+	if !errors.As(err, &e) || e.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("err = %v, want a 429 response", err)
+	}
+	if e.RetryAfter <= 0 {
+		t.Errorf("RetryAfter = %v, want the backoff of the server", e.RetryAfter)
+	}
+
+	// Each call sent one request, waiting for none of the backoffs
+	before := s.count(http.MethodPost, "/api/auth/login")
+	start := time.Now()
+	if _, _, err := c.Login(ctx, "alice", "alice-password"); !errors.Is(err, client.ErrTooManyRequests) {
+		t.Errorf("login during backoff: err = %v, want ErrTooManyRequests", err)
+	}
+	if n := s.count(http.MethodPost, "/api/auth/login") - before; n != 1 {
+		t.Errorf("login during backoff sent %d requests, want 1", n)
+	}
+	if d := time.Since(start); d > time.Second {
+		t.Errorf("login during backoff took %v", d)
+	}
+}
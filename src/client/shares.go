@@ -0,0 +1,279 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Share is a public link to a file or folder
+type Share struct {
+	ID               int        `json:"id"`
+	Code             string     `json:"code"`
+	Slug             *string    `json:"slug"`
+	ShareType        int        `json:"share_type"`
+	Status           string     `json:"status"`
+	ExpiresAt        *time.Time `json:"expires_at"`
+	AccessCount      int        `json:"access_count"`
+	MaxAccessCount   *int       `json:"max_access_count"`
+	DownloadCount    int        `json:"download_count"`
+	MaxDownloadCount *int       `json:"max_download_count"`
+	HasPassword      bool       `json:"has_password"`
+	ExtractCode      string     `json:"extract_code"`
+	CreatedAt        time.Time  `json:"created_at"`
+	UpdatedAt        time.Time  `json:"updated_at"` // Only set by UpdateShare
+	Node             *ShareNode `json:"node"`       // Only set by Shares
+}
+
+// ShareNode is a shared file or folder, or one in a shared folder
+type ShareNode struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	Type      int       `json:"type"`
+	Size      int64     `json:"size"`
+	MimeType  string    `json:"mime_type"`
+	UpdatedAt time.Time `json:"updated_at"` // Only set by ShareFolder
+}
+
+// ShareOptions are the settings of a new share
+type ShareOptions struct {
+	ExpiresAt           *time.Time
+	Password            string
+	MaxAccessCount      int
+	MaxDownloadCount    int
+	Slug                string
+	GenerateExtractCode bool
+}
+
+// CreateShare creates a public link to a file or folder
+func (c *Client) CreateShare(ctx context.Context, nodeID int, opts ShareOptions) (*Share, error) {
+	shareType := 0
+	if opts.ExpiresAt != nil {
+		shareType = 1
+	}
+	var s Share
+	err := c.do(ctx, &request{
+		method: http.MethodPost,
+		path:   "/api/shares",
+		body: map[string]any{
+			"node_id":               strconv.Itoa(nodeID),
+			"share_type":            shareType,
+			"expires_at":            opts.ExpiresAt,
+			"password":              opts.Password,
+			"max_access_count":      opts.MaxAccessCount,
+			"max_download_count":    opts.MaxDownloadCount,
+			"slug":                  opts.Slug,
+			"generate_extract_code": opts.GenerateExtractCode,
+		},
+	}, &s)
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// ShareUpdate changes the settings of a share. Nil fields are left alone.
+type ShareUpdate struct {
+	ShareType        *int       `json:"share_type,omitempty"` // 0: permanent, 1: until ExpiresAt
+	ExpiresAt        *time.Time `json:"expires_at,omitempty"`
+	Password         *string    `json:"password,omitempty"`           // Empty removes the password
+	MaxAccessCount   *int       `json:"max_access_count,omitempty"`   // 0 removes the limit
+	MaxDownloadCount *int       `json:"max_download_count,omitempty"` // 0 removes the limit
+	Slug             *string    `json:"slug,omitempty"`               // Empty removes the slug
+}
+
+// UpdateShare changes the settings of a share
+func (c *Client) UpdateShare(ctx context.Context, id int, update ShareUpdate) (*Share, error) {
+	var s Share
+	err := c.do(ctx, &request{
+		method: http.MethodPut,
+		path:   "/api/shares/" + strconv.Itoa(id),
+		body:   update,
+	}, &s)
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// DeleteShare deletes a share, which ends its link
+func (c *Client) DeleteShare(ctx context.Context, id int) error {
+	return c.do(ctx, &request{method: http.MethodDelete, path: "/api/shares/" + strconv.Itoa(id)}, nil)
+}
+
+// Shares returns the shares of the current user
+func (c *Client) Shares(ctx context.Context) ([]Share, error) {
+	var resp struct {
+		Shares []Share `json:"shares"`
+	}
+	err := c.do(ctx, &request{method: http.MethodGet, path: "/api/shares"}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Shares, nil
+}
+
+// ShareURL returns the link to a share
+func (c *Client) ShareURL(s *Share) string {
+	name := s.Code
+	if s.Slug != nil && *s.Slug != "" {
+		name = *s.Slug
+	}
+	return c.baseURL + "/s/" + url.PathEscape(name)
+}
+
+// ShareStats sums up the accesses of a share
+type ShareStats struct {
+	ShareID        int            `json:"share_id"`
+	Code           string         `json:"code"`
+	AccessCount    int            `json:"access_count"`
+	DownloadCount  int            `json:"download_count"`
+	UniqueVisitors int            `json:"unique_visitors"` // Counted by IP address
+	BytesServed    int64          `json:"bytes_served"`
+	Actions        map[string]int `json:"actions"` // Accesses by action
+	Files          []struct {
+		NodeID    *int   `json:"node_id"` // Nil when the file was deleted
+		Name      string `json:"name"`
+		Downloads int    `json:"downloads"`
+		Bytes     int64  `json:"bytes"`
+	} `json:"files"` // Downloads by file
+	LastAccessAt *time.Time `json:"last_access_at"`
+}
+
+// ShareAccess is an access to a share
+type ShareAccess struct {
+	ID        int       `json:"id"`
+	Action    string    `json:"action"` // view, list, download or preview
+	IP        string    `json:"ip"`
+	UserAgent string    `json:"user_agent"`
+	Bytes     int64     `json:"bytes"`
+	NodeID    *int      `json:"node_id"`
+	NodeName  string    `json:"node_name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ShareAccessList is a page of the access log of a share
+type ShareAccessList struct {
+	Accesses []ShareAccess `json:"accesses"`
+	Total    int           `json:"total"`
+	Page     int           `json:"page"`
+	PageSize int           `json:"page_size"`
+}
+
+// ShareStats returns how often a share was accessed
+func (c *Client) ShareStats(ctx context.Context, id int) (*ShareStats, error) {
+	var st ShareStats
+	err := c.do(ctx, &request{method: http.MethodGet, path: "/api/shares/stats/" + strconv.Itoa(id)}, &st)
+	if err != nil {
+		return nil, err
+	}
+	return &st, nil
+}
+
+// ShareAccesses returns a page (from 1) of the access log of a share, most
+// recent first. action limits it to one kind of access unless empty.
+func (c *Client) ShareAccesses(ctx context.Context, id, page, pageSize int, action string) (*ShareAccessList, error) {
+	query := url.Values{}
+	pageQuery(query, page, pageSize)
+	if action != "" {
+		query.Set("action", action)
+	}
+	var list ShareAccessList
+	err := c.do(ctx, &request{
+		method: http.MethodGet,
+		path:   "/api/shares/stats/" + strconv.Itoa(id) + "/accesses",
+		query:  query,
+	}, &list)
+	if err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+// PublicShare is a share as seen by anyone with its link
+type PublicShare struct {
+	Code             string     `json:"code"`
+	ShareType        int        `json:"share_type"`
+	ExpiresAt        *time.Time `json:"expires_at"`
+	AccessCount      int        `json:"access_count"`
+	MaxAccessCount   *int       `json:"max_access_count"`
+	DownloadCount    int        `json:"download_count"`
+	MaxDownloadCount *int       `json:"max_download_count"`
+	Node             ShareNode  `json:"node"`
+}
+
+// shareRequest returns a request for a shared link by code or slug, which
+// is sent without the token of the client. password is the password or
+// extraction code of the share, if it has one.
+func shareRequest(code, suffix, password string) *request {
+	r := &request{method: http.MethodGet, path: "/api/shares/" + url.PathEscape(code) + suffix, public: true}
+	if password != "" {
+		r.query = url.Values{"password": {password}}
+	}
+	return r
+}
+
+// PublicShare opens a shared link, which counts as a view. Shares with a
+// password fail with ErrUnauthorized when it is missing or wrong.
+func (c *Client) PublicShare(ctx context.Context, code, password string) (*PublicShare, error) {
+	var s PublicShare
+	if err := c.do(ctx, shareRequest(code, "", password), &s); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// ShareFolder returns the contents of a shared folder, or of a folder in it
+func (c *Client) ShareFolder(ctx context.Context, code string, folderID int, password string) ([]ShareNode, error) {
+	var resp struct {
+		Files []ShareNode `json:"files"`
+	}
+	err := c.do(ctx, shareRequest(code, "/folder/"+strconv.Itoa(folderID), password), &resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Files, nil
+}
+
+// DownloadShare returns the content of a shared file, or of a file in a
+// shared folder when fileID is not 0. The caller closes the reader.
+func (c *Client) DownloadShare(ctx context.Context, code string, fileID int, password string) (io.ReadCloser, error) {
+	r := shareRequest(code, "/download", password)
+	if fileID != 0 {
+		if r.query == nil {
+			r.query = url.Values{}
+		}
+		r.query.Set("file_id", strconv.Itoa(fileID))
+	}
+	return c.stream(ctx, r)
+}
+
+// PreviewShareFile returns how to preview a shared file
+func (c *Client) PreviewShareFile(ctx context.Context, code string, fileID int, password string) (*Preview, error) {
+	var p Preview
+	err := c.do(ctx, shareRequest(code, "/preview/"+strconv.Itoa(fileID), password), &p)
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// ShareQRCode returns the link to a share as a QR code PNG image. Only
+// active shares have one, and a protected share needs its password.
+func (c *Client) ShareQRCode(ctx context.Context, code, password string) ([]byte, error) {
+	body, err := c.stream(ctx, shareRequest(code, "/qrcode", password))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	return io.ReadAll(body)
+}
+
+// ShareThumbnail returns the image of a shared image file, as shown in
+// link previews. The caller closes the reader.
+func (c *Client) ShareThumbnail(ctx context.Context, code, password string) (io.ReadCloser, error) {
+	return c.stream(ctx, shareRequest(code, "/thumbnail", password))
+}
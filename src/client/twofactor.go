@@ -0,0 +1,84 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// TwoFactorStatus tells whether two-factor authentication is enabled
+type TwoFactorStatus struct {
+	Enabled                bool `json:"enabled"`
+	RecoveryCodesRemaining int  `json:"recovery_codes_remaining"`
+}
+
+// TwoFactorSetup is a new TOTP secret, to be added to an authenticator app
+type TwoFactorSetup struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioning_uri"` // otpauth:// URI
+	QRCode          string `json:"qr_code"`          // PNG image of the URI, as a data URL
+}
+
+// TwoFactor returns whether two-factor authentication is enabled for the
+// current user
+func (c *Client) TwoFactor(ctx context.Context) (*TwoFactorStatus, error) {
+	var st TwoFactorStatus
+	err := c.do(ctx, &request{method: http.MethodGet, path: "/api/user/2fa"}, &st)
+	if err != nil {
+		return nil, err
+	}
+	return &st, nil
+}
+
+// SetupTwoFactor starts enabling two-factor authentication with a new
+// secret. EnableTwoFactor completes it with a code from the app.
+func (c *Client) SetupTwoFactor(ctx context.Context) (*TwoFactorSetup, error) {
+	var setup TwoFactorSetup
+	err := c.do(ctx, &request{method: http.MethodPost, path: "/api/user/2fa/setup"}, &setup)
+	if err != nil {
+		return nil, err
+	}
+	return &setup, nil
+}
+
+// EnableTwoFactor enables two-factor authentication with a code for the
+// secret of SetupTwoFactor, and returns the recovery codes
+func (c *Client) EnableTwoFactor(ctx context.Context, code string) ([]string, error) {
+	var resp struct {
+		RecoveryCodes []string `json:"recovery_codes"`
+	}
+	err := c.do(ctx, &request{
+		method: http.MethodPost,
+		path:   "/api/user/2fa/enable",
+		body:   map[string]string{"code": code},
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp.RecoveryCodes, nil
+}
+
+// DisableTwoFactor disables two-factor authentication
+func (c *Client) DisableTwoFactor(ctx context.Context, password, code string) error {
+	return c.do(ctx, &request{
+		method: http.MethodPost,
+		path:   "/api/user/2fa/disable",
+		body:   map[string]string{"password": password, "code": code},
+	}, nil)
+}
+
+// RegenerateRecoveryCodes replaces the recovery codes, and returns the new
+// ones
+func (c *Client) RegenerateRecoveryCodes(ctx context.Context, code string) ([]string, error) {
+	var resp struct {
+		RecoveryCodes []string `json:"recovery_codes"`
+	}
+	err := c.do(ctx, &request{
+		method: http.MethodPost,
+		path:   "/api/user/2fa/recovery-codes",
+		body:   map[string]string{"code": code},
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp.RecoveryCodes, nil
+}
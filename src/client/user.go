@@ -0,0 +1,102 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Capacity is the storage use of the current user
+type Capacity struct {
+	TotalQuota int64   `json:"total_quota"`
+	TotalUsed  int64   `json:"total_used"`
+	Remaining  int64   `json:"remaining"`
+	Percentage float64 `json:"percentage"` // 0 to 100
+}
+
+// Capacity returns the storage use of the current user
+func (c *Client) Capacity(ctx context.Context) (*Capacity, error) {
+	var capacity Capacity
+	err := c.do(ctx, &request{method: http.MethodGet, path: "/api/user/capacity"}, &capacity)
+	if err != nil {
+		return nil, err
+	}
+	return &capacity, nil
+}
+
+// RecalculateCapacity counts the storage in use again from the files of
+// the current user, and returns it
+func (c *Client) RecalculateCapacity(ctx context.Context) (int64, error) {
+	var resp struct {
+		TotalUsed int64 `json:"total_used"`
+	}
+	err := c.do(ctx, &request{method: http.MethodPost, path: "/api/user/recalculate"}, &resp)
+	if err != nil {
+		return 0, err
+	}
+	return resp.TotalUsed, nil
+}
+
+// ChangePassword changes the password of the current user, which signs out
+// the other sessions, and returns how many were signed out
+func (c *Client) ChangePassword(ctx context.Context, oldPassword, newPassword string) (int, error) {
+	var resp struct {
+		RevokedSessions int `json:"revoked_sessions"`
+	}
+	err := c.do(ctx, &request{
+		method: http.MethodPut,
+		path:   "/api/user/password",
+		body:   map[string]string{"old_password": oldPassword, "new_password": newPassword},
+	}, &resp)
+	if err != nil {
+		return 0, err
+	}
+	return resp.RevokedSessions, nil
+}
+
+// SendEmailVerification emails a new verification link to the current user
+func (c *Client) SendEmailVerification(ctx context.Context) error {
+	return c.do(ctx, &request{method: http.MethodPost, path: "/api/user/email/verification"}, nil)
+}
+
+// DeviceSession is a login session of the current user
+type DeviceSession struct {
+	ID         int       `json:"id"`
+	IP         string    `json:"ip"`
+	UserAgent  string    `json:"user_agent"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	Current    bool      `json:"current"` // The session of the client
+}
+
+// Sessions returns the login sessions of the current user
+func (c *Client) Sessions(ctx context.Context) ([]DeviceSession, error) {
+	var resp struct {
+		Sessions []DeviceSession `json:"sessions"`
+	}
+	err := c.do(ctx, &request{method: http.MethodGet, path: "/api/user/sessions"}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Sessions, nil
+}
+
+// RevokeSession signs out a login session
+func (c *Client) RevokeSession(ctx context.Context, id int) error {
+	return c.do(ctx, &request{method: http.MethodDelete, path: "/api/user/sessions/" + strconv.Itoa(id)}, nil)
+}
+
+// RevokeOtherSessions signs out all login sessions but the client's, and
+// returns how many were signed out
+func (c *Client) RevokeOtherSessions(ctx context.Context) (int, error) {
+	var resp struct {
+		Count int `json:"count"`
+	}
+	err := c.do(ctx, &request{method: http.MethodDelete, path: "/api/user/sessions"}, &resp)
+	if err != nil {
+		return 0, err
+	}
+	return resp.Count, nil
+}
@@ -0,0 +1,477 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"gopan-server/client"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// remotePath returns a remote path as an absolute, clean path
+func remotePath(p string) string {
+	return path.Clean("/" + p)
+}
+
+// formatSize returns a size in bytes in a readable unit
+func formatSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(size)/float64(div), "KMGTPE"[exp])
+}
+
+// prompt reads a line from standard input
+func prompt(stdin *bufio.Reader, label string) (string, error) {
+	fmt.Fprint(os.Stderr, label)
+	line, err := stdin.ReadString('\n')
+	if err != nil && line == "" {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+// runLogin handles gopan-cli login - Sign in and save the session
+// The password is read from standard input, so it can be piped in.
+func runLogin(ctx context.Context, a *app, args []string) error {
+	if len(args) > 1 {
+		return usageError("login")
+	}
+
+	// A personal access token given with -token is only checked and saved
+	if a.tokenGiven {
+		u, err := a.client.Me(ctx)
+		if err != nil {
+			return err
+		}
+		a.cfg.Username = u.Username
+		if err := a.cfg.save(); err != nil {
+			return err
+		}
+		fmt.Printf("Signed in to %s as %s with an access token\n", a.cfg.Server, u.Username)
+		return nil
+	}
+
+	stdin := bufio.NewReader(os.Stdin)
+	username := ""
+	if len(args) == 1 {
+		username = args[0]
+	} else {
+		var err error
+		if username, err = prompt(stdin, "Username: "); err != nil {
+			return err
+		}
+	}
+	password, err := prompt(stdin, "Password: ")
+	if err != nil {
+		return err
+	}
+
+	a.client.SetToken("")
+	s, challenge, err := a.client.Login(ctx, username, password)
+	if errors.Is(err, client.ErrTwoFactorRequired) {
+		code, err := prompt(stdin, "Two-factor code: ")
+		if err != nil {
+			return err
+		}
+		s, err = a.client.LoginTwoFactor(ctx, challenge, code)
+		if err != nil {
+			return err
+		}
+	} else if err != nil {
+		return err
+	}
+
+	a.cfg.Username = s.User.Username
+	a.cfg.Token, a.cfg.RefreshToken = s.Token, s.RefreshToken
+	if err := a.cfg.save(); err != nil {
+		return err
+	}
+	fmt.Printf("Signed in to %s as %s\n", a.cfg.Server, s.User.Username)
+	return nil
+}
+
+// runLogout handles gopan-cli logout - End the session and forget it
+func runLogout(ctx context.Context, a *app, args []string) error {
+	if len(args) > 0 {
+		return usageError("logout")
+	}
+	if a.cfg.RefreshToken != "" {
+		if err := a.client.Logout(ctx); err != nil {
+			fmt.Fprintf(os.Stderr, "gopan-cli: failed to end the session on the server: %v\n", err)
+		}
+	}
+	a.cfg.Username, a.cfg.Token, a.cfg.RefreshToken = "", "", ""
+	return a.cfg.save()
+}
+
+// runLs handles gopan-cli ls - List a folder
+func runLs(ctx context.Context, a *app, args []string) error {
+	fs := newFlagSet("ls")
+	long := fs.Bool("l", false, "show type, size and modification time")
+	if fs.Parse(args) != nil {
+		return flag.ErrHelp
+	}
+	if fs.NArg() > 1 {
+		return usageError("ls")
+	}
+	p := remotePath(fs.Arg(0))
+
+	n, err := a.client.Stat(ctx, p)
+	if err != nil {
+		return err
+	}
+	nodes := []*client.Node{n}
+	if n.IsFolder() {
+		if nodes, err = a.client.List(ctx, p); err != nil {
+			return err
+		}
+	}
+
+	for _, n := range nodes {
+		name := n.Name
+		if n.IsFolder() {
+			name += "/"
+		}
+		if !*long {
+			fmt.Println(name)
+			continue
+		}
+		kind, size := "-", formatSize(n.Size)
+		if n.IsFolder() {
+			kind, size = "d", ""
+		}
+		fmt.Printf("%s %10s  %s  %s\n", kind, size, n.UpdatedAt.Local().Format("2006-01-02 15:04"), name)
+	}
+	return nil
+}
+
+// runTree handles gopan-cli tree - List a folder and everything in it
+func runTree(ctx context.Context, a *app, args []string) error {
+	if len(args) > 1 {
+		return usageError("tree")
+	}
+	p := remotePath(strings.Join(args, ""))
+	fmt.Println(p)
+
+	var files, folders int
+	var printTree func(p, indent string) error
+	printTree = func(p, indent string) error {
+		nodes, err := a.client.List(ctx, p)
+		if err != nil {
+			return err
+		}
+		for i, n := range nodes {
+			branch, next := "├── ", "│   "
+			if i == len(nodes)-1 {
+				branch, next = "└── ", "    "
+			}
+			if !n.IsFolder() {
+				files++
+				fmt.Printf("%s%s%s (%s)\n", indent, branch, n.Name, formatSize(n.Size))
+				continue
+			}
+			folders++
+			fmt.Printf("%s%s%s/\n", indent, branch, n.Name)
+			if err := printTree(n.Path, indent+next); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := printTree(p, ""); err != nil {
+		return err
+	}
+	fmt.Printf("\n%d folders, %d files\n", folders, files)
+	return nil
+}
+
+// runMv handles gopan-cli mv - Move or rename a file or folder
+// Moving onto an existing folder moves into it.
+func runMv(ctx context.Context, a *app, args []string) error {
+	if len(args) != 2 {
+		return usageError("mv")
+	}
+	from, to := remotePath(args[0]), remotePath(args[1])
+
+	if dst, err := a.client.Stat(ctx, to); err == nil && dst.IsFolder() {
+		to = path.Join(to, path.Base(from))
+	} else if err != nil && !client.IsNotFound(err) {
+		return err
+	}
+	_, err := a.client.Move(ctx, from, to)
+	return err
+}
+
+// runCp handles gopan-cli cp - Copy files and folders
+// Copies go into the destination folder, where names that exist get a
+// number appended. A single source may also be copied to a new name.
+func runCp(ctx context.Context, a *app, args []string) error {
+	if len(args) < 2 {
+		return usageError("cp")
+	}
+	sources, to := args[:len(args)-1], remotePath(args[len(args)-1])
+
+	dst, err := a.client.Stat(ctx, to)
+	rename := ""
+	if client.IsNotFound(err) && len(sources) == 1 {
+		rename = path.Base(to)
+		dst, err = a.client.Stat(ctx, path.Dir(to))
+	}
+	if err != nil {
+		return err
+	}
+	if !dst.IsFolder() {
+		return fmt.Errorf("%s is not a folder", dst.Path)
+	}
+
+	for _, src := range sources {
+		n, err := a.client.Stat(ctx, remotePath(src))
+		if err != nil {
+			return err
+		}
+		if n.IsFolder() && (dst.Path == n.Path || strings.HasPrefix(dst.Path, n.Path+"/")) {
+			return fmt.Errorf("cannot copy %s into itself", n.Path)
+		}
+		copied, err := copyNode(ctx, a.client, n, dst)
+		if err != nil {
+			return err
+		}
+		if rename != "" && copied.Name != rename {
+			if _, err := a.client.Move(ctx, path.Join(dst.Path, copied.Name), to); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// copyNode copies n into the folder dst. The server copies folders without
+// their contents, so those are copied one by one.
+func copyNode(ctx context.Context, c *client.Client, n, dst *client.Node) (*client.File, error) {
+	copied, err := c.Copy(ctx, []int{n.ID}, dst.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(copied) == 0 {
+		return nil, fmt.Errorf("failed to copy %s", n.Path)
+	}
+	if !n.IsFolder() {
+		return &copied[0], nil
+	}
+
+	folder := &client.Node{ID: copied[0].ID, Path: path.Join(dst.Path, copied[0].Name), Type: client.TypeFolder}
+	children, err := c.List(ctx, n.Path)
+	if err != nil {
+		return nil, err
+	}
+	for _, child := range children {
+		if _, err := copyNode(ctx, c, child, folder); err != nil {
+			return nil, err
+		}
+	}
+	return &copied[0], nil
+}
+
+// runRm handles gopan-cli rm - Move files and folders to the trash
+func runRm(ctx context.Context, a *app, args []string) error {
+	fs := newFlagSet("rm")
+	permanent := fs.Bool("p", false, "delete permanently instead of moving to the trash")
+	if fs.Parse(args) != nil {
+		return flag.ErrHelp
+	}
+	if fs.NArg() == 0 {
+		return usageError("rm")
+	}
+
+	for _, arg := range fs.Args() {
+		p := remotePath(arg)
+		n, err := a.client.Stat(ctx, p)
+		if err != nil {
+			return fmt.Errorf("%s: %w", p, err)
+		}
+		if err := a.client.Delete(ctx, p); err != nil {
+			return fmt.Errorf("%s: %w", p, err)
+		}
+		if *permanent {
+			if err := a.client.DeletePermanently(ctx, n.ID); err != nil {
+				return fmt.Errorf("%s: %w", p, err)
+			}
+		}
+	}
+	return nil
+}
+
+// runTrash handles gopan-cli trash - List, purge or empty the trash
+func runTrash(ctx context.Context, a *app, args []string) error {
+	action := "ls"
+	if len(args) > 0 {
+		action, args = args[0], args[1:]
+	}
+
+	switch action {
+	case "ls":
+		if len(args) > 0 {
+			return usageError("trash")
+		}
+		trash, err := a.client.Trash(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range trash {
+			name := n.Name
+			if n.Type == client.TypeFolder {
+				name += "/"
+			}
+			fmt.Printf("%8d  %10s  %s  %s\n", n.ID, formatSize(n.Size), n.DeletedAt.Local().Format("2006-01-02 15:04"), name)
+		}
+		return nil
+	case "purge":
+		ids, err := parseIDs(args)
+		if err != nil || len(ids) == 0 {
+			return usageError("trash")
+		}
+		for _, id := range ids {
+			if err := a.client.DeletePermanently(ctx, id); err != nil {
+				return fmt.Errorf("%d: %w", id, err)
+			}
+		}
+		return nil
+	case "empty":
+		trash, err := a.client.Trash(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range trash {
+			// Files in trashed folders go with the folder
+			if err := a.client.DeletePermanently(ctx, n.ID); err != nil && !client.IsNotFound(err) {
+				return fmt.Errorf("%s: %w", n.Name, err)
+			}
+		}
+		return nil
+	default:
+		return usageError("trash")
+	}
+}
+
+// runRestore handles gopan-cli restore - Restore files and folders from the trash
+func runRestore(ctx context.Context, a *app, args []string) error {
+	ids, err := parseIDs(args)
+	if err != nil || len(ids) == 0 {
+		return usageError("restore")
+	}
+	for _, id := range ids {
+		if err := a.client.Restore(ctx, id); err != nil {
+			return fmt.Errorf("%d: %w", id, err)
+		}
+	}
+	return nil
+}
+
+// parseIDs parses file IDs given as arguments
+func parseIDs(args []string) ([]int, error) {
+	ids := make([]int, len(args))
+	for i, arg := range args {
+		id, err := strconv.Atoi(arg)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
+
+// runShare handles gopan-cli share - Create or list public links
+func runShare(ctx context.Context, a *app, args []string) error {
+	if len(args) == 0 {
+		return usageError("share")
+	}
+
+	switch args[0] {
+	case "create":
+		fs := newFlagSet("share")
+		expires := fs.Duration("expires", 0, "how long the link is valid, such as 168h (default forever)")
+		password := fs.String("password", "", "extraction code needed to open the link")
+		code := fs.Bool("code", false, "generate an extraction code")
+		maxViews := fs.Int("max-views", 0, "disable the link after this many views")
+		maxDownloads := fs.Int("max-downloads", 0, "disable the link after this many downloads")
+		slug := fs.String("slug", "", "custom link name")
+		if fs.Parse(args[1:]) != nil {
+			return flag.ErrHelp
+		}
+		if fs.NArg() != 1 {
+			return usageError("share")
+		}
+
+		n, err := a.client.Stat(ctx, remotePath(fs.Arg(0)))
+		if err != nil {
+			return err
+		}
+		opts := client.ShareOptions{
+			Password:            *password,
+			GenerateExtractCode: *code,
+			MaxAccessCount:      *maxViews,
+			MaxDownloadCount:    *maxDownloads,
+			Slug:                *slug,
+		}
+		if *expires > 0 {
+			t := time.Now().Add(*expires)
+			opts.ExpiresAt = &t
+		}
+		s, err := a.client.CreateShare(ctx, n.ID, opts)
+		if err != nil {
+			return err
+		}
+		fmt.Println(a.client.ShareURL(s))
+		if s.ExtractCode != "" {
+			fmt.Printf("Extraction code: %s\n", s.ExtractCode)
+		}
+		return nil
+	case "ls":
+		shares, err := a.client.Shares(ctx)
+		if err != nil {
+			return err
+		}
+		for _, s := range shares {
+			name := ""
+			if s.Node != nil {
+				name = s.Node.Name
+			}
+			expires := "never"
+			if s.ExpiresAt != nil {
+				expires = s.ExpiresAt.Local().Format("2006-01-02 15:04")
+			}
+			fmt.Printf("%-9s  %6d views  %6d downloads  expires %-16s  %s  %s\n",
+				s.Status, s.AccessCount, s.DownloadCount, expires, a.client.ShareURL(&s), name)
+		}
+		return nil
+	default:
+		return usageError("share")
+	}
+}
+
+// runQuota handles gopan-cli quota - Show the storage in use
+func runQuota(ctx context.Context, a *app, args []string) error {
+	if len(args) > 0 {
+		return usageError("quota")
+	}
+	c, err := a.client.Capacity(ctx)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%s of %s in use (%.1f%%), %s free\n",
+		formatSize(c.TotalUsed), formatSize(c.TotalQuota), c.Percentage, formatSize(c.Remaining))
+	return nil
+}
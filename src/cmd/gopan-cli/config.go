@@ -0,0 +1,57 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+)
+
+// cliConfig is what the command line client remembers between runs
+type cliConfig struct {
+	Server       string `json:"server"`
+	Username     string `json:"username,omitempty"`
+	Token        string `json:"token,omitempty"`         // Access token of the login session, or a personal access token
+	RefreshToken string `json:"refresh_token,omitempty"` // Empty for personal access tokens
+
+	path string
+}
+
+// defaultConfigPath returns where the config is kept, such as
+// ~/.config/gopan/cli.json
+func defaultConfigPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "gopan", "cli.json"), nil
+}
+
+// loadConfig reads the config at path, which may not exist yet
+func loadConfig(path string) (*cliConfig, error) {
+	cfg := &cliConfig{path: path}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return cfg, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, cfg); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// save writes the config, readable only by the current user as it holds
+// tokens
+func (cfg *cliConfig) save() error {
+	data, err := json.MarshalIndent(cfg, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(cfg.path), 0700); err != nil {
+		return err
+	}
+	return os.WriteFile(cfg.path, append(data, '\n'), 0600)
+}
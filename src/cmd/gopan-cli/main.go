@@ -0,0 +1,168 @@
+// Command gopan-cli works with the files of a GoPan account from the
+// command line. It signs in with a username and password, or with a
+// personal access token, and remembers the server and session in its
+// config file.
+//
+// Usage:
+//
+//	gopan-cli [-server URL] [-token TOKEN] [-config FILE] <command> [arguments]
+//
+// Remote paths start at the top level of the account's files, such as
+// /Documents/2024/report.pdf.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"gopan-server/client"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+)
+
+// command is a subcommand of the client
+type command struct {
+	usage string // Arguments, such as "[-l] [path]"
+	help  string
+	run   func(ctx context.Context, a *app, args []string) error
+
+	noAuth bool // Runs without being signed in
+}
+
+// commands is set in init, as the commands refer to it for their usage
+var commands map[string]*command
+
+func init() {
+	commands = map[string]*command{
+		"login":   {usage: "[username]", help: "sign in with a password, or save the token given with -token", run: runLogin, noAuth: true},
+		"logout":  {help: "sign out and forget the session", run: runLogout, noAuth: true},
+		"ls":      {usage: "[-l] [path]", help: "list a folder", run: runLs},
+		"tree":    {usage: "[path]", help: "list a folder and everything in it", run: runTree},
+		"put":     {usage: "[-j n] local... remote", help: "upload files and folders, skipping files that are there already", run: runPut},
+		"get":     {usage: "[-j n] remote... local", help: "download files and folders, resuming partial downloads", run: runGet},
+		"mv":      {usage: "source destination", help: "move or rename a file or folder", run: runMv},
+		"cp":      {usage: "source... destination", help: "copy files and folders", run: runCp},
+		"rm":      {usage: "[-p] path...", help: "move files and folders to the trash, or with -p delete them for good", run: runRm},
+		"trash":   {usage: "[ls | purge id... | empty]", help: "list or empty the trash", run: runTrash},
+		"restore": {usage: "id...", help: "restore files and folders from the trash, by the ID shown by trash", run: runRestore},
+		"share":   {usage: "create [options] path | ls", help: "create or list public links", run: runShare},
+		"quota":   {help: "show the storage in use", run: runQuota},
+		"sync":    {usage: "[-n] [-watch] local remote", help: "keep a local and a remote folder in sync both ways", run: runSync},
+	}
+}
+
+// app is the state shared by the commands
+type app struct {
+	cfg        *cliConfig
+	client     *client.Client
+	tokenGiven bool // A token was given with -token or GOPAN_TOKEN
+}
+
+func usage() {
+	out := flag.CommandLine.Output()
+	fmt.Fprintf(out, "Usage: gopan-cli [options] <command> [arguments]\n\nCommands:\n")
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		cmd := commands[name]
+		fmt.Fprintf(out, "  %-8s %-28s %s\n", name, cmd.usage, cmd.help)
+	}
+	fmt.Fprintf(out, "\nOptions:\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	configPath := flag.String("config", "", "config file (default gopan/cli.json in the user config directory)")
+	server := flag.String("server", os.Getenv("GOPAN_SERVER"), "server URL, such as https://pan.example.com (env GOPAN_SERVER)")
+	token := flag.String("token", os.Getenv("GOPAN_TOKEN"), "personal access token (env GOPAN_TOKEN)")
+	flag.Usage = usage
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		usage()
+		os.Exit(2)
+	}
+	cmd, ok := commands[flag.Arg(0)]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "gopan-cli: unknown command %q\n\n", flag.Arg(0))
+		usage()
+		os.Exit(2)
+	}
+
+	a, err := newApp(*configPath, *server, *token, cmd.noAuth)
+	if err == nil {
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+		err = cmd.run(ctx, a, flag.Args()[1:])
+		stop()
+	}
+	// Wrong flags were reported by the flag set already
+	if errors.Is(err, flag.ErrHelp) {
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "gopan-cli: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// newApp loads the config and sets up the client. The server and token
+// given on the command line take precedence over the config.
+func newApp(configPath, server, token string, noAuth bool) (*app, error) {
+	if configPath == "" {
+		p, err := defaultConfigPath()
+		if err != nil {
+			return nil, err
+		}
+		configPath = p
+	}
+	cfg, err := loadConfig(configPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", configPath, err)
+	}
+	if server != "" {
+		cfg.Server = strings.TrimRight(server, "/")
+	}
+	if token != "" {
+		cfg.Token, cfg.RefreshToken = token, ""
+	}
+	if cfg.Server == "" {
+		return nil, errors.New("no server given, use -server or GOPAN_SERVER")
+	}
+	if cfg.Token == "" && !noAuth {
+		return nil, errors.New("not signed in, run gopan-cli login or use -token")
+	}
+
+	c := client.New(cfg.Server)
+	if cfg.RefreshToken != "" {
+		c.SetSession(cfg.Token, cfg.RefreshToken, func(token, refreshToken string) {
+			cfg.Token, cfg.RefreshToken = token, refreshToken
+			if err := cfg.save(); err != nil {
+				fmt.Fprintf(os.Stderr, "gopan-cli: failed to save session: %v\n", err)
+			}
+		})
+	} else {
+		c.SetToken(cfg.Token)
+	}
+	return &app{cfg: cfg, client: c, tokenGiven: token != ""}, nil
+}
+
+// newFlagSet returns the flags of a command
+func newFlagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: gopan-cli %s %s\n", name, commands[name].usage)
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+// usageError reports wrong arguments of a command
+func usageError(name string) error {
+	return fmt.Errorf("usage: gopan-cli %s %s", name, commands[name].usage)
+}
@@ -0,0 +1,536 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"gopan-server/client"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// errChangedLocally stops a sync from replacing a local file changed while
+// syncing
+var errChangedLocally = errors.New("changed locally meanwhile, sync again")
+
+// runSync handles gopan-cli sync - Sync a local folder with a remote folder
+// Changes on either side since the last sync are carried over to the other
+// side, found by comparing content hashes with the state kept in the local
+// folder. See planSync for how conflicts are resolved.
+func runSync(ctx context.Context, a *app, args []string) error {
+	flags := newFlagSet("sync")
+	dryRun := flags.Bool("n", false, "only show what would be done")
+	watch := flags.Bool("watch", false, "keep syncing as files change on either side")
+	jobs := flags.Int("j", defaultJobs, "number of files transferred at once")
+	var ignores []string
+	flags.Func("ignore", "leave out files and folders matching a pattern, such as *.tmp (repeatable)", func(p string) error {
+		ignores = append(ignores, p)
+		return nil
+	})
+	if flags.Parse(args) != nil {
+		return flag.ErrHelp
+	}
+	if flags.NArg() != 2 {
+		return usageError("sync")
+	}
+	if *dryRun && *watch {
+		return errors.New("-n and -watch cannot be combined")
+	}
+
+	local, err := filepath.Abs(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+	remote := remotePath(flags.Arg(1))
+	st, err := loadSyncState(local, a.cfg.Server, remote)
+	if err != nil {
+		return err
+	}
+	s := &syncer{c: a.client, local: local, remote: remote, extra: ignores, jobs: *jobs, dryRun: *dryRun, state: st}
+	if *watch {
+		return s.watch(ctx)
+	}
+	return s.sync(ctx)
+}
+
+// syncer syncs a local folder with a remote folder
+type syncer struct {
+	c      *client.Client
+	local  string // Absolute
+	remote string
+	extra  []string // Ignore patterns given on the command line
+	jobs   int
+	dryRun bool
+
+	state   *syncState
+	ignores ignoreList
+
+	mu      sync.Mutex
+	folders map[string]int        // Remote folder IDs by path, for uploads
+	next    map[string]*syncEntry // State after the sync
+}
+
+// localPath returns the local path of the file or folder at p
+func (s *syncer) localPath(p string) string {
+	return filepath.Join(s.local, filepath.FromSlash(p))
+}
+
+// remotePath returns the remote path of the file or folder at p
+func (s *syncer) remotePath(p string) string {
+	return path.Join(s.remote, p)
+}
+
+// sync syncs both sides once and saves the state. Failed changes are
+// reported and the others carried on with, so the next sync only has to
+// do what is left.
+func (s *syncer) sync(ctx context.Context) error {
+	var err error
+	if s.ignores, err = loadIgnores(s.local, s.extra); err != nil {
+		return err
+	}
+	base := s.state.Entries
+
+	// The cursor is taken before listing, so changes made meanwhile are
+	// seen again by the next sync
+	changed, cursor, err := s.state.remoteChanged(ctx, s.c)
+	if err != nil {
+		return err
+	}
+	root, err := s.c.Stat(ctx, s.remote)
+	switch {
+	case client.IsNotFound(err) && len(base) > 0:
+		return fmt.Errorf("%s is missing on the server, delete %s to sync it again", s.remote, s.state.path)
+	case client.IsNotFound(err) && s.dryRun:
+		root = nil
+	case client.IsNotFound(err):
+		if root, err = s.c.Mkdir(ctx, s.remote); err != nil {
+			return err
+		}
+	case err != nil:
+		return err
+	case !root.IsFolder():
+		return fmt.Errorf("%s is not a folder", s.remote)
+	case root.ID != s.state.RootID && len(base) > 0:
+		fmt.Fprintf(os.Stderr, "gopan-cli: %s was replaced on the server, comparing all files again\n", s.remote)
+		base, changed = map[string]*syncEntry{}, true
+	}
+
+	remote := map[string]*syncEntry{}
+	switch {
+	case root == nil:
+	case changed:
+		if remote, err = s.scanRemote(ctx); err != nil {
+			return err
+		}
+	default:
+		for p, e := range base {
+			remote[p] = &syncEntry{Folder: e.Folder, Size: e.Size, Hash: e.Hash, RemoteID: e.RemoteID}
+		}
+	}
+	local, err := s.scanLocal(base)
+	if err != nil {
+		return err
+	}
+
+	actions := planSync(base, local, remote, time.Now())
+	if s.dryRun {
+		n := 0
+		for _, a := range actions {
+			if a.op != opNone {
+				fmt.Println(a)
+				n++
+			}
+		}
+		if n == 0 {
+			fmt.Println("Everything is in sync")
+		}
+		return nil
+	}
+
+	if root != nil {
+		s.state.RootID = root.ID
+	}
+	s.apply(ctx, actions, base, remote)
+
+	// Changes not made are tried again, and the remote files listed again
+	// as the state does not tell what they are
+	failed := 0
+	for _, a := range actions {
+		if a.done {
+			continue
+		}
+		failed++
+		s.restore(a, base)
+	}
+	s.state.Entries, s.state.Cursor = s.next, cursor
+	if failed > 0 {
+		s.state.Cursor = ""
+	}
+	if err := s.state.save(); err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d changes failed", failed, len(actions))
+	}
+	return nil
+}
+
+// scanLocal returns the files and folders in the local folder, which is
+// created when missing. Files are hashed unless their size and
+// modification time are as last synced.
+func (s *syncer) scanLocal(base map[string]*syncEntry) (map[string]*syncEntry, error) {
+	files := map[string]*syncEntry{}
+	if _, err := os.Stat(s.local); errors.Is(err, os.ErrNotExist) {
+		if s.dryRun {
+			return files, nil
+		}
+		if err := os.MkdirAll(s.local, 0755); err != nil {
+			return nil, err
+		}
+	}
+
+	err := filepath.WalkDir(s.local, func(local string, d fs.DirEntry, err error) error {
+		if err != nil || local == s.local {
+			return err
+		}
+		rel, err := filepath.Rel(s.local, local)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if s.ignores.match(rel) {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if d.IsDir() {
+			files[rel] = &syncEntry{Folder: true}
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			fmt.Fprintf(os.Stderr, "gopan-cli: skipping %s, not a regular file\n", local)
+			return nil
+		}
+
+		info, err := d.Info()
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		e := &syncEntry{Size: info.Size(), ModTime: info.ModTime().UnixNano()}
+		if b := base[rel]; b != nil && !b.Folder && b.Hash != "" && b.Size == e.Size && b.ModTime == e.ModTime {
+			e.Hash = b.Hash
+		} else {
+			f, err := os.Open(local)
+			if errors.Is(err, os.ErrNotExist) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			e.Hash, err = hashFile(f)
+			f.Close()
+			if err != nil {
+				return err
+			}
+		}
+		files[rel] = e
+		return nil
+	})
+	return files, err
+}
+
+// scanRemote returns the files and folders in the remote folder
+func (s *syncer) scanRemote(ctx context.Context) (map[string]*syncEntry, error) {
+	files := map[string]*syncEntry{}
+	var scan func(dir string) error
+	scan = func(dir string) error {
+		nodes, err := s.c.List(ctx, s.remotePath(dir))
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			rel := path.Join(dir, n.Name)
+			if s.ignores.match(rel) {
+				continue
+			}
+			if n.IsFolder() {
+				files[rel] = &syncEntry{Folder: true, RemoteID: n.ID}
+				if err := scan(rel); err != nil {
+					return err
+				}
+				continue
+			}
+			files[rel] = &syncEntry{Size: n.Size, Hash: n.FileHash, RemoteID: n.ID}
+		}
+		return nil
+	}
+	return files, scan("")
+}
+
+// apply carries out the actions: folders are created first, then files
+// transferred on several workers, then files and folders deleted, those
+// in a folder before the folder
+func (s *syncer) apply(ctx context.Context, actions []*syncAction, base, remote map[string]*syncEntry) {
+	s.next = map[string]*syncEntry{}
+	s.folders = map[string]int{".": s.state.RootID}
+	for p, e := range remote {
+		if e.Folder && e.RemoteID != 0 {
+			s.folders[p] = e.RemoteID
+		}
+	}
+
+	var transfers []transfer
+	var deletes []*syncAction
+	byRemote := map[string]*syncAction{}
+	for _, a := range actions {
+		switch a.op {
+		case opNone:
+			e := *a.local
+			e.RemoteID = a.remote.RemoteID
+			if e.Hash == "" {
+				e.Hash = a.remote.Hash
+			}
+			s.record(a.path, &e)
+			a.done = true
+		case opUpload, opDownload, opConflict:
+			// A conflict between a file and a folder is resolved before
+			// the folder is filled
+			if a.op == opConflict && a.local.Folder != a.remote.Folder {
+				s.do(ctx, a)
+				continue
+			}
+			t := transfer{local: s.localPath(a.path), remote: s.remotePath(a.path)}
+			transfers = append(transfers, t)
+			byRemote[t.remote] = a
+		case opDeleteLocal, opDeleteRemote:
+			deletes = append(deletes, a)
+		default:
+			s.do(ctx, a)
+		}
+	}
+
+	// Failures are reported as they happen and counted by the caller
+	runTransfers(ctx, s.jobs, transfers, func(ctx context.Context, t transfer) error {
+		return s.run(ctx, byRemote[t.remote])
+	})
+
+	for i := len(deletes) - 1; i >= 0 && ctx.Err() == nil; i-- {
+		s.do(ctx, deletes[i])
+	}
+}
+
+// do runs an action, reporting a failure
+func (s *syncer) do(ctx context.Context, a *syncAction) {
+	if err := s.run(ctx, a); err != nil {
+		p := s.remotePath(a.path)
+		if a.op == opDeleteLocal || a.op == opMkdirLocal {
+			p = s.localPath(a.path)
+		}
+		fmt.Fprintf(os.Stderr, "gopan-cli: %s: %v\n", p, err)
+	}
+}
+
+// run runs an action and reports it once done
+func (s *syncer) run(ctx context.Context, a *syncAction) error {
+	var err error
+	switch a.op {
+	case opUpload:
+		err = s.upload(ctx, a.path, a.local)
+	case opDownload:
+		err = s.download(ctx, a.path, a.local)
+	case opMkdirRemote:
+		err = s.mkdirRemote(ctx, a.path)
+	case opMkdirLocal:
+		err = s.mkdirLocal(a.path, a.remote.RemoteID)
+	case opDeleteRemote:
+		err = s.c.Delete(ctx, s.remotePath(a.path))
+		if client.IsNotFound(err) {
+			err = nil
+		}
+	case opDeleteLocal:
+		err = s.deleteLocal(a.path, a.local)
+	case opConflict:
+		err = s.conflict(ctx, a)
+	}
+	if err != nil {
+		return err
+	}
+	a.done = true
+	fmt.Println(a)
+	return nil
+}
+
+// record sets the state of the file or folder at p after the sync
+func (s *syncer) record(p string, e *syncEntry) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.next[p] = e
+}
+
+// restore keeps the state of a file or folder whose action was not done,
+// so the next sync plans the same. Files in a folder that was to be
+// trashed are kept with it. After a failed conflict both sides are
+// compared as new instead, which deletes nothing.
+func (s *syncer) restore(a *syncAction, base map[string]*syncEntry) {
+	if a.op == opConflict || a.base == nil {
+		return
+	}
+	if _, ok := s.next[a.path]; !ok {
+		s.next[a.path] = a.base
+	}
+	if a.op != opDeleteRemote || !a.base.Folder {
+		return
+	}
+	for p, e := range base {
+		if _, ok := s.next[p]; !ok && strings.HasPrefix(p, a.path+"/") {
+			s.next[p] = e
+		}
+	}
+}
+
+// folderID returns the ID of the remote folder at dir, creating it when
+// missing, for uploading into it
+func (s *syncer) folderID(ctx context.Context, dir string) (int, error) {
+	s.mu.Lock()
+	id, ok := s.folders[dir]
+	s.mu.Unlock()
+	if ok {
+		return id, nil
+	}
+	n, err := s.c.Mkdir(ctx, s.remotePath(dir))
+	if err != nil {
+		return 0, err
+	}
+	s.mu.Lock()
+	s.folders[dir] = n.ID
+	s.mu.Unlock()
+	return n.ID, nil
+}
+
+// unchanged reports whether the local file at p is as it was scanned
+func (s *syncer) unchanged(p string, e *syncEntry) bool {
+	info, err := os.Stat(s.localPath(p))
+	return err == nil && info.Size() == e.Size && info.ModTime().UnixNano() == e.ModTime
+}
+
+// upload uploads the local file at p, scanned as e
+func (s *syncer) upload(ctx context.Context, p string, e *syncEntry) error {
+	parentID, err := s.folderID(ctx, path.Dir(p))
+	if err != nil {
+		return err
+	}
+	if _, err := upload(ctx, s.c, transfer{local: s.localPath(p), remote: s.remotePath(p), parentID: parentID}); err != nil {
+		return err
+	}
+	s.record(p, &syncEntry{Size: e.Size, Hash: e.Hash, ModTime: e.ModTime})
+	return nil
+}
+
+// download downloads the remote file at p, replacing the local file
+// scanned as e unless it changed meanwhile
+func (s *syncer) download(ctx context.Context, p string, e *syncEntry) error {
+	if e != nil && !s.unchanged(p, e) {
+		return errChangedLocally
+	}
+	n, err := s.c.Stat(ctx, s.remotePath(p))
+	if err != nil {
+		return err
+	}
+	if n.IsFolder() {
+		return errors.New("is a folder")
+	}
+	local := s.localPath(p)
+	if err := os.MkdirAll(filepath.Dir(local), 0755); err != nil {
+		return err
+	}
+	if _, err := download(ctx, s.c, transfer{local: local, remote: n.Path, node: n}); err != nil {
+		return err
+	}
+	info, err := os.Stat(local)
+	if err != nil {
+		return err
+	}
+	s.record(p, &syncEntry{Size: n.Size, Hash: n.FileHash, ModTime: info.ModTime().UnixNano(), RemoteID: n.ID})
+	return nil
+}
+
+// mkdirRemote creates the remote folder at p
+func (s *syncer) mkdirRemote(ctx context.Context, p string) error {
+	n, err := s.c.Mkdir(ctx, s.remotePath(p))
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	s.folders[p] = n.ID
+	s.mu.Unlock()
+	s.record(p, &syncEntry{Folder: true, RemoteID: n.ID})
+	return nil
+}
+
+// mkdirLocal creates the local folder at p, for the remote folder id
+func (s *syncer) mkdirLocal(p string, id int) error {
+	if err := os.MkdirAll(s.localPath(p), 0755); err != nil {
+		return err
+	}
+	s.record(p, &syncEntry{Folder: true, RemoteID: id})
+	return nil
+}
+
+// deleteLocal deletes the local file or folder at p, scanned as e. Files
+// changed meanwhile are kept, and folders only deleted once empty.
+func (s *syncer) deleteLocal(p string, e *syncEntry) error {
+	if !e.Folder && !s.unchanged(p, e) {
+		if _, err := os.Stat(s.localPath(p)); errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return errChangedLocally
+	}
+	err := os.Remove(s.localPath(p))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// conflict keeps both versions of a file changed on both sides. The local
+// file is renamed to the copy name and uploaded, and the remote file
+// downloaded in its place. When the local side is a folder, the remote
+// file is renamed and downloaded instead.
+func (s *syncer) conflict(ctx context.Context, a *syncAction) error {
+	if a.local.Folder {
+		if _, err := s.c.Move(ctx, s.remotePath(a.path), s.remotePath(a.copy)); err != nil {
+			return err
+		}
+		if err := s.download(ctx, a.copy, nil); err != nil {
+			return err
+		}
+		return s.mkdirRemote(ctx, a.path)
+	}
+
+	if !s.unchanged(a.path, a.local) {
+		return errChangedLocally
+	}
+	if err := os.Rename(s.localPath(a.path), s.localPath(a.copy)); err != nil {
+		return err
+	}
+	if err := s.upload(ctx, a.copy, a.local); err != nil {
+		return err
+	}
+	if a.remote.Folder {
+		return s.mkdirLocal(a.path, a.remote.RemoteID)
+	}
+	return s.download(ctx, a.path, nil)
+}
@@ -0,0 +1,193 @@
+package main
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// syncOp is what a sync does with a file or folder
+type syncOp int
+
+const (
+	opNone         syncOp = iota // In sync already
+	opUpload                     // Changed or new locally
+	opDownload                   // Changed or new on the server
+	opMkdirRemote                // New locally, or deleted on the server with changed files in it
+	opMkdirLocal                 // New on the server, or deleted locally with changed files in it
+	opDeleteRemote               // Deleted locally
+	opDeleteLocal                // Deleted on the server
+	opConflict                   // Changed on both sides, the local file is kept as a copy
+)
+
+// syncAction is what a sync does with the file or folder at path, a slash
+// separated path below the synced folders
+type syncAction struct {
+	op     syncOp
+	path   string
+	local  *syncEntry // Nil when missing locally
+	remote *syncEntry // Nil when missing on the server
+	base   *syncEntry // As last synced, nil when new on both sides
+	copy   string     // Path the conflicting file is kept at
+	done   bool
+}
+
+// String returns the line reported for the action, such as
+// "upload   remote docs/a.txt"
+func (a *syncAction) String() string {
+	switch a.op {
+	case opUpload:
+		return "upload   remote " + a.path
+	case opDownload:
+		return "download local  " + a.path
+	case opMkdirRemote:
+		return "mkdir    remote " + a.path
+	case opMkdirLocal:
+		return "mkdir    local  " + a.path
+	case opDeleteRemote:
+		return "delete   remote " + a.path
+	case opDeleteLocal:
+		return "delete   local  " + a.path
+	case opConflict:
+		return "conflict both   " + a.path + ", keeping " + a.copy
+	}
+	return "in sync         " + a.path
+}
+
+// planSync compares the files and folders on both sides with how they
+// were last synced, by path. What changed on one side only is carried over
+// to the other. Files changed on both sides, and paths that are a file on
+// one side and a folder on the other, are conflicts: both versions are
+// kept, one under a new name. Nothing is deleted that changed since the
+// last sync.
+func planSync(base, local, remote map[string]*syncEntry, now time.Time) []*syncAction {
+	paths := make([]string, 0, len(local)+len(remote))
+	for p := range local {
+		paths = append(paths, p)
+	}
+	for p := range remote {
+		if local[p] == nil {
+			paths = append(paths, p)
+		}
+	}
+	sort.Strings(paths)
+
+	// Below a folder that conflicts with a file, both sides are new
+	conflicts := map[string]bool{}
+	var actions []*syncAction
+	for _, p := range paths {
+		a := &syncAction{path: p, local: local[p], remote: remote[p], base: base[p]}
+		for dir := path.Dir(p); dir != "."; dir = path.Dir(dir) {
+			if conflicts[dir] {
+				a.base = nil
+			}
+		}
+		l, r, b := a.local, a.remote, a.base
+
+		switch {
+		case l != nil && r != nil:
+			switch {
+			case l.same(r):
+				a.op = opNone
+			case l.Folder != r.Folder:
+				a.op = opConflict
+				conflicts[p] = true
+			case b != nil && l.same(b):
+				a.op = opDownload
+			case b != nil && r.same(b):
+				a.op = opUpload
+			default:
+				a.op = opConflict
+			}
+		case l != nil:
+			switch {
+			case b != nil && l.same(b):
+				a.op = opDeleteLocal
+			case l.Folder:
+				a.op = opMkdirRemote
+			default:
+				a.op = opUpload
+			}
+		default:
+			switch {
+			case b != nil && r.same(b):
+				a.op = opDeleteRemote
+			case r.Folder:
+				a.op = opMkdirLocal
+			default:
+				a.op = opDownload
+			}
+		}
+		for n := 1; a.op == opConflict && a.copy == ""; n++ {
+			if c := conflictName(p, now, n); local[c] == nil && remote[c] == nil {
+				a.copy = c
+			}
+		}
+		actions = append(actions, a)
+	}
+	return pruneDeletes(actions)
+}
+
+// pruneDeletes keeps folders with files in them that are kept, creating
+// them on the side they were deleted on instead. Deleting a remote folder
+// moves everything in it to the trash, so what is in it is not deleted
+// one by one. Local folders are emptied first.
+func pruneDeletes(actions []*syncAction) []*syncAction {
+	// Contents follow their folder in path order
+	for i, a := range actions {
+		if a.op != opDeleteLocal && a.op != opDeleteRemote {
+			continue
+		}
+		if (a.local != nil && !a.local.Folder) || (a.remote != nil && !a.remote.Folder) {
+			continue
+		}
+		for _, d := range actions[i+1:] {
+			if !strings.HasPrefix(d.path, a.path+"/") {
+				continue
+			}
+			if a.op == opDeleteLocal && d.op != opDeleteLocal && d.local != nil {
+				a.op = opMkdirRemote
+				break
+			}
+			if a.op == opDeleteRemote && d.op != opDeleteRemote && d.remote != nil {
+				a.op = opMkdirLocal
+				break
+			}
+		}
+	}
+
+	var pruned []*syncAction
+	trashed := map[string]bool{}
+	for _, a := range actions {
+		if a.op == opDeleteRemote {
+			if a.remote.Folder {
+				trashed[a.path] = true
+			}
+			if trashed[path.Dir(a.path)] {
+				continue
+			}
+		}
+		pruned = append(pruned, a)
+	}
+	return pruned
+}
+
+// conflictName returns the nth path to try keeping a conflicting file at p
+// at, such as "docs/report (conflict 2024-05-01 153000).pdf", numbered from
+// the second on
+func conflictName(p string, now time.Time, n int) string {
+	name := path.Base(p)
+	ext := path.Ext(name)
+	if ext == name {
+		ext = ""
+	}
+	suffix := now.Format("2006-01-02 150405")
+	if n > 1 {
+		suffix += " " + strconv.Itoa(n)
+	}
+	name = fmt.Sprintf("%s (conflict %s)%s", strings.TrimSuffix(name, ext), suffix, ext)
+	return path.Join(path.Dir(p), name)
+}
@@ -0,0 +1,204 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"gopan-server/client"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// stateFile keeps the sync state at the top of a synced local folder
+const stateFile = ".gopan-sync.json"
+
+// ignoreFile lists ignore patterns at the top of a synced local folder,
+// one per line, synced like any other file
+const ignoreFile = ".gopanignore"
+
+// defaultIgnores are never synced
+var defaultIgnores = []string{stateFile, stateFile + ".tmp", "*" + partSuffix}
+
+// syncState is what was in sync on both sides after the last sync, so the
+// next one can tell on which side something changed
+type syncState struct {
+	Server  string                `json:"server"`
+	Remote  string                `json:"remote"`
+	RootID  int                   `json:"root_id"`          // Remote folder, 0 for the top level
+	Cursor  string                `json:"cursor,omitempty"` // Change journal position the entries are current at, empty to list the remote files again
+	Entries map[string]*syncEntry `json:"entries"`          // By slash separated path below the synced folders
+
+	path string
+}
+
+// syncEntry is a file or folder on one side of a sync, or on both as
+// they were last synced
+type syncEntry struct {
+	Folder   bool   `json:"folder,omitempty"`
+	Size     int64  `json:"size,omitempty"`
+	Hash     string `json:"hash,omitempty"`      // SHA-256 of the content, as the server hashes it
+	ModTime  int64  `json:"mod_time,omitempty"`  // Local modification time in nanoseconds, to skip hashing unchanged files
+	RemoteID int    `json:"remote_id,omitempty"` // 0 when not known
+}
+
+// same reports whether e and o have the same type and content. Files
+// without a hash are compared by size.
+func (e *syncEntry) same(o *syncEntry) bool {
+	if e.Folder || o.Folder {
+		return e.Folder == o.Folder
+	}
+	return e.Size == o.Size && (e.Hash == "" || o.Hash == "" || e.Hash == o.Hash)
+}
+
+// loadSyncState reads the state of syncing the local folder dir with the
+// remote folder. A state of another server or remote folder is not used,
+// so everything is compared again without deleting anything.
+func loadSyncState(dir, server, remote string) (*syncState, error) {
+	st := &syncState{Server: server, Remote: remote, Entries: map[string]*syncEntry{}, path: filepath.Join(dir, stateFile)}
+	data, err := os.ReadFile(st.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return st, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var saved syncState
+	if err := json.Unmarshal(data, &saved); err != nil {
+		return nil, fmt.Errorf("%s: %w", st.path, err)
+	}
+	if saved.Server != server || saved.Remote != remote {
+		fmt.Fprintf(os.Stderr, "gopan-cli: %s was synced with %s%s before, starting over\n", dir, saved.Server, saved.Remote)
+		return st, nil
+	}
+	if saved.Entries != nil {
+		st.RootID, st.Cursor, st.Entries = saved.RootID, saved.Cursor, saved.Entries
+	}
+	return st, nil
+}
+
+// save writes the state next to the synced files, replacing the old state
+// only once written in full
+func (st *syncState) save() error {
+	data, err := json.Marshal(st)
+	if err != nil {
+		return err
+	}
+	tmp := st.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, st.path)
+}
+
+// remoteIDs returns the IDs of the synced remote folder and of the files
+// and folders in it that are known
+func (st *syncState) remoteIDs() map[int]bool {
+	ids := map[int]bool{st.RootID: true}
+	for _, e := range st.Entries {
+		if e.RemoteID != 0 {
+			ids[e.RemoteID] = true
+		}
+	}
+	return ids
+}
+
+// remoteChanged reports whether anything in the synced remote folder
+// changed since the state was saved, and returns the cursor to save next.
+// Changes are matched by the IDs of the files and of their folders, so
+// any change is found, along with some that only look related.
+func (st *syncState) remoteChanged(ctx context.Context, c *client.Client) (bool, string, error) {
+	if st.Cursor == "" {
+		cursor, err := c.LatestCursor(ctx)
+		return true, cursor, err
+	}
+
+	ids := st.remoteIDs()
+	cursor, changed := st.Cursor, false
+	for {
+		page, err := c.Changes(ctx, cursor, 0, 0)
+		if errors.Is(err, client.ErrGone) {
+			cursor, err := c.LatestCursor(ctx)
+			return true, cursor, err
+		}
+		if err != nil {
+			return false, "", err
+		}
+		for _, ch := range page.Changes {
+			if relatedChange(ids, ch) {
+				changed = true
+			}
+		}
+		cursor = page.Cursor
+		if !page.HasMore {
+			return changed, cursor, nil
+		}
+	}
+}
+
+// relatedChange reports whether ch is about one of the files or folders
+// ids, or about something in one of the folders
+func relatedChange(ids map[int]bool, ch client.Change) bool {
+	parent := 0
+	if ch.ParentID != nil {
+		parent = *ch.ParentID
+	}
+	return ids[ch.NodeID] || ids[parent]
+}
+
+// ignoreList holds patterns of files and folders left out of a sync.
+// Patterns without a slash match names at any depth, such as *.tmp, the
+// others paths from the top of the synced folders, such as build/cache.
+// Ignoring a folder ignores everything in it.
+type ignoreList []string
+
+// loadIgnores returns the default patterns, those of the ignore file in
+// the local folder dir and the extra ones
+func loadIgnores(dir string, extra []string) (ignoreList, error) {
+	patterns := append([]string{}, defaultIgnores...)
+	f, err := os.Open(filepath.Join(dir, ignoreFile))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	if err == nil {
+		defer f.Close()
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line != "" && !strings.HasPrefix(line, "#") {
+				patterns = append(patterns, line)
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+	}
+	patterns = append(patterns, extra...)
+
+	for i, p := range patterns {
+		p = strings.Trim(p, "/")
+		if _, err := path.Match(p, ""); err != nil {
+			return nil, fmt.Errorf("bad ignore pattern %q", patterns[i])
+		}
+		patterns[i] = p
+	}
+	return patterns, nil
+}
+
+// match reports whether the file or folder at rel, a slash separated path
+// below the synced folders, is ignored
+func (l ignoreList) match(rel string) bool {
+	for _, p := range l {
+		name := rel
+		if !strings.Contains(p, "/") {
+			name = path.Base(rel)
+		}
+		if ok, _ := path.Match(p, name); ok {
+			return true
+		}
+	}
+	return false
+}
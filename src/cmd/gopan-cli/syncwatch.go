@@ -0,0 +1,155 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gopan-server/client"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+const (
+	// settleDelay is how long local files must be left alone before they
+	// are synced, so files being written are synced once finished
+	settleDelay = 2 * time.Second
+
+	// retryDelay is how long watching waits to sync again after a failure
+	retryDelay = time.Minute
+
+	// changeWait is how long a request for remote changes waits for some
+	changeWait = 50 * time.Second
+)
+
+// watch syncs, then syncs again whenever files change on either side,
+// until ctx is done. Local changes are noticed through file system
+// notifications, remote ones through the change journal.
+func (s *syncer) watch(ctx context.Context) error {
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer w.Close()
+
+	for {
+		failed := false
+		if err := s.sync(ctx); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			fmt.Fprintf(os.Stderr, "gopan-cli: %v\n", err)
+			failed = true
+		}
+		if err := s.watchFolders(w); err != nil {
+			return err
+		}
+
+		waitCtx, cancel := context.WithCancel(ctx)
+		remote := make(chan struct{}, 1)
+		go func() {
+			if s.waitRemote(waitCtx) == nil {
+				remote <- struct{}{}
+			}
+		}()
+		err := s.waitLocal(waitCtx, w, remote, failed)
+		cancel()
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// watchFolders watches the local folder and the folders in it for changes.
+// Folders watched already are left as they are.
+func (s *syncer) watchFolders(w *fsnotify.Watcher) error {
+	if err := w.Add(s.local); err != nil {
+		return err
+	}
+	for p, e := range s.state.Entries {
+		if !e.Folder {
+			continue
+		}
+		if err := w.Add(s.localPath(p)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to watch %s: %w", s.localPath(p), err)
+		}
+	}
+	return nil
+}
+
+// waitLocal waits until local files changed and were then left alone for
+// settleDelay, remote files changed, or after a failed sync retryDelay
+// passed
+func (s *syncer) waitLocal(ctx context.Context, w *fsnotify.Watcher, remote <-chan struct{}, failed bool) error {
+	var retry <-chan time.Time
+	if failed {
+		retry = time.After(retryDelay)
+	}
+	settle := time.NewTimer(settleDelay)
+	settle.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-remote:
+			return nil
+		case <-retry:
+			return nil
+		case <-settle.C:
+			return nil
+		case err := <-w.Errors:
+			return err
+		case ev := <-w.Events:
+			if ev.Op == fsnotify.Chmod {
+				continue
+			}
+			rel, err := filepath.Rel(s.local, ev.Name)
+			if err != nil || s.ignores.match(filepath.ToSlash(rel)) {
+				continue
+			}
+			settle.Reset(settleDelay)
+		}
+	}
+}
+
+// waitRemote waits until anything in the synced remote folder changed
+// after the state was saved. Requests that fail are retried after a while,
+// as the server may be unreachable for some time.
+func (s *syncer) waitRemote(ctx context.Context) error {
+	ids := s.state.remoteIDs()
+	cursor := s.state.Cursor
+	for {
+		var err error
+		if cursor == "" {
+			cursor, err = s.c.LatestCursor(ctx)
+		}
+		var page *client.ChangePage
+		if err == nil {
+			page, err = s.c.Changes(ctx, cursor, 0, changeWait)
+		}
+		switch {
+		case ctx.Err() != nil:
+			return ctx.Err()
+		case errors.Is(err, client.ErrGone):
+			return nil
+		case err != nil:
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(retryDelay):
+			}
+			continue
+		}
+		for _, ch := range page.Changes {
+			if relatedChange(ids, ch) {
+				return nil
+			}
+		}
+		cursor = page.Cursor
+	}
+}
@@ -0,0 +1,364 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"flag"
+	"fmt"
+	"gopan-server/client"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// defaultJobs is how many files are transferred at once
+const defaultJobs = 4
+
+// partSuffix marks files that are still being downloaded
+const partSuffix = ".gopan-part"
+
+// transfer is a file to upload or download
+type transfer struct {
+	local    string
+	remote   string
+	parentID int          // Remote folder, for uploads
+	node     *client.Node // Remote file, for downloads
+}
+
+// runTransfers runs fn for the transfers on jobs workers. Failed transfers
+// are reported and the others carried on with, so running the command
+// again only has to do what is left.
+func runTransfers(ctx context.Context, jobs int, transfers []transfer, fn func(context.Context, transfer) error) error {
+	queue := make(chan transfer)
+	var mu sync.Mutex
+	failed := 0
+
+	var wg sync.WaitGroup
+	for range max(jobs, 1) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for t := range queue {
+				if err := fn(ctx, t); err != nil {
+					fmt.Fprintf(os.Stderr, "gopan-cli: %s: %v\n", t.remote, err)
+					mu.Lock()
+					failed++
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+	for _, t := range transfers {
+		if ctx.Err() != nil {
+			break
+		}
+		queue <- t
+	}
+	close(queue)
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d files failed", failed, len(transfers))
+	}
+	return nil
+}
+
+// hashFile returns the SHA-256 of a file, as the server hashes contents
+func hashFile(f io.ReadSeeker) (string, error) {
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// runPut handles gopan-cli put - Upload files and folders
+// Sources go into the destination folder, which is created when missing,
+// except that a single file may be uploaded as the destination file. Files
+// whose content the remote file has already are skipped, so an interrupted
+// upload is resumed by running it again.
+func runPut(ctx context.Context, a *app, args []string) error {
+	flags := newFlagSet("put")
+	jobs := flags.Int("j", defaultJobs, "number of files uploaded at once")
+	if flags.Parse(args) != nil {
+		return flag.ErrHelp
+	}
+	if flags.NArg() < 2 {
+		return usageError("put")
+	}
+	sources, dst := flags.Args()[:flags.NArg()-1], flags.Arg(flags.NArg()-1)
+
+	st, err := a.client.Stat(ctx, remotePath(dst))
+	if err != nil && !client.IsNotFound(err) {
+		return err
+	}
+	into := true
+	if len(sources) == 1 && !strings.HasSuffix(dst, "/") && (err != nil || !st.IsFolder()) {
+		info, err := os.Stat(sources[0])
+		if err != nil {
+			return err
+		}
+		into = info.IsDir()
+	}
+	if into && err == nil && !st.IsFolder() {
+		return fmt.Errorf("%s is not a folder", st.Path)
+	}
+	dst = remotePath(dst)
+
+	// Folders are created before uploading, one at a time
+	var transfers []transfer
+	folders := make(map[string]int)
+	mkdir := func(p string) (int, error) {
+		if id, ok := folders[p]; ok {
+			return id, nil
+		}
+		n, err := a.client.Mkdir(ctx, p)
+		if err != nil {
+			return 0, fmt.Errorf("%s: %w", p, err)
+		}
+		folders[p] = n.ID
+		return n.ID, nil
+	}
+
+	for _, src := range sources {
+		target := dst
+		if into {
+			target = path.Join(dst, filepath.Base(filepath.Clean(src)))
+		}
+		err := filepath.WalkDir(src, func(local string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			rel, err := filepath.Rel(src, local)
+			if err != nil {
+				return err
+			}
+			remote := path.Join(target, filepath.ToSlash(rel))
+			if d.IsDir() {
+				_, err := mkdir(remote)
+				return err
+			}
+			if !d.Type().IsRegular() {
+				fmt.Fprintf(os.Stderr, "gopan-cli: skipping %s, not a regular file\n", local)
+				return nil
+			}
+			parentID, err := mkdir(path.Dir(remote))
+			if err != nil {
+				return err
+			}
+			transfers = append(transfers, transfer{local: local, remote: remote, parentID: parentID})
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return runTransfers(ctx, *jobs, transfers, func(ctx context.Context, t transfer) error {
+		status, err := upload(ctx, a.client, t)
+		if err == nil {
+			fmt.Printf("%-9s %s\n", status, t.remote)
+		}
+		return err
+	})
+}
+
+// upload uploads a file unless the remote file has the same content, and
+// returns what was done. New files are first created from content the
+// server stores already.
+func upload(ctx context.Context, c *client.Client, t transfer) (string, error) {
+	f, err := os.Open(t.local)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return "", err
+	}
+	hash, err := hashFile(f)
+	if err != nil {
+		return "", err
+	}
+
+	existing, err := c.Stat(ctx, t.remote)
+	switch {
+	case err == nil && existing.IsFolder():
+		return "", errors.New("is a folder")
+	case err == nil && existing.FileHash == hash:
+		return "unchanged", nil
+	case client.IsNotFound(err):
+		if info.Size() > 0 {
+			_, err := c.QuickUpload(ctx, t.parentID, path.Base(t.remote), hash, info.Size())
+			if err == nil {
+				return "instant", nil
+			}
+			if !client.IsNotFound(err) {
+				return "", err
+			}
+		}
+	case err != nil:
+		return "", err
+	}
+
+	if _, err := c.Upload(ctx, t.remote, f, info.Size(), true); err != nil {
+		return "", err
+	}
+	return "uploaded", nil
+}
+
+// runGet handles gopan-cli get - Download files and folders
+// Sources go into the destination folder, which is created when missing,
+// except that a single file may be downloaded as the destination file.
+// Files that are there already are skipped and partial downloads resumed.
+func runGet(ctx context.Context, a *app, args []string) error {
+	flags := newFlagSet("get")
+	jobs := flags.Int("j", defaultJobs, "number of files downloaded at once")
+	if flags.Parse(args) != nil {
+		return flag.ErrHelp
+	}
+	if flags.NArg() < 2 {
+		return usageError("get")
+	}
+	sources, dst := flags.Args()[:flags.NArg()-1], flags.Arg(flags.NArg()-1)
+
+	info, err := os.Stat(dst)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	into := true
+	if len(sources) == 1 && !strings.HasSuffix(dst, string(filepath.Separator)) && !strings.HasSuffix(dst, "/") && (err != nil || !info.IsDir()) {
+		n, err := a.client.Stat(ctx, remotePath(sources[0]))
+		if err != nil {
+			return err
+		}
+		into = n.IsFolder()
+	}
+	if into && err == nil && !info.IsDir() {
+		return fmt.Errorf("%s is not a folder", dst)
+	}
+
+	var transfers []transfer
+	for _, src := range sources {
+		src = remotePath(src)
+		target := dst
+		if into && src != "/" {
+			target = filepath.Join(dst, path.Base(src))
+		}
+		err := a.client.Walk(ctx, src, func(n *client.Node) error {
+			local := filepath.Join(target, filepath.FromSlash(strings.TrimPrefix(n.Path, src)))
+			if n.IsFolder() {
+				return os.MkdirAll(local, 0755)
+			}
+			transfers = append(transfers, transfer{local: local, remote: n.Path, node: n})
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("%s: %w", src, err)
+		}
+	}
+
+	return runTransfers(ctx, *jobs, transfers, func(ctx context.Context, t transfer) error {
+		status, err := download(ctx, a.client, t)
+		if err == nil {
+			fmt.Printf("%-10s %s\n", status, t.local)
+		}
+		return err
+	})
+}
+
+// download downloads a file unless the local file has the same content,
+// and returns what was done. The file is written next to its destination
+// and moved there once its content is verified, and an earlier partial
+// download is continued.
+func download(ctx context.Context, c *client.Client, t transfer) (string, error) {
+	if same, err := sameContent(t.local, t.node); err != nil || same {
+		if err != nil {
+			return "", err
+		}
+		return "unchanged", nil
+	}
+
+	part := t.local + partSuffix
+	var offset int64
+	if info, err := os.Stat(part); err == nil && info.Size() <= t.node.Size {
+		offset = info.Size()
+	}
+	f, err := os.OpenFile(part, os.O_WRONLY|os.O_CREATE, 0644)
+	if err != nil {
+		return "", err
+	}
+	if err := f.Truncate(offset); err != nil {
+		f.Close()
+		return "", err
+	}
+
+	if offset < t.node.Size {
+		r, err := c.Download(ctx, t.remote, offset)
+		if err != nil {
+			f.Close()
+			return "", err
+		}
+		_, err = f.Seek(offset, io.SeekStart)
+		if err == nil {
+			_, err = io.Copy(f, r)
+		}
+		r.Close()
+		if err != nil {
+			f.Close()
+			return "", err
+		}
+	}
+	if err := f.Close(); err != nil {
+		return "", err
+	}
+
+	if same, err := sameContent(part, t.node); err != nil || !same {
+		os.Remove(part)
+		if err == nil {
+			err = errors.New("downloaded content does not match, the file may have changed meanwhile")
+		}
+		return "", err
+	}
+	if err := os.Rename(part, t.local); err != nil {
+		return "", err
+	}
+	os.Chtimes(t.local, t.node.UpdatedAt, t.node.UpdatedAt)
+	if offset > 0 {
+		return "resumed", nil
+	}
+	return "downloaded", nil
+}
+
+// sameContent reports whether the local file has the content of n
+func sameContent(local string, n *client.Node) (bool, error) {
+	f, err := os.Open(local)
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil || info.Size() != n.Size {
+		return false, err
+	}
+	if n.FileHash == "" {
+		return true, nil
+	}
+	hash, err := hashFile(f)
+	return hash == n.FileHash, err
+}
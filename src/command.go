@@ -0,0 +1,44 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"gopan-server/config"
+	"gopan-server/internal/account"
+)
+
+// runCommand runs a management command given on the command line instead of the server
+func runCommand(ctx context.Context, cfg *config.Config, args []string) error {
+	switch args[0] {
+	case "create-admin":
+		fs := flag.NewFlagSet("create-admin", flag.ExitOnError)
+		username := fs.String("username", "", "username of the admin, an existing user is promoted")
+		password := fs.String("password", "", "password, required for a new user")
+		email := fs.String("email", "", "email of a new user")
+		fs.Parse(args[1:])
+
+		u, created, err := account.CreateAdmin(ctx, *username, *password, *email, cfg.Registration.DefaultQuota)
+		if err != nil {
+			return err
+		}
+		if created {
+			fmt.Printf("Created admin user %s (id %d)\n", u.Username, u.ID)
+		} else {
+			fmt.Printf("Promoted user %s (id %d) to admin\n", u.Username, u.ID)
+		}
+		return nil
+	case "sync-ldap":
+		if !cfg.LDAP.Enabled {
+			return fmt.Errorf("LDAP is not enabled in the config")
+		}
+		updated, disabled, err := account.SyncDirectory(ctx, &cfg.LDAP)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Synchronised %d directory users, disabled %d\n", updated, disabled)
+		return nil
+	default:
+		return fmt.Errorf("unknown command %q, available commands: create-admin, sync-ldap", args[0])
+	}
+}
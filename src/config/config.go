@@ -5,22 +5,35 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 )
 
 // Config holds all configuration for the application
 type Config struct {
-	Server   ServerConfig   `json:"server"`
-	Database DatabaseConfig `json:"database"`
-	MinIO    MinIOConfig    `json:"minio"`
-	JWT      JWTConfig      `json:"jwt"`
-	Preview  PreviewConfig  `json:"preview"`
+	Server       ServerConfig       `json:"server"`
+	Database     DatabaseConfig     `json:"database"`
+	MinIO        MinIOConfig        `json:"minio"`
+	JWT          JWTConfig          `json:"jwt"`
+	Preview      PreviewConfig      `json:"preview"`
+	Share        ShareConfig        `json:"share"`
+	Admin        AdminConfig        `json:"admin"`
+	OIDC         OIDCConfig         `json:"oidc"`
+	LDAP         LDAPConfig         `json:"ldap"`
+	Security     SecurityConfig     `json:"security"`
+	Mail         MailConfig         `json:"mail"`
+	Registration RegistrationConfig `json:"registration"`
+	WebDAV       WebDAVConfig       `json:"webdav"`
+	S3           S3Config           `json:"s3"`
+	SFTP         SFTPConfig         `json:"sftp"`
+	Events       EventsConfig       `json:"events"`
 }
 
 // ServerConfig holds server configuration
 type ServerConfig struct {
-	Host string `json:"host"`
-	Port int    `json:"port"`
+	Host    string `json:"host"`
+	Port    int    `json:"port"`
+	BaseURL string `json:"base_url"` // Public URL of GoPan used in share links and link previews, e.g. "https://pan.example.com"
 }
 
 // DatabaseConfig holds database configuration
@@ -44,8 +57,9 @@ type MinIOConfig struct {
 
 // JWTConfig holds JWT configuration
 type JWTConfig struct {
-	Secret     string `json:"secret"`
-	Expiration string `json:"expiration"` // Duration as string (e.g., "24h", "1h30m")
+	Secret            string `json:"secret"`
+	Expiration        string `json:"expiration"`         // Access token lifetime as duration string (e.g., "15m", "1h30m")
+	RefreshExpiration string `json:"refresh_expiration"` // Session (refresh token) lifetime (default: "720h")
 }
 
 // PreviewConfig holds preview service configuration
@@ -59,14 +73,217 @@ type KKFileViewConfig struct {
 	BaseURL string `json:"base_url"` // e.g., "http://localhost:8012"
 }
 
+// ShareConfig holds public share link configuration
+type ShareConfig struct {
+	CodeLength        int    `json:"code_length"`         // Length of generated share codes (default and minimum: 8)
+	CodeAlphabet      string `json:"code_alphabet"`       // Characters used in generated codes (default: no look-alike characters)
+	ExtractCodeLength int    `json:"extract_code_length"` // Length of generated extraction codes (default: 4)
+	SweepInterval     string `json:"sweep_interval"`      // How often expired shares are disabled (default: "10m")
+	ExpiredRetention  string `json:"expired_retention"`   // How long disabled shares are kept, empty to keep forever
+}
+
+// AdminConfig holds the bootstrap administrator account
+type AdminConfig struct {
+	Username string `json:"username"` // Created or promoted on startup when no admin exists
+	Password string `json:"password"` // Password of the admin (required when the user does not exist)
+	Email    string `json:"email"`
+}
+
+// OIDCConfig holds OpenID Connect single sign-on configuration
+type OIDCConfig struct {
+	Enabled      bool             `json:"enabled"`
+	Issuer       string           `json:"issuer"` // Issuer URL, e.g. "https://idp.example.com/realms/main"
+	ClientID     string           `json:"client_id"`
+	ClientSecret string           `json:"client_secret"` // Empty for public clients, which rely on PKCE only
+	RedirectURL  string           `json:"redirect_url"`  // e.g. "https://pan.example.com/api/auth/oidc/callback"
+	Scopes       []string         `json:"scopes"`        // Requested scopes (default: openid, profile, email)
+	ButtonText   string           `json:"button_text"`   // Label of the login button (default: "SSO 登录")
+	AutoCreate   bool             `json:"auto_create"`   // Create users on their first login
+	LinkByEmail  bool             `json:"link_by_email"` // Link local users whose email matches a verified email claim
+	Claims       OIDCClaimsConfig `json:"claims"`
+}
+
+// OIDCClaimsConfig maps ID token claims to user fields
+type OIDCClaimsConfig struct {
+	Username string            `json:"username"` // Username claim (default: "preferred_username")
+	Email    string            `json:"email"`    // Email claim (default: "email")
+	Role     string            `json:"role"`     // Claim holding roles or groups, empty to leave roles alone
+	RoleMap  map[string]string `json:"role_map"` // Claim value to role ("admin", "user" or "readonly")
+	Quota    string            `json:"quota"`    // Claim holding the quota in bytes, empty for the default quota
+}
+
+// LDAPConfig holds LDAP / Active Directory authentication configuration
+type LDAPConfig struct {
+	Enabled            bool              `json:"enabled"`
+	URL                string            `json:"url"`                  // e.g. "ldap://ldap.example.com:389" or "ldaps://dc.example.com:636"
+	StartTLS           bool              `json:"start_tls"`            // Upgrade ldap:// connections with StartTLS
+	InsecureSkipVerify bool              `json:"insecure_skip_verify"` // Skip TLS certificate verification (testing only)
+	BindDN             string            `json:"bind_dn"`              // Service account used to search users, empty for anonymous search
+	BindPassword       string            `json:"bind_password"`
+	BaseDN             string            `json:"base_dn"`            // Subtree searched for users
+	UserFilter         string            `json:"user_filter"`        // %s is replaced by the escaped username (default: "(uid=%s)", AD: "(sAMAccountName=%s)")
+	UsernameAttribute  string            `json:"username_attribute"` // Attribute holding the username (default: "uid")
+	EmailAttribute     string            `json:"email_attribute"`    // Attribute holding the email (default: "mail")
+	GroupAttribute     string            `json:"group_attribute"`    // User attribute listing group DNs (default: "memberOf")
+	GroupBaseDN        string            `json:"group_base_dn"`      // Search groups here instead of reading group_attribute
+	GroupFilter        string            `json:"group_filter"`       // %s is replaced by the user DN (default: "(member=%s)")
+	RequiredGroup      string            `json:"required_group"`     // Only members of this group may log in, empty for everyone
+	RoleGroups         map[string]string `json:"role_groups"`        // Group DN to role ("admin", "user" or "readonly"), unmatched users get "user"
+	QuotaGroups        map[string]int64  `json:"quota_groups"`       // Group DN to quota in bytes, the largest applies
+	SyncInterval       string            `json:"sync_interval"`      // How often directory users are synchronised (default: "1h", "0" disables)
+}
+
+// SecurityConfig holds brute-force protection of logins and share passwords
+type SecurityConfig struct {
+	DisableThrottling bool   `json:"disable_throttling"` // Turn off backoff and lockout (failed attempts are still audited)
+	UserFreeAttempts  int    `json:"user_free_attempts"` // Failures per username or share before backoff starts (default: 3)
+	IPFreeAttempts    int    `json:"ip_free_attempts"`   // Failures per client IP before backoff starts (default: 10)
+	BaseDelay         string `json:"base_delay"`         // First backoff delay, doubled on each further failure (default: "2s")
+	MaxDelay          string `json:"max_delay"`          // Longest backoff delay (default: "5m")
+	LockoutThreshold  int    `json:"lockout_threshold"`  // Failures per username that lock the account, -1 disables lockout (default: 10)
+	LockoutDuration   string `json:"lockout_duration"`   // How long a locked account stays locked (default: "30m")
+	ResetAfter        string `json:"reset_after"`        // Failure counts are forgotten after this long without failures (default: "1h")
+	AuditRetention    string `json:"audit_retention"`    // How long failed attempts are kept (default: "720h")
+}
+
+// MailConfig holds the SMTP server used for password reset and verification emails
+type MailConfig struct {
+	Enabled            bool   `json:"enabled"`
+	Host               string `json:"host"`
+	Port               int    `json:"port"`     // SMTP port (default: 587)
+	Username           string `json:"username"` // Empty for servers without authentication
+	Password           string `json:"password"`
+	From               string `json:"from"`                 // Sender, e.g. "GoPan <noreply@example.com>"
+	Security           string `json:"security"`             // "starttls" (default), "tls" for implicit TLS or "none"
+	InsecureSkipVerify bool   `json:"insecure_skip_verify"` // Skip TLS certificate verification (testing only)
+	BaseURL            string `json:"base_url"`             // Public URL of GoPan used in email links (default: server.base_url)
+}
+
+// Registration modes
+const (
+	RegistrationOpen   = "open"   // Anyone can register, restricted by AllowedDomains when set
+	RegistrationInvite = "invite" // Registration requires an invite code
+	RegistrationClosed = "closed" // Only admins create users
+)
+
+// RegistrationConfig holds the self-registration policy
+type RegistrationConfig struct {
+	Mode           string   `json:"mode"`            // "open" (default), "invite" or "closed"
+	AllowedDomains []string `json:"allowed_domains"` // Email domains allowed to register without an invite, empty allows all
+	DefaultQuota   int64    `json:"default_quota"`   // Storage quota of new users in bytes (default: 10GB)
+}
+
+// WebDAVConfig holds the WebDAV endpoint for mounting files as a network drive
+type WebDAVConfig struct {
+	Enabled         bool   `json:"enabled"`
+	Prefix          string `json:"prefix"`           // URL path of the endpoint (default: "/dav")
+	DisablePassword bool   `json:"disable_password"` // Only accept personal access tokens, not account passwords
+}
+
+// S3Config holds the S3 compatible gateway, which listens on its own port as
+// S3 clients address buckets from the root path
+type S3Config struct {
+	Enabled bool   `json:"enabled"`
+	Host    string `json:"host"`   // Listen address (default: same as server.host)
+	Port    int    `json:"port"`   // Listen port (default: 9100)
+	Region  string `json:"region"` // Region reported to clients (default: "us-east-1")
+}
+
+// SFTPConfig holds the embedded SFTP server
+type SFTPConfig struct {
+	Enabled         bool   `json:"enabled"`
+	Host            string `json:"host"`             // Listen address (default: same as server.host)
+	Port            int    `json:"port"`             // Listen port (default: 2022)
+	HostKey         string `json:"host_key"`         // Private host key file, generated when missing (default: "sftp_host_key")
+	DisablePassword bool   `json:"disable_password"` // Only accept SSH keys and personal access tokens, not account passwords
+}
+
+// EventsConfig holds the delivery of real-time events to connected clients
+type EventsConfig struct {
+	Broker string `json:"broker"` // "memory" (default, single instance) or "postgres" (LISTEN/NOTIFY, across instances)
+}
+
 // GetExpiration returns the parsed duration
 func (j *JWTConfig) GetExpiration() time.Duration {
 	if j.Expiration == "" {
-		return 24 * time.Hour
+		return 15 * time.Minute
 	}
 	duration, err := time.ParseDuration(j.Expiration)
 	if err != nil {
-		return 24 * time.Hour
+		return 15 * time.Minute
+	}
+	return duration
+}
+
+// GetRefreshExpiration returns the parsed session lifetime
+func (j *JWTConfig) GetRefreshExpiration() time.Duration {
+	duration, err := time.ParseDuration(j.RefreshExpiration)
+	if err != nil || duration <= 0 {
+		return 30 * 24 * time.Hour
+	}
+	return duration
+}
+
+// GetSweepInterval returns the parsed share sweep interval
+func (s *ShareConfig) GetSweepInterval() time.Duration {
+	duration, err := time.ParseDuration(s.SweepInterval)
+	if err != nil || duration <= 0 {
+		return 10 * time.Minute
+	}
+	return duration
+}
+
+// GetExpiredRetention returns how long disabled shares are kept, 0 to keep forever
+func (s *ShareConfig) GetExpiredRetention() time.Duration {
+	duration, err := time.ParseDuration(s.ExpiredRetention)
+	if err != nil || duration < 0 {
+		return 0
+	}
+	return duration
+}
+
+// GetSyncInterval returns the parsed directory sync interval, 0 when sync is disabled
+func (l *LDAPConfig) GetSyncInterval() time.Duration {
+	if l.SyncInterval == "" {
+		return time.Hour
+	}
+	duration, err := time.ParseDuration(l.SyncInterval)
+	if err != nil || duration < 0 {
+		return time.Hour
+	}
+	return duration
+}
+
+// GetBaseDelay returns the parsed first backoff delay
+func (s *SecurityConfig) GetBaseDelay() time.Duration {
+	return parsePositiveDuration(s.BaseDelay, 2*time.Second)
+}
+
+// GetMaxDelay returns the parsed longest backoff delay
+func (s *SecurityConfig) GetMaxDelay() time.Duration {
+	return parsePositiveDuration(s.MaxDelay, 5*time.Minute)
+}
+
+// GetLockoutDuration returns the parsed lockout duration
+func (s *SecurityConfig) GetLockoutDuration() time.Duration {
+	return parsePositiveDuration(s.LockoutDuration, 30*time.Minute)
+}
+
+// GetResetAfter returns the parsed failure count lifetime
+func (s *SecurityConfig) GetResetAfter() time.Duration {
+	return parsePositiveDuration(s.ResetAfter, time.Hour)
+}
+
+// GetAuditRetention returns the parsed retention of failed attempts
+func (s *SecurityConfig) GetAuditRetention() time.Duration {
+	return parsePositiveDuration(s.AuditRetention, 30*24*time.Hour)
+}
+
+// parsePositiveDuration parses value, falling back to def when it is empty or invalid
+func parsePositiveDuration(value string, def time.Duration) time.Duration {
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration <= 0 {
+		return def
 	}
 	return duration
 }
@@ -94,7 +311,11 @@ func Load() (*Config, error) {
 		return nil, fmt.Errorf("failed to read config file %s: %w", configPath, err)
 	}
 
-	// Parse JSON
+	return Parse(data)
+}
+
+// Parse parses a configuration file and applies the defaults
+func Parse(data []byte) (*Config, error) {
 	var config Config
 	if err := json.Unmarshal(data, &config); err != nil {
 		return nil, fmt.Errorf("failed to parse config file: %w", err)
@@ -105,7 +326,10 @@ func Load() (*Config, error) {
 		return nil, fmt.Errorf("JWT secret is required in config file")
 	}
 	if config.JWT.Expiration == "" {
-		config.JWT.Expiration = "24h"
+		config.JWT.Expiration = "15m"
+	}
+	if config.JWT.RefreshExpiration == "" {
+		config.JWT.RefreshExpiration = "720h"
 	}
 	if config.Server.Host == "" {
 		config.Server.Host = "0.0.0.0"
@@ -114,6 +338,135 @@ func Load() (*Config, error) {
 		config.Server.Port = 8080
 	}
 
+	// Set default share config
+	// Shorter codes could be enumerated
+	if config.Share.CodeLength < 8 {
+		config.Share.CodeLength = 8
+	}
+	if config.Share.CodeAlphabet == "" {
+		config.Share.CodeAlphabet = "23456789abcdefghjkmnpqrstuvwxyz"
+	}
+	if config.Share.ExtractCodeLength < 4 {
+		config.Share.ExtractCodeLength = 4
+	}
+
+	// Set default OIDC config
+	if len(config.OIDC.Scopes) == 0 {
+		config.OIDC.Scopes = []string{"openid", "profile", "email"}
+	}
+	if config.OIDC.ButtonText == "" {
+		config.OIDC.ButtonText = "SSO 登录"
+	}
+	if config.OIDC.Claims.Username == "" {
+		config.OIDC.Claims.Username = "preferred_username"
+	}
+	if config.OIDC.Claims.Email == "" {
+		config.OIDC.Claims.Email = "email"
+	}
+
+	// Set default security config
+	if config.Security.UserFreeAttempts <= 0 {
+		config.Security.UserFreeAttempts = 3
+	}
+	if config.Security.IPFreeAttempts <= 0 {
+		config.Security.IPFreeAttempts = 10
+	}
+	if config.Security.LockoutThreshold == 0 {
+		config.Security.LockoutThreshold = 10
+	}
+
+	// Set default mail config. Links in emails must not be built from the
+	// request Host header, which a client can forge, so they use the
+	// configured public URL
+	if config.Mail.Port == 0 {
+		config.Mail.Port = 587
+	}
+	if config.Mail.Security == "" {
+		config.Mail.Security = "starttls"
+	}
+	config.Server.BaseURL = strings.TrimRight(config.Server.BaseURL, "/")
+	if config.Mail.BaseURL == "" {
+		config.Mail.BaseURL = config.Server.BaseURL
+	}
+	if config.Mail.Enabled && config.Mail.BaseURL == "" {
+		return nil, fmt.Errorf("server.base_url or mail.base_url is required when mail is enabled")
+	}
+	config.Mail.BaseURL = strings.TrimRight(config.Mail.BaseURL, "/")
+
+	// Set default registration config
+	switch config.Registration.Mode {
+	case "":
+		config.Registration.Mode = RegistrationOpen
+	case RegistrationOpen, RegistrationInvite, RegistrationClosed:
+	default:
+		return nil, fmt.Errorf("invalid registration.mode %q", config.Registration.Mode)
+	}
+	for i, domain := range config.Registration.AllowedDomains {
+		config.Registration.AllowedDomains[i] = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(domain), "@"))
+	}
+	if config.Registration.DefaultQuota <= 0 {
+		config.Registration.DefaultQuota = 10737418240 // 10GB = 10 * 1024 * 1024 * 1024
+	}
+
+	// Set default LDAP config
+	if config.LDAP.UserFilter == "" {
+		config.LDAP.UserFilter = "(uid=%s)"
+	}
+	if config.LDAP.UsernameAttribute == "" {
+		config.LDAP.UsernameAttribute = "uid"
+	}
+	if config.LDAP.EmailAttribute == "" {
+		config.LDAP.EmailAttribute = "mail"
+	}
+	if config.LDAP.GroupAttribute == "" {
+		config.LDAP.GroupAttribute = "memberOf"
+	}
+	if config.LDAP.GroupFilter == "" {
+		config.LDAP.GroupFilter = "(member=%s)"
+	}
+
+	// Set default WebDAV config
+	config.WebDAV.Prefix = "/" + strings.Trim(config.WebDAV.Prefix, "/")
+	if config.WebDAV.Prefix == "/" {
+		config.WebDAV.Prefix = "/dav"
+	}
+	if strings.HasPrefix(config.WebDAV.Prefix+"/", "/api/") {
+		return nil, fmt.Errorf("webdav.prefix must not be below /api")
+	}
+
+	// Set default S3 gateway config
+	if config.S3.Host == "" {
+		config.S3.Host = config.Server.Host
+	}
+	if config.S3.Port == 0 {
+		config.S3.Port = 9100
+	}
+	if config.S3.Region == "" {
+		config.S3.Region = "us-east-1"
+	}
+	if config.S3.Enabled && config.S3.Host == config.Server.Host && config.S3.Port == config.Server.Port {
+		return nil, fmt.Errorf("s3.port must differ from server.port")
+	}
+
+	// Set default SFTP config
+	if config.SFTP.Host == "" {
+		config.SFTP.Host = config.Server.Host
+	}
+	if config.SFTP.Port == 0 {
+		config.SFTP.Port = 2022
+	}
+	if config.SFTP.HostKey == "" {
+		config.SFTP.HostKey = "sftp_host_key"
+	}
+
+	// Set default events config
+	if config.Events.Broker == "" {
+		config.Events.Broker = "memory"
+	}
+	if config.Events.Broker != "memory" && config.Events.Broker != "postgres" {
+		return nil, fmt.Errorf("events.broker must be memory or postgres")
+	}
+
 	// Set default preview config
 	if config.Preview.KKFileView.BaseURL == "" {
 		config.Preview.KKFileView.BaseURL = "http://localhost:8012"
@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"gopan-server/ent/authfailure"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// AuthFailure is the model entity for the AuthFailure schema.
+type AuthFailure struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// What was attempted
+	Kind authfailure.Kind `json:"kind,omitempty"`
+	// Username or share code the attempt was for
+	Subject string `json:"subject,omitempty"`
+	// User the attempt was for, if known
+	UserID *int `json:"user_id,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason authfailure.Reason `json:"reason,omitempty"`
+	// IP holds the value of the "ip" field.
+	IP string `json:"ip,omitempty"`
+	// UserAgent holds the value of the "user_agent" field.
+	UserAgent string `json:"user_agent,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AuthFailure) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case authfailure.FieldID, authfailure.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case authfailure.FieldKind, authfailure.FieldSubject, authfailure.FieldReason, authfailure.FieldIP, authfailure.FieldUserAgent:
+			values[i] = new(sql.NullString)
+		case authfailure.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AuthFailure fields.
+func (af *AuthFailure) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case authfailure.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			af.ID = int(value.Int64)
+		case authfailure.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				af.Kind = authfailure.Kind(value.String)
+			}
+		case authfailure.FieldSubject:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject", values[i])
+			} else if value.Valid {
+				af.Subject = value.String
+			}
+		case authfailure.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				af.UserID = new(int)
+				*af.UserID = int(value.Int64)
+			}
+		case authfailure.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				af.Reason = authfailure.Reason(value.String)
+			}
+		case authfailure.FieldIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip", values[i])
+			} else if value.Valid {
+				af.IP = value.String
+			}
+		case authfailure.FieldUserAgent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_agent", values[i])
+			} else if value.Valid {
+				af.UserAgent = value.String
+			}
+		case authfailure.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				af.CreatedAt = value.Time
+			}
+		default:
+			af.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AuthFailure.
+// This includes values selected through modifiers, order, etc.
+func (af *AuthFailure) Value(name string) (ent.Value, error) {
+	return af.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AuthFailure.
+// Note that you need to call AuthFailure.Unwrap() before calling this method if this AuthFailure
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (af *AuthFailure) Update() *AuthFailureUpdateOne {
+	return NewAuthFailureClient(af.config).UpdateOne(af)
+}
+
+// Unwrap unwraps the AuthFailure entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (af *AuthFailure) Unwrap() *AuthFailure {
+	_tx, ok := af.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AuthFailure is not a transactional entity")
+	}
+	af.config.driver = _tx.drv
+	return af
+}
+
+// String implements the fmt.Stringer.
+func (af *AuthFailure) String() string {
+	var builder strings.Builder
+	builder.WriteString("AuthFailure(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", af.ID))
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", af.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("subject=")
+	builder.WriteString(af.Subject)
+	builder.WriteString(", ")
+	if v := af.UserID; v != nil {
+		builder.WriteString("user_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(fmt.Sprintf("%v", af.Reason))
+	builder.WriteString(", ")
+	builder.WriteString("ip=")
+	builder.WriteString(af.IP)
+	builder.WriteString(", ")
+	builder.WriteString("user_agent=")
+	builder.WriteString(af.UserAgent)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(af.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AuthFailures is a parsable slice of AuthFailure.
+type AuthFailures []*AuthFailure
@@ -0,0 +1,310 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gopan-server/ent/authfailure"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AuthFailureCreate is the builder for creating a AuthFailure entity.
+type AuthFailureCreate struct {
+	config
+	mutation *AuthFailureMutation
+	hooks    []Hook
+}
+
+// SetKind sets the "kind" field.
+func (afc *AuthFailureCreate) SetKind(a authfailure.Kind) *AuthFailureCreate {
+	afc.mutation.SetKind(a)
+	return afc
+}
+
+// SetSubject sets the "subject" field.
+func (afc *AuthFailureCreate) SetSubject(s string) *AuthFailureCreate {
+	afc.mutation.SetSubject(s)
+	return afc
+}
+
+// SetUserID sets the "user_id" field.
+func (afc *AuthFailureCreate) SetUserID(i int) *AuthFailureCreate {
+	afc.mutation.SetUserID(i)
+	return afc
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (afc *AuthFailureCreate) SetNillableUserID(i *int) *AuthFailureCreate {
+	if i != nil {
+		afc.SetUserID(*i)
+	}
+	return afc
+}
+
+// SetReason sets the "reason" field.
+func (afc *AuthFailureCreate) SetReason(a authfailure.Reason) *AuthFailureCreate {
+	afc.mutation.SetReason(a)
+	return afc
+}
+
+// SetIP sets the "ip" field.
+func (afc *AuthFailureCreate) SetIP(s string) *AuthFailureCreate {
+	afc.mutation.SetIP(s)
+	return afc
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (afc *AuthFailureCreate) SetNillableIP(s *string) *AuthFailureCreate {
+	if s != nil {
+		afc.SetIP(*s)
+	}
+	return afc
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (afc *AuthFailureCreate) SetUserAgent(s string) *AuthFailureCreate {
+	afc.mutation.SetUserAgent(s)
+	return afc
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (afc *AuthFailureCreate) SetNillableUserAgent(s *string) *AuthFailureCreate {
+	if s != nil {
+		afc.SetUserAgent(*s)
+	}
+	return afc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (afc *AuthFailureCreate) SetCreatedAt(t time.Time) *AuthFailureCreate {
+	afc.mutation.SetCreatedAt(t)
+	return afc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (afc *AuthFailureCreate) SetNillableCreatedAt(t *time.Time) *AuthFailureCreate {
+	if t != nil {
+		afc.SetCreatedAt(*t)
+	}
+	return afc
+}
+
+// Mutation returns the AuthFailureMutation object of the builder.
+func (afc *AuthFailureCreate) Mutation() *AuthFailureMutation {
+	return afc.mutation
+}
+
+// Save creates the AuthFailure in the database.
+func (afc *AuthFailureCreate) Save(ctx context.Context) (*AuthFailure, error) {
+	afc.defaults()
+	return withHooks(ctx, afc.sqlSave, afc.mutation, afc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (afc *AuthFailureCreate) SaveX(ctx context.Context) *AuthFailure {
+	v, err := afc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (afc *AuthFailureCreate) Exec(ctx context.Context) error {
+	_, err := afc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (afc *AuthFailureCreate) ExecX(ctx context.Context) {
+	if err := afc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (afc *AuthFailureCreate) defaults() {
+	if _, ok := afc.mutation.CreatedAt(); !ok {
+		v := authfailure.DefaultCreatedAt()
+		afc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (afc *AuthFailureCreate) check() error {
+	if _, ok := afc.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "AuthFailure.kind"`)}
+	}
+	if v, ok := afc.mutation.Kind(); ok {
+		if err := authfailure.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "AuthFailure.kind": %w`, err)}
+		}
+	}
+	if _, ok := afc.mutation.Subject(); !ok {
+		return &ValidationError{Name: "subject", err: errors.New(`ent: missing required field "AuthFailure.subject"`)}
+	}
+	if _, ok := afc.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "AuthFailure.reason"`)}
+	}
+	if v, ok := afc.mutation.Reason(); ok {
+		if err := authfailure.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "AuthFailure.reason": %w`, err)}
+		}
+	}
+	if v, ok := afc.mutation.UserAgent(); ok {
+		if err := authfailure.UserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "AuthFailure.user_agent": %w`, err)}
+		}
+	}
+	if _, ok := afc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AuthFailure.created_at"`)}
+	}
+	return nil
+}
+
+func (afc *AuthFailureCreate) sqlSave(ctx context.Context) (*AuthFailure, error) {
+	if err := afc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := afc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, afc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	afc.mutation.id = &_node.ID
+	afc.mutation.done = true
+	return _node, nil
+}
+
+func (afc *AuthFailureCreate) createSpec() (*AuthFailure, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuthFailure{config: afc.config}
+		_spec = sqlgraph.NewCreateSpec(authfailure.Table, sqlgraph.NewFieldSpec(authfailure.FieldID, field.TypeInt))
+	)
+	if value, ok := afc.mutation.Kind(); ok {
+		_spec.SetField(authfailure.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := afc.mutation.Subject(); ok {
+		_spec.SetField(authfailure.FieldSubject, field.TypeString, value)
+		_node.Subject = value
+	}
+	if value, ok := afc.mutation.UserID(); ok {
+		_spec.SetField(authfailure.FieldUserID, field.TypeInt, value)
+		_node.UserID = &value
+	}
+	if value, ok := afc.mutation.Reason(); ok {
+		_spec.SetField(authfailure.FieldReason, field.TypeEnum, value)
+		_node.Reason = value
+	}
+	if value, ok := afc.mutation.IP(); ok {
+		_spec.SetField(authfailure.FieldIP, field.TypeString, value)
+		_node.IP = value
+	}
+	if value, ok := afc.mutation.UserAgent(); ok {
+		_spec.SetField(authfailure.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = value
+	}
+	if value, ok := afc.mutation.CreatedAt(); ok {
+		_spec.SetField(authfailure.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// AuthFailureCreateBulk is the builder for creating many AuthFailure entities in bulk.
+type AuthFailureCreateBulk struct {
+	config
+	err      error
+	builders []*AuthFailureCreate
+}
+
+// Save creates the AuthFailure entities in the database.
+func (afcb *AuthFailureCreateBulk) Save(ctx context.Context) ([]*AuthFailure, error) {
+	if afcb.err != nil {
+		return nil, afcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(afcb.builders))
+	nodes := make([]*AuthFailure, len(afcb.builders))
+	mutators := make([]Mutator, len(afcb.builders))
+	for i := range afcb.builders {
+		func(i int, root context.Context) {
+			builder := afcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuthFailureMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, afcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, afcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, afcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (afcb *AuthFailureCreateBulk) SaveX(ctx context.Context) []*AuthFailure {
+	v, err := afcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (afcb *AuthFailureCreateBulk) Exec(ctx context.Context) error {
+	_, err := afcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (afcb *AuthFailureCreateBulk) ExecX(ctx context.Context) {
+	if err := afcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"gopan-server/ent/authfailure"
+	"gopan-server/ent/predicate"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AuthFailureQuery is the builder for querying AuthFailure entities.
+type AuthFailureQuery struct {
+	config
+	ctx        *QueryContext
+	order      []authfailure.OrderOption
+	inters     []Interceptor
+	predicates []predicate.AuthFailure
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the AuthFailureQuery builder.
+func (afq *AuthFailureQuery) Where(ps ...predicate.AuthFailure) *AuthFailureQuery {
+	afq.predicates = append(afq.predicates, ps...)
+	return afq
+}
+
+// Limit the number of records to be returned by this query.
+func (afq *AuthFailureQuery) Limit(limit int) *AuthFailureQuery {
+	afq.ctx.Limit = &limit
+	return afq
+}
+
+// Offset to start from.
+func (afq *AuthFailureQuery) Offset(offset int) *AuthFailureQuery {
+	afq.ctx.Offset = &offset
+	return afq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (afq *AuthFailureQuery) Unique(unique bool) *AuthFailureQuery {
+	afq.ctx.Unique = &unique
+	return afq
+}
+
+// Order specifies how the records should be ordered.
+func (afq *AuthFailureQuery) Order(o ...authfailure.OrderOption) *AuthFailureQuery {
+	afq.order = append(afq.order, o...)
+	return afq
+}
+
+// First returns the first AuthFailure entity from the query.
+// Returns a *NotFoundError when no AuthFailure was found.
+func (afq *AuthFailureQuery) First(ctx context.Context) (*AuthFailure, error) {
+	nodes, err := afq.Limit(1).All(setContextOp(ctx, afq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{authfailure.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (afq *AuthFailureQuery) FirstX(ctx context.Context) *AuthFailure {
+	node, err := afq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first AuthFailure ID from the query.
+// Returns a *NotFoundError when no AuthFailure ID was found.
+func (afq *AuthFailureQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = afq.Limit(1).IDs(setContextOp(ctx, afq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{authfailure.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (afq *AuthFailureQuery) FirstIDX(ctx context.Context) int {
+	id, err := afq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single AuthFailure entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one AuthFailure entity is found.
+// Returns a *NotFoundError when no AuthFailure entities are found.
+func (afq *AuthFailureQuery) Only(ctx context.Context) (*AuthFailure, error) {
+	nodes, err := afq.Limit(2).All(setContextOp(ctx, afq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{authfailure.Label}
+	default:
+		return nil, &NotSingularError{authfailure.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (afq *AuthFailureQuery) OnlyX(ctx context.Context) *AuthFailure {
+	node, err := afq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only AuthFailure ID in the query.
+// Returns a *NotSingularError when more than one AuthFailure ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (afq *AuthFailureQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = afq.Limit(2).IDs(setContextOp(ctx, afq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{authfailure.Label}
+	default:
+		err = &NotSingularError{authfailure.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (afq *AuthFailureQuery) OnlyIDX(ctx context.Context) int {
+	id, err := afq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of AuthFailures.
+func (afq *AuthFailureQuery) All(ctx context.Context) ([]*AuthFailure, error) {
+	ctx = setContextOp(ctx, afq.ctx, ent.OpQueryAll)
+	if err := afq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*AuthFailure, *AuthFailureQuery]()
+	return withInterceptors[[]*AuthFailure](ctx, afq, qr, afq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (afq *AuthFailureQuery) AllX(ctx context.Context) []*AuthFailure {
+	nodes, err := afq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of AuthFailure IDs.
+func (afq *AuthFailureQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if afq.ctx.Unique == nil && afq.path != nil {
+		afq.Unique(true)
+	}
+	ctx = setContextOp(ctx, afq.ctx, ent.OpQueryIDs)
+	if err = afq.Select(authfailure.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (afq *AuthFailureQuery) IDsX(ctx context.Context) []int {
+	ids, err := afq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (afq *AuthFailureQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, afq.ctx, ent.OpQueryCount)
+	if err := afq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, afq, querierCount[*AuthFailureQuery](), afq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (afq *AuthFailureQuery) CountX(ctx context.Context) int {
+	count, err := afq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (afq *AuthFailureQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, afq.ctx, ent.OpQueryExist)
+	switch _, err := afq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (afq *AuthFailureQuery) ExistX(ctx context.Context) bool {
+	exist, err := afq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the AuthFailureQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (afq *AuthFailureQuery) Clone() *AuthFailureQuery {
+	if afq == nil {
+		return nil
+	}
+	return &AuthFailureQuery{
+		config:     afq.config,
+		ctx:        afq.ctx.Clone(),
+		order:      append([]authfailure.OrderOption{}, afq.order...),
+		inters:     append([]Interceptor{}, afq.inters...),
+		predicates: append([]predicate.AuthFailure{}, afq.predicates...),
+		// clone intermediate query.
+		sql:  afq.sql.Clone(),
+		path: afq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Kind authfailure.Kind `json:"kind,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.AuthFailure.Query().
+//		GroupBy(authfailure.FieldKind).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (afq *AuthFailureQuery) GroupBy(field string, fields ...string) *AuthFailureGroupBy {
+	afq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &AuthFailureGroupBy{build: afq}
+	grbuild.flds = &afq.ctx.Fields
+	grbuild.label = authfailure.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Kind authfailure.Kind `json:"kind,omitempty"`
+//	}
+//
+//	client.AuthFailure.Query().
+//		Select(authfailure.FieldKind).
+//		Scan(ctx, &v)
+func (afq *AuthFailureQuery) Select(fields ...string) *AuthFailureSelect {
+	afq.ctx.Fields = append(afq.ctx.Fields, fields...)
+	sbuild := &AuthFailureSelect{AuthFailureQuery: afq}
+	sbuild.label = authfailure.Label
+	sbuild.flds, sbuild.scan = &afq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a AuthFailureSelect configured with the given aggregations.
+func (afq *AuthFailureQuery) Aggregate(fns ...AggregateFunc) *AuthFailureSelect {
+	return afq.Select().Aggregate(fns...)
+}
+
+func (afq *AuthFailureQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range afq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, afq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range afq.ctx.Fields {
+		if !authfailure.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if afq.path != nil {
+		prev, err := afq.path(ctx)
+		if err != nil {
+			return err
+		}
+		afq.sql = prev
+	}
+	return nil
+}
+
+func (afq *AuthFailureQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*AuthFailure, error) {
+	var (
+		nodes = []*AuthFailure{}
+		_spec = afq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*AuthFailure).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &AuthFailure{config: afq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, afq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (afq *AuthFailureQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := afq.querySpec()
+	_spec.Node.Columns = afq.ctx.Fields
+	if len(afq.ctx.Fields) > 0 {
+		_spec.Unique = afq.ctx.Unique != nil && *afq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, afq.driver, _spec)
+}
+
+func (afq *AuthFailureQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(authfailure.Table, authfailure.Columns, sqlgraph.NewFieldSpec(authfailure.FieldID, field.TypeInt))
+	_spec.From = afq.sql
+	if unique := afq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if afq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := afq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, authfailure.FieldID)
+		for i := range fields {
+			if fields[i] != authfailure.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := afq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := afq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := afq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := afq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (afq *AuthFailureQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(afq.driver.Dialect())
+	t1 := builder.Table(authfailure.Table)
+	columns := afq.ctx.Fields
+	if len(columns) == 0 {
+		columns = authfailure.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if afq.sql != nil {
+		selector = afq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if afq.ctx.Unique != nil && *afq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range afq.predicates {
+		p(selector)
+	}
+	for _, p := range afq.order {
+		p(selector)
+	}
+	if offset := afq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := afq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// AuthFailureGroupBy is the group-by builder for AuthFailure entities.
+type AuthFailureGroupBy struct {
+	selector
+	build *AuthFailureQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (afgb *AuthFailureGroupBy) Aggregate(fns ...AggregateFunc) *AuthFailureGroupBy {
+	afgb.fns = append(afgb.fns, fns...)
+	return afgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (afgb *AuthFailureGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, afgb.build.ctx, ent.OpQueryGroupBy)
+	if err := afgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*AuthFailureQuery, *AuthFailureGroupBy](ctx, afgb.build, afgb, afgb.build.inters, v)
+}
+
+func (afgb *AuthFailureGroupBy) sqlScan(ctx context.Context, root *AuthFailureQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(afgb.fns))
+	for _, fn := range afgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*afgb.flds)+len(afgb.fns))
+		for _, f := range *afgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*afgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := afgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// AuthFailureSelect is the builder for selecting fields of AuthFailure entities.
+type AuthFailureSelect struct {
+	*AuthFailureQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (afs *AuthFailureSelect) Aggregate(fns ...AggregateFunc) *AuthFailureSelect {
+	afs.fns = append(afs.fns, fns...)
+	return afs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (afs *AuthFailureSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, afs.ctx, ent.OpQuerySelect)
+	if err := afs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*AuthFailureQuery, *AuthFailureSelect](ctx, afs.AuthFailureQuery, afs, afs.inters, v)
+}
+
+func (afs *AuthFailureSelect) sqlScan(ctx context.Context, root *AuthFailureQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(afs.fns))
+	for _, fn := range afs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*afs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := afs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,534 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gopan-server/ent/authfailure"
+	"gopan-server/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AuthFailureUpdate is the builder for updating AuthFailure entities.
+type AuthFailureUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AuthFailureMutation
+}
+
+// Where appends a list predicates to the AuthFailureUpdate builder.
+func (afu *AuthFailureUpdate) Where(ps ...predicate.AuthFailure) *AuthFailureUpdate {
+	afu.mutation.Where(ps...)
+	return afu
+}
+
+// SetKind sets the "kind" field.
+func (afu *AuthFailureUpdate) SetKind(a authfailure.Kind) *AuthFailureUpdate {
+	afu.mutation.SetKind(a)
+	return afu
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (afu *AuthFailureUpdate) SetNillableKind(a *authfailure.Kind) *AuthFailureUpdate {
+	if a != nil {
+		afu.SetKind(*a)
+	}
+	return afu
+}
+
+// SetSubject sets the "subject" field.
+func (afu *AuthFailureUpdate) SetSubject(s string) *AuthFailureUpdate {
+	afu.mutation.SetSubject(s)
+	return afu
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (afu *AuthFailureUpdate) SetNillableSubject(s *string) *AuthFailureUpdate {
+	if s != nil {
+		afu.SetSubject(*s)
+	}
+	return afu
+}
+
+// SetUserID sets the "user_id" field.
+func (afu *AuthFailureUpdate) SetUserID(i int) *AuthFailureUpdate {
+	afu.mutation.ResetUserID()
+	afu.mutation.SetUserID(i)
+	return afu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (afu *AuthFailureUpdate) SetNillableUserID(i *int) *AuthFailureUpdate {
+	if i != nil {
+		afu.SetUserID(*i)
+	}
+	return afu
+}
+
+// AddUserID adds i to the "user_id" field.
+func (afu *AuthFailureUpdate) AddUserID(i int) *AuthFailureUpdate {
+	afu.mutation.AddUserID(i)
+	return afu
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (afu *AuthFailureUpdate) ClearUserID() *AuthFailureUpdate {
+	afu.mutation.ClearUserID()
+	return afu
+}
+
+// SetReason sets the "reason" field.
+func (afu *AuthFailureUpdate) SetReason(a authfailure.Reason) *AuthFailureUpdate {
+	afu.mutation.SetReason(a)
+	return afu
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (afu *AuthFailureUpdate) SetNillableReason(a *authfailure.Reason) *AuthFailureUpdate {
+	if a != nil {
+		afu.SetReason(*a)
+	}
+	return afu
+}
+
+// SetIP sets the "ip" field.
+func (afu *AuthFailureUpdate) SetIP(s string) *AuthFailureUpdate {
+	afu.mutation.SetIP(s)
+	return afu
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (afu *AuthFailureUpdate) SetNillableIP(s *string) *AuthFailureUpdate {
+	if s != nil {
+		afu.SetIP(*s)
+	}
+	return afu
+}
+
+// ClearIP clears the value of the "ip" field.
+func (afu *AuthFailureUpdate) ClearIP() *AuthFailureUpdate {
+	afu.mutation.ClearIP()
+	return afu
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (afu *AuthFailureUpdate) SetUserAgent(s string) *AuthFailureUpdate {
+	afu.mutation.SetUserAgent(s)
+	return afu
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (afu *AuthFailureUpdate) SetNillableUserAgent(s *string) *AuthFailureUpdate {
+	if s != nil {
+		afu.SetUserAgent(*s)
+	}
+	return afu
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (afu *AuthFailureUpdate) ClearUserAgent() *AuthFailureUpdate {
+	afu.mutation.ClearUserAgent()
+	return afu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (afu *AuthFailureUpdate) SetCreatedAt(t time.Time) *AuthFailureUpdate {
+	afu.mutation.SetCreatedAt(t)
+	return afu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (afu *AuthFailureUpdate) SetNillableCreatedAt(t *time.Time) *AuthFailureUpdate {
+	if t != nil {
+		afu.SetCreatedAt(*t)
+	}
+	return afu
+}
+
+// Mutation returns the AuthFailureMutation object of the builder.
+func (afu *AuthFailureUpdate) Mutation() *AuthFailureMutation {
+	return afu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (afu *AuthFailureUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, afu.sqlSave, afu.mutation, afu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (afu *AuthFailureUpdate) SaveX(ctx context.Context) int {
+	affected, err := afu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (afu *AuthFailureUpdate) Exec(ctx context.Context) error {
+	_, err := afu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (afu *AuthFailureUpdate) ExecX(ctx context.Context) {
+	if err := afu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (afu *AuthFailureUpdate) check() error {
+	if v, ok := afu.mutation.Kind(); ok {
+		if err := authfailure.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "AuthFailure.kind": %w`, err)}
+		}
+	}
+	if v, ok := afu.mutation.Reason(); ok {
+		if err := authfailure.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "AuthFailure.reason": %w`, err)}
+		}
+	}
+	if v, ok := afu.mutation.UserAgent(); ok {
+		if err := authfailure.UserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "AuthFailure.user_agent": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (afu *AuthFailureUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := afu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(authfailure.Table, authfailure.Columns, sqlgraph.NewFieldSpec(authfailure.FieldID, field.TypeInt))
+	if ps := afu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := afu.mutation.Kind(); ok {
+		_spec.SetField(authfailure.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := afu.mutation.Subject(); ok {
+		_spec.SetField(authfailure.FieldSubject, field.TypeString, value)
+	}
+	if value, ok := afu.mutation.UserID(); ok {
+		_spec.SetField(authfailure.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := afu.mutation.AddedUserID(); ok {
+		_spec.AddField(authfailure.FieldUserID, field.TypeInt, value)
+	}
+	if afu.mutation.UserIDCleared() {
+		_spec.ClearField(authfailure.FieldUserID, field.TypeInt)
+	}
+	if value, ok := afu.mutation.Reason(); ok {
+		_spec.SetField(authfailure.FieldReason, field.TypeEnum, value)
+	}
+	if value, ok := afu.mutation.IP(); ok {
+		_spec.SetField(authfailure.FieldIP, field.TypeString, value)
+	}
+	if afu.mutation.IPCleared() {
+		_spec.ClearField(authfailure.FieldIP, field.TypeString)
+	}
+	if value, ok := afu.mutation.UserAgent(); ok {
+		_spec.SetField(authfailure.FieldUserAgent, field.TypeString, value)
+	}
+	if afu.mutation.UserAgentCleared() {
+		_spec.ClearField(authfailure.FieldUserAgent, field.TypeString)
+	}
+	if value, ok := afu.mutation.CreatedAt(); ok {
+		_spec.SetField(authfailure.FieldCreatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, afu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{authfailure.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	afu.mutation.done = true
+	return n, nil
+}
+
+// AuthFailureUpdateOne is the builder for updating a single AuthFailure entity.
+type AuthFailureUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AuthFailureMutation
+}
+
+// SetKind sets the "kind" field.
+func (afuo *AuthFailureUpdateOne) SetKind(a authfailure.Kind) *AuthFailureUpdateOne {
+	afuo.mutation.SetKind(a)
+	return afuo
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (afuo *AuthFailureUpdateOne) SetNillableKind(a *authfailure.Kind) *AuthFailureUpdateOne {
+	if a != nil {
+		afuo.SetKind(*a)
+	}
+	return afuo
+}
+
+// SetSubject sets the "subject" field.
+func (afuo *AuthFailureUpdateOne) SetSubject(s string) *AuthFailureUpdateOne {
+	afuo.mutation.SetSubject(s)
+	return afuo
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (afuo *AuthFailureUpdateOne) SetNillableSubject(s *string) *AuthFailureUpdateOne {
+	if s != nil {
+		afuo.SetSubject(*s)
+	}
+	return afuo
+}
+
+// SetUserID sets the "user_id" field.
+func (afuo *AuthFailureUpdateOne) SetUserID(i int) *AuthFailureUpdateOne {
+	afuo.mutation.ResetUserID()
+	afuo.mutation.SetUserID(i)
+	return afuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (afuo *AuthFailureUpdateOne) SetNillableUserID(i *int) *AuthFailureUpdateOne {
+	if i != nil {
+		afuo.SetUserID(*i)
+	}
+	return afuo
+}
+
+// AddUserID adds i to the "user_id" field.
+func (afuo *AuthFailureUpdateOne) AddUserID(i int) *AuthFailureUpdateOne {
+	afuo.mutation.AddUserID(i)
+	return afuo
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (afuo *AuthFailureUpdateOne) ClearUserID() *AuthFailureUpdateOne {
+	afuo.mutation.ClearUserID()
+	return afuo
+}
+
+// SetReason sets the "reason" field.
+func (afuo *AuthFailureUpdateOne) SetReason(a authfailure.Reason) *AuthFailureUpdateOne {
+	afuo.mutation.SetReason(a)
+	return afuo
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (afuo *AuthFailureUpdateOne) SetNillableReason(a *authfailure.Reason) *AuthFailureUpdateOne {
+	if a != nil {
+		afuo.SetReason(*a)
+	}
+	return afuo
+}
+
+// SetIP sets the "ip" field.
+func (afuo *AuthFailureUpdateOne) SetIP(s string) *AuthFailureUpdateOne {
+	afuo.mutation.SetIP(s)
+	return afuo
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (afuo *AuthFailureUpdateOne) SetNillableIP(s *string) *AuthFailureUpdateOne {
+	if s != nil {
+		afuo.SetIP(*s)
+	}
+	return afuo
+}
+
+// ClearIP clears the value of the "ip" field.
+func (afuo *AuthFailureUpdateOne) ClearIP() *AuthFailureUpdateOne {
+	afuo.mutation.ClearIP()
+	return afuo
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (afuo *AuthFailureUpdateOne) SetUserAgent(s string) *AuthFailureUpdateOne {
+	afuo.mutation.SetUserAgent(s)
+	return afuo
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (afuo *AuthFailureUpdateOne) SetNillableUserAgent(s *string) *AuthFailureUpdateOne {
+	if s != nil {
+		afuo.SetUserAgent(*s)
+	}
+	return afuo
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (afuo *AuthFailureUpdateOne) ClearUserAgent() *AuthFailureUpdateOne {
+	afuo.mutation.ClearUserAgent()
+	return afuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (afuo *AuthFailureUpdateOne) SetCreatedAt(t time.Time) *AuthFailureUpdateOne {
+	afuo.mutation.SetCreatedAt(t)
+	return afuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (afuo *AuthFailureUpdateOne) SetNillableCreatedAt(t *time.Time) *AuthFailureUpdateOne {
+	if t != nil {
+		afuo.SetCreatedAt(*t)
+	}
+	return afuo
+}
+
+// Mutation returns the AuthFailureMutation object of the builder.
+func (afuo *AuthFailureUpdateOne) Mutation() *AuthFailureMutation {
+	return afuo.mutation
+}
+
+// Where appends a list predicates to the AuthFailureUpdate builder.
+func (afuo *AuthFailureUpdateOne) Where(ps ...predicate.AuthFailure) *AuthFailureUpdateOne {
+	afuo.mutation.Where(ps...)
+	return afuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (afuo *AuthFailureUpdateOne) Select(field string, fields ...string) *AuthFailureUpdateOne {
+	afuo.fields = append([]string{field}, fields...)
+	return afuo
+}
+
+// Save executes the query and returns the updated AuthFailure entity.
+func (afuo *AuthFailureUpdateOne) Save(ctx context.Context) (*AuthFailure, error) {
+	return withHooks(ctx, afuo.sqlSave, afuo.mutation, afuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (afuo *AuthFailureUpdateOne) SaveX(ctx context.Context) *AuthFailure {
+	node, err := afuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (afuo *AuthFailureUpdateOne) Exec(ctx context.Context) error {
+	_, err := afuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (afuo *AuthFailureUpdateOne) ExecX(ctx context.Context) {
+	if err := afuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (afuo *AuthFailureUpdateOne) check() error {
+	if v, ok := afuo.mutation.Kind(); ok {
+		if err := authfailure.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "AuthFailure.kind": %w`, err)}
+		}
+	}
+	if v, ok := afuo.mutation.Reason(); ok {
+		if err := authfailure.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "AuthFailure.reason": %w`, err)}
+		}
+	}
+	if v, ok := afuo.mutation.UserAgent(); ok {
+		if err := authfailure.UserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "AuthFailure.user_agent": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (afuo *AuthFailureUpdateOne) sqlSave(ctx context.Context) (_node *AuthFailure, err error) {
+	if err := afuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(authfailure.Table, authfailure.Columns, sqlgraph.NewFieldSpec(authfailure.FieldID, field.TypeInt))
+	id, ok := afuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AuthFailure.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := afuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, authfailure.FieldID)
+		for _, f := range fields {
+			if !authfailure.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != authfailure.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := afuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := afuo.mutation.Kind(); ok {
+		_spec.SetField(authfailure.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := afuo.mutation.Subject(); ok {
+		_spec.SetField(authfailure.FieldSubject, field.TypeString, value)
+	}
+	if value, ok := afuo.mutation.UserID(); ok {
+		_spec.SetField(authfailure.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := afuo.mutation.AddedUserID(); ok {
+		_spec.AddField(authfailure.FieldUserID, field.TypeInt, value)
+	}
+	if afuo.mutation.UserIDCleared() {
+		_spec.ClearField(authfailure.FieldUserID, field.TypeInt)
+	}
+	if value, ok := afuo.mutation.Reason(); ok {
+		_spec.SetField(authfailure.FieldReason, field.TypeEnum, value)
+	}
+	if value, ok := afuo.mutation.IP(); ok {
+		_spec.SetField(authfailure.FieldIP, field.TypeString, value)
+	}
+	if afuo.mutation.IPCleared() {
+		_spec.ClearField(authfailure.FieldIP, field.TypeString)
+	}
+	if value, ok := afuo.mutation.UserAgent(); ok {
+		_spec.SetField(authfailure.FieldUserAgent, field.TypeString, value)
+	}
+	if afuo.mutation.UserAgentCleared() {
+		_spec.ClearField(authfailure.FieldUserAgent, field.TypeString)
+	}
+	if value, ok := afuo.mutation.CreatedAt(); ok {
+		_spec.SetField(authfailure.FieldCreatedAt, field.TypeTime, value)
+	}
+	_node = &AuthFailure{config: afuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, afuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{authfailure.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	afuo.mutation.done = true
+	return _node, nil
+}
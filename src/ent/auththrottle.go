@@ -0,0 +1,155 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"gopan-server/ent/auththrottle"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// AuthThrottle is the model entity for the AuthThrottle schema.
+type AuthThrottle struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Throttled subject, e.g. user:alice, ip:10.0.0.1 or share:42
+	Key string `json:"key,omitempty"`
+	// Failed attempts since the last reset
+	Failures int `json:"failures,omitempty"`
+	// LastFailureAt holds the value of the "last_failure_at" field.
+	LastFailureAt time.Time `json:"last_failure_at,omitempty"`
+	// Attempts are rejected until this time
+	BlockedUntil *time.Time `json:"blocked_until,omitempty"`
+	// Whether the block is a lockout rather than a backoff delay
+	Locked       bool `json:"locked,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AuthThrottle) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case auththrottle.FieldLocked:
+			values[i] = new(sql.NullBool)
+		case auththrottle.FieldID, auththrottle.FieldFailures:
+			values[i] = new(sql.NullInt64)
+		case auththrottle.FieldKey:
+			values[i] = new(sql.NullString)
+		case auththrottle.FieldLastFailureAt, auththrottle.FieldBlockedUntil:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AuthThrottle fields.
+func (at *AuthThrottle) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case auththrottle.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			at.ID = int(value.Int64)
+		case auththrottle.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				at.Key = value.String
+			}
+		case auththrottle.FieldFailures:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field failures", values[i])
+			} else if value.Valid {
+				at.Failures = int(value.Int64)
+			}
+		case auththrottle.FieldLastFailureAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_failure_at", values[i])
+			} else if value.Valid {
+				at.LastFailureAt = value.Time
+			}
+		case auththrottle.FieldBlockedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field blocked_until", values[i])
+			} else if value.Valid {
+				at.BlockedUntil = new(time.Time)
+				*at.BlockedUntil = value.Time
+			}
+		case auththrottle.FieldLocked:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field locked", values[i])
+			} else if value.Valid {
+				at.Locked = value.Bool
+			}
+		default:
+			at.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AuthThrottle.
+// This includes values selected through modifiers, order, etc.
+func (at *AuthThrottle) Value(name string) (ent.Value, error) {
+	return at.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AuthThrottle.
+// Note that you need to call AuthThrottle.Unwrap() before calling this method if this AuthThrottle
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (at *AuthThrottle) Update() *AuthThrottleUpdateOne {
+	return NewAuthThrottleClient(at.config).UpdateOne(at)
+}
+
+// Unwrap unwraps the AuthThrottle entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (at *AuthThrottle) Unwrap() *AuthThrottle {
+	_tx, ok := at.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AuthThrottle is not a transactional entity")
+	}
+	at.config.driver = _tx.drv
+	return at
+}
+
+// String implements the fmt.Stringer.
+func (at *AuthThrottle) String() string {
+	var builder strings.Builder
+	builder.WriteString("AuthThrottle(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", at.ID))
+	builder.WriteString("key=")
+	builder.WriteString(at.Key)
+	builder.WriteString(", ")
+	builder.WriteString("failures=")
+	builder.WriteString(fmt.Sprintf("%v", at.Failures))
+	builder.WriteString(", ")
+	builder.WriteString("last_failure_at=")
+	builder.WriteString(at.LastFailureAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := at.BlockedUntil; v != nil {
+		builder.WriteString("blocked_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("locked=")
+	builder.WriteString(fmt.Sprintf("%v", at.Locked))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AuthThrottles is a parsable slice of AuthThrottle.
+type AuthThrottles []*AuthThrottle
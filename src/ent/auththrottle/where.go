@@ -0,0 +1,300 @@
+// Code generated by ent, DO NOT EDIT.
+
+package auththrottle
+
+import (
+	"gopan-server/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldLTE(FieldID, id))
+}
+
+// Key applies equality check predicate on the "key" field. It's identical to KeyEQ.
+func Key(v string) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldEQ(FieldKey, v))
+}
+
+// Failures applies equality check predicate on the "failures" field. It's identical to FailuresEQ.
+func Failures(v int) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldEQ(FieldFailures, v))
+}
+
+// LastFailureAt applies equality check predicate on the "last_failure_at" field. It's identical to LastFailureAtEQ.
+func LastFailureAt(v time.Time) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldEQ(FieldLastFailureAt, v))
+}
+
+// BlockedUntil applies equality check predicate on the "blocked_until" field. It's identical to BlockedUntilEQ.
+func BlockedUntil(v time.Time) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldEQ(FieldBlockedUntil, v))
+}
+
+// Locked applies equality check predicate on the "locked" field. It's identical to LockedEQ.
+func Locked(v bool) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldEQ(FieldLocked, v))
+}
+
+// KeyEQ applies the EQ predicate on the "key" field.
+func KeyEQ(v string) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldEQ(FieldKey, v))
+}
+
+// KeyNEQ applies the NEQ predicate on the "key" field.
+func KeyNEQ(v string) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldNEQ(FieldKey, v))
+}
+
+// KeyIn applies the In predicate on the "key" field.
+func KeyIn(vs ...string) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldIn(FieldKey, vs...))
+}
+
+// KeyNotIn applies the NotIn predicate on the "key" field.
+func KeyNotIn(vs ...string) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldNotIn(FieldKey, vs...))
+}
+
+// KeyGT applies the GT predicate on the "key" field.
+func KeyGT(v string) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldGT(FieldKey, v))
+}
+
+// KeyGTE applies the GTE predicate on the "key" field.
+func KeyGTE(v string) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldGTE(FieldKey, v))
+}
+
+// KeyLT applies the LT predicate on the "key" field.
+func KeyLT(v string) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldLT(FieldKey, v))
+}
+
+// KeyLTE applies the LTE predicate on the "key" field.
+func KeyLTE(v string) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldLTE(FieldKey, v))
+}
+
+// KeyContains applies the Contains predicate on the "key" field.
+func KeyContains(v string) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldContains(FieldKey, v))
+}
+
+// KeyHasPrefix applies the HasPrefix predicate on the "key" field.
+func KeyHasPrefix(v string) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldHasPrefix(FieldKey, v))
+}
+
+// KeyHasSuffix applies the HasSuffix predicate on the "key" field.
+func KeyHasSuffix(v string) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldHasSuffix(FieldKey, v))
+}
+
+// KeyEqualFold applies the EqualFold predicate on the "key" field.
+func KeyEqualFold(v string) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldEqualFold(FieldKey, v))
+}
+
+// KeyContainsFold applies the ContainsFold predicate on the "key" field.
+func KeyContainsFold(v string) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldContainsFold(FieldKey, v))
+}
+
+// FailuresEQ applies the EQ predicate on the "failures" field.
+func FailuresEQ(v int) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldEQ(FieldFailures, v))
+}
+
+// FailuresNEQ applies the NEQ predicate on the "failures" field.
+func FailuresNEQ(v int) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldNEQ(FieldFailures, v))
+}
+
+// FailuresIn applies the In predicate on the "failures" field.
+func FailuresIn(vs ...int) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldIn(FieldFailures, vs...))
+}
+
+// FailuresNotIn applies the NotIn predicate on the "failures" field.
+func FailuresNotIn(vs ...int) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldNotIn(FieldFailures, vs...))
+}
+
+// FailuresGT applies the GT predicate on the "failures" field.
+func FailuresGT(v int) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldGT(FieldFailures, v))
+}
+
+// FailuresGTE applies the GTE predicate on the "failures" field.
+func FailuresGTE(v int) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldGTE(FieldFailures, v))
+}
+
+// FailuresLT applies the LT predicate on the "failures" field.
+func FailuresLT(v int) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldLT(FieldFailures, v))
+}
+
+// FailuresLTE applies the LTE predicate on the "failures" field.
+func FailuresLTE(v int) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldLTE(FieldFailures, v))
+}
+
+// LastFailureAtEQ applies the EQ predicate on the "last_failure_at" field.
+func LastFailureAtEQ(v time.Time) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldEQ(FieldLastFailureAt, v))
+}
+
+// LastFailureAtNEQ applies the NEQ predicate on the "last_failure_at" field.
+func LastFailureAtNEQ(v time.Time) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldNEQ(FieldLastFailureAt, v))
+}
+
+// LastFailureAtIn applies the In predicate on the "last_failure_at" field.
+func LastFailureAtIn(vs ...time.Time) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldIn(FieldLastFailureAt, vs...))
+}
+
+// LastFailureAtNotIn applies the NotIn predicate on the "last_failure_at" field.
+func LastFailureAtNotIn(vs ...time.Time) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldNotIn(FieldLastFailureAt, vs...))
+}
+
+// LastFailureAtGT applies the GT predicate on the "last_failure_at" field.
+func LastFailureAtGT(v time.Time) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldGT(FieldLastFailureAt, v))
+}
+
+// LastFailureAtGTE applies the GTE predicate on the "last_failure_at" field.
+func LastFailureAtGTE(v time.Time) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldGTE(FieldLastFailureAt, v))
+}
+
+// LastFailureAtLT applies the LT predicate on the "last_failure_at" field.
+func LastFailureAtLT(v time.Time) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldLT(FieldLastFailureAt, v))
+}
+
+// LastFailureAtLTE applies the LTE predicate on the "last_failure_at" field.
+func LastFailureAtLTE(v time.Time) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldLTE(FieldLastFailureAt, v))
+}
+
+// BlockedUntilEQ applies the EQ predicate on the "blocked_until" field.
+func BlockedUntilEQ(v time.Time) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldEQ(FieldBlockedUntil, v))
+}
+
+// BlockedUntilNEQ applies the NEQ predicate on the "blocked_until" field.
+func BlockedUntilNEQ(v time.Time) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldNEQ(FieldBlockedUntil, v))
+}
+
+// BlockedUntilIn applies the In predicate on the "blocked_until" field.
+func BlockedUntilIn(vs ...time.Time) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldIn(FieldBlockedUntil, vs...))
+}
+
+// BlockedUntilNotIn applies the NotIn predicate on the "blocked_until" field.
+func BlockedUntilNotIn(vs ...time.Time) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldNotIn(FieldBlockedUntil, vs...))
+}
+
+// BlockedUntilGT applies the GT predicate on the "blocked_until" field.
+func BlockedUntilGT(v time.Time) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldGT(FieldBlockedUntil, v))
+}
+
+// BlockedUntilGTE applies the GTE predicate on the "blocked_until" field.
+func BlockedUntilGTE(v time.Time) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldGTE(FieldBlockedUntil, v))
+}
+
+// BlockedUntilLT applies the LT predicate on the "blocked_until" field.
+func BlockedUntilLT(v time.Time) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldLT(FieldBlockedUntil, v))
+}
+
+// BlockedUntilLTE applies the LTE predicate on the "blocked_until" field.
+func BlockedUntilLTE(v time.Time) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldLTE(FieldBlockedUntil, v))
+}
+
+// BlockedUntilIsNil applies the IsNil predicate on the "blocked_until" field.
+func BlockedUntilIsNil() predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldIsNull(FieldBlockedUntil))
+}
+
+// BlockedUntilNotNil applies the NotNil predicate on the "blocked_until" field.
+func BlockedUntilNotNil() predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldNotNull(FieldBlockedUntil))
+}
+
+// LockedEQ applies the EQ predicate on the "locked" field.
+func LockedEQ(v bool) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldEQ(FieldLocked, v))
+}
+
+// LockedNEQ applies the NEQ predicate on the "locked" field.
+func LockedNEQ(v bool) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.FieldNEQ(FieldLocked, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AuthThrottle) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AuthThrottle) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AuthThrottle) predicate.AuthThrottle {
+	return predicate.AuthThrottle(sql.NotPredicates(p))
+}
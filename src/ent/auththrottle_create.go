@@ -0,0 +1,288 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gopan-server/ent/auththrottle"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AuthThrottleCreate is the builder for creating a AuthThrottle entity.
+type AuthThrottleCreate struct {
+	config
+	mutation *AuthThrottleMutation
+	hooks    []Hook
+}
+
+// SetKey sets the "key" field.
+func (atc *AuthThrottleCreate) SetKey(s string) *AuthThrottleCreate {
+	atc.mutation.SetKey(s)
+	return atc
+}
+
+// SetFailures sets the "failures" field.
+func (atc *AuthThrottleCreate) SetFailures(i int) *AuthThrottleCreate {
+	atc.mutation.SetFailures(i)
+	return atc
+}
+
+// SetNillableFailures sets the "failures" field if the given value is not nil.
+func (atc *AuthThrottleCreate) SetNillableFailures(i *int) *AuthThrottleCreate {
+	if i != nil {
+		atc.SetFailures(*i)
+	}
+	return atc
+}
+
+// SetLastFailureAt sets the "last_failure_at" field.
+func (atc *AuthThrottleCreate) SetLastFailureAt(t time.Time) *AuthThrottleCreate {
+	atc.mutation.SetLastFailureAt(t)
+	return atc
+}
+
+// SetNillableLastFailureAt sets the "last_failure_at" field if the given value is not nil.
+func (atc *AuthThrottleCreate) SetNillableLastFailureAt(t *time.Time) *AuthThrottleCreate {
+	if t != nil {
+		atc.SetLastFailureAt(*t)
+	}
+	return atc
+}
+
+// SetBlockedUntil sets the "blocked_until" field.
+func (atc *AuthThrottleCreate) SetBlockedUntil(t time.Time) *AuthThrottleCreate {
+	atc.mutation.SetBlockedUntil(t)
+	return atc
+}
+
+// SetNillableBlockedUntil sets the "blocked_until" field if the given value is not nil.
+func (atc *AuthThrottleCreate) SetNillableBlockedUntil(t *time.Time) *AuthThrottleCreate {
+	if t != nil {
+		atc.SetBlockedUntil(*t)
+	}
+	return atc
+}
+
+// SetLocked sets the "locked" field.
+func (atc *AuthThrottleCreate) SetLocked(b bool) *AuthThrottleCreate {
+	atc.mutation.SetLocked(b)
+	return atc
+}
+
+// SetNillableLocked sets the "locked" field if the given value is not nil.
+func (atc *AuthThrottleCreate) SetNillableLocked(b *bool) *AuthThrottleCreate {
+	if b != nil {
+		atc.SetLocked(*b)
+	}
+	return atc
+}
+
+// Mutation returns the AuthThrottleMutation object of the builder.
+func (atc *AuthThrottleCreate) Mutation() *AuthThrottleMutation {
+	return atc.mutation
+}
+
+// Save creates the AuthThrottle in the database.
+func (atc *AuthThrottleCreate) Save(ctx context.Context) (*AuthThrottle, error) {
+	atc.defaults()
+	return withHooks(ctx, atc.sqlSave, atc.mutation, atc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (atc *AuthThrottleCreate) SaveX(ctx context.Context) *AuthThrottle {
+	v, err := atc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (atc *AuthThrottleCreate) Exec(ctx context.Context) error {
+	_, err := atc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atc *AuthThrottleCreate) ExecX(ctx context.Context) {
+	if err := atc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (atc *AuthThrottleCreate) defaults() {
+	if _, ok := atc.mutation.Failures(); !ok {
+		v := auththrottle.DefaultFailures
+		atc.mutation.SetFailures(v)
+	}
+	if _, ok := atc.mutation.LastFailureAt(); !ok {
+		v := auththrottle.DefaultLastFailureAt()
+		atc.mutation.SetLastFailureAt(v)
+	}
+	if _, ok := atc.mutation.Locked(); !ok {
+		v := auththrottle.DefaultLocked
+		atc.mutation.SetLocked(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (atc *AuthThrottleCreate) check() error {
+	if _, ok := atc.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "AuthThrottle.key"`)}
+	}
+	if v, ok := atc.mutation.Key(); ok {
+		if err := auththrottle.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "AuthThrottle.key": %w`, err)}
+		}
+	}
+	if _, ok := atc.mutation.Failures(); !ok {
+		return &ValidationError{Name: "failures", err: errors.New(`ent: missing required field "AuthThrottle.failures"`)}
+	}
+	if _, ok := atc.mutation.LastFailureAt(); !ok {
+		return &ValidationError{Name: "last_failure_at", err: errors.New(`ent: missing required field "AuthThrottle.last_failure_at"`)}
+	}
+	if _, ok := atc.mutation.Locked(); !ok {
+		return &ValidationError{Name: "locked", err: errors.New(`ent: missing required field "AuthThrottle.locked"`)}
+	}
+	return nil
+}
+
+func (atc *AuthThrottleCreate) sqlSave(ctx context.Context) (*AuthThrottle, error) {
+	if err := atc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := atc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, atc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	atc.mutation.id = &_node.ID
+	atc.mutation.done = true
+	return _node, nil
+}
+
+func (atc *AuthThrottleCreate) createSpec() (*AuthThrottle, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuthThrottle{config: atc.config}
+		_spec = sqlgraph.NewCreateSpec(auththrottle.Table, sqlgraph.NewFieldSpec(auththrottle.FieldID, field.TypeInt))
+	)
+	if value, ok := atc.mutation.Key(); ok {
+		_spec.SetField(auththrottle.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := atc.mutation.Failures(); ok {
+		_spec.SetField(auththrottle.FieldFailures, field.TypeInt, value)
+		_node.Failures = value
+	}
+	if value, ok := atc.mutation.LastFailureAt(); ok {
+		_spec.SetField(auththrottle.FieldLastFailureAt, field.TypeTime, value)
+		_node.LastFailureAt = value
+	}
+	if value, ok := atc.mutation.BlockedUntil(); ok {
+		_spec.SetField(auththrottle.FieldBlockedUntil, field.TypeTime, value)
+		_node.BlockedUntil = &value
+	}
+	if value, ok := atc.mutation.Locked(); ok {
+		_spec.SetField(auththrottle.FieldLocked, field.TypeBool, value)
+		_node.Locked = value
+	}
+	return _node, _spec
+}
+
+// AuthThrottleCreateBulk is the builder for creating many AuthThrottle entities in bulk.
+type AuthThrottleCreateBulk struct {
+	config
+	err      error
+	builders []*AuthThrottleCreate
+}
+
+// Save creates the AuthThrottle entities in the database.
+func (atcb *AuthThrottleCreateBulk) Save(ctx context.Context) ([]*AuthThrottle, error) {
+	if atcb.err != nil {
+		return nil, atcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(atcb.builders))
+	nodes := make([]*AuthThrottle, len(atcb.builders))
+	mutators := make([]Mutator, len(atcb.builders))
+	for i := range atcb.builders {
+		func(i int, root context.Context) {
+			builder := atcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuthThrottleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, atcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, atcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, atcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (atcb *AuthThrottleCreateBulk) SaveX(ctx context.Context) []*AuthThrottle {
+	v, err := atcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (atcb *AuthThrottleCreateBulk) Exec(ctx context.Context) error {
+	_, err := atcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atcb *AuthThrottleCreateBulk) ExecX(ctx context.Context) {
+	if err := atcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,410 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gopan-server/ent/auththrottle"
+	"gopan-server/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AuthThrottleUpdate is the builder for updating AuthThrottle entities.
+type AuthThrottleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AuthThrottleMutation
+}
+
+// Where appends a list predicates to the AuthThrottleUpdate builder.
+func (atu *AuthThrottleUpdate) Where(ps ...predicate.AuthThrottle) *AuthThrottleUpdate {
+	atu.mutation.Where(ps...)
+	return atu
+}
+
+// SetKey sets the "key" field.
+func (atu *AuthThrottleUpdate) SetKey(s string) *AuthThrottleUpdate {
+	atu.mutation.SetKey(s)
+	return atu
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (atu *AuthThrottleUpdate) SetNillableKey(s *string) *AuthThrottleUpdate {
+	if s != nil {
+		atu.SetKey(*s)
+	}
+	return atu
+}
+
+// SetFailures sets the "failures" field.
+func (atu *AuthThrottleUpdate) SetFailures(i int) *AuthThrottleUpdate {
+	atu.mutation.ResetFailures()
+	atu.mutation.SetFailures(i)
+	return atu
+}
+
+// SetNillableFailures sets the "failures" field if the given value is not nil.
+func (atu *AuthThrottleUpdate) SetNillableFailures(i *int) *AuthThrottleUpdate {
+	if i != nil {
+		atu.SetFailures(*i)
+	}
+	return atu
+}
+
+// AddFailures adds i to the "failures" field.
+func (atu *AuthThrottleUpdate) AddFailures(i int) *AuthThrottleUpdate {
+	atu.mutation.AddFailures(i)
+	return atu
+}
+
+// SetLastFailureAt sets the "last_failure_at" field.
+func (atu *AuthThrottleUpdate) SetLastFailureAt(t time.Time) *AuthThrottleUpdate {
+	atu.mutation.SetLastFailureAt(t)
+	return atu
+}
+
+// SetNillableLastFailureAt sets the "last_failure_at" field if the given value is not nil.
+func (atu *AuthThrottleUpdate) SetNillableLastFailureAt(t *time.Time) *AuthThrottleUpdate {
+	if t != nil {
+		atu.SetLastFailureAt(*t)
+	}
+	return atu
+}
+
+// SetBlockedUntil sets the "blocked_until" field.
+func (atu *AuthThrottleUpdate) SetBlockedUntil(t time.Time) *AuthThrottleUpdate {
+	atu.mutation.SetBlockedUntil(t)
+	return atu
+}
+
+// SetNillableBlockedUntil sets the "blocked_until" field if the given value is not nil.
+func (atu *AuthThrottleUpdate) SetNillableBlockedUntil(t *time.Time) *AuthThrottleUpdate {
+	if t != nil {
+		atu.SetBlockedUntil(*t)
+	}
+	return atu
+}
+
+// ClearBlockedUntil clears the value of the "blocked_until" field.
+func (atu *AuthThrottleUpdate) ClearBlockedUntil() *AuthThrottleUpdate {
+	atu.mutation.ClearBlockedUntil()
+	return atu
+}
+
+// SetLocked sets the "locked" field.
+func (atu *AuthThrottleUpdate) SetLocked(b bool) *AuthThrottleUpdate {
+	atu.mutation.SetLocked(b)
+	return atu
+}
+
+// SetNillableLocked sets the "locked" field if the given value is not nil.
+func (atu *AuthThrottleUpdate) SetNillableLocked(b *bool) *AuthThrottleUpdate {
+	if b != nil {
+		atu.SetLocked(*b)
+	}
+	return atu
+}
+
+// Mutation returns the AuthThrottleMutation object of the builder.
+func (atu *AuthThrottleUpdate) Mutation() *AuthThrottleMutation {
+	return atu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (atu *AuthThrottleUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, atu.sqlSave, atu.mutation, atu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (atu *AuthThrottleUpdate) SaveX(ctx context.Context) int {
+	affected, err := atu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (atu *AuthThrottleUpdate) Exec(ctx context.Context) error {
+	_, err := atu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atu *AuthThrottleUpdate) ExecX(ctx context.Context) {
+	if err := atu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (atu *AuthThrottleUpdate) check() error {
+	if v, ok := atu.mutation.Key(); ok {
+		if err := auththrottle.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "AuthThrottle.key": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (atu *AuthThrottleUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := atu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(auththrottle.Table, auththrottle.Columns, sqlgraph.NewFieldSpec(auththrottle.FieldID, field.TypeInt))
+	if ps := atu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := atu.mutation.Key(); ok {
+		_spec.SetField(auththrottle.FieldKey, field.TypeString, value)
+	}
+	if value, ok := atu.mutation.Failures(); ok {
+		_spec.SetField(auththrottle.FieldFailures, field.TypeInt, value)
+	}
+	if value, ok := atu.mutation.AddedFailures(); ok {
+		_spec.AddField(auththrottle.FieldFailures, field.TypeInt, value)
+	}
+	if value, ok := atu.mutation.LastFailureAt(); ok {
+		_spec.SetField(auththrottle.FieldLastFailureAt, field.TypeTime, value)
+	}
+	if value, ok := atu.mutation.BlockedUntil(); ok {
+		_spec.SetField(auththrottle.FieldBlockedUntil, field.TypeTime, value)
+	}
+	if atu.mutation.BlockedUntilCleared() {
+		_spec.ClearField(auththrottle.FieldBlockedUntil, field.TypeTime)
+	}
+	if value, ok := atu.mutation.Locked(); ok {
+		_spec.SetField(auththrottle.FieldLocked, field.TypeBool, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, atu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auththrottle.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	atu.mutation.done = true
+	return n, nil
+}
+
+// AuthThrottleUpdateOne is the builder for updating a single AuthThrottle entity.
+type AuthThrottleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AuthThrottleMutation
+}
+
+// SetKey sets the "key" field.
+func (atuo *AuthThrottleUpdateOne) SetKey(s string) *AuthThrottleUpdateOne {
+	atuo.mutation.SetKey(s)
+	return atuo
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (atuo *AuthThrottleUpdateOne) SetNillableKey(s *string) *AuthThrottleUpdateOne {
+	if s != nil {
+		atuo.SetKey(*s)
+	}
+	return atuo
+}
+
+// SetFailures sets the "failures" field.
+func (atuo *AuthThrottleUpdateOne) SetFailures(i int) *AuthThrottleUpdateOne {
+	atuo.mutation.ResetFailures()
+	atuo.mutation.SetFailures(i)
+	return atuo
+}
+
+// SetNillableFailures sets the "failures" field if the given value is not nil.
+func (atuo *AuthThrottleUpdateOne) SetNillableFailures(i *int) *AuthThrottleUpdateOne {
+	if i != nil {
+		atuo.SetFailures(*i)
+	}
+	return atuo
+}
+
+// AddFailures adds i to the "failures" field.
+func (atuo *AuthThrottleUpdateOne) AddFailures(i int) *AuthThrottleUpdateOne {
+	atuo.mutation.AddFailures(i)
+	return atuo
+}
+
+// SetLastFailureAt sets the "last_failure_at" field.
+func (atuo *AuthThrottleUpdateOne) SetLastFailureAt(t time.Time) *AuthThrottleUpdateOne {
+	atuo.mutation.SetLastFailureAt(t)
+	return atuo
+}
+
+// SetNillableLastFailureAt sets the "last_failure_at" field if the given value is not nil.
+func (atuo *AuthThrottleUpdateOne) SetNillableLastFailureAt(t *time.Time) *AuthThrottleUpdateOne {
+	if t != nil {
+		atuo.SetLastFailureAt(*t)
+	}
+	return atuo
+}
+
+// SetBlockedUntil sets the "blocked_until" field.
+func (atuo *AuthThrottleUpdateOne) SetBlockedUntil(t time.Time) *AuthThrottleUpdateOne {
+	atuo.mutation.SetBlockedUntil(t)
+	return atuo
+}
+
+// SetNillableBlockedUntil sets the "blocked_until" field if the given value is not nil.
+func (atuo *AuthThrottleUpdateOne) SetNillableBlockedUntil(t *time.Time) *AuthThrottleUpdateOne {
+	if t != nil {
+		atuo.SetBlockedUntil(*t)
+	}
+	return atuo
+}
+
+// ClearBlockedUntil clears the value of the "blocked_until" field.
+func (atuo *AuthThrottleUpdateOne) ClearBlockedUntil() *AuthThrottleUpdateOne {
+	atuo.mutation.ClearBlockedUntil()
+	return atuo
+}
+
+// SetLocked sets the "locked" field.
+func (atuo *AuthThrottleUpdateOne) SetLocked(b bool) *AuthThrottleUpdateOne {
+	atuo.mutation.SetLocked(b)
+	return atuo
+}
+
+// SetNillableLocked sets the "locked" field if the given value is not nil.
+func (atuo *AuthThrottleUpdateOne) SetNillableLocked(b *bool) *AuthThrottleUpdateOne {
+	if b != nil {
+		atuo.SetLocked(*b)
+	}
+	return atuo
+}
+
+// Mutation returns the AuthThrottleMutation object of the builder.
+func (atuo *AuthThrottleUpdateOne) Mutation() *AuthThrottleMutation {
+	return atuo.mutation
+}
+
+// Where appends a list predicates to the AuthThrottleUpdate builder.
+func (atuo *AuthThrottleUpdateOne) Where(ps ...predicate.AuthThrottle) *AuthThrottleUpdateOne {
+	atuo.mutation.Where(ps...)
+	return atuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (atuo *AuthThrottleUpdateOne) Select(field string, fields ...string) *AuthThrottleUpdateOne {
+	atuo.fields = append([]string{field}, fields...)
+	return atuo
+}
+
+// Save executes the query and returns the updated AuthThrottle entity.
+func (atuo *AuthThrottleUpdateOne) Save(ctx context.Context) (*AuthThrottle, error) {
+	return withHooks(ctx, atuo.sqlSave, atuo.mutation, atuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (atuo *AuthThrottleUpdateOne) SaveX(ctx context.Context) *AuthThrottle {
+	node, err := atuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (atuo *AuthThrottleUpdateOne) Exec(ctx context.Context) error {
+	_, err := atuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atuo *AuthThrottleUpdateOne) ExecX(ctx context.Context) {
+	if err := atuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (atuo *AuthThrottleUpdateOne) check() error {
+	if v, ok := atuo.mutation.Key(); ok {
+		if err := auththrottle.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "AuthThrottle.key": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (atuo *AuthThrottleUpdateOne) sqlSave(ctx context.Context) (_node *AuthThrottle, err error) {
+	if err := atuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(auththrottle.Table, auththrottle.Columns, sqlgraph.NewFieldSpec(auththrottle.FieldID, field.TypeInt))
+	id, ok := atuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AuthThrottle.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := atuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, auththrottle.FieldID)
+		for _, f := range fields {
+			if !auththrottle.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != auththrottle.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := atuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := atuo.mutation.Key(); ok {
+		_spec.SetField(auththrottle.FieldKey, field.TypeString, value)
+	}
+	if value, ok := atuo.mutation.Failures(); ok {
+		_spec.SetField(auththrottle.FieldFailures, field.TypeInt, value)
+	}
+	if value, ok := atuo.mutation.AddedFailures(); ok {
+		_spec.AddField(auththrottle.FieldFailures, field.TypeInt, value)
+	}
+	if value, ok := atuo.mutation.LastFailureAt(); ok {
+		_spec.SetField(auththrottle.FieldLastFailureAt, field.TypeTime, value)
+	}
+	if value, ok := atuo.mutation.BlockedUntil(); ok {
+		_spec.SetField(auththrottle.FieldBlockedUntil, field.TypeTime, value)
+	}
+	if atuo.mutation.BlockedUntilCleared() {
+		_spec.ClearField(auththrottle.FieldBlockedUntil, field.TypeTime)
+	}
+	if value, ok := atuo.mutation.Locked(); ok {
+		_spec.SetField(auththrottle.FieldLocked, field.TypeBool, value)
+	}
+	_node = &AuthThrottle{config: atuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, atuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auththrottle.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	atuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,237 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"gopan-server/ent/change"
+	"gopan-server/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Change is the model entity for the Change schema.
+type Change struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// Position in the journal of the user, counting up from 1
+	Seq int64 `json:"seq,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind change.Kind `json:"kind,omitempty"`
+	// Not an edge, as purged nodes are gone
+	NodeID int `json:"node_id,omitempty"`
+	// Parent folder after the change, nil for the top level
+	ParentID *int `json:"parent_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// 0: folder, 1: file
+	Type int `json:"type,omitempty"`
+	// Size holds the value of the "size" field.
+	Size int64 `json:"size,omitempty"`
+	// FileHash holds the value of the "file_hash" field.
+	FileHash string `json:"file_hash,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ChangeQuery when eager-loading is set.
+	Edges        ChangeEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ChangeEdges holds the relations/edges for other nodes in the graph.
+type ChangeEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ChangeEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Change) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case change.FieldID, change.FieldUserID, change.FieldSeq, change.FieldNodeID, change.FieldParentID, change.FieldType, change.FieldSize:
+			values[i] = new(sql.NullInt64)
+		case change.FieldKind, change.FieldName, change.FieldFileHash:
+			values[i] = new(sql.NullString)
+		case change.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Change fields.
+func (c *Change) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case change.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			c.ID = int(value.Int64)
+		case change.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				c.UserID = int(value.Int64)
+			}
+		case change.FieldSeq:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field seq", values[i])
+			} else if value.Valid {
+				c.Seq = value.Int64
+			}
+		case change.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				c.Kind = change.Kind(value.String)
+			}
+		case change.FieldNodeID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field node_id", values[i])
+			} else if value.Valid {
+				c.NodeID = int(value.Int64)
+			}
+		case change.FieldParentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_id", values[i])
+			} else if value.Valid {
+				c.ParentID = new(int)
+				*c.ParentID = int(value.Int64)
+			}
+		case change.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				c.Name = value.String
+			}
+		case change.FieldType:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				c.Type = int(value.Int64)
+			}
+		case change.FieldSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size", values[i])
+			} else if value.Valid {
+				c.Size = value.Int64
+			}
+		case change.FieldFileHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field file_hash", values[i])
+			} else if value.Valid {
+				c.FileHash = value.String
+			}
+		case change.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				c.CreatedAt = value.Time
+			}
+		default:
+			c.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Change.
+// This includes values selected through modifiers, order, etc.
+func (c *Change) Value(name string) (ent.Value, error) {
+	return c.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the Change entity.
+func (c *Change) QueryUser() *UserQuery {
+	return NewChangeClient(c.config).QueryUser(c)
+}
+
+// Update returns a builder for updating this Change.
+// Note that you need to call Change.Unwrap() before calling this method if this Change
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (c *Change) Update() *ChangeUpdateOne {
+	return NewChangeClient(c.config).UpdateOne(c)
+}
+
+// Unwrap unwraps the Change entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (c *Change) Unwrap() *Change {
+	_tx, ok := c.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Change is not a transactional entity")
+	}
+	c.config.driver = _tx.drv
+	return c
+}
+
+// String implements the fmt.Stringer.
+func (c *Change) String() string {
+	var builder strings.Builder
+	builder.WriteString("Change(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", c.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", c.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("seq=")
+	builder.WriteString(fmt.Sprintf("%v", c.Seq))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", c.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("node_id=")
+	builder.WriteString(fmt.Sprintf("%v", c.NodeID))
+	builder.WriteString(", ")
+	if v := c.ParentID; v != nil {
+		builder.WriteString("parent_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(c.Name)
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", c.Type))
+	builder.WriteString(", ")
+	builder.WriteString("size=")
+	builder.WriteString(fmt.Sprintf("%v", c.Size))
+	builder.WriteString(", ")
+	builder.WriteString("file_hash=")
+	builder.WriteString(c.FileHash)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(c.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Changes is a parsable slice of Change.
+type Changes []*Change
@@ -0,0 +1,368 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gopan-server/ent/change"
+	"gopan-server/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ChangeCreate is the builder for creating a Change entity.
+type ChangeCreate struct {
+	config
+	mutation *ChangeMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (cc *ChangeCreate) SetUserID(i int) *ChangeCreate {
+	cc.mutation.SetUserID(i)
+	return cc
+}
+
+// SetSeq sets the "seq" field.
+func (cc *ChangeCreate) SetSeq(i int64) *ChangeCreate {
+	cc.mutation.SetSeq(i)
+	return cc
+}
+
+// SetKind sets the "kind" field.
+func (cc *ChangeCreate) SetKind(c change.Kind) *ChangeCreate {
+	cc.mutation.SetKind(c)
+	return cc
+}
+
+// SetNodeID sets the "node_id" field.
+func (cc *ChangeCreate) SetNodeID(i int) *ChangeCreate {
+	cc.mutation.SetNodeID(i)
+	return cc
+}
+
+// SetParentID sets the "parent_id" field.
+func (cc *ChangeCreate) SetParentID(i int) *ChangeCreate {
+	cc.mutation.SetParentID(i)
+	return cc
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (cc *ChangeCreate) SetNillableParentID(i *int) *ChangeCreate {
+	if i != nil {
+		cc.SetParentID(*i)
+	}
+	return cc
+}
+
+// SetName sets the "name" field.
+func (cc *ChangeCreate) SetName(s string) *ChangeCreate {
+	cc.mutation.SetName(s)
+	return cc
+}
+
+// SetType sets the "type" field.
+func (cc *ChangeCreate) SetType(i int) *ChangeCreate {
+	cc.mutation.SetType(i)
+	return cc
+}
+
+// SetSize sets the "size" field.
+func (cc *ChangeCreate) SetSize(i int64) *ChangeCreate {
+	cc.mutation.SetSize(i)
+	return cc
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (cc *ChangeCreate) SetNillableSize(i *int64) *ChangeCreate {
+	if i != nil {
+		cc.SetSize(*i)
+	}
+	return cc
+}
+
+// SetFileHash sets the "file_hash" field.
+func (cc *ChangeCreate) SetFileHash(s string) *ChangeCreate {
+	cc.mutation.SetFileHash(s)
+	return cc
+}
+
+// SetNillableFileHash sets the "file_hash" field if the given value is not nil.
+func (cc *ChangeCreate) SetNillableFileHash(s *string) *ChangeCreate {
+	if s != nil {
+		cc.SetFileHash(*s)
+	}
+	return cc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cc *ChangeCreate) SetCreatedAt(t time.Time) *ChangeCreate {
+	cc.mutation.SetCreatedAt(t)
+	return cc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cc *ChangeCreate) SetNillableCreatedAt(t *time.Time) *ChangeCreate {
+	if t != nil {
+		cc.SetCreatedAt(*t)
+	}
+	return cc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (cc *ChangeCreate) SetUser(u *User) *ChangeCreate {
+	return cc.SetUserID(u.ID)
+}
+
+// Mutation returns the ChangeMutation object of the builder.
+func (cc *ChangeCreate) Mutation() *ChangeMutation {
+	return cc.mutation
+}
+
+// Save creates the Change in the database.
+func (cc *ChangeCreate) Save(ctx context.Context) (*Change, error) {
+	cc.defaults()
+	return withHooks(ctx, cc.sqlSave, cc.mutation, cc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cc *ChangeCreate) SaveX(ctx context.Context) *Change {
+	v, err := cc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cc *ChangeCreate) Exec(ctx context.Context) error {
+	_, err := cc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cc *ChangeCreate) ExecX(ctx context.Context) {
+	if err := cc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cc *ChangeCreate) defaults() {
+	if _, ok := cc.mutation.Size(); !ok {
+		v := change.DefaultSize
+		cc.mutation.SetSize(v)
+	}
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		v := change.DefaultCreatedAt()
+		cc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cc *ChangeCreate) check() error {
+	if _, ok := cc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Change.user_id"`)}
+	}
+	if _, ok := cc.mutation.Seq(); !ok {
+		return &ValidationError{Name: "seq", err: errors.New(`ent: missing required field "Change.seq"`)}
+	}
+	if _, ok := cc.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "Change.kind"`)}
+	}
+	if v, ok := cc.mutation.Kind(); ok {
+		if err := change.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Change.kind": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.NodeID(); !ok {
+		return &ValidationError{Name: "node_id", err: errors.New(`ent: missing required field "Change.node_id"`)}
+	}
+	if _, ok := cc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Change.name"`)}
+	}
+	if _, ok := cc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "Change.type"`)}
+	}
+	if _, ok := cc.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "Change.size"`)}
+	}
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Change.created_at"`)}
+	}
+	if len(cc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Change.user"`)}
+	}
+	return nil
+}
+
+func (cc *ChangeCreate) sqlSave(ctx context.Context) (*Change, error) {
+	if err := cc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	cc.mutation.id = &_node.ID
+	cc.mutation.done = true
+	return _node, nil
+}
+
+func (cc *ChangeCreate) createSpec() (*Change, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Change{config: cc.config}
+		_spec = sqlgraph.NewCreateSpec(change.Table, sqlgraph.NewFieldSpec(change.FieldID, field.TypeInt))
+	)
+	if value, ok := cc.mutation.Seq(); ok {
+		_spec.SetField(change.FieldSeq, field.TypeInt64, value)
+		_node.Seq = value
+	}
+	if value, ok := cc.mutation.Kind(); ok {
+		_spec.SetField(change.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := cc.mutation.NodeID(); ok {
+		_spec.SetField(change.FieldNodeID, field.TypeInt, value)
+		_node.NodeID = value
+	}
+	if value, ok := cc.mutation.ParentID(); ok {
+		_spec.SetField(change.FieldParentID, field.TypeInt, value)
+		_node.ParentID = &value
+	}
+	if value, ok := cc.mutation.Name(); ok {
+		_spec.SetField(change.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := cc.mutation.GetType(); ok {
+		_spec.SetField(change.FieldType, field.TypeInt, value)
+		_node.Type = value
+	}
+	if value, ok := cc.mutation.Size(); ok {
+		_spec.SetField(change.FieldSize, field.TypeInt64, value)
+		_node.Size = value
+	}
+	if value, ok := cc.mutation.FileHash(); ok {
+		_spec.SetField(change.FieldFileHash, field.TypeString, value)
+		_node.FileHash = value
+	}
+	if value, ok := cc.mutation.CreatedAt(); ok {
+		_spec.SetField(change.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := cc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   change.UserTable,
+			Columns: []string{change.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ChangeCreateBulk is the builder for creating many Change entities in bulk.
+type ChangeCreateBulk struct {
+	config
+	err      error
+	builders []*ChangeCreate
+}
+
+// Save creates the Change entities in the database.
+func (ccb *ChangeCreateBulk) Save(ctx context.Context) ([]*Change, error) {
+	if ccb.err != nil {
+		return nil, ccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ccb.builders))
+	nodes := make([]*Change, len(ccb.builders))
+	mutators := make([]Mutator, len(ccb.builders))
+	for i := range ccb.builders {
+		func(i int, root context.Context) {
+			builder := ccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ChangeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccb *ChangeCreateBulk) SaveX(ctx context.Context) []*Change {
+	v, err := ccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ccb *ChangeCreateBulk) Exec(ctx context.Context) error {
+	_, err := ccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccb *ChangeCreateBulk) ExecX(ctx context.Context) {
+	if err := ccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
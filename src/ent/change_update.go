@@ -0,0 +1,759 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gopan-server/ent/change"
+	"gopan-server/ent/predicate"
+	"gopan-server/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ChangeUpdate is the builder for updating Change entities.
+type ChangeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ChangeMutation
+}
+
+// Where appends a list predicates to the ChangeUpdate builder.
+func (cu *ChangeUpdate) Where(ps ...predicate.Change) *ChangeUpdate {
+	cu.mutation.Where(ps...)
+	return cu
+}
+
+// SetUserID sets the "user_id" field.
+func (cu *ChangeUpdate) SetUserID(i int) *ChangeUpdate {
+	cu.mutation.SetUserID(i)
+	return cu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (cu *ChangeUpdate) SetNillableUserID(i *int) *ChangeUpdate {
+	if i != nil {
+		cu.SetUserID(*i)
+	}
+	return cu
+}
+
+// SetSeq sets the "seq" field.
+func (cu *ChangeUpdate) SetSeq(i int64) *ChangeUpdate {
+	cu.mutation.ResetSeq()
+	cu.mutation.SetSeq(i)
+	return cu
+}
+
+// SetNillableSeq sets the "seq" field if the given value is not nil.
+func (cu *ChangeUpdate) SetNillableSeq(i *int64) *ChangeUpdate {
+	if i != nil {
+		cu.SetSeq(*i)
+	}
+	return cu
+}
+
+// AddSeq adds i to the "seq" field.
+func (cu *ChangeUpdate) AddSeq(i int64) *ChangeUpdate {
+	cu.mutation.AddSeq(i)
+	return cu
+}
+
+// SetKind sets the "kind" field.
+func (cu *ChangeUpdate) SetKind(c change.Kind) *ChangeUpdate {
+	cu.mutation.SetKind(c)
+	return cu
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (cu *ChangeUpdate) SetNillableKind(c *change.Kind) *ChangeUpdate {
+	if c != nil {
+		cu.SetKind(*c)
+	}
+	return cu
+}
+
+// SetNodeID sets the "node_id" field.
+func (cu *ChangeUpdate) SetNodeID(i int) *ChangeUpdate {
+	cu.mutation.ResetNodeID()
+	cu.mutation.SetNodeID(i)
+	return cu
+}
+
+// SetNillableNodeID sets the "node_id" field if the given value is not nil.
+func (cu *ChangeUpdate) SetNillableNodeID(i *int) *ChangeUpdate {
+	if i != nil {
+		cu.SetNodeID(*i)
+	}
+	return cu
+}
+
+// AddNodeID adds i to the "node_id" field.
+func (cu *ChangeUpdate) AddNodeID(i int) *ChangeUpdate {
+	cu.mutation.AddNodeID(i)
+	return cu
+}
+
+// SetParentID sets the "parent_id" field.
+func (cu *ChangeUpdate) SetParentID(i int) *ChangeUpdate {
+	cu.mutation.ResetParentID()
+	cu.mutation.SetParentID(i)
+	return cu
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (cu *ChangeUpdate) SetNillableParentID(i *int) *ChangeUpdate {
+	if i != nil {
+		cu.SetParentID(*i)
+	}
+	return cu
+}
+
+// AddParentID adds i to the "parent_id" field.
+func (cu *ChangeUpdate) AddParentID(i int) *ChangeUpdate {
+	cu.mutation.AddParentID(i)
+	return cu
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (cu *ChangeUpdate) ClearParentID() *ChangeUpdate {
+	cu.mutation.ClearParentID()
+	return cu
+}
+
+// SetName sets the "name" field.
+func (cu *ChangeUpdate) SetName(s string) *ChangeUpdate {
+	cu.mutation.SetName(s)
+	return cu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (cu *ChangeUpdate) SetNillableName(s *string) *ChangeUpdate {
+	if s != nil {
+		cu.SetName(*s)
+	}
+	return cu
+}
+
+// SetType sets the "type" field.
+func (cu *ChangeUpdate) SetType(i int) *ChangeUpdate {
+	cu.mutation.ResetType()
+	cu.mutation.SetType(i)
+	return cu
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (cu *ChangeUpdate) SetNillableType(i *int) *ChangeUpdate {
+	if i != nil {
+		cu.SetType(*i)
+	}
+	return cu
+}
+
+// AddType adds i to the "type" field.
+func (cu *ChangeUpdate) AddType(i int) *ChangeUpdate {
+	cu.mutation.AddType(i)
+	return cu
+}
+
+// SetSize sets the "size" field.
+func (cu *ChangeUpdate) SetSize(i int64) *ChangeUpdate {
+	cu.mutation.ResetSize()
+	cu.mutation.SetSize(i)
+	return cu
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (cu *ChangeUpdate) SetNillableSize(i *int64) *ChangeUpdate {
+	if i != nil {
+		cu.SetSize(*i)
+	}
+	return cu
+}
+
+// AddSize adds i to the "size" field.
+func (cu *ChangeUpdate) AddSize(i int64) *ChangeUpdate {
+	cu.mutation.AddSize(i)
+	return cu
+}
+
+// SetFileHash sets the "file_hash" field.
+func (cu *ChangeUpdate) SetFileHash(s string) *ChangeUpdate {
+	cu.mutation.SetFileHash(s)
+	return cu
+}
+
+// SetNillableFileHash sets the "file_hash" field if the given value is not nil.
+func (cu *ChangeUpdate) SetNillableFileHash(s *string) *ChangeUpdate {
+	if s != nil {
+		cu.SetFileHash(*s)
+	}
+	return cu
+}
+
+// ClearFileHash clears the value of the "file_hash" field.
+func (cu *ChangeUpdate) ClearFileHash() *ChangeUpdate {
+	cu.mutation.ClearFileHash()
+	return cu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cu *ChangeUpdate) SetCreatedAt(t time.Time) *ChangeUpdate {
+	cu.mutation.SetCreatedAt(t)
+	return cu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cu *ChangeUpdate) SetNillableCreatedAt(t *time.Time) *ChangeUpdate {
+	if t != nil {
+		cu.SetCreatedAt(*t)
+	}
+	return cu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (cu *ChangeUpdate) SetUser(u *User) *ChangeUpdate {
+	return cu.SetUserID(u.ID)
+}
+
+// Mutation returns the ChangeMutation object of the builder.
+func (cu *ChangeUpdate) Mutation() *ChangeMutation {
+	return cu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (cu *ChangeUpdate) ClearUser() *ChangeUpdate {
+	cu.mutation.ClearUser()
+	return cu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cu *ChangeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, cu.sqlSave, cu.mutation, cu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cu *ChangeUpdate) SaveX(ctx context.Context) int {
+	affected, err := cu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cu *ChangeUpdate) Exec(ctx context.Context) error {
+	_, err := cu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cu *ChangeUpdate) ExecX(ctx context.Context) {
+	if err := cu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cu *ChangeUpdate) check() error {
+	if v, ok := cu.mutation.Kind(); ok {
+		if err := change.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Change.kind": %w`, err)}
+		}
+	}
+	if cu.mutation.UserCleared() && len(cu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Change.user"`)
+	}
+	return nil
+}
+
+func (cu *ChangeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(change.Table, change.Columns, sqlgraph.NewFieldSpec(change.FieldID, field.TypeInt))
+	if ps := cu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cu.mutation.Seq(); ok {
+		_spec.SetField(change.FieldSeq, field.TypeInt64, value)
+	}
+	if value, ok := cu.mutation.AddedSeq(); ok {
+		_spec.AddField(change.FieldSeq, field.TypeInt64, value)
+	}
+	if value, ok := cu.mutation.Kind(); ok {
+		_spec.SetField(change.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := cu.mutation.NodeID(); ok {
+		_spec.SetField(change.FieldNodeID, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.AddedNodeID(); ok {
+		_spec.AddField(change.FieldNodeID, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.ParentID(); ok {
+		_spec.SetField(change.FieldParentID, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.AddedParentID(); ok {
+		_spec.AddField(change.FieldParentID, field.TypeInt, value)
+	}
+	if cu.mutation.ParentIDCleared() {
+		_spec.ClearField(change.FieldParentID, field.TypeInt)
+	}
+	if value, ok := cu.mutation.Name(); ok {
+		_spec.SetField(change.FieldName, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.GetType(); ok {
+		_spec.SetField(change.FieldType, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.AddedType(); ok {
+		_spec.AddField(change.FieldType, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.Size(); ok {
+		_spec.SetField(change.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := cu.mutation.AddedSize(); ok {
+		_spec.AddField(change.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := cu.mutation.FileHash(); ok {
+		_spec.SetField(change.FieldFileHash, field.TypeString, value)
+	}
+	if cu.mutation.FileHashCleared() {
+		_spec.ClearField(change.FieldFileHash, field.TypeString)
+	}
+	if value, ok := cu.mutation.CreatedAt(); ok {
+		_spec.SetField(change.FieldCreatedAt, field.TypeTime, value)
+	}
+	if cu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   change.UserTable,
+			Columns: []string{change.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   change.UserTable,
+			Columns: []string{change.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{change.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cu.mutation.done = true
+	return n, nil
+}
+
+// ChangeUpdateOne is the builder for updating a single Change entity.
+type ChangeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ChangeMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (cuo *ChangeUpdateOne) SetUserID(i int) *ChangeUpdateOne {
+	cuo.mutation.SetUserID(i)
+	return cuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (cuo *ChangeUpdateOne) SetNillableUserID(i *int) *ChangeUpdateOne {
+	if i != nil {
+		cuo.SetUserID(*i)
+	}
+	return cuo
+}
+
+// SetSeq sets the "seq" field.
+func (cuo *ChangeUpdateOne) SetSeq(i int64) *ChangeUpdateOne {
+	cuo.mutation.ResetSeq()
+	cuo.mutation.SetSeq(i)
+	return cuo
+}
+
+// SetNillableSeq sets the "seq" field if the given value is not nil.
+func (cuo *ChangeUpdateOne) SetNillableSeq(i *int64) *ChangeUpdateOne {
+	if i != nil {
+		cuo.SetSeq(*i)
+	}
+	return cuo
+}
+
+// AddSeq adds i to the "seq" field.
+func (cuo *ChangeUpdateOne) AddSeq(i int64) *ChangeUpdateOne {
+	cuo.mutation.AddSeq(i)
+	return cuo
+}
+
+// SetKind sets the "kind" field.
+func (cuo *ChangeUpdateOne) SetKind(c change.Kind) *ChangeUpdateOne {
+	cuo.mutation.SetKind(c)
+	return cuo
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (cuo *ChangeUpdateOne) SetNillableKind(c *change.Kind) *ChangeUpdateOne {
+	if c != nil {
+		cuo.SetKind(*c)
+	}
+	return cuo
+}
+
+// SetNodeID sets the "node_id" field.
+func (cuo *ChangeUpdateOne) SetNodeID(i int) *ChangeUpdateOne {
+	cuo.mutation.ResetNodeID()
+	cuo.mutation.SetNodeID(i)
+	return cuo
+}
+
+// SetNillableNodeID sets the "node_id" field if the given value is not nil.
+func (cuo *ChangeUpdateOne) SetNillableNodeID(i *int) *ChangeUpdateOne {
+	if i != nil {
+		cuo.SetNodeID(*i)
+	}
+	return cuo
+}
+
+// AddNodeID adds i to the "node_id" field.
+func (cuo *ChangeUpdateOne) AddNodeID(i int) *ChangeUpdateOne {
+	cuo.mutation.AddNodeID(i)
+	return cuo
+}
+
+// SetParentID sets the "parent_id" field.
+func (cuo *ChangeUpdateOne) SetParentID(i int) *ChangeUpdateOne {
+	cuo.mutation.ResetParentID()
+	cuo.mutation.SetParentID(i)
+	return cuo
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (cuo *ChangeUpdateOne) SetNillableParentID(i *int) *ChangeUpdateOne {
+	if i != nil {
+		cuo.SetParentID(*i)
+	}
+	return cuo
+}
+
+// AddParentID adds i to the "parent_id" field.
+func (cuo *ChangeUpdateOne) AddParentID(i int) *ChangeUpdateOne {
+	cuo.mutation.AddParentID(i)
+	return cuo
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (cuo *ChangeUpdateOne) ClearParentID() *ChangeUpdateOne {
+	cuo.mutation.ClearParentID()
+	return cuo
+}
+
+// SetName sets the "name" field.
+func (cuo *ChangeUpdateOne) SetName(s string) *ChangeUpdateOne {
+	cuo.mutation.SetName(s)
+	return cuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (cuo *ChangeUpdateOne) SetNillableName(s *string) *ChangeUpdateOne {
+	if s != nil {
+		cuo.SetName(*s)
+	}
+	return cuo
+}
+
+// SetType sets the "type" field.
+func (cuo *ChangeUpdateOne) SetType(i int) *ChangeUpdateOne {
+	cuo.mutation.ResetType()
+	cuo.mutation.SetType(i)
+	return cuo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (cuo *ChangeUpdateOne) SetNillableType(i *int) *ChangeUpdateOne {
+	if i != nil {
+		cuo.SetType(*i)
+	}
+	return cuo
+}
+
+// AddType adds i to the "type" field.
+func (cuo *ChangeUpdateOne) AddType(i int) *ChangeUpdateOne {
+	cuo.mutation.AddType(i)
+	return cuo
+}
+
+// SetSize sets the "size" field.
+func (cuo *ChangeUpdateOne) SetSize(i int64) *ChangeUpdateOne {
+	cuo.mutation.ResetSize()
+	cuo.mutation.SetSize(i)
+	return cuo
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (cuo *ChangeUpdateOne) SetNillableSize(i *int64) *ChangeUpdateOne {
+	if i != nil {
+		cuo.SetSize(*i)
+	}
+	return cuo
+}
+
+// AddSize adds i to the "size" field.
+func (cuo *ChangeUpdateOne) AddSize(i int64) *ChangeUpdateOne {
+	cuo.mutation.AddSize(i)
+	return cuo
+}
+
+// SetFileHash sets the "file_hash" field.
+func (cuo *ChangeUpdateOne) SetFileHash(s string) *ChangeUpdateOne {
+	cuo.mutation.SetFileHash(s)
+	return cuo
+}
+
+// SetNillableFileHash sets the "file_hash" field if the given value is not nil.
+func (cuo *ChangeUpdateOne) SetNillableFileHash(s *string) *ChangeUpdateOne {
+	if s != nil {
+		cuo.SetFileHash(*s)
+	}
+	return cuo
+}
+
+// ClearFileHash clears the value of the "file_hash" field.
+func (cuo *ChangeUpdateOne) ClearFileHash() *ChangeUpdateOne {
+	cuo.mutation.ClearFileHash()
+	return cuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cuo *ChangeUpdateOne) SetCreatedAt(t time.Time) *ChangeUpdateOne {
+	cuo.mutation.SetCreatedAt(t)
+	return cuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cuo *ChangeUpdateOne) SetNillableCreatedAt(t *time.Time) *ChangeUpdateOne {
+	if t != nil {
+		cuo.SetCreatedAt(*t)
+	}
+	return cuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (cuo *ChangeUpdateOne) SetUser(u *User) *ChangeUpdateOne {
+	return cuo.SetUserID(u.ID)
+}
+
+// Mutation returns the ChangeMutation object of the builder.
+func (cuo *ChangeUpdateOne) Mutation() *ChangeMutation {
+	return cuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (cuo *ChangeUpdateOne) ClearUser() *ChangeUpdateOne {
+	cuo.mutation.ClearUser()
+	return cuo
+}
+
+// Where appends a list predicates to the ChangeUpdate builder.
+func (cuo *ChangeUpdateOne) Where(ps ...predicate.Change) *ChangeUpdateOne {
+	cuo.mutation.Where(ps...)
+	return cuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cuo *ChangeUpdateOne) Select(field string, fields ...string) *ChangeUpdateOne {
+	cuo.fields = append([]string{field}, fields...)
+	return cuo
+}
+
+// Save executes the query and returns the updated Change entity.
+func (cuo *ChangeUpdateOne) Save(ctx context.Context) (*Change, error) {
+	return withHooks(ctx, cuo.sqlSave, cuo.mutation, cuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cuo *ChangeUpdateOne) SaveX(ctx context.Context) *Change {
+	node, err := cuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cuo *ChangeUpdateOne) Exec(ctx context.Context) error {
+	_, err := cuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cuo *ChangeUpdateOne) ExecX(ctx context.Context) {
+	if err := cuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cuo *ChangeUpdateOne) check() error {
+	if v, ok := cuo.mutation.Kind(); ok {
+		if err := change.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Change.kind": %w`, err)}
+		}
+	}
+	if cuo.mutation.UserCleared() && len(cuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Change.user"`)
+	}
+	return nil
+}
+
+func (cuo *ChangeUpdateOne) sqlSave(ctx context.Context) (_node *Change, err error) {
+	if err := cuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(change.Table, change.Columns, sqlgraph.NewFieldSpec(change.FieldID, field.TypeInt))
+	id, ok := cuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Change.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, change.FieldID)
+		for _, f := range fields {
+			if !change.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != change.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cuo.mutation.Seq(); ok {
+		_spec.SetField(change.FieldSeq, field.TypeInt64, value)
+	}
+	if value, ok := cuo.mutation.AddedSeq(); ok {
+		_spec.AddField(change.FieldSeq, field.TypeInt64, value)
+	}
+	if value, ok := cuo.mutation.Kind(); ok {
+		_spec.SetField(change.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := cuo.mutation.NodeID(); ok {
+		_spec.SetField(change.FieldNodeID, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.AddedNodeID(); ok {
+		_spec.AddField(change.FieldNodeID, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.ParentID(); ok {
+		_spec.SetField(change.FieldParentID, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.AddedParentID(); ok {
+		_spec.AddField(change.FieldParentID, field.TypeInt, value)
+	}
+	if cuo.mutation.ParentIDCleared() {
+		_spec.ClearField(change.FieldParentID, field.TypeInt)
+	}
+	if value, ok := cuo.mutation.Name(); ok {
+		_spec.SetField(change.FieldName, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.GetType(); ok {
+		_spec.SetField(change.FieldType, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.AddedType(); ok {
+		_spec.AddField(change.FieldType, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.Size(); ok {
+		_spec.SetField(change.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := cuo.mutation.AddedSize(); ok {
+		_spec.AddField(change.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := cuo.mutation.FileHash(); ok {
+		_spec.SetField(change.FieldFileHash, field.TypeString, value)
+	}
+	if cuo.mutation.FileHashCleared() {
+		_spec.ClearField(change.FieldFileHash, field.TypeString)
+	}
+	if value, ok := cuo.mutation.CreatedAt(); ok {
+		_spec.SetField(change.FieldCreatedAt, field.TypeTime, value)
+	}
+	if cuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   change.UserTable,
+			Columns: []string{change.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   change.UserTable,
+			Columns: []string{change.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Change{config: cuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{change.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cuo.mutation.done = true
+	return _node, nil
+}
@@ -6,10 +6,24 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"gopan-server/ent/accesstoken"
+	"gopan-server/ent/authfailure"
+	"gopan-server/ent/auththrottle"
+	"gopan-server/ent/change"
 	"gopan-server/ent/filehash"
+	"gopan-server/ent/group"
+	"gopan-server/ent/invite"
 	"gopan-server/ent/node"
+	"gopan-server/ent/nodepermission"
+	"gopan-server/ent/s3key"
+	"gopan-server/ent/s3upload"
+	"gopan-server/ent/session"
 	"gopan-server/ent/share"
+	"gopan-server/ent/shareaccess"
+	"gopan-server/ent/sshkey"
 	"gopan-server/ent/user"
+	"gopan-server/ent/useridentity"
+	"gopan-server/ent/usertoken"
 	"reflect"
 	"sync"
 
@@ -76,10 +90,24 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			filehash.Table: filehash.ValidColumn,
-			node.Table:     node.ValidColumn,
-			share.Table:    share.ValidColumn,
-			user.Table:     user.ValidColumn,
+			accesstoken.Table:    accesstoken.ValidColumn,
+			authfailure.Table:    authfailure.ValidColumn,
+			auththrottle.Table:   auththrottle.ValidColumn,
+			change.Table:         change.ValidColumn,
+			filehash.Table:       filehash.ValidColumn,
+			group.Table:          group.ValidColumn,
+			invite.Table:         invite.ValidColumn,
+			node.Table:           node.ValidColumn,
+			nodepermission.Table: nodepermission.ValidColumn,
+			s3key.Table:          s3key.ValidColumn,
+			s3upload.Table:       s3upload.ValidColumn,
+			sshkey.Table:         sshkey.ValidColumn,
+			session.Table:        session.ValidColumn,
+			share.Table:          share.ValidColumn,
+			shareaccess.Table:    shareaccess.ValidColumn,
+			user.Table:           user.ValidColumn,
+			useridentity.Table:   useridentity.ValidColumn,
+			usertoken.Table:      usertoken.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
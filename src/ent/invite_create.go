@@ -0,0 +1,410 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gopan-server/ent/invite"
+	"gopan-server/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InviteCreate is the builder for creating a Invite entity.
+type InviteCreate struct {
+	config
+	mutation *InviteMutation
+	hooks    []Hook
+}
+
+// SetCode sets the "code" field.
+func (ic *InviteCreate) SetCode(s string) *InviteCreate {
+	ic.mutation.SetCode(s)
+	return ic
+}
+
+// SetNote sets the "note" field.
+func (ic *InviteCreate) SetNote(s string) *InviteCreate {
+	ic.mutation.SetNote(s)
+	return ic
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (ic *InviteCreate) SetNillableNote(s *string) *InviteCreate {
+	if s != nil {
+		ic.SetNote(*s)
+	}
+	return ic
+}
+
+// SetRole sets the "role" field.
+func (ic *InviteCreate) SetRole(i invite.Role) *InviteCreate {
+	ic.mutation.SetRole(i)
+	return ic
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (ic *InviteCreate) SetNillableRole(i *invite.Role) *InviteCreate {
+	if i != nil {
+		ic.SetRole(*i)
+	}
+	return ic
+}
+
+// SetTotalQuota sets the "total_quota" field.
+func (ic *InviteCreate) SetTotalQuota(i int64) *InviteCreate {
+	ic.mutation.SetTotalQuota(i)
+	return ic
+}
+
+// SetNillableTotalQuota sets the "total_quota" field if the given value is not nil.
+func (ic *InviteCreate) SetNillableTotalQuota(i *int64) *InviteCreate {
+	if i != nil {
+		ic.SetTotalQuota(*i)
+	}
+	return ic
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (ic *InviteCreate) SetMaxUses(i int) *InviteCreate {
+	ic.mutation.SetMaxUses(i)
+	return ic
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (ic *InviteCreate) SetNillableMaxUses(i *int) *InviteCreate {
+	if i != nil {
+		ic.SetMaxUses(*i)
+	}
+	return ic
+}
+
+// SetUses sets the "uses" field.
+func (ic *InviteCreate) SetUses(i int) *InviteCreate {
+	ic.mutation.SetUses(i)
+	return ic
+}
+
+// SetNillableUses sets the "uses" field if the given value is not nil.
+func (ic *InviteCreate) SetNillableUses(i *int) *InviteCreate {
+	if i != nil {
+		ic.SetUses(*i)
+	}
+	return ic
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (ic *InviteCreate) SetExpiresAt(t time.Time) *InviteCreate {
+	ic.mutation.SetExpiresAt(t)
+	return ic
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (ic *InviteCreate) SetNillableExpiresAt(t *time.Time) *InviteCreate {
+	if t != nil {
+		ic.SetExpiresAt(*t)
+	}
+	return ic
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (ic *InviteCreate) SetCreatedBy(i int) *InviteCreate {
+	ic.mutation.SetCreatedBy(i)
+	return ic
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (ic *InviteCreate) SetNillableCreatedBy(i *int) *InviteCreate {
+	if i != nil {
+		ic.SetCreatedBy(*i)
+	}
+	return ic
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ic *InviteCreate) SetCreatedAt(t time.Time) *InviteCreate {
+	ic.mutation.SetCreatedAt(t)
+	return ic
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ic *InviteCreate) SetNillableCreatedAt(t *time.Time) *InviteCreate {
+	if t != nil {
+		ic.SetCreatedAt(*t)
+	}
+	return ic
+}
+
+// SetCreatorID sets the "creator" edge to the User entity by ID.
+func (ic *InviteCreate) SetCreatorID(id int) *InviteCreate {
+	ic.mutation.SetCreatorID(id)
+	return ic
+}
+
+// SetNillableCreatorID sets the "creator" edge to the User entity by ID if the given value is not nil.
+func (ic *InviteCreate) SetNillableCreatorID(id *int) *InviteCreate {
+	if id != nil {
+		ic = ic.SetCreatorID(*id)
+	}
+	return ic
+}
+
+// SetCreator sets the "creator" edge to the User entity.
+func (ic *InviteCreate) SetCreator(u *User) *InviteCreate {
+	return ic.SetCreatorID(u.ID)
+}
+
+// Mutation returns the InviteMutation object of the builder.
+func (ic *InviteCreate) Mutation() *InviteMutation {
+	return ic.mutation
+}
+
+// Save creates the Invite in the database.
+func (ic *InviteCreate) Save(ctx context.Context) (*Invite, error) {
+	ic.defaults()
+	return withHooks(ctx, ic.sqlSave, ic.mutation, ic.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ic *InviteCreate) SaveX(ctx context.Context) *Invite {
+	v, err := ic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ic *InviteCreate) Exec(ctx context.Context) error {
+	_, err := ic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ic *InviteCreate) ExecX(ctx context.Context) {
+	if err := ic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ic *InviteCreate) defaults() {
+	if _, ok := ic.mutation.Role(); !ok {
+		v := invite.DefaultRole
+		ic.mutation.SetRole(v)
+	}
+	if _, ok := ic.mutation.MaxUses(); !ok {
+		v := invite.DefaultMaxUses
+		ic.mutation.SetMaxUses(v)
+	}
+	if _, ok := ic.mutation.Uses(); !ok {
+		v := invite.DefaultUses
+		ic.mutation.SetUses(v)
+	}
+	if _, ok := ic.mutation.CreatedAt(); !ok {
+		v := invite.DefaultCreatedAt()
+		ic.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ic *InviteCreate) check() error {
+	if _, ok := ic.mutation.Code(); !ok {
+		return &ValidationError{Name: "code", err: errors.New(`ent: missing required field "Invite.code"`)}
+	}
+	if v, ok := ic.mutation.Code(); ok {
+		if err := invite.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "Invite.code": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "Invite.role"`)}
+	}
+	if v, ok := ic.mutation.Role(); ok {
+		if err := invite.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "Invite.role": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.MaxUses(); !ok {
+		return &ValidationError{Name: "max_uses", err: errors.New(`ent: missing required field "Invite.max_uses"`)}
+	}
+	if v, ok := ic.mutation.MaxUses(); ok {
+		if err := invite.MaxUsesValidator(v); err != nil {
+			return &ValidationError{Name: "max_uses", err: fmt.Errorf(`ent: validator failed for field "Invite.max_uses": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.Uses(); !ok {
+		return &ValidationError{Name: "uses", err: errors.New(`ent: missing required field "Invite.uses"`)}
+	}
+	if _, ok := ic.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Invite.created_at"`)}
+	}
+	return nil
+}
+
+func (ic *InviteCreate) sqlSave(ctx context.Context) (*Invite, error) {
+	if err := ic.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ic.mutation.id = &_node.ID
+	ic.mutation.done = true
+	return _node, nil
+}
+
+func (ic *InviteCreate) createSpec() (*Invite, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Invite{config: ic.config}
+		_spec = sqlgraph.NewCreateSpec(invite.Table, sqlgraph.NewFieldSpec(invite.FieldID, field.TypeInt))
+	)
+	if value, ok := ic.mutation.Code(); ok {
+		_spec.SetField(invite.FieldCode, field.TypeString, value)
+		_node.Code = value
+	}
+	if value, ok := ic.mutation.Note(); ok {
+		_spec.SetField(invite.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if value, ok := ic.mutation.Role(); ok {
+		_spec.SetField(invite.FieldRole, field.TypeEnum, value)
+		_node.Role = value
+	}
+	if value, ok := ic.mutation.TotalQuota(); ok {
+		_spec.SetField(invite.FieldTotalQuota, field.TypeInt64, value)
+		_node.TotalQuota = &value
+	}
+	if value, ok := ic.mutation.MaxUses(); ok {
+		_spec.SetField(invite.FieldMaxUses, field.TypeInt, value)
+		_node.MaxUses = value
+	}
+	if value, ok := ic.mutation.Uses(); ok {
+		_spec.SetField(invite.FieldUses, field.TypeInt, value)
+		_node.Uses = value
+	}
+	if value, ok := ic.mutation.ExpiresAt(); ok {
+		_spec.SetField(invite.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := ic.mutation.CreatedAt(); ok {
+		_spec.SetField(invite.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := ic.mutation.CreatorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invite.CreatorTable,
+			Columns: []string{invite.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CreatedBy = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// InviteCreateBulk is the builder for creating many Invite entities in bulk.
+type InviteCreateBulk struct {
+	config
+	err      error
+	builders []*InviteCreate
+}
+
+// Save creates the Invite entities in the database.
+func (icb *InviteCreateBulk) Save(ctx context.Context) ([]*Invite, error) {
+	if icb.err != nil {
+		return nil, icb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(icb.builders))
+	nodes := make([]*Invite, len(icb.builders))
+	mutators := make([]Mutator, len(icb.builders))
+	for i := range icb.builders {
+		func(i int, root context.Context) {
+			builder := icb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InviteMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, icb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, icb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, icb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (icb *InviteCreateBulk) SaveX(ctx context.Context) []*Invite {
+	v, err := icb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (icb *InviteCreateBulk) Exec(ctx context.Context) error {
+	_, err := icb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icb *InviteCreateBulk) ExecX(ctx context.Context) {
+	if err := icb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,757 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gopan-server/ent/invite"
+	"gopan-server/ent/predicate"
+	"gopan-server/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InviteUpdate is the builder for updating Invite entities.
+type InviteUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InviteMutation
+}
+
+// Where appends a list predicates to the InviteUpdate builder.
+func (iu *InviteUpdate) Where(ps ...predicate.Invite) *InviteUpdate {
+	iu.mutation.Where(ps...)
+	return iu
+}
+
+// SetCode sets the "code" field.
+func (iu *InviteUpdate) SetCode(s string) *InviteUpdate {
+	iu.mutation.SetCode(s)
+	return iu
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (iu *InviteUpdate) SetNillableCode(s *string) *InviteUpdate {
+	if s != nil {
+		iu.SetCode(*s)
+	}
+	return iu
+}
+
+// SetNote sets the "note" field.
+func (iu *InviteUpdate) SetNote(s string) *InviteUpdate {
+	iu.mutation.SetNote(s)
+	return iu
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (iu *InviteUpdate) SetNillableNote(s *string) *InviteUpdate {
+	if s != nil {
+		iu.SetNote(*s)
+	}
+	return iu
+}
+
+// ClearNote clears the value of the "note" field.
+func (iu *InviteUpdate) ClearNote() *InviteUpdate {
+	iu.mutation.ClearNote()
+	return iu
+}
+
+// SetRole sets the "role" field.
+func (iu *InviteUpdate) SetRole(i invite.Role) *InviteUpdate {
+	iu.mutation.SetRole(i)
+	return iu
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (iu *InviteUpdate) SetNillableRole(i *invite.Role) *InviteUpdate {
+	if i != nil {
+		iu.SetRole(*i)
+	}
+	return iu
+}
+
+// SetTotalQuota sets the "total_quota" field.
+func (iu *InviteUpdate) SetTotalQuota(i int64) *InviteUpdate {
+	iu.mutation.ResetTotalQuota()
+	iu.mutation.SetTotalQuota(i)
+	return iu
+}
+
+// SetNillableTotalQuota sets the "total_quota" field if the given value is not nil.
+func (iu *InviteUpdate) SetNillableTotalQuota(i *int64) *InviteUpdate {
+	if i != nil {
+		iu.SetTotalQuota(*i)
+	}
+	return iu
+}
+
+// AddTotalQuota adds i to the "total_quota" field.
+func (iu *InviteUpdate) AddTotalQuota(i int64) *InviteUpdate {
+	iu.mutation.AddTotalQuota(i)
+	return iu
+}
+
+// ClearTotalQuota clears the value of the "total_quota" field.
+func (iu *InviteUpdate) ClearTotalQuota() *InviteUpdate {
+	iu.mutation.ClearTotalQuota()
+	return iu
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (iu *InviteUpdate) SetMaxUses(i int) *InviteUpdate {
+	iu.mutation.ResetMaxUses()
+	iu.mutation.SetMaxUses(i)
+	return iu
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (iu *InviteUpdate) SetNillableMaxUses(i *int) *InviteUpdate {
+	if i != nil {
+		iu.SetMaxUses(*i)
+	}
+	return iu
+}
+
+// AddMaxUses adds i to the "max_uses" field.
+func (iu *InviteUpdate) AddMaxUses(i int) *InviteUpdate {
+	iu.mutation.AddMaxUses(i)
+	return iu
+}
+
+// SetUses sets the "uses" field.
+func (iu *InviteUpdate) SetUses(i int) *InviteUpdate {
+	iu.mutation.ResetUses()
+	iu.mutation.SetUses(i)
+	return iu
+}
+
+// SetNillableUses sets the "uses" field if the given value is not nil.
+func (iu *InviteUpdate) SetNillableUses(i *int) *InviteUpdate {
+	if i != nil {
+		iu.SetUses(*i)
+	}
+	return iu
+}
+
+// AddUses adds i to the "uses" field.
+func (iu *InviteUpdate) AddUses(i int) *InviteUpdate {
+	iu.mutation.AddUses(i)
+	return iu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (iu *InviteUpdate) SetExpiresAt(t time.Time) *InviteUpdate {
+	iu.mutation.SetExpiresAt(t)
+	return iu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (iu *InviteUpdate) SetNillableExpiresAt(t *time.Time) *InviteUpdate {
+	if t != nil {
+		iu.SetExpiresAt(*t)
+	}
+	return iu
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (iu *InviteUpdate) ClearExpiresAt() *InviteUpdate {
+	iu.mutation.ClearExpiresAt()
+	return iu
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (iu *InviteUpdate) SetCreatedBy(i int) *InviteUpdate {
+	iu.mutation.SetCreatedBy(i)
+	return iu
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (iu *InviteUpdate) SetNillableCreatedBy(i *int) *InviteUpdate {
+	if i != nil {
+		iu.SetCreatedBy(*i)
+	}
+	return iu
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (iu *InviteUpdate) ClearCreatedBy() *InviteUpdate {
+	iu.mutation.ClearCreatedBy()
+	return iu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (iu *InviteUpdate) SetCreatedAt(t time.Time) *InviteUpdate {
+	iu.mutation.SetCreatedAt(t)
+	return iu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (iu *InviteUpdate) SetNillableCreatedAt(t *time.Time) *InviteUpdate {
+	if t != nil {
+		iu.SetCreatedAt(*t)
+	}
+	return iu
+}
+
+// SetCreatorID sets the "creator" edge to the User entity by ID.
+func (iu *InviteUpdate) SetCreatorID(id int) *InviteUpdate {
+	iu.mutation.SetCreatorID(id)
+	return iu
+}
+
+// SetNillableCreatorID sets the "creator" edge to the User entity by ID if the given value is not nil.
+func (iu *InviteUpdate) SetNillableCreatorID(id *int) *InviteUpdate {
+	if id != nil {
+		iu = iu.SetCreatorID(*id)
+	}
+	return iu
+}
+
+// SetCreator sets the "creator" edge to the User entity.
+func (iu *InviteUpdate) SetCreator(u *User) *InviteUpdate {
+	return iu.SetCreatorID(u.ID)
+}
+
+// Mutation returns the InviteMutation object of the builder.
+func (iu *InviteUpdate) Mutation() *InviteMutation {
+	return iu.mutation
+}
+
+// ClearCreator clears the "creator" edge to the User entity.
+func (iu *InviteUpdate) ClearCreator() *InviteUpdate {
+	iu.mutation.ClearCreator()
+	return iu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iu *InviteUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, iu.sqlSave, iu.mutation, iu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iu *InviteUpdate) SaveX(ctx context.Context) int {
+	affected, err := iu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iu *InviteUpdate) Exec(ctx context.Context) error {
+	_, err := iu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iu *InviteUpdate) ExecX(ctx context.Context) {
+	if err := iu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iu *InviteUpdate) check() error {
+	if v, ok := iu.mutation.Code(); ok {
+		if err := invite.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "Invite.code": %w`, err)}
+		}
+	}
+	if v, ok := iu.mutation.Role(); ok {
+		if err := invite.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "Invite.role": %w`, err)}
+		}
+	}
+	if v, ok := iu.mutation.MaxUses(); ok {
+		if err := invite.MaxUsesValidator(v); err != nil {
+			return &ValidationError{Name: "max_uses", err: fmt.Errorf(`ent: validator failed for field "Invite.max_uses": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (iu *InviteUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := iu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(invite.Table, invite.Columns, sqlgraph.NewFieldSpec(invite.FieldID, field.TypeInt))
+	if ps := iu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iu.mutation.Code(); ok {
+		_spec.SetField(invite.FieldCode, field.TypeString, value)
+	}
+	if value, ok := iu.mutation.Note(); ok {
+		_spec.SetField(invite.FieldNote, field.TypeString, value)
+	}
+	if iu.mutation.NoteCleared() {
+		_spec.ClearField(invite.FieldNote, field.TypeString)
+	}
+	if value, ok := iu.mutation.Role(); ok {
+		_spec.SetField(invite.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := iu.mutation.TotalQuota(); ok {
+		_spec.SetField(invite.FieldTotalQuota, field.TypeInt64, value)
+	}
+	if value, ok := iu.mutation.AddedTotalQuota(); ok {
+		_spec.AddField(invite.FieldTotalQuota, field.TypeInt64, value)
+	}
+	if iu.mutation.TotalQuotaCleared() {
+		_spec.ClearField(invite.FieldTotalQuota, field.TypeInt64)
+	}
+	if value, ok := iu.mutation.MaxUses(); ok {
+		_spec.SetField(invite.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := iu.mutation.AddedMaxUses(); ok {
+		_spec.AddField(invite.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := iu.mutation.Uses(); ok {
+		_spec.SetField(invite.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := iu.mutation.AddedUses(); ok {
+		_spec.AddField(invite.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := iu.mutation.ExpiresAt(); ok {
+		_spec.SetField(invite.FieldExpiresAt, field.TypeTime, value)
+	}
+	if iu.mutation.ExpiresAtCleared() {
+		_spec.ClearField(invite.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := iu.mutation.CreatedAt(); ok {
+		_spec.SetField(invite.FieldCreatedAt, field.TypeTime, value)
+	}
+	if iu.mutation.CreatorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invite.CreatorTable,
+			Columns: []string{invite.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.CreatorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invite.CreatorTable,
+			Columns: []string{invite.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, iu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invite.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	iu.mutation.done = true
+	return n, nil
+}
+
+// InviteUpdateOne is the builder for updating a single Invite entity.
+type InviteUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InviteMutation
+}
+
+// SetCode sets the "code" field.
+func (iuo *InviteUpdateOne) SetCode(s string) *InviteUpdateOne {
+	iuo.mutation.SetCode(s)
+	return iuo
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (iuo *InviteUpdateOne) SetNillableCode(s *string) *InviteUpdateOne {
+	if s != nil {
+		iuo.SetCode(*s)
+	}
+	return iuo
+}
+
+// SetNote sets the "note" field.
+func (iuo *InviteUpdateOne) SetNote(s string) *InviteUpdateOne {
+	iuo.mutation.SetNote(s)
+	return iuo
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (iuo *InviteUpdateOne) SetNillableNote(s *string) *InviteUpdateOne {
+	if s != nil {
+		iuo.SetNote(*s)
+	}
+	return iuo
+}
+
+// ClearNote clears the value of the "note" field.
+func (iuo *InviteUpdateOne) ClearNote() *InviteUpdateOne {
+	iuo.mutation.ClearNote()
+	return iuo
+}
+
+// SetRole sets the "role" field.
+func (iuo *InviteUpdateOne) SetRole(i invite.Role) *InviteUpdateOne {
+	iuo.mutation.SetRole(i)
+	return iuo
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (iuo *InviteUpdateOne) SetNillableRole(i *invite.Role) *InviteUpdateOne {
+	if i != nil {
+		iuo.SetRole(*i)
+	}
+	return iuo
+}
+
+// SetTotalQuota sets the "total_quota" field.
+func (iuo *InviteUpdateOne) SetTotalQuota(i int64) *InviteUpdateOne {
+	iuo.mutation.ResetTotalQuota()
+	iuo.mutation.SetTotalQuota(i)
+	return iuo
+}
+
+// SetNillableTotalQuota sets the "total_quota" field if the given value is not nil.
+func (iuo *InviteUpdateOne) SetNillableTotalQuota(i *int64) *InviteUpdateOne {
+	if i != nil {
+		iuo.SetTotalQuota(*i)
+	}
+	return iuo
+}
+
+// AddTotalQuota adds i to the "total_quota" field.
+func (iuo *InviteUpdateOne) AddTotalQuota(i int64) *InviteUpdateOne {
+	iuo.mutation.AddTotalQuota(i)
+	return iuo
+}
+
+// ClearTotalQuota clears the value of the "total_quota" field.
+func (iuo *InviteUpdateOne) ClearTotalQuota() *InviteUpdateOne {
+	iuo.mutation.ClearTotalQuota()
+	return iuo
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (iuo *InviteUpdateOne) SetMaxUses(i int) *InviteUpdateOne {
+	iuo.mutation.ResetMaxUses()
+	iuo.mutation.SetMaxUses(i)
+	return iuo
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (iuo *InviteUpdateOne) SetNillableMaxUses(i *int) *InviteUpdateOne {
+	if i != nil {
+		iuo.SetMaxUses(*i)
+	}
+	return iuo
+}
+
+// AddMaxUses adds i to the "max_uses" field.
+func (iuo *InviteUpdateOne) AddMaxUses(i int) *InviteUpdateOne {
+	iuo.mutation.AddMaxUses(i)
+	return iuo
+}
+
+// SetUses sets the "uses" field.
+func (iuo *InviteUpdateOne) SetUses(i int) *InviteUpdateOne {
+	iuo.mutation.ResetUses()
+	iuo.mutation.SetUses(i)
+	return iuo
+}
+
+// SetNillableUses sets the "uses" field if the given value is not nil.
+func (iuo *InviteUpdateOne) SetNillableUses(i *int) *InviteUpdateOne {
+	if i != nil {
+		iuo.SetUses(*i)
+	}
+	return iuo
+}
+
+// AddUses adds i to the "uses" field.
+func (iuo *InviteUpdateOne) AddUses(i int) *InviteUpdateOne {
+	iuo.mutation.AddUses(i)
+	return iuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (iuo *InviteUpdateOne) SetExpiresAt(t time.Time) *InviteUpdateOne {
+	iuo.mutation.SetExpiresAt(t)
+	return iuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (iuo *InviteUpdateOne) SetNillableExpiresAt(t *time.Time) *InviteUpdateOne {
+	if t != nil {
+		iuo.SetExpiresAt(*t)
+	}
+	return iuo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (iuo *InviteUpdateOne) ClearExpiresAt() *InviteUpdateOne {
+	iuo.mutation.ClearExpiresAt()
+	return iuo
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (iuo *InviteUpdateOne) SetCreatedBy(i int) *InviteUpdateOne {
+	iuo.mutation.SetCreatedBy(i)
+	return iuo
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (iuo *InviteUpdateOne) SetNillableCreatedBy(i *int) *InviteUpdateOne {
+	if i != nil {
+		iuo.SetCreatedBy(*i)
+	}
+	return iuo
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (iuo *InviteUpdateOne) ClearCreatedBy() *InviteUpdateOne {
+	iuo.mutation.ClearCreatedBy()
+	return iuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (iuo *InviteUpdateOne) SetCreatedAt(t time.Time) *InviteUpdateOne {
+	iuo.mutation.SetCreatedAt(t)
+	return iuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (iuo *InviteUpdateOne) SetNillableCreatedAt(t *time.Time) *InviteUpdateOne {
+	if t != nil {
+		iuo.SetCreatedAt(*t)
+	}
+	return iuo
+}
+
+// SetCreatorID sets the "creator" edge to the User entity by ID.
+func (iuo *InviteUpdateOne) SetCreatorID(id int) *InviteUpdateOne {
+	iuo.mutation.SetCreatorID(id)
+	return iuo
+}
+
+// SetNillableCreatorID sets the "creator" edge to the User entity by ID if the given value is not nil.
+func (iuo *InviteUpdateOne) SetNillableCreatorID(id *int) *InviteUpdateOne {
+	if id != nil {
+		iuo = iuo.SetCreatorID(*id)
+	}
+	return iuo
+}
+
+// SetCreator sets the "creator" edge to the User entity.
+func (iuo *InviteUpdateOne) SetCreator(u *User) *InviteUpdateOne {
+	return iuo.SetCreatorID(u.ID)
+}
+
+// Mutation returns the InviteMutation object of the builder.
+func (iuo *InviteUpdateOne) Mutation() *InviteMutation {
+	return iuo.mutation
+}
+
+// ClearCreator clears the "creator" edge to the User entity.
+func (iuo *InviteUpdateOne) ClearCreator() *InviteUpdateOne {
+	iuo.mutation.ClearCreator()
+	return iuo
+}
+
+// Where appends a list predicates to the InviteUpdate builder.
+func (iuo *InviteUpdateOne) Where(ps ...predicate.Invite) *InviteUpdateOne {
+	iuo.mutation.Where(ps...)
+	return iuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (iuo *InviteUpdateOne) Select(field string, fields ...string) *InviteUpdateOne {
+	iuo.fields = append([]string{field}, fields...)
+	return iuo
+}
+
+// Save executes the query and returns the updated Invite entity.
+func (iuo *InviteUpdateOne) Save(ctx context.Context) (*Invite, error) {
+	return withHooks(ctx, iuo.sqlSave, iuo.mutation, iuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iuo *InviteUpdateOne) SaveX(ctx context.Context) *Invite {
+	node, err := iuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (iuo *InviteUpdateOne) Exec(ctx context.Context) error {
+	_, err := iuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iuo *InviteUpdateOne) ExecX(ctx context.Context) {
+	if err := iuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iuo *InviteUpdateOne) check() error {
+	if v, ok := iuo.mutation.Code(); ok {
+		if err := invite.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "Invite.code": %w`, err)}
+		}
+	}
+	if v, ok := iuo.mutation.Role(); ok {
+		if err := invite.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "Invite.role": %w`, err)}
+		}
+	}
+	if v, ok := iuo.mutation.MaxUses(); ok {
+		if err := invite.MaxUsesValidator(v); err != nil {
+			return &ValidationError{Name: "max_uses", err: fmt.Errorf(`ent: validator failed for field "Invite.max_uses": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (iuo *InviteUpdateOne) sqlSave(ctx context.Context) (_node *Invite, err error) {
+	if err := iuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(invite.Table, invite.Columns, sqlgraph.NewFieldSpec(invite.FieldID, field.TypeInt))
+	id, ok := iuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Invite.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := iuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, invite.FieldID)
+		for _, f := range fields {
+			if !invite.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != invite.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := iuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iuo.mutation.Code(); ok {
+		_spec.SetField(invite.FieldCode, field.TypeString, value)
+	}
+	if value, ok := iuo.mutation.Note(); ok {
+		_spec.SetField(invite.FieldNote, field.TypeString, value)
+	}
+	if iuo.mutation.NoteCleared() {
+		_spec.ClearField(invite.FieldNote, field.TypeString)
+	}
+	if value, ok := iuo.mutation.Role(); ok {
+		_spec.SetField(invite.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := iuo.mutation.TotalQuota(); ok {
+		_spec.SetField(invite.FieldTotalQuota, field.TypeInt64, value)
+	}
+	if value, ok := iuo.mutation.AddedTotalQuota(); ok {
+		_spec.AddField(invite.FieldTotalQuota, field.TypeInt64, value)
+	}
+	if iuo.mutation.TotalQuotaCleared() {
+		_spec.ClearField(invite.FieldTotalQuota, field.TypeInt64)
+	}
+	if value, ok := iuo.mutation.MaxUses(); ok {
+		_spec.SetField(invite.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := iuo.mutation.AddedMaxUses(); ok {
+		_spec.AddField(invite.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := iuo.mutation.Uses(); ok {
+		_spec.SetField(invite.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := iuo.mutation.AddedUses(); ok {
+		_spec.AddField(invite.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := iuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(invite.FieldExpiresAt, field.TypeTime, value)
+	}
+	if iuo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(invite.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := iuo.mutation.CreatedAt(); ok {
+		_spec.SetField(invite.FieldCreatedAt, field.TypeTime, value)
+	}
+	if iuo.mutation.CreatorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invite.CreatorTable,
+			Columns: []string{invite.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.CreatorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invite.CreatorTable,
+			Columns: []string{invite.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Invite{config: iuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, iuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invite.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	iuo.mutation.done = true
+	return _node, nil
+}
@@ -8,6 +8,143 @@ import (
 )
 
 var (
+	// AccessTokensColumns holds the columns for the "access_tokens" table.
+	AccessTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "token_prefix", Type: field.TypeString},
+		{Name: "scope", Type: field.TypeEnum, Enums: []string{"full", "read", "upload"}, Default: "full"},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_ip", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "folder_id", Type: field.TypeInt, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// AccessTokensTable holds the schema information for the "access_tokens" table.
+	AccessTokensTable = &schema.Table{
+		Name:       "access_tokens",
+		Columns:    AccessTokensColumns,
+		PrimaryKey: []*schema.Column{AccessTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "access_tokens_nodes_access_tokens",
+				Columns:    []*schema.Column{AccessTokensColumns[9]},
+				RefColumns: []*schema.Column{NodesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "access_tokens_users_access_tokens",
+				Columns:    []*schema.Column{AccessTokensColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "accesstoken_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{AccessTokensColumns[10]},
+			},
+		},
+	}
+	// AuthFailuresColumns holds the columns for the "auth_failures" table.
+	AuthFailuresColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"login", "two_factor", "share"}},
+		{Name: "subject", Type: field.TypeString},
+		{Name: "user_id", Type: field.TypeInt, Nullable: true},
+		{Name: "reason", Type: field.TypeEnum, Enums: []string{"invalid_credentials", "invalid_code", "wrong_password", "throttled", "locked"}},
+		{Name: "ip", Type: field.TypeString, Nullable: true},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true, Size: 512},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AuthFailuresTable holds the schema information for the "auth_failures" table.
+	AuthFailuresTable = &schema.Table{
+		Name:       "auth_failures",
+		Columns:    AuthFailuresColumns,
+		PrimaryKey: []*schema.Column{AuthFailuresColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "authfailure_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuthFailuresColumns[7]},
+			},
+			{
+				Name:    "authfailure_subject_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuthFailuresColumns[2], AuthFailuresColumns[7]},
+			},
+			{
+				Name:    "authfailure_ip_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuthFailuresColumns[5], AuthFailuresColumns[7]},
+			},
+		},
+	}
+	// AuthThrottlesColumns holds the columns for the "auth_throttles" table.
+	AuthThrottlesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "key", Type: field.TypeString, Unique: true},
+		{Name: "failures", Type: field.TypeInt, Default: 0},
+		{Name: "last_failure_at", Type: field.TypeTime},
+		{Name: "blocked_until", Type: field.TypeTime, Nullable: true},
+		{Name: "locked", Type: field.TypeBool, Default: false},
+	}
+	// AuthThrottlesTable holds the schema information for the "auth_throttles" table.
+	AuthThrottlesTable = &schema.Table{
+		Name:       "auth_throttles",
+		Columns:    AuthThrottlesColumns,
+		PrimaryKey: []*schema.Column{AuthThrottlesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auththrottle_last_failure_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuthThrottlesColumns[3]},
+			},
+		},
+	}
+	// ChangesColumns holds the columns for the "changes" table.
+	ChangesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "seq", Type: field.TypeInt64},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"create", "update", "move", "rename", "trash", "restore", "purge"}},
+		{Name: "node_id", Type: field.TypeInt},
+		{Name: "parent_id", Type: field.TypeInt, Nullable: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "type", Type: field.TypeInt},
+		{Name: "size", Type: field.TypeInt64, Default: 0},
+		{Name: "file_hash", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// ChangesTable holds the schema information for the "changes" table.
+	ChangesTable = &schema.Table{
+		Name:       "changes",
+		Columns:    ChangesColumns,
+		PrimaryKey: []*schema.Column{ChangesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "changes_users_changes",
+				Columns:    []*schema.Column{ChangesColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "change_user_id_seq",
+				Unique:  true,
+				Columns: []*schema.Column{ChangesColumns[10], ChangesColumns[1]},
+			},
+			{
+				Name:    "change_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ChangesColumns[9]},
+			},
+		},
+	}
 	// FileHashesColumns holds the columns for the "file_hashes" table.
 	FileHashesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -25,6 +162,63 @@ var (
 		Columns:    FileHashesColumns,
 		PrimaryKey: []*schema.Column{FileHashesColumns[0]},
 	}
+	// GroupsColumns holds the columns for the "groups" table.
+	GroupsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_owned_groups", Type: field.TypeInt},
+	}
+	// GroupsTable holds the schema information for the "groups" table.
+	GroupsTable = &schema.Table{
+		Name:       "groups",
+		Columns:    GroupsColumns,
+		PrimaryKey: []*schema.Column{GroupsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "groups_users_owned_groups",
+				Columns:    []*schema.Column{GroupsColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "group_name_user_owned_groups",
+				Unique:  true,
+				Columns: []*schema.Column{GroupsColumns[1], GroupsColumns[5]},
+			},
+		},
+	}
+	// InvitesColumns holds the columns for the "invites" table.
+	InvitesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "code", Type: field.TypeString, Unique: true},
+		{Name: "note", Type: field.TypeString, Nullable: true},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"admin", "user", "readonly"}, Default: "user"},
+		{Name: "total_quota", Type: field.TypeInt64, Nullable: true},
+		{Name: "max_uses", Type: field.TypeInt, Default: 1},
+		{Name: "uses", Type: field.TypeInt, Default: 0},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeInt, Nullable: true},
+	}
+	// InvitesTable holds the schema information for the "invites" table.
+	InvitesTable = &schema.Table{
+		Name:       "invites",
+		Columns:    InvitesColumns,
+		PrimaryKey: []*schema.Column{InvitesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "invites_users_invites",
+				Columns:    []*schema.Column{InvitesColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
 	// NodesColumns holds the columns for the "nodes" table.
 	NodesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -60,16 +254,215 @@ var (
 				OnDelete:   schema.NoAction,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "node_name_node_parent",
+				Unique:  false,
+				Columns: []*schema.Column{NodesColumns[1], NodesColumns[11]},
+			},
+			{
+				Name:    "node_user_nodes",
+				Unique:  false,
+				Columns: []*schema.Column{NodesColumns[12]},
+			},
+		},
+	}
+	// NodePermissionsColumns holds the columns for the "node_permissions" table.
+	NodePermissionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"read", "write"}, Default: "read"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "group_node_permissions", Type: field.TypeInt, Nullable: true},
+		{Name: "node_permissions", Type: field.TypeInt},
+		{Name: "user_granted_permissions", Type: field.TypeInt},
+		{Name: "user_node_permissions", Type: field.TypeInt, Nullable: true},
+	}
+	// NodePermissionsTable holds the schema information for the "node_permissions" table.
+	NodePermissionsTable = &schema.Table{
+		Name:       "node_permissions",
+		Columns:    NodePermissionsColumns,
+		PrimaryKey: []*schema.Column{NodePermissionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "node_permissions_groups_node_permissions",
+				Columns:    []*schema.Column{NodePermissionsColumns[4]},
+				RefColumns: []*schema.Column{GroupsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "node_permissions_nodes_permissions",
+				Columns:    []*schema.Column{NodePermissionsColumns[5]},
+				RefColumns: []*schema.Column{NodesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "node_permissions_users_granted_permissions",
+				Columns:    []*schema.Column{NodePermissionsColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "node_permissions_users_node_permissions",
+				Columns:    []*schema.Column{NodePermissionsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// S3keysColumns holds the columns for the "s3keys" table.
+	S3keysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "access_key", Type: field.TypeString, Unique: true},
+		{Name: "secret_key", Type: field.TypeString},
+		{Name: "read_only", Type: field.TypeBool, Default: false},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_ip", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// S3keysTable holds the schema information for the "s3keys" table.
+	S3keysTable = &schema.Table{
+		Name:       "s3keys",
+		Columns:    S3keysColumns,
+		PrimaryKey: []*schema.Column{S3keysColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "s3keys_users_s3_keys",
+				Columns:    []*schema.Column{S3keysColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "s3key_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{S3keysColumns[8]},
+			},
+		},
+	}
+	// S3uploadsColumns holds the columns for the "s3uploads" table.
+	S3uploadsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "upload_id", Type: field.TypeString, Unique: true},
+		{Name: "bucket", Type: field.TypeString},
+		{Name: "key", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// S3uploadsTable holds the schema information for the "s3uploads" table.
+	S3uploadsTable = &schema.Table{
+		Name:       "s3uploads",
+		Columns:    S3uploadsColumns,
+		PrimaryKey: []*schema.Column{S3uploadsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "s3uploads_users_s3_uploads",
+				Columns:    []*schema.Column{S3uploadsColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "s3upload_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{S3uploadsColumns[5]},
+			},
+			{
+				Name:    "s3upload_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{S3uploadsColumns[4]},
+			},
+		},
+	}
+	// SSHKeysColumns holds the columns for the "ssh_keys" table.
+	SSHKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "public_key", Type: field.TypeString, Size: 2147483647},
+		{Name: "fingerprint", Type: field.TypeString},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_ip", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// SSHKeysTable holds the schema information for the "ssh_keys" table.
+	SSHKeysTable = &schema.Table{
+		Name:       "ssh_keys",
+		Columns:    SSHKeysColumns,
+		PrimaryKey: []*schema.Column{SSHKeysColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "ssh_keys_users_ssh_keys",
+				Columns:    []*schema.Column{SSHKeysColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "sshkey_user_id_fingerprint",
+				Unique:  true,
+				Columns: []*schema.Column{SSHKeysColumns[7], SSHKeysColumns[3]},
+			},
+		},
+	}
+	// SessionsColumns holds the columns for the "sessions" table.
+	SessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "refresh_token_hash", Type: field.TypeString, Unique: true},
+		{Name: "previous_token_hash", Type: field.TypeString, Nullable: true},
+		{Name: "ip", Type: field.TypeString, Nullable: true},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true, Size: 512},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "last_used_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// SessionsTable holds the schema information for the "sessions" table.
+	SessionsTable = &schema.Table{
+		Name:       "sessions",
+		Columns:    SessionsColumns,
+		PrimaryKey: []*schema.Column{SessionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sessions_users_sessions",
+				Columns:    []*schema.Column{SessionsColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "session_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{SessionsColumns[9]},
+			},
+			{
+				Name:    "session_previous_token_hash",
+				Unique:  false,
+				Columns: []*schema.Column{SessionsColumns[2]},
+			},
+		},
 	}
 	// SharesColumns holds the columns for the "shares" table.
 	SharesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "code", Type: field.TypeString, Unique: true},
+		{Name: "slug", Type: field.TypeString, Unique: true, Nullable: true},
 		{Name: "share_type", Type: field.TypeInt, Default: 0},
 		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
 		{Name: "password", Type: field.TypeString, Nullable: true},
 		{Name: "access_count", Type: field.TypeInt, Default: 0},
 		{Name: "max_access_count", Type: field.TypeInt, Nullable: true},
+		{Name: "download_count", Type: field.TypeInt, Default: 0},
+		{Name: "max_download_count", Type: field.TypeInt, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"active", "expired", "exhausted", "suspended"}, Default: "active"},
+		{Name: "disabled_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "node_shares", Type: field.TypeInt},
@@ -83,29 +476,77 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "shares_nodes_shares",
-				Columns:    []*schema.Column{SharesColumns[9]},
+				Columns:    []*schema.Column{SharesColumns[14]},
 				RefColumns: []*schema.Column{NodesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "shares_users_shares",
-				Columns:    []*schema.Column{SharesColumns[10]},
+				Columns:    []*schema.Column{SharesColumns[15]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 	}
+	// ShareAccessesColumns holds the columns for the "share_accesses" table.
+	ShareAccessesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"view", "list", "preview", "download"}},
+		{Name: "ip", Type: field.TypeString, Nullable: true},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true, Size: 512},
+		{Name: "bytes", Type: field.TypeInt64, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "node_id", Type: field.TypeInt, Nullable: true},
+		{Name: "share_id", Type: field.TypeInt},
+	}
+	// ShareAccessesTable holds the schema information for the "share_accesses" table.
+	ShareAccessesTable = &schema.Table{
+		Name:       "share_accesses",
+		Columns:    ShareAccessesColumns,
+		PrimaryKey: []*schema.Column{ShareAccessesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "share_accesses_nodes_share_accesses",
+				Columns:    []*schema.Column{ShareAccessesColumns[6]},
+				RefColumns: []*schema.Column{NodesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "share_accesses_shares_accesses",
+				Columns:    []*schema.Column{ShareAccessesColumns[7]},
+				RefColumns: []*schema.Column{SharesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "shareaccess_share_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ShareAccessesColumns[7], ShareAccessesColumns[5]},
+			},
+		},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "username", Type: field.TypeString, Unique: true},
 		{Name: "password_hash", Type: field.TypeString},
 		{Name: "email", Type: field.TypeString, Nullable: true},
-		{Name: "total_quota", Type: field.TypeInt64, Default: 10737418240},
+		{Name: "email_verified", Type: field.TypeBool, Default: false},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"admin", "user", "readonly"}, Default: "user"},
+		{Name: "is_disabled", Type: field.TypeBool, Default: false},
+		{Name: "auth_source", Type: field.TypeEnum, Enums: []string{"local", "ldap"}, Default: "local"},
+		{Name: "ldap_dn", Type: field.TypeString, Nullable: true},
+		{Name: "total_quota", Type: field.TypeInt64},
 		{Name: "total_used", Type: field.TypeInt64, Default: 0},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "last_login_at", Type: field.TypeTime, Nullable: true},
+		{Name: "totp_secret", Type: field.TypeString, Nullable: true},
+		{Name: "totp_enabled", Type: field.TypeBool, Default: false},
+		{Name: "totp_last_step", Type: field.TypeInt64, Default: 0},
+		{Name: "totp_recovery_codes", Type: field.TypeJSON, Nullable: true},
+		{Name: "change_seq", Type: field.TypeInt64, Default: 0},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
@@ -113,18 +554,151 @@ var (
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
 	}
+	// UserIdentitiesColumns holds the columns for the "user_identities" table.
+	UserIdentitiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "provider", Type: field.TypeString},
+		{Name: "issuer", Type: field.TypeString},
+		{Name: "subject", Type: field.TypeString},
+		{Name: "email", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "last_login_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// UserIdentitiesTable holds the schema information for the "user_identities" table.
+	UserIdentitiesTable = &schema.Table{
+		Name:       "user_identities",
+		Columns:    UserIdentitiesColumns,
+		PrimaryKey: []*schema.Column{UserIdentitiesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "user_identities_users_identities",
+				Columns:    []*schema.Column{UserIdentitiesColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "useridentity_issuer_subject",
+				Unique:  true,
+				Columns: []*schema.Column{UserIdentitiesColumns[2], UserIdentitiesColumns[3]},
+			},
+			{
+				Name:    "useridentity_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{UserIdentitiesColumns[7]},
+			},
+		},
+	}
+	// UserTokensColumns holds the columns for the "user_tokens" table.
+	UserTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "purpose", Type: field.TypeEnum, Enums: []string{"password_reset", "email_verification"}},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "email", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// UserTokensTable holds the schema information for the "user_tokens" table.
+	UserTokensTable = &schema.Table{
+		Name:       "user_tokens",
+		Columns:    UserTokensColumns,
+		PrimaryKey: []*schema.Column{UserTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "user_tokens_users_tokens",
+				Columns:    []*schema.Column{UserTokensColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "usertoken_user_id_purpose",
+				Unique:  false,
+				Columns: []*schema.Column{UserTokensColumns[7], UserTokensColumns[1]},
+			},
+			{
+				Name:    "usertoken_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{UserTokensColumns[5]},
+			},
+		},
+	}
+	// GroupMembersColumns holds the columns for the "group_members" table.
+	GroupMembersColumns = []*schema.Column{
+		{Name: "group_id", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// GroupMembersTable holds the schema information for the "group_members" table.
+	GroupMembersTable = &schema.Table{
+		Name:       "group_members",
+		Columns:    GroupMembersColumns,
+		PrimaryKey: []*schema.Column{GroupMembersColumns[0], GroupMembersColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "group_members_group_id",
+				Columns:    []*schema.Column{GroupMembersColumns[0]},
+				RefColumns: []*schema.Column{GroupsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "group_members_user_id",
+				Columns:    []*schema.Column{GroupMembersColumns[1]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AccessTokensTable,
+		AuthFailuresTable,
+		AuthThrottlesTable,
+		ChangesTable,
 		FileHashesTable,
+		GroupsTable,
+		InvitesTable,
 		NodesTable,
+		NodePermissionsTable,
+		S3keysTable,
+		S3uploadsTable,
+		SSHKeysTable,
+		SessionsTable,
 		SharesTable,
+		ShareAccessesTable,
 		UsersTable,
+		UserIdentitiesTable,
+		UserTokensTable,
+		GroupMembersTable,
 	}
 )
 
 func init() {
+	AccessTokensTable.ForeignKeys[0].RefTable = NodesTable
+	AccessTokensTable.ForeignKeys[1].RefTable = UsersTable
+	ChangesTable.ForeignKeys[0].RefTable = UsersTable
+	GroupsTable.ForeignKeys[0].RefTable = UsersTable
+	InvitesTable.ForeignKeys[0].RefTable = UsersTable
 	NodesTable.ForeignKeys[0].RefTable = NodesTable
 	NodesTable.ForeignKeys[1].RefTable = UsersTable
+	NodePermissionsTable.ForeignKeys[0].RefTable = GroupsTable
+	NodePermissionsTable.ForeignKeys[1].RefTable = NodesTable
+	NodePermissionsTable.ForeignKeys[2].RefTable = UsersTable
+	NodePermissionsTable.ForeignKeys[3].RefTable = UsersTable
+	S3keysTable.ForeignKeys[0].RefTable = UsersTable
+	S3uploadsTable.ForeignKeys[0].RefTable = UsersTable
+	SSHKeysTable.ForeignKeys[0].RefTable = UsersTable
+	SessionsTable.ForeignKeys[0].RefTable = UsersTable
 	SharesTable.ForeignKeys[0].RefTable = NodesTable
 	SharesTable.ForeignKeys[1].RefTable = UsersTable
+	ShareAccessesTable.ForeignKeys[0].RefTable = NodesTable
+	ShareAccessesTable.ForeignKeys[1].RefTable = SharesTable
+	UserIdentitiesTable.ForeignKeys[0].RefTable = UsersTable
+	UserTokensTable.ForeignKeys[0].RefTable = UsersTable
+	GroupMembersTable.ForeignKeys[0].RefTable = GroupsTable
+	GroupMembersTable.ForeignKeys[1].RefTable = UsersTable
 }
@@ -56,9 +56,15 @@ type NodeEdges struct {
 	Children []*Node `json:"children,omitempty"`
 	// Shares holds the value of the shares edge.
 	Shares []*Share `json:"shares,omitempty"`
+	// Permissions holds the value of the permissions edge.
+	Permissions []*NodePermission `json:"permissions,omitempty"`
+	// ShareAccesses holds the value of the share_accesses edge.
+	ShareAccesses []*ShareAccess `json:"share_accesses,omitempty"`
+	// AccessTokens holds the value of the access_tokens edge.
+	AccessTokens []*AccessToken `json:"access_tokens,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [7]bool
 }
 
 // OwnerOrErr returns the Owner value or an error if the edge
@@ -101,6 +107,33 @@ func (e NodeEdges) SharesOrErr() ([]*Share, error) {
 	return nil, &NotLoadedError{edge: "shares"}
 }
 
+// PermissionsOrErr returns the Permissions value or an error if the edge
+// was not loaded in eager-loading.
+func (e NodeEdges) PermissionsOrErr() ([]*NodePermission, error) {
+	if e.loadedTypes[4] {
+		return e.Permissions, nil
+	}
+	return nil, &NotLoadedError{edge: "permissions"}
+}
+
+// ShareAccessesOrErr returns the ShareAccesses value or an error if the edge
+// was not loaded in eager-loading.
+func (e NodeEdges) ShareAccessesOrErr() ([]*ShareAccess, error) {
+	if e.loadedTypes[5] {
+		return e.ShareAccesses, nil
+	}
+	return nil, &NotLoadedError{edge: "share_accesses"}
+}
+
+// AccessTokensOrErr returns the AccessTokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e NodeEdges) AccessTokensOrErr() ([]*AccessToken, error) {
+	if e.loadedTypes[6] {
+		return e.AccessTokens, nil
+	}
+	return nil, &NotLoadedError{edge: "access_tokens"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Node) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -246,6 +279,21 @@ func (n *Node) QueryShares() *ShareQuery {
 	return NewNodeClient(n.config).QueryShares(n)
 }
 
+// QueryPermissions queries the "permissions" edge of the Node entity.
+func (n *Node) QueryPermissions() *NodePermissionQuery {
+	return NewNodeClient(n.config).QueryPermissions(n)
+}
+
+// QueryShareAccesses queries the "share_accesses" edge of the Node entity.
+func (n *Node) QueryShareAccesses() *ShareAccessQuery {
+	return NewNodeClient(n.config).QueryShareAccesses(n)
+}
+
+// QueryAccessTokens queries the "access_tokens" edge of the Node entity.
+func (n *Node) QueryAccessTokens() *AccessTokenQuery {
+	return NewNodeClient(n.config).QueryAccessTokens(n)
+}
+
 // Update returns a builder for updating this Node.
 // Note that you need to call Node.Unwrap() before calling this method if this Node
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -42,6 +42,12 @@ const (
 	EdgeChildren = "children"
 	// EdgeShares holds the string denoting the shares edge name in mutations.
 	EdgeShares = "shares"
+	// EdgePermissions holds the string denoting the permissions edge name in mutations.
+	EdgePermissions = "permissions"
+	// EdgeShareAccesses holds the string denoting the share_accesses edge name in mutations.
+	EdgeShareAccesses = "share_accesses"
+	// EdgeAccessTokens holds the string denoting the access_tokens edge name in mutations.
+	EdgeAccessTokens = "access_tokens"
 	// Table holds the table name of the node in the database.
 	Table = "nodes"
 	// OwnerTable is the table that holds the owner relation/edge.
@@ -66,6 +72,27 @@ const (
 	SharesInverseTable = "shares"
 	// SharesColumn is the table column denoting the shares relation/edge.
 	SharesColumn = "node_shares"
+	// PermissionsTable is the table that holds the permissions relation/edge.
+	PermissionsTable = "node_permissions"
+	// PermissionsInverseTable is the table name for the NodePermission entity.
+	// It exists in this package in order to avoid circular dependency with the "nodepermission" package.
+	PermissionsInverseTable = "node_permissions"
+	// PermissionsColumn is the table column denoting the permissions relation/edge.
+	PermissionsColumn = "node_permissions"
+	// ShareAccessesTable is the table that holds the share_accesses relation/edge.
+	ShareAccessesTable = "share_accesses"
+	// ShareAccessesInverseTable is the table name for the ShareAccess entity.
+	// It exists in this package in order to avoid circular dependency with the "shareaccess" package.
+	ShareAccessesInverseTable = "share_accesses"
+	// ShareAccessesColumn is the table column denoting the share_accesses relation/edge.
+	ShareAccessesColumn = "node_id"
+	// AccessTokensTable is the table that holds the access_tokens relation/edge.
+	AccessTokensTable = "access_tokens"
+	// AccessTokensInverseTable is the table name for the AccessToken entity.
+	// It exists in this package in order to avoid circular dependency with the "accesstoken" package.
+	AccessTokensInverseTable = "access_tokens"
+	// AccessTokensColumn is the table column denoting the access_tokens relation/edge.
+	AccessTokensColumn = "folder_id"
 )
 
 // Columns holds all SQL columns for node fields.
@@ -221,6 +248,48 @@ func ByShares(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newSharesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByPermissionsCount orders the results by permissions count.
+func ByPermissionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPermissionsStep(), opts...)
+	}
+}
+
+// ByPermissions orders the results by permissions terms.
+func ByPermissions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPermissionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByShareAccessesCount orders the results by share_accesses count.
+func ByShareAccessesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newShareAccessesStep(), opts...)
+	}
+}
+
+// ByShareAccesses orders the results by share_accesses terms.
+func ByShareAccesses(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newShareAccessesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAccessTokensCount orders the results by access_tokens count.
+func ByAccessTokensCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAccessTokensStep(), opts...)
+	}
+}
+
+// ByAccessTokens orders the results by access_tokens terms.
+func ByAccessTokens(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAccessTokensStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newOwnerStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -249,3 +318,24 @@ func newSharesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, SharesTable, SharesColumn),
 	)
 }
+func newPermissionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PermissionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PermissionsTable, PermissionsColumn),
+	)
+}
+func newShareAccessesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ShareAccessesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ShareAccessesTable, ShareAccessesColumn),
+	)
+}
+func newAccessTokensStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AccessTokensInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AccessTokensTable, AccessTokensColumn),
+	)
+}
@@ -6,8 +6,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"gopan-server/ent/accesstoken"
 	"gopan-server/ent/node"
+	"gopan-server/ent/nodepermission"
 	"gopan-server/ent/share"
+	"gopan-server/ent/shareaccess"
 	"gopan-server/ent/user"
 	"time"
 
@@ -214,6 +217,51 @@ func (nc *NodeCreate) AddShares(s ...*Share) *NodeCreate {
 	return nc.AddShareIDs(ids...)
 }
 
+// AddPermissionIDs adds the "permissions" edge to the NodePermission entity by IDs.
+func (nc *NodeCreate) AddPermissionIDs(ids ...int) *NodeCreate {
+	nc.mutation.AddPermissionIDs(ids...)
+	return nc
+}
+
+// AddPermissions adds the "permissions" edges to the NodePermission entity.
+func (nc *NodeCreate) AddPermissions(n ...*NodePermission) *NodeCreate {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nc.AddPermissionIDs(ids...)
+}
+
+// AddShareAccessIDs adds the "share_accesses" edge to the ShareAccess entity by IDs.
+func (nc *NodeCreate) AddShareAccessIDs(ids ...int) *NodeCreate {
+	nc.mutation.AddShareAccessIDs(ids...)
+	return nc
+}
+
+// AddShareAccesses adds the "share_accesses" edges to the ShareAccess entity.
+func (nc *NodeCreate) AddShareAccesses(s ...*ShareAccess) *NodeCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return nc.AddShareAccessIDs(ids...)
+}
+
+// AddAccessTokenIDs adds the "access_tokens" edge to the AccessToken entity by IDs.
+func (nc *NodeCreate) AddAccessTokenIDs(ids ...int) *NodeCreate {
+	nc.mutation.AddAccessTokenIDs(ids...)
+	return nc
+}
+
+// AddAccessTokens adds the "access_tokens" edges to the AccessToken entity.
+func (nc *NodeCreate) AddAccessTokens(a ...*AccessToken) *NodeCreate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return nc.AddAccessTokenIDs(ids...)
+}
+
 // Mutation returns the NodeMutation object of the builder.
 func (nc *NodeCreate) Mutation() *NodeMutation {
 	return nc.mutation
@@ -431,6 +479,54 @@ func (nc *NodeCreate) createSpec() (*Node, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := nc.mutation.PermissionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   node.PermissionsTable,
+			Columns: []string{node.PermissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(nodepermission.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := nc.mutation.ShareAccessesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   node.ShareAccessesTable,
+			Columns: []string{node.ShareAccessesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shareaccess.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := nc.mutation.AccessTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   node.AccessTokensTable,
+			Columns: []string{node.AccessTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accesstoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
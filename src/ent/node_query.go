@@ -6,9 +6,12 @@ import (
 	"context"
 	"database/sql/driver"
 	"fmt"
+	"gopan-server/ent/accesstoken"
 	"gopan-server/ent/node"
+	"gopan-server/ent/nodepermission"
 	"gopan-server/ent/predicate"
 	"gopan-server/ent/share"
+	"gopan-server/ent/shareaccess"
 	"gopan-server/ent/user"
 	"math"
 
@@ -21,15 +24,18 @@ import (
 // NodeQuery is the builder for querying Node entities.
 type NodeQuery struct {
 	config
-	ctx          *QueryContext
-	order        []node.OrderOption
-	inters       []Interceptor
-	predicates   []predicate.Node
-	withOwner    *UserQuery
-	withParent   *NodeQuery
-	withChildren *NodeQuery
-	withShares   *ShareQuery
-	withFKs      bool
+	ctx               *QueryContext
+	order             []node.OrderOption
+	inters            []Interceptor
+	predicates        []predicate.Node
+	withOwner         *UserQuery
+	withParent        *NodeQuery
+	withChildren      *NodeQuery
+	withShares        *ShareQuery
+	withPermissions   *NodePermissionQuery
+	withShareAccesses *ShareAccessQuery
+	withAccessTokens  *AccessTokenQuery
+	withFKs           bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -154,6 +160,72 @@ func (nq *NodeQuery) QueryShares() *ShareQuery {
 	return query
 }
 
+// QueryPermissions chains the current query on the "permissions" edge.
+func (nq *NodeQuery) QueryPermissions() *NodePermissionQuery {
+	query := (&NodePermissionClient{config: nq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := nq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := nq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(node.Table, node.FieldID, selector),
+			sqlgraph.To(nodepermission.Table, nodepermission.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, node.PermissionsTable, node.PermissionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(nq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryShareAccesses chains the current query on the "share_accesses" edge.
+func (nq *NodeQuery) QueryShareAccesses() *ShareAccessQuery {
+	query := (&ShareAccessClient{config: nq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := nq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := nq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(node.Table, node.FieldID, selector),
+			sqlgraph.To(shareaccess.Table, shareaccess.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, node.ShareAccessesTable, node.ShareAccessesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(nq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAccessTokens chains the current query on the "access_tokens" edge.
+func (nq *NodeQuery) QueryAccessTokens() *AccessTokenQuery {
+	query := (&AccessTokenClient{config: nq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := nq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := nq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(node.Table, node.FieldID, selector),
+			sqlgraph.To(accesstoken.Table, accesstoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, node.AccessTokensTable, node.AccessTokensColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(nq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Node entity from the query.
 // Returns a *NotFoundError when no Node was found.
 func (nq *NodeQuery) First(ctx context.Context) (*Node, error) {
@@ -341,15 +413,18 @@ func (nq *NodeQuery) Clone() *NodeQuery {
 		return nil
 	}
 	return &NodeQuery{
-		config:       nq.config,
-		ctx:          nq.ctx.Clone(),
-		order:        append([]node.OrderOption{}, nq.order...),
-		inters:       append([]Interceptor{}, nq.inters...),
-		predicates:   append([]predicate.Node{}, nq.predicates...),
-		withOwner:    nq.withOwner.Clone(),
-		withParent:   nq.withParent.Clone(),
-		withChildren: nq.withChildren.Clone(),
-		withShares:   nq.withShares.Clone(),
+		config:            nq.config,
+		ctx:               nq.ctx.Clone(),
+		order:             append([]node.OrderOption{}, nq.order...),
+		inters:            append([]Interceptor{}, nq.inters...),
+		predicates:        append([]predicate.Node{}, nq.predicates...),
+		withOwner:         nq.withOwner.Clone(),
+		withParent:        nq.withParent.Clone(),
+		withChildren:      nq.withChildren.Clone(),
+		withShares:        nq.withShares.Clone(),
+		withPermissions:   nq.withPermissions.Clone(),
+		withShareAccesses: nq.withShareAccesses.Clone(),
+		withAccessTokens:  nq.withAccessTokens.Clone(),
 		// clone intermediate query.
 		sql:  nq.sql.Clone(),
 		path: nq.path,
@@ -400,6 +475,39 @@ func (nq *NodeQuery) WithShares(opts ...func(*ShareQuery)) *NodeQuery {
 	return nq
 }
 
+// WithPermissions tells the query-builder to eager-load the nodes that are connected to
+// the "permissions" edge. The optional arguments are used to configure the query builder of the edge.
+func (nq *NodeQuery) WithPermissions(opts ...func(*NodePermissionQuery)) *NodeQuery {
+	query := (&NodePermissionClient{config: nq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	nq.withPermissions = query
+	return nq
+}
+
+// WithShareAccesses tells the query-builder to eager-load the nodes that are connected to
+// the "share_accesses" edge. The optional arguments are used to configure the query builder of the edge.
+func (nq *NodeQuery) WithShareAccesses(opts ...func(*ShareAccessQuery)) *NodeQuery {
+	query := (&ShareAccessClient{config: nq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	nq.withShareAccesses = query
+	return nq
+}
+
+// WithAccessTokens tells the query-builder to eager-load the nodes that are connected to
+// the "access_tokens" edge. The optional arguments are used to configure the query builder of the edge.
+func (nq *NodeQuery) WithAccessTokens(opts ...func(*AccessTokenQuery)) *NodeQuery {
+	query := (&AccessTokenClient{config: nq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	nq.withAccessTokens = query
+	return nq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -479,11 +587,14 @@ func (nq *NodeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Node, e
 		nodes       = []*Node{}
 		withFKs     = nq.withFKs
 		_spec       = nq.querySpec()
-		loadedTypes = [4]bool{
+		loadedTypes = [7]bool{
 			nq.withOwner != nil,
 			nq.withParent != nil,
 			nq.withChildren != nil,
 			nq.withShares != nil,
+			nq.withPermissions != nil,
+			nq.withShareAccesses != nil,
+			nq.withAccessTokens != nil,
 		}
 	)
 	if nq.withOwner != nil || nq.withParent != nil {
@@ -536,6 +647,27 @@ func (nq *NodeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Node, e
 			return nil, err
 		}
 	}
+	if query := nq.withPermissions; query != nil {
+		if err := nq.loadPermissions(ctx, query, nodes,
+			func(n *Node) { n.Edges.Permissions = []*NodePermission{} },
+			func(n *Node, e *NodePermission) { n.Edges.Permissions = append(n.Edges.Permissions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := nq.withShareAccesses; query != nil {
+		if err := nq.loadShareAccesses(ctx, query, nodes,
+			func(n *Node) { n.Edges.ShareAccesses = []*ShareAccess{} },
+			func(n *Node, e *ShareAccess) { n.Edges.ShareAccesses = append(n.Edges.ShareAccesses, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := nq.withAccessTokens; query != nil {
+		if err := nq.loadAccessTokens(ctx, query, nodes,
+			func(n *Node) { n.Edges.AccessTokens = []*AccessToken{} },
+			func(n *Node, e *AccessToken) { n.Edges.AccessTokens = append(n.Edges.AccessTokens, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -665,6 +797,103 @@ func (nq *NodeQuery) loadShares(ctx context.Context, query *ShareQuery, nodes []
 	}
 	return nil
 }
+func (nq *NodeQuery) loadPermissions(ctx context.Context, query *NodePermissionQuery, nodes []*Node, init func(*Node), assign func(*Node, *NodePermission)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Node)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.NodePermission(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(node.PermissionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.node_permissions
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "node_permissions" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "node_permissions" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (nq *NodeQuery) loadShareAccesses(ctx context.Context, query *ShareAccessQuery, nodes []*Node, init func(*Node), assign func(*Node, *ShareAccess)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Node)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(shareaccess.FieldNodeID)
+	}
+	query.Where(predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(node.ShareAccessesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.NodeID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "node_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "node_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (nq *NodeQuery) loadAccessTokens(ctx context.Context, query *AccessTokenQuery, nodes []*Node, init func(*Node), assign func(*Node, *AccessToken)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Node)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(accesstoken.FieldFolderID)
+	}
+	query.Where(predicate.AccessToken(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(node.AccessTokensColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.FolderID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "folder_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "folder_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (nq *NodeQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := nq.querySpec()
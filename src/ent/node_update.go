@@ -6,9 +6,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"gopan-server/ent/accesstoken"
 	"gopan-server/ent/node"
+	"gopan-server/ent/nodepermission"
 	"gopan-server/ent/predicate"
 	"gopan-server/ent/share"
+	"gopan-server/ent/shareaccess"
 	"gopan-server/ent/user"
 	"time"
 
@@ -260,6 +263,51 @@ func (nu *NodeUpdate) AddShares(s ...*Share) *NodeUpdate {
 	return nu.AddShareIDs(ids...)
 }
 
+// AddPermissionIDs adds the "permissions" edge to the NodePermission entity by IDs.
+func (nu *NodeUpdate) AddPermissionIDs(ids ...int) *NodeUpdate {
+	nu.mutation.AddPermissionIDs(ids...)
+	return nu
+}
+
+// AddPermissions adds the "permissions" edges to the NodePermission entity.
+func (nu *NodeUpdate) AddPermissions(n ...*NodePermission) *NodeUpdate {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nu.AddPermissionIDs(ids...)
+}
+
+// AddShareAccessIDs adds the "share_accesses" edge to the ShareAccess entity by IDs.
+func (nu *NodeUpdate) AddShareAccessIDs(ids ...int) *NodeUpdate {
+	nu.mutation.AddShareAccessIDs(ids...)
+	return nu
+}
+
+// AddShareAccesses adds the "share_accesses" edges to the ShareAccess entity.
+func (nu *NodeUpdate) AddShareAccesses(s ...*ShareAccess) *NodeUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return nu.AddShareAccessIDs(ids...)
+}
+
+// AddAccessTokenIDs adds the "access_tokens" edge to the AccessToken entity by IDs.
+func (nu *NodeUpdate) AddAccessTokenIDs(ids ...int) *NodeUpdate {
+	nu.mutation.AddAccessTokenIDs(ids...)
+	return nu
+}
+
+// AddAccessTokens adds the "access_tokens" edges to the AccessToken entity.
+func (nu *NodeUpdate) AddAccessTokens(a ...*AccessToken) *NodeUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return nu.AddAccessTokenIDs(ids...)
+}
+
 // Mutation returns the NodeMutation object of the builder.
 func (nu *NodeUpdate) Mutation() *NodeMutation {
 	return nu.mutation
@@ -319,6 +367,69 @@ func (nu *NodeUpdate) RemoveShares(s ...*Share) *NodeUpdate {
 	return nu.RemoveShareIDs(ids...)
 }
 
+// ClearPermissions clears all "permissions" edges to the NodePermission entity.
+func (nu *NodeUpdate) ClearPermissions() *NodeUpdate {
+	nu.mutation.ClearPermissions()
+	return nu
+}
+
+// RemovePermissionIDs removes the "permissions" edge to NodePermission entities by IDs.
+func (nu *NodeUpdate) RemovePermissionIDs(ids ...int) *NodeUpdate {
+	nu.mutation.RemovePermissionIDs(ids...)
+	return nu
+}
+
+// RemovePermissions removes "permissions" edges to NodePermission entities.
+func (nu *NodeUpdate) RemovePermissions(n ...*NodePermission) *NodeUpdate {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nu.RemovePermissionIDs(ids...)
+}
+
+// ClearShareAccesses clears all "share_accesses" edges to the ShareAccess entity.
+func (nu *NodeUpdate) ClearShareAccesses() *NodeUpdate {
+	nu.mutation.ClearShareAccesses()
+	return nu
+}
+
+// RemoveShareAccessIDs removes the "share_accesses" edge to ShareAccess entities by IDs.
+func (nu *NodeUpdate) RemoveShareAccessIDs(ids ...int) *NodeUpdate {
+	nu.mutation.RemoveShareAccessIDs(ids...)
+	return nu
+}
+
+// RemoveShareAccesses removes "share_accesses" edges to ShareAccess entities.
+func (nu *NodeUpdate) RemoveShareAccesses(s ...*ShareAccess) *NodeUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return nu.RemoveShareAccessIDs(ids...)
+}
+
+// ClearAccessTokens clears all "access_tokens" edges to the AccessToken entity.
+func (nu *NodeUpdate) ClearAccessTokens() *NodeUpdate {
+	nu.mutation.ClearAccessTokens()
+	return nu
+}
+
+// RemoveAccessTokenIDs removes the "access_tokens" edge to AccessToken entities by IDs.
+func (nu *NodeUpdate) RemoveAccessTokenIDs(ids ...int) *NodeUpdate {
+	nu.mutation.RemoveAccessTokenIDs(ids...)
+	return nu
+}
+
+// RemoveAccessTokens removes "access_tokens" edges to AccessToken entities.
+func (nu *NodeUpdate) RemoveAccessTokens(a ...*AccessToken) *NodeUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return nu.RemoveAccessTokenIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (nu *NodeUpdate) Save(ctx context.Context) (int, error) {
 	nu.defaults()
@@ -576,6 +687,141 @@ func (nu *NodeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if nu.mutation.PermissionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   node.PermissionsTable,
+			Columns: []string{node.PermissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(nodepermission.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.RemovedPermissionsIDs(); len(nodes) > 0 && !nu.mutation.PermissionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   node.PermissionsTable,
+			Columns: []string{node.PermissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(nodepermission.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.PermissionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   node.PermissionsTable,
+			Columns: []string{node.PermissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(nodepermission.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nu.mutation.ShareAccessesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   node.ShareAccessesTable,
+			Columns: []string{node.ShareAccessesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shareaccess.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.RemovedShareAccessesIDs(); len(nodes) > 0 && !nu.mutation.ShareAccessesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   node.ShareAccessesTable,
+			Columns: []string{node.ShareAccessesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shareaccess.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.ShareAccessesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   node.ShareAccessesTable,
+			Columns: []string{node.ShareAccessesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shareaccess.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nu.mutation.AccessTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   node.AccessTokensTable,
+			Columns: []string{node.AccessTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accesstoken.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.RemovedAccessTokensIDs(); len(nodes) > 0 && !nu.mutation.AccessTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   node.AccessTokensTable,
+			Columns: []string{node.AccessTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accesstoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.AccessTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   node.AccessTokensTable,
+			Columns: []string{node.AccessTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accesstoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, nu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{node.Label}
@@ -826,6 +1072,51 @@ func (nuo *NodeUpdateOne) AddShares(s ...*Share) *NodeUpdateOne {
 	return nuo.AddShareIDs(ids...)
 }
 
+// AddPermissionIDs adds the "permissions" edge to the NodePermission entity by IDs.
+func (nuo *NodeUpdateOne) AddPermissionIDs(ids ...int) *NodeUpdateOne {
+	nuo.mutation.AddPermissionIDs(ids...)
+	return nuo
+}
+
+// AddPermissions adds the "permissions" edges to the NodePermission entity.
+func (nuo *NodeUpdateOne) AddPermissions(n ...*NodePermission) *NodeUpdateOne {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nuo.AddPermissionIDs(ids...)
+}
+
+// AddShareAccessIDs adds the "share_accesses" edge to the ShareAccess entity by IDs.
+func (nuo *NodeUpdateOne) AddShareAccessIDs(ids ...int) *NodeUpdateOne {
+	nuo.mutation.AddShareAccessIDs(ids...)
+	return nuo
+}
+
+// AddShareAccesses adds the "share_accesses" edges to the ShareAccess entity.
+func (nuo *NodeUpdateOne) AddShareAccesses(s ...*ShareAccess) *NodeUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return nuo.AddShareAccessIDs(ids...)
+}
+
+// AddAccessTokenIDs adds the "access_tokens" edge to the AccessToken entity by IDs.
+func (nuo *NodeUpdateOne) AddAccessTokenIDs(ids ...int) *NodeUpdateOne {
+	nuo.mutation.AddAccessTokenIDs(ids...)
+	return nuo
+}
+
+// AddAccessTokens adds the "access_tokens" edges to the AccessToken entity.
+func (nuo *NodeUpdateOne) AddAccessTokens(a ...*AccessToken) *NodeUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return nuo.AddAccessTokenIDs(ids...)
+}
+
 // Mutation returns the NodeMutation object of the builder.
 func (nuo *NodeUpdateOne) Mutation() *NodeMutation {
 	return nuo.mutation
@@ -885,6 +1176,69 @@ func (nuo *NodeUpdateOne) RemoveShares(s ...*Share) *NodeUpdateOne {
 	return nuo.RemoveShareIDs(ids...)
 }
 
+// ClearPermissions clears all "permissions" edges to the NodePermission entity.
+func (nuo *NodeUpdateOne) ClearPermissions() *NodeUpdateOne {
+	nuo.mutation.ClearPermissions()
+	return nuo
+}
+
+// RemovePermissionIDs removes the "permissions" edge to NodePermission entities by IDs.
+func (nuo *NodeUpdateOne) RemovePermissionIDs(ids ...int) *NodeUpdateOne {
+	nuo.mutation.RemovePermissionIDs(ids...)
+	return nuo
+}
+
+// RemovePermissions removes "permissions" edges to NodePermission entities.
+func (nuo *NodeUpdateOne) RemovePermissions(n ...*NodePermission) *NodeUpdateOne {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nuo.RemovePermissionIDs(ids...)
+}
+
+// ClearShareAccesses clears all "share_accesses" edges to the ShareAccess entity.
+func (nuo *NodeUpdateOne) ClearShareAccesses() *NodeUpdateOne {
+	nuo.mutation.ClearShareAccesses()
+	return nuo
+}
+
+// RemoveShareAccessIDs removes the "share_accesses" edge to ShareAccess entities by IDs.
+func (nuo *NodeUpdateOne) RemoveShareAccessIDs(ids ...int) *NodeUpdateOne {
+	nuo.mutation.RemoveShareAccessIDs(ids...)
+	return nuo
+}
+
+// RemoveShareAccesses removes "share_accesses" edges to ShareAccess entities.
+func (nuo *NodeUpdateOne) RemoveShareAccesses(s ...*ShareAccess) *NodeUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return nuo.RemoveShareAccessIDs(ids...)
+}
+
+// ClearAccessTokens clears all "access_tokens" edges to the AccessToken entity.
+func (nuo *NodeUpdateOne) ClearAccessTokens() *NodeUpdateOne {
+	nuo.mutation.ClearAccessTokens()
+	return nuo
+}
+
+// RemoveAccessTokenIDs removes the "access_tokens" edge to AccessToken entities by IDs.
+func (nuo *NodeUpdateOne) RemoveAccessTokenIDs(ids ...int) *NodeUpdateOne {
+	nuo.mutation.RemoveAccessTokenIDs(ids...)
+	return nuo
+}
+
+// RemoveAccessTokens removes "access_tokens" edges to AccessToken entities.
+func (nuo *NodeUpdateOne) RemoveAccessTokens(a ...*AccessToken) *NodeUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return nuo.RemoveAccessTokenIDs(ids...)
+}
+
 // Where appends a list predicates to the NodeUpdate builder.
 func (nuo *NodeUpdateOne) Where(ps ...predicate.Node) *NodeUpdateOne {
 	nuo.mutation.Where(ps...)
@@ -1172,6 +1526,141 @@ func (nuo *NodeUpdateOne) sqlSave(ctx context.Context) (_node *Node, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if nuo.mutation.PermissionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   node.PermissionsTable,
+			Columns: []string{node.PermissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(nodepermission.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nuo.mutation.RemovedPermissionsIDs(); len(nodes) > 0 && !nuo.mutation.PermissionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   node.PermissionsTable,
+			Columns: []string{node.PermissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(nodepermission.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nuo.mutation.PermissionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   node.PermissionsTable,
+			Columns: []string{node.PermissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(nodepermission.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nuo.mutation.ShareAccessesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   node.ShareAccessesTable,
+			Columns: []string{node.ShareAccessesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shareaccess.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nuo.mutation.RemovedShareAccessesIDs(); len(nodes) > 0 && !nuo.mutation.ShareAccessesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   node.ShareAccessesTable,
+			Columns: []string{node.ShareAccessesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shareaccess.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nuo.mutation.ShareAccessesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   node.ShareAccessesTable,
+			Columns: []string{node.ShareAccessesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shareaccess.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nuo.mutation.AccessTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   node.AccessTokensTable,
+			Columns: []string{node.AccessTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accesstoken.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nuo.mutation.RemovedAccessTokensIDs(); len(nodes) > 0 && !nuo.mutation.AccessTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   node.AccessTokensTable,
+			Columns: []string{node.AccessTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accesstoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nuo.mutation.AccessTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   node.AccessTokensTable,
+			Columns: []string{node.AccessTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accesstoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Node{config: nuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,253 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"gopan-server/ent/group"
+	"gopan-server/ent/node"
+	"gopan-server/ent/nodepermission"
+	"gopan-server/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// NodePermission is the model entity for the NodePermission schema.
+type NodePermission struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// read: view and download, write: also upload, rename and delete
+	Role nodepermission.Role `json:"role,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the NodePermissionQuery when eager-loading is set.
+	Edges                    NodePermissionEdges `json:"edges"`
+	group_node_permissions   *int
+	node_permissions         *int
+	user_granted_permissions *int
+	user_node_permissions    *int
+	selectValues             sql.SelectValues
+}
+
+// NodePermissionEdges holds the relations/edges for other nodes in the graph.
+type NodePermissionEdges struct {
+	// Node holds the value of the node edge.
+	Node *Node `json:"node,omitempty"`
+	// GrantedBy holds the value of the granted_by edge.
+	GrantedBy *User `json:"granted_by,omitempty"`
+	// Grantee user, empty when granted to a group
+	User *User `json:"user,omitempty"`
+	// Grantee group, empty when granted to a user
+	Group *Group `json:"group,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// NodeOrErr returns the Node value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NodePermissionEdges) NodeOrErr() (*Node, error) {
+	if e.Node != nil {
+		return e.Node, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: node.Label}
+	}
+	return nil, &NotLoadedError{edge: "node"}
+}
+
+// GrantedByOrErr returns the GrantedBy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NodePermissionEdges) GrantedByOrErr() (*User, error) {
+	if e.GrantedBy != nil {
+		return e.GrantedBy, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "granted_by"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NodePermissionEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// GroupOrErr returns the Group value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NodePermissionEdges) GroupOrErr() (*Group, error) {
+	if e.Group != nil {
+		return e.Group, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: group.Label}
+	}
+	return nil, &NotLoadedError{edge: "group"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*NodePermission) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case nodepermission.FieldID:
+			values[i] = new(sql.NullInt64)
+		case nodepermission.FieldRole:
+			values[i] = new(sql.NullString)
+		case nodepermission.FieldCreatedAt, nodepermission.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case nodepermission.ForeignKeys[0]: // group_node_permissions
+			values[i] = new(sql.NullInt64)
+		case nodepermission.ForeignKeys[1]: // node_permissions
+			values[i] = new(sql.NullInt64)
+		case nodepermission.ForeignKeys[2]: // user_granted_permissions
+			values[i] = new(sql.NullInt64)
+		case nodepermission.ForeignKeys[3]: // user_node_permissions
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the NodePermission fields.
+func (np *NodePermission) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case nodepermission.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			np.ID = int(value.Int64)
+		case nodepermission.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				np.Role = nodepermission.Role(value.String)
+			}
+		case nodepermission.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				np.CreatedAt = value.Time
+			}
+		case nodepermission.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				np.UpdatedAt = value.Time
+			}
+		case nodepermission.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field group_node_permissions", value)
+			} else if value.Valid {
+				np.group_node_permissions = new(int)
+				*np.group_node_permissions = int(value.Int64)
+			}
+		case nodepermission.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field node_permissions", value)
+			} else if value.Valid {
+				np.node_permissions = new(int)
+				*np.node_permissions = int(value.Int64)
+			}
+		case nodepermission.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_granted_permissions", value)
+			} else if value.Valid {
+				np.user_granted_permissions = new(int)
+				*np.user_granted_permissions = int(value.Int64)
+			}
+		case nodepermission.ForeignKeys[3]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_node_permissions", value)
+			} else if value.Valid {
+				np.user_node_permissions = new(int)
+				*np.user_node_permissions = int(value.Int64)
+			}
+		default:
+			np.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the NodePermission.
+// This includes values selected through modifiers, order, etc.
+func (np *NodePermission) Value(name string) (ent.Value, error) {
+	return np.selectValues.Get(name)
+}
+
+// QueryNode queries the "node" edge of the NodePermission entity.
+func (np *NodePermission) QueryNode() *NodeQuery {
+	return NewNodePermissionClient(np.config).QueryNode(np)
+}
+
+// QueryGrantedBy queries the "granted_by" edge of the NodePermission entity.
+func (np *NodePermission) QueryGrantedBy() *UserQuery {
+	return NewNodePermissionClient(np.config).QueryGrantedBy(np)
+}
+
+// QueryUser queries the "user" edge of the NodePermission entity.
+func (np *NodePermission) QueryUser() *UserQuery {
+	return NewNodePermissionClient(np.config).QueryUser(np)
+}
+
+// QueryGroup queries the "group" edge of the NodePermission entity.
+func (np *NodePermission) QueryGroup() *GroupQuery {
+	return NewNodePermissionClient(np.config).QueryGroup(np)
+}
+
+// Update returns a builder for updating this NodePermission.
+// Note that you need to call NodePermission.Unwrap() before calling this method if this NodePermission
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (np *NodePermission) Update() *NodePermissionUpdateOne {
+	return NewNodePermissionClient(np.config).UpdateOne(np)
+}
+
+// Unwrap unwraps the NodePermission entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (np *NodePermission) Unwrap() *NodePermission {
+	_tx, ok := np.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: NodePermission is not a transactional entity")
+	}
+	np.config.driver = _tx.drv
+	return np
+}
+
+// String implements the fmt.Stringer.
+func (np *NodePermission) String() string {
+	var builder strings.Builder
+	builder.WriteString("NodePermission(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", np.ID))
+	builder.WriteString("role=")
+	builder.WriteString(fmt.Sprintf("%v", np.Role))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(np.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(np.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NodePermissions is a parsable slice of NodePermission.
+type NodePermissions []*NodePermission
@@ -0,0 +1,394 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gopan-server/ent/group"
+	"gopan-server/ent/node"
+	"gopan-server/ent/nodepermission"
+	"gopan-server/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// NodePermissionCreate is the builder for creating a NodePermission entity.
+type NodePermissionCreate struct {
+	config
+	mutation *NodePermissionMutation
+	hooks    []Hook
+}
+
+// SetRole sets the "role" field.
+func (npc *NodePermissionCreate) SetRole(n nodepermission.Role) *NodePermissionCreate {
+	npc.mutation.SetRole(n)
+	return npc
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (npc *NodePermissionCreate) SetNillableRole(n *nodepermission.Role) *NodePermissionCreate {
+	if n != nil {
+		npc.SetRole(*n)
+	}
+	return npc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (npc *NodePermissionCreate) SetCreatedAt(t time.Time) *NodePermissionCreate {
+	npc.mutation.SetCreatedAt(t)
+	return npc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (npc *NodePermissionCreate) SetNillableCreatedAt(t *time.Time) *NodePermissionCreate {
+	if t != nil {
+		npc.SetCreatedAt(*t)
+	}
+	return npc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (npc *NodePermissionCreate) SetUpdatedAt(t time.Time) *NodePermissionCreate {
+	npc.mutation.SetUpdatedAt(t)
+	return npc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (npc *NodePermissionCreate) SetNillableUpdatedAt(t *time.Time) *NodePermissionCreate {
+	if t != nil {
+		npc.SetUpdatedAt(*t)
+	}
+	return npc
+}
+
+// SetNodeID sets the "node" edge to the Node entity by ID.
+func (npc *NodePermissionCreate) SetNodeID(id int) *NodePermissionCreate {
+	npc.mutation.SetNodeID(id)
+	return npc
+}
+
+// SetNode sets the "node" edge to the Node entity.
+func (npc *NodePermissionCreate) SetNode(n *Node) *NodePermissionCreate {
+	return npc.SetNodeID(n.ID)
+}
+
+// SetGrantedByID sets the "granted_by" edge to the User entity by ID.
+func (npc *NodePermissionCreate) SetGrantedByID(id int) *NodePermissionCreate {
+	npc.mutation.SetGrantedByID(id)
+	return npc
+}
+
+// SetGrantedBy sets the "granted_by" edge to the User entity.
+func (npc *NodePermissionCreate) SetGrantedBy(u *User) *NodePermissionCreate {
+	return npc.SetGrantedByID(u.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (npc *NodePermissionCreate) SetUserID(id int) *NodePermissionCreate {
+	npc.mutation.SetUserID(id)
+	return npc
+}
+
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (npc *NodePermissionCreate) SetNillableUserID(id *int) *NodePermissionCreate {
+	if id != nil {
+		npc = npc.SetUserID(*id)
+	}
+	return npc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (npc *NodePermissionCreate) SetUser(u *User) *NodePermissionCreate {
+	return npc.SetUserID(u.ID)
+}
+
+// SetGroupID sets the "group" edge to the Group entity by ID.
+func (npc *NodePermissionCreate) SetGroupID(id int) *NodePermissionCreate {
+	npc.mutation.SetGroupID(id)
+	return npc
+}
+
+// SetNillableGroupID sets the "group" edge to the Group entity by ID if the given value is not nil.
+func (npc *NodePermissionCreate) SetNillableGroupID(id *int) *NodePermissionCreate {
+	if id != nil {
+		npc = npc.SetGroupID(*id)
+	}
+	return npc
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (npc *NodePermissionCreate) SetGroup(g *Group) *NodePermissionCreate {
+	return npc.SetGroupID(g.ID)
+}
+
+// Mutation returns the NodePermissionMutation object of the builder.
+func (npc *NodePermissionCreate) Mutation() *NodePermissionMutation {
+	return npc.mutation
+}
+
+// Save creates the NodePermission in the database.
+func (npc *NodePermissionCreate) Save(ctx context.Context) (*NodePermission, error) {
+	npc.defaults()
+	return withHooks(ctx, npc.sqlSave, npc.mutation, npc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (npc *NodePermissionCreate) SaveX(ctx context.Context) *NodePermission {
+	v, err := npc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (npc *NodePermissionCreate) Exec(ctx context.Context) error {
+	_, err := npc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (npc *NodePermissionCreate) ExecX(ctx context.Context) {
+	if err := npc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (npc *NodePermissionCreate) defaults() {
+	if _, ok := npc.mutation.Role(); !ok {
+		v := nodepermission.DefaultRole
+		npc.mutation.SetRole(v)
+	}
+	if _, ok := npc.mutation.CreatedAt(); !ok {
+		v := nodepermission.DefaultCreatedAt()
+		npc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := npc.mutation.UpdatedAt(); !ok {
+		v := nodepermission.DefaultUpdatedAt()
+		npc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (npc *NodePermissionCreate) check() error {
+	if _, ok := npc.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "NodePermission.role"`)}
+	}
+	if v, ok := npc.mutation.Role(); ok {
+		if err := nodepermission.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "NodePermission.role": %w`, err)}
+		}
+	}
+	if _, ok := npc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "NodePermission.created_at"`)}
+	}
+	if _, ok := npc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "NodePermission.updated_at"`)}
+	}
+	if len(npc.mutation.NodeIDs()) == 0 {
+		return &ValidationError{Name: "node", err: errors.New(`ent: missing required edge "NodePermission.node"`)}
+	}
+	if len(npc.mutation.GrantedByIDs()) == 0 {
+		return &ValidationError{Name: "granted_by", err: errors.New(`ent: missing required edge "NodePermission.granted_by"`)}
+	}
+	return nil
+}
+
+func (npc *NodePermissionCreate) sqlSave(ctx context.Context) (*NodePermission, error) {
+	if err := npc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := npc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, npc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	npc.mutation.id = &_node.ID
+	npc.mutation.done = true
+	return _node, nil
+}
+
+func (npc *NodePermissionCreate) createSpec() (*NodePermission, *sqlgraph.CreateSpec) {
+	var (
+		_node = &NodePermission{config: npc.config}
+		_spec = sqlgraph.NewCreateSpec(nodepermission.Table, sqlgraph.NewFieldSpec(nodepermission.FieldID, field.TypeInt))
+	)
+	if value, ok := npc.mutation.Role(); ok {
+		_spec.SetField(nodepermission.FieldRole, field.TypeEnum, value)
+		_node.Role = value
+	}
+	if value, ok := npc.mutation.CreatedAt(); ok {
+		_spec.SetField(nodepermission.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := npc.mutation.UpdatedAt(); ok {
+		_spec.SetField(nodepermission.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := npc.mutation.NodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   nodepermission.NodeTable,
+			Columns: []string{nodepermission.NodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.node_permissions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := npc.mutation.GrantedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   nodepermission.GrantedByTable,
+			Columns: []string{nodepermission.GrantedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_granted_permissions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := npc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   nodepermission.UserTable,
+			Columns: []string{nodepermission.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_node_permissions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := npc.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   nodepermission.GroupTable,
+			Columns: []string{nodepermission.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.group_node_permissions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// NodePermissionCreateBulk is the builder for creating many NodePermission entities in bulk.
+type NodePermissionCreateBulk struct {
+	config
+	err      error
+	builders []*NodePermissionCreate
+}
+
+// Save creates the NodePermission entities in the database.
+func (npcb *NodePermissionCreateBulk) Save(ctx context.Context) ([]*NodePermission, error) {
+	if npcb.err != nil {
+		return nil, npcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(npcb.builders))
+	nodes := make([]*NodePermission, len(npcb.builders))
+	mutators := make([]Mutator, len(npcb.builders))
+	for i := range npcb.builders {
+		func(i int, root context.Context) {
+			builder := npcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NodePermissionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, npcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, npcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, npcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (npcb *NodePermissionCreateBulk) SaveX(ctx context.Context) []*NodePermission {
+	v, err := npcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (npcb *NodePermissionCreateBulk) Exec(ctx context.Context) error {
+	_, err := npcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (npcb *NodePermissionCreateBulk) ExecX(ctx context.Context) {
+	if err := npcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"gopan-server/ent/nodepermission"
+	"gopan-server/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// NodePermissionDelete is the builder for deleting a NodePermission entity.
+type NodePermissionDelete struct {
+	config
+	hooks    []Hook
+	mutation *NodePermissionMutation
+}
+
+// Where appends a list predicates to the NodePermissionDelete builder.
+func (npd *NodePermissionDelete) Where(ps ...predicate.NodePermission) *NodePermissionDelete {
+	npd.mutation.Where(ps...)
+	return npd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (npd *NodePermissionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, npd.sqlExec, npd.mutation, npd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (npd *NodePermissionDelete) ExecX(ctx context.Context) int {
+	n, err := npd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (npd *NodePermissionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(nodepermission.Table, sqlgraph.NewFieldSpec(nodepermission.FieldID, field.TypeInt))
+	if ps := npd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, npd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	npd.mutation.done = true
+	return affected, err
+}
+
+// NodePermissionDeleteOne is the builder for deleting a single NodePermission entity.
+type NodePermissionDeleteOne struct {
+	npd *NodePermissionDelete
+}
+
+// Where appends a list predicates to the NodePermissionDelete builder.
+func (npdo *NodePermissionDeleteOne) Where(ps ...predicate.NodePermission) *NodePermissionDeleteOne {
+	npdo.npd.mutation.Where(ps...)
+	return npdo
+}
+
+// Exec executes the deletion query.
+func (npdo *NodePermissionDeleteOne) Exec(ctx context.Context) error {
+	n, err := npdo.npd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{nodepermission.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (npdo *NodePermissionDeleteOne) ExecX(ctx context.Context) {
+	if err := npdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
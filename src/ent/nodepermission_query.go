@@ -0,0 +1,838 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"gopan-server/ent/group"
+	"gopan-server/ent/node"
+	"gopan-server/ent/nodepermission"
+	"gopan-server/ent/predicate"
+	"gopan-server/ent/user"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// NodePermissionQuery is the builder for querying NodePermission entities.
+type NodePermissionQuery struct {
+	config
+	ctx           *QueryContext
+	order         []nodepermission.OrderOption
+	inters        []Interceptor
+	predicates    []predicate.NodePermission
+	withNode      *NodeQuery
+	withGrantedBy *UserQuery
+	withUser      *UserQuery
+	withGroup     *GroupQuery
+	withFKs       bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the NodePermissionQuery builder.
+func (npq *NodePermissionQuery) Where(ps ...predicate.NodePermission) *NodePermissionQuery {
+	npq.predicates = append(npq.predicates, ps...)
+	return npq
+}
+
+// Limit the number of records to be returned by this query.
+func (npq *NodePermissionQuery) Limit(limit int) *NodePermissionQuery {
+	npq.ctx.Limit = &limit
+	return npq
+}
+
+// Offset to start from.
+func (npq *NodePermissionQuery) Offset(offset int) *NodePermissionQuery {
+	npq.ctx.Offset = &offset
+	return npq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (npq *NodePermissionQuery) Unique(unique bool) *NodePermissionQuery {
+	npq.ctx.Unique = &unique
+	return npq
+}
+
+// Order specifies how the records should be ordered.
+func (npq *NodePermissionQuery) Order(o ...nodepermission.OrderOption) *NodePermissionQuery {
+	npq.order = append(npq.order, o...)
+	return npq
+}
+
+// QueryNode chains the current query on the "node" edge.
+func (npq *NodePermissionQuery) QueryNode() *NodeQuery {
+	query := (&NodeClient{config: npq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := npq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := npq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(nodepermission.Table, nodepermission.FieldID, selector),
+			sqlgraph.To(node.Table, node.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, nodepermission.NodeTable, nodepermission.NodeColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(npq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryGrantedBy chains the current query on the "granted_by" edge.
+func (npq *NodePermissionQuery) QueryGrantedBy() *UserQuery {
+	query := (&UserClient{config: npq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := npq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := npq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(nodepermission.Table, nodepermission.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, nodepermission.GrantedByTable, nodepermission.GrantedByColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(npq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (npq *NodePermissionQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: npq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := npq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := npq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(nodepermission.Table, nodepermission.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, nodepermission.UserTable, nodepermission.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(npq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryGroup chains the current query on the "group" edge.
+func (npq *NodePermissionQuery) QueryGroup() *GroupQuery {
+	query := (&GroupClient{config: npq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := npq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := npq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(nodepermission.Table, nodepermission.FieldID, selector),
+			sqlgraph.To(group.Table, group.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, nodepermission.GroupTable, nodepermission.GroupColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(npq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first NodePermission entity from the query.
+// Returns a *NotFoundError when no NodePermission was found.
+func (npq *NodePermissionQuery) First(ctx context.Context) (*NodePermission, error) {
+	nodes, err := npq.Limit(1).All(setContextOp(ctx, npq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{nodepermission.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (npq *NodePermissionQuery) FirstX(ctx context.Context) *NodePermission {
+	node, err := npq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first NodePermission ID from the query.
+// Returns a *NotFoundError when no NodePermission ID was found.
+func (npq *NodePermissionQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = npq.Limit(1).IDs(setContextOp(ctx, npq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{nodepermission.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (npq *NodePermissionQuery) FirstIDX(ctx context.Context) int {
+	id, err := npq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single NodePermission entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one NodePermission entity is found.
+// Returns a *NotFoundError when no NodePermission entities are found.
+func (npq *NodePermissionQuery) Only(ctx context.Context) (*NodePermission, error) {
+	nodes, err := npq.Limit(2).All(setContextOp(ctx, npq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{nodepermission.Label}
+	default:
+		return nil, &NotSingularError{nodepermission.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (npq *NodePermissionQuery) OnlyX(ctx context.Context) *NodePermission {
+	node, err := npq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only NodePermission ID in the query.
+// Returns a *NotSingularError when more than one NodePermission ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (npq *NodePermissionQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = npq.Limit(2).IDs(setContextOp(ctx, npq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{nodepermission.Label}
+	default:
+		err = &NotSingularError{nodepermission.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (npq *NodePermissionQuery) OnlyIDX(ctx context.Context) int {
+	id, err := npq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of NodePermissions.
+func (npq *NodePermissionQuery) All(ctx context.Context) ([]*NodePermission, error) {
+	ctx = setContextOp(ctx, npq.ctx, ent.OpQueryAll)
+	if err := npq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*NodePermission, *NodePermissionQuery]()
+	return withInterceptors[[]*NodePermission](ctx, npq, qr, npq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (npq *NodePermissionQuery) AllX(ctx context.Context) []*NodePermission {
+	nodes, err := npq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of NodePermission IDs.
+func (npq *NodePermissionQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if npq.ctx.Unique == nil && npq.path != nil {
+		npq.Unique(true)
+	}
+	ctx = setContextOp(ctx, npq.ctx, ent.OpQueryIDs)
+	if err = npq.Select(nodepermission.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (npq *NodePermissionQuery) IDsX(ctx context.Context) []int {
+	ids, err := npq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (npq *NodePermissionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, npq.ctx, ent.OpQueryCount)
+	if err := npq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, npq, querierCount[*NodePermissionQuery](), npq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (npq *NodePermissionQuery) CountX(ctx context.Context) int {
+	count, err := npq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (npq *NodePermissionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, npq.ctx, ent.OpQueryExist)
+	switch _, err := npq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (npq *NodePermissionQuery) ExistX(ctx context.Context) bool {
+	exist, err := npq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the NodePermissionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (npq *NodePermissionQuery) Clone() *NodePermissionQuery {
+	if npq == nil {
+		return nil
+	}
+	return &NodePermissionQuery{
+		config:        npq.config,
+		ctx:           npq.ctx.Clone(),
+		order:         append([]nodepermission.OrderOption{}, npq.order...),
+		inters:        append([]Interceptor{}, npq.inters...),
+		predicates:    append([]predicate.NodePermission{}, npq.predicates...),
+		withNode:      npq.withNode.Clone(),
+		withGrantedBy: npq.withGrantedBy.Clone(),
+		withUser:      npq.withUser.Clone(),
+		withGroup:     npq.withGroup.Clone(),
+		// clone intermediate query.
+		sql:  npq.sql.Clone(),
+		path: npq.path,
+	}
+}
+
+// WithNode tells the query-builder to eager-load the nodes that are connected to
+// the "node" edge. The optional arguments are used to configure the query builder of the edge.
+func (npq *NodePermissionQuery) WithNode(opts ...func(*NodeQuery)) *NodePermissionQuery {
+	query := (&NodeClient{config: npq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	npq.withNode = query
+	return npq
+}
+
+// WithGrantedBy tells the query-builder to eager-load the nodes that are connected to
+// the "granted_by" edge. The optional arguments are used to configure the query builder of the edge.
+func (npq *NodePermissionQuery) WithGrantedBy(opts ...func(*UserQuery)) *NodePermissionQuery {
+	query := (&UserClient{config: npq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	npq.withGrantedBy = query
+	return npq
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (npq *NodePermissionQuery) WithUser(opts ...func(*UserQuery)) *NodePermissionQuery {
+	query := (&UserClient{config: npq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	npq.withUser = query
+	return npq
+}
+
+// WithGroup tells the query-builder to eager-load the nodes that are connected to
+// the "group" edge. The optional arguments are used to configure the query builder of the edge.
+func (npq *NodePermissionQuery) WithGroup(opts ...func(*GroupQuery)) *NodePermissionQuery {
+	query := (&GroupClient{config: npq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	npq.withGroup = query
+	return npq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Role nodepermission.Role `json:"role,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.NodePermission.Query().
+//		GroupBy(nodepermission.FieldRole).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (npq *NodePermissionQuery) GroupBy(field string, fields ...string) *NodePermissionGroupBy {
+	npq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &NodePermissionGroupBy{build: npq}
+	grbuild.flds = &npq.ctx.Fields
+	grbuild.label = nodepermission.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Role nodepermission.Role `json:"role,omitempty"`
+//	}
+//
+//	client.NodePermission.Query().
+//		Select(nodepermission.FieldRole).
+//		Scan(ctx, &v)
+func (npq *NodePermissionQuery) Select(fields ...string) *NodePermissionSelect {
+	npq.ctx.Fields = append(npq.ctx.Fields, fields...)
+	sbuild := &NodePermissionSelect{NodePermissionQuery: npq}
+	sbuild.label = nodepermission.Label
+	sbuild.flds, sbuild.scan = &npq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a NodePermissionSelect configured with the given aggregations.
+func (npq *NodePermissionQuery) Aggregate(fns ...AggregateFunc) *NodePermissionSelect {
+	return npq.Select().Aggregate(fns...)
+}
+
+func (npq *NodePermissionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range npq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, npq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range npq.ctx.Fields {
+		if !nodepermission.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if npq.path != nil {
+		prev, err := npq.path(ctx)
+		if err != nil {
+			return err
+		}
+		npq.sql = prev
+	}
+	return nil
+}
+
+func (npq *NodePermissionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*NodePermission, error) {
+	var (
+		nodes       = []*NodePermission{}
+		withFKs     = npq.withFKs
+		_spec       = npq.querySpec()
+		loadedTypes = [4]bool{
+			npq.withNode != nil,
+			npq.withGrantedBy != nil,
+			npq.withUser != nil,
+			npq.withGroup != nil,
+		}
+	)
+	if npq.withNode != nil || npq.withGrantedBy != nil || npq.withUser != nil || npq.withGroup != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, nodepermission.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*NodePermission).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &NodePermission{config: npq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, npq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := npq.withNode; query != nil {
+		if err := npq.loadNode(ctx, query, nodes, nil,
+			func(n *NodePermission, e *Node) { n.Edges.Node = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := npq.withGrantedBy; query != nil {
+		if err := npq.loadGrantedBy(ctx, query, nodes, nil,
+			func(n *NodePermission, e *User) { n.Edges.GrantedBy = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := npq.withUser; query != nil {
+		if err := npq.loadUser(ctx, query, nodes, nil,
+			func(n *NodePermission, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := npq.withGroup; query != nil {
+		if err := npq.loadGroup(ctx, query, nodes, nil,
+			func(n *NodePermission, e *Group) { n.Edges.Group = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (npq *NodePermissionQuery) loadNode(ctx context.Context, query *NodeQuery, nodes []*NodePermission, init func(*NodePermission), assign func(*NodePermission, *Node)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*NodePermission)
+	for i := range nodes {
+		if nodes[i].node_permissions == nil {
+			continue
+		}
+		fk := *nodes[i].node_permissions
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(node.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "node_permissions" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (npq *NodePermissionQuery) loadGrantedBy(ctx context.Context, query *UserQuery, nodes []*NodePermission, init func(*NodePermission), assign func(*NodePermission, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*NodePermission)
+	for i := range nodes {
+		if nodes[i].user_granted_permissions == nil {
+			continue
+		}
+		fk := *nodes[i].user_granted_permissions
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_granted_permissions" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (npq *NodePermissionQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*NodePermission, init func(*NodePermission), assign func(*NodePermission, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*NodePermission)
+	for i := range nodes {
+		if nodes[i].user_node_permissions == nil {
+			continue
+		}
+		fk := *nodes[i].user_node_permissions
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_node_permissions" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (npq *NodePermissionQuery) loadGroup(ctx context.Context, query *GroupQuery, nodes []*NodePermission, init func(*NodePermission), assign func(*NodePermission, *Group)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*NodePermission)
+	for i := range nodes {
+		if nodes[i].group_node_permissions == nil {
+			continue
+		}
+		fk := *nodes[i].group_node_permissions
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(group.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "group_node_permissions" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (npq *NodePermissionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := npq.querySpec()
+	_spec.Node.Columns = npq.ctx.Fields
+	if len(npq.ctx.Fields) > 0 {
+		_spec.Unique = npq.ctx.Unique != nil && *npq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, npq.driver, _spec)
+}
+
+func (npq *NodePermissionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(nodepermission.Table, nodepermission.Columns, sqlgraph.NewFieldSpec(nodepermission.FieldID, field.TypeInt))
+	_spec.From = npq.sql
+	if unique := npq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if npq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := npq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, nodepermission.FieldID)
+		for i := range fields {
+			if fields[i] != nodepermission.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := npq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := npq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := npq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := npq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (npq *NodePermissionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(npq.driver.Dialect())
+	t1 := builder.Table(nodepermission.Table)
+	columns := npq.ctx.Fields
+	if len(columns) == 0 {
+		columns = nodepermission.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if npq.sql != nil {
+		selector = npq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if npq.ctx.Unique != nil && *npq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range npq.predicates {
+		p(selector)
+	}
+	for _, p := range npq.order {
+		p(selector)
+	}
+	if offset := npq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := npq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// NodePermissionGroupBy is the group-by builder for NodePermission entities.
+type NodePermissionGroupBy struct {
+	selector
+	build *NodePermissionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (npgb *NodePermissionGroupBy) Aggregate(fns ...AggregateFunc) *NodePermissionGroupBy {
+	npgb.fns = append(npgb.fns, fns...)
+	return npgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (npgb *NodePermissionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, npgb.build.ctx, ent.OpQueryGroupBy)
+	if err := npgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*NodePermissionQuery, *NodePermissionGroupBy](ctx, npgb.build, npgb, npgb.build.inters, v)
+}
+
+func (npgb *NodePermissionGroupBy) sqlScan(ctx context.Context, root *NodePermissionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(npgb.fns))
+	for _, fn := range npgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*npgb.flds)+len(npgb.fns))
+		for _, f := range *npgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*npgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := npgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// NodePermissionSelect is the builder for selecting fields of NodePermission entities.
+type NodePermissionSelect struct {
+	*NodePermissionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (nps *NodePermissionSelect) Aggregate(fns ...AggregateFunc) *NodePermissionSelect {
+	nps.fns = append(nps.fns, fns...)
+	return nps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (nps *NodePermissionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, nps.ctx, ent.OpQuerySelect)
+	if err := nps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*NodePermissionQuery, *NodePermissionSelect](ctx, nps.NodePermissionQuery, nps, nps.inters, v)
+}
+
+func (nps *NodePermissionSelect) sqlScan(ctx context.Context, root *NodePermissionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(nps.fns))
+	for _, fn := range nps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*nps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := nps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,721 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gopan-server/ent/group"
+	"gopan-server/ent/node"
+	"gopan-server/ent/nodepermission"
+	"gopan-server/ent/predicate"
+	"gopan-server/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// NodePermissionUpdate is the builder for updating NodePermission entities.
+type NodePermissionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NodePermissionMutation
+}
+
+// Where appends a list predicates to the NodePermissionUpdate builder.
+func (npu *NodePermissionUpdate) Where(ps ...predicate.NodePermission) *NodePermissionUpdate {
+	npu.mutation.Where(ps...)
+	return npu
+}
+
+// SetRole sets the "role" field.
+func (npu *NodePermissionUpdate) SetRole(n nodepermission.Role) *NodePermissionUpdate {
+	npu.mutation.SetRole(n)
+	return npu
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (npu *NodePermissionUpdate) SetNillableRole(n *nodepermission.Role) *NodePermissionUpdate {
+	if n != nil {
+		npu.SetRole(*n)
+	}
+	return npu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (npu *NodePermissionUpdate) SetCreatedAt(t time.Time) *NodePermissionUpdate {
+	npu.mutation.SetCreatedAt(t)
+	return npu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (npu *NodePermissionUpdate) SetNillableCreatedAt(t *time.Time) *NodePermissionUpdate {
+	if t != nil {
+		npu.SetCreatedAt(*t)
+	}
+	return npu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (npu *NodePermissionUpdate) SetUpdatedAt(t time.Time) *NodePermissionUpdate {
+	npu.mutation.SetUpdatedAt(t)
+	return npu
+}
+
+// SetNodeID sets the "node" edge to the Node entity by ID.
+func (npu *NodePermissionUpdate) SetNodeID(id int) *NodePermissionUpdate {
+	npu.mutation.SetNodeID(id)
+	return npu
+}
+
+// SetNode sets the "node" edge to the Node entity.
+func (npu *NodePermissionUpdate) SetNode(n *Node) *NodePermissionUpdate {
+	return npu.SetNodeID(n.ID)
+}
+
+// SetGrantedByID sets the "granted_by" edge to the User entity by ID.
+func (npu *NodePermissionUpdate) SetGrantedByID(id int) *NodePermissionUpdate {
+	npu.mutation.SetGrantedByID(id)
+	return npu
+}
+
+// SetGrantedBy sets the "granted_by" edge to the User entity.
+func (npu *NodePermissionUpdate) SetGrantedBy(u *User) *NodePermissionUpdate {
+	return npu.SetGrantedByID(u.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (npu *NodePermissionUpdate) SetUserID(id int) *NodePermissionUpdate {
+	npu.mutation.SetUserID(id)
+	return npu
+}
+
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (npu *NodePermissionUpdate) SetNillableUserID(id *int) *NodePermissionUpdate {
+	if id != nil {
+		npu = npu.SetUserID(*id)
+	}
+	return npu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (npu *NodePermissionUpdate) SetUser(u *User) *NodePermissionUpdate {
+	return npu.SetUserID(u.ID)
+}
+
+// SetGroupID sets the "group" edge to the Group entity by ID.
+func (npu *NodePermissionUpdate) SetGroupID(id int) *NodePermissionUpdate {
+	npu.mutation.SetGroupID(id)
+	return npu
+}
+
+// SetNillableGroupID sets the "group" edge to the Group entity by ID if the given value is not nil.
+func (npu *NodePermissionUpdate) SetNillableGroupID(id *int) *NodePermissionUpdate {
+	if id != nil {
+		npu = npu.SetGroupID(*id)
+	}
+	return npu
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (npu *NodePermissionUpdate) SetGroup(g *Group) *NodePermissionUpdate {
+	return npu.SetGroupID(g.ID)
+}
+
+// Mutation returns the NodePermissionMutation object of the builder.
+func (npu *NodePermissionUpdate) Mutation() *NodePermissionMutation {
+	return npu.mutation
+}
+
+// ClearNode clears the "node" edge to the Node entity.
+func (npu *NodePermissionUpdate) ClearNode() *NodePermissionUpdate {
+	npu.mutation.ClearNode()
+	return npu
+}
+
+// ClearGrantedBy clears the "granted_by" edge to the User entity.
+func (npu *NodePermissionUpdate) ClearGrantedBy() *NodePermissionUpdate {
+	npu.mutation.ClearGrantedBy()
+	return npu
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (npu *NodePermissionUpdate) ClearUser() *NodePermissionUpdate {
+	npu.mutation.ClearUser()
+	return npu
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (npu *NodePermissionUpdate) ClearGroup() *NodePermissionUpdate {
+	npu.mutation.ClearGroup()
+	return npu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (npu *NodePermissionUpdate) Save(ctx context.Context) (int, error) {
+	npu.defaults()
+	return withHooks(ctx, npu.sqlSave, npu.mutation, npu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (npu *NodePermissionUpdate) SaveX(ctx context.Context) int {
+	affected, err := npu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (npu *NodePermissionUpdate) Exec(ctx context.Context) error {
+	_, err := npu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (npu *NodePermissionUpdate) ExecX(ctx context.Context) {
+	if err := npu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (npu *NodePermissionUpdate) defaults() {
+	if _, ok := npu.mutation.UpdatedAt(); !ok {
+		v := nodepermission.UpdateDefaultUpdatedAt()
+		npu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (npu *NodePermissionUpdate) check() error {
+	if v, ok := npu.mutation.Role(); ok {
+		if err := nodepermission.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "NodePermission.role": %w`, err)}
+		}
+	}
+	if npu.mutation.NodeCleared() && len(npu.mutation.NodeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "NodePermission.node"`)
+	}
+	if npu.mutation.GrantedByCleared() && len(npu.mutation.GrantedByIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "NodePermission.granted_by"`)
+	}
+	return nil
+}
+
+func (npu *NodePermissionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := npu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(nodepermission.Table, nodepermission.Columns, sqlgraph.NewFieldSpec(nodepermission.FieldID, field.TypeInt))
+	if ps := npu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := npu.mutation.Role(); ok {
+		_spec.SetField(nodepermission.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := npu.mutation.CreatedAt(); ok {
+		_spec.SetField(nodepermission.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := npu.mutation.UpdatedAt(); ok {
+		_spec.SetField(nodepermission.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if npu.mutation.NodeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   nodepermission.NodeTable,
+			Columns: []string{nodepermission.NodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := npu.mutation.NodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   nodepermission.NodeTable,
+			Columns: []string{nodepermission.NodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if npu.mutation.GrantedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   nodepermission.GrantedByTable,
+			Columns: []string{nodepermission.GrantedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := npu.mutation.GrantedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   nodepermission.GrantedByTable,
+			Columns: []string{nodepermission.GrantedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if npu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   nodepermission.UserTable,
+			Columns: []string{nodepermission.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := npu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   nodepermission.UserTable,
+			Columns: []string{nodepermission.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if npu.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   nodepermission.GroupTable,
+			Columns: []string{nodepermission.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := npu.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   nodepermission.GroupTable,
+			Columns: []string{nodepermission.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, npu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{nodepermission.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	npu.mutation.done = true
+	return n, nil
+}
+
+// NodePermissionUpdateOne is the builder for updating a single NodePermission entity.
+type NodePermissionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NodePermissionMutation
+}
+
+// SetRole sets the "role" field.
+func (npuo *NodePermissionUpdateOne) SetRole(n nodepermission.Role) *NodePermissionUpdateOne {
+	npuo.mutation.SetRole(n)
+	return npuo
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (npuo *NodePermissionUpdateOne) SetNillableRole(n *nodepermission.Role) *NodePermissionUpdateOne {
+	if n != nil {
+		npuo.SetRole(*n)
+	}
+	return npuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (npuo *NodePermissionUpdateOne) SetCreatedAt(t time.Time) *NodePermissionUpdateOne {
+	npuo.mutation.SetCreatedAt(t)
+	return npuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (npuo *NodePermissionUpdateOne) SetNillableCreatedAt(t *time.Time) *NodePermissionUpdateOne {
+	if t != nil {
+		npuo.SetCreatedAt(*t)
+	}
+	return npuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (npuo *NodePermissionUpdateOne) SetUpdatedAt(t time.Time) *NodePermissionUpdateOne {
+	npuo.mutation.SetUpdatedAt(t)
+	return npuo
+}
+
+// SetNodeID sets the "node" edge to the Node entity by ID.
+func (npuo *NodePermissionUpdateOne) SetNodeID(id int) *NodePermissionUpdateOne {
+	npuo.mutation.SetNodeID(id)
+	return npuo
+}
+
+// SetNode sets the "node" edge to the Node entity.
+func (npuo *NodePermissionUpdateOne) SetNode(n *Node) *NodePermissionUpdateOne {
+	return npuo.SetNodeID(n.ID)
+}
+
+// SetGrantedByID sets the "granted_by" edge to the User entity by ID.
+func (npuo *NodePermissionUpdateOne) SetGrantedByID(id int) *NodePermissionUpdateOne {
+	npuo.mutation.SetGrantedByID(id)
+	return npuo
+}
+
+// SetGrantedBy sets the "granted_by" edge to the User entity.
+func (npuo *NodePermissionUpdateOne) SetGrantedBy(u *User) *NodePermissionUpdateOne {
+	return npuo.SetGrantedByID(u.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (npuo *NodePermissionUpdateOne) SetUserID(id int) *NodePermissionUpdateOne {
+	npuo.mutation.SetUserID(id)
+	return npuo
+}
+
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (npuo *NodePermissionUpdateOne) SetNillableUserID(id *int) *NodePermissionUpdateOne {
+	if id != nil {
+		npuo = npuo.SetUserID(*id)
+	}
+	return npuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (npuo *NodePermissionUpdateOne) SetUser(u *User) *NodePermissionUpdateOne {
+	return npuo.SetUserID(u.ID)
+}
+
+// SetGroupID sets the "group" edge to the Group entity by ID.
+func (npuo *NodePermissionUpdateOne) SetGroupID(id int) *NodePermissionUpdateOne {
+	npuo.mutation.SetGroupID(id)
+	return npuo
+}
+
+// SetNillableGroupID sets the "group" edge to the Group entity by ID if the given value is not nil.
+func (npuo *NodePermissionUpdateOne) SetNillableGroupID(id *int) *NodePermissionUpdateOne {
+	if id != nil {
+		npuo = npuo.SetGroupID(*id)
+	}
+	return npuo
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (npuo *NodePermissionUpdateOne) SetGroup(g *Group) *NodePermissionUpdateOne {
+	return npuo.SetGroupID(g.ID)
+}
+
+// Mutation returns the NodePermissionMutation object of the builder.
+func (npuo *NodePermissionUpdateOne) Mutation() *NodePermissionMutation {
+	return npuo.mutation
+}
+
+// ClearNode clears the "node" edge to the Node entity.
+func (npuo *NodePermissionUpdateOne) ClearNode() *NodePermissionUpdateOne {
+	npuo.mutation.ClearNode()
+	return npuo
+}
+
+// ClearGrantedBy clears the "granted_by" edge to the User entity.
+func (npuo *NodePermissionUpdateOne) ClearGrantedBy() *NodePermissionUpdateOne {
+	npuo.mutation.ClearGrantedBy()
+	return npuo
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (npuo *NodePermissionUpdateOne) ClearUser() *NodePermissionUpdateOne {
+	npuo.mutation.ClearUser()
+	return npuo
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (npuo *NodePermissionUpdateOne) ClearGroup() *NodePermissionUpdateOne {
+	npuo.mutation.ClearGroup()
+	return npuo
+}
+
+// Where appends a list predicates to the NodePermissionUpdate builder.
+func (npuo *NodePermissionUpdateOne) Where(ps ...predicate.NodePermission) *NodePermissionUpdateOne {
+	npuo.mutation.Where(ps...)
+	return npuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (npuo *NodePermissionUpdateOne) Select(field string, fields ...string) *NodePermissionUpdateOne {
+	npuo.fields = append([]string{field}, fields...)
+	return npuo
+}
+
+// Save executes the query and returns the updated NodePermission entity.
+func (npuo *NodePermissionUpdateOne) Save(ctx context.Context) (*NodePermission, error) {
+	npuo.defaults()
+	return withHooks(ctx, npuo.sqlSave, npuo.mutation, npuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (npuo *NodePermissionUpdateOne) SaveX(ctx context.Context) *NodePermission {
+	node, err := npuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (npuo *NodePermissionUpdateOne) Exec(ctx context.Context) error {
+	_, err := npuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (npuo *NodePermissionUpdateOne) ExecX(ctx context.Context) {
+	if err := npuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (npuo *NodePermissionUpdateOne) defaults() {
+	if _, ok := npuo.mutation.UpdatedAt(); !ok {
+		v := nodepermission.UpdateDefaultUpdatedAt()
+		npuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (npuo *NodePermissionUpdateOne) check() error {
+	if v, ok := npuo.mutation.Role(); ok {
+		if err := nodepermission.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "NodePermission.role": %w`, err)}
+		}
+	}
+	if npuo.mutation.NodeCleared() && len(npuo.mutation.NodeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "NodePermission.node"`)
+	}
+	if npuo.mutation.GrantedByCleared() && len(npuo.mutation.GrantedByIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "NodePermission.granted_by"`)
+	}
+	return nil
+}
+
+func (npuo *NodePermissionUpdateOne) sqlSave(ctx context.Context) (_node *NodePermission, err error) {
+	if err := npuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(nodepermission.Table, nodepermission.Columns, sqlgraph.NewFieldSpec(nodepermission.FieldID, field.TypeInt))
+	id, ok := npuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "NodePermission.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := npuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, nodepermission.FieldID)
+		for _, f := range fields {
+			if !nodepermission.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != nodepermission.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := npuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := npuo.mutation.Role(); ok {
+		_spec.SetField(nodepermission.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := npuo.mutation.CreatedAt(); ok {
+		_spec.SetField(nodepermission.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := npuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(nodepermission.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if npuo.mutation.NodeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   nodepermission.NodeTable,
+			Columns: []string{nodepermission.NodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := npuo.mutation.NodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   nodepermission.NodeTable,
+			Columns: []string{nodepermission.NodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if npuo.mutation.GrantedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   nodepermission.GrantedByTable,
+			Columns: []string{nodepermission.GrantedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := npuo.mutation.GrantedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   nodepermission.GrantedByTable,
+			Columns: []string{nodepermission.GrantedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if npuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   nodepermission.UserTable,
+			Columns: []string{nodepermission.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := npuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   nodepermission.UserTable,
+			Columns: []string{nodepermission.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if npuo.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   nodepermission.GroupTable,
+			Columns: []string{nodepermission.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := npuo.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   nodepermission.GroupTable,
+			Columns: []string{nodepermission.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &NodePermission{config: npuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, npuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{nodepermission.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	npuo.mutation.done = true
+	return _node, nil
+}
@@ -6,14 +6,56 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AccessToken is the predicate function for accesstoken builders.
+type AccessToken func(*sql.Selector)
+
+// AuthFailure is the predicate function for authfailure builders.
+type AuthFailure func(*sql.Selector)
+
+// AuthThrottle is the predicate function for auththrottle builders.
+type AuthThrottle func(*sql.Selector)
+
+// Change is the predicate function for change builders.
+type Change func(*sql.Selector)
+
 // FileHash is the predicate function for filehash builders.
 type FileHash func(*sql.Selector)
 
+// Group is the predicate function for group builders.
+type Group func(*sql.Selector)
+
+// Invite is the predicate function for invite builders.
+type Invite func(*sql.Selector)
+
 // Node is the predicate function for node builders.
 type Node func(*sql.Selector)
 
+// NodePermission is the predicate function for nodepermission builders.
+type NodePermission func(*sql.Selector)
+
+// S3Key is the predicate function for s3key builders.
+type S3Key func(*sql.Selector)
+
+// S3Upload is the predicate function for s3upload builders.
+type S3Upload func(*sql.Selector)
+
+// SSHKey is the predicate function for sshkey builders.
+type SSHKey func(*sql.Selector)
+
+// Session is the predicate function for session builders.
+type Session func(*sql.Selector)
+
 // Share is the predicate function for share builders.
 type Share func(*sql.Selector)
 
+// ShareAccess is the predicate function for shareaccess builders.
+type ShareAccess func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
+
+// UserIdentity is the predicate function for useridentity builders.
+type UserIdentity func(*sql.Selector)
+
+// UserToken is the predicate function for usertoken builders.
+type UserToken func(*sql.Selector)
@@ -3,11 +3,25 @@
 package ent
 
 import (
+	"gopan-server/ent/accesstoken"
+	"gopan-server/ent/authfailure"
+	"gopan-server/ent/auththrottle"
+	"gopan-server/ent/change"
 	"gopan-server/ent/filehash"
+	"gopan-server/ent/group"
+	"gopan-server/ent/invite"
 	"gopan-server/ent/node"
+	"gopan-server/ent/nodepermission"
+	"gopan-server/ent/s3key"
+	"gopan-server/ent/s3upload"
 	"gopan-server/ent/schema"
+	"gopan-server/ent/session"
 	"gopan-server/ent/share"
+	"gopan-server/ent/shareaccess"
+	"gopan-server/ent/sshkey"
 	"gopan-server/ent/user"
+	"gopan-server/ent/useridentity"
+	"gopan-server/ent/usertoken"
 	"time"
 )
 
@@ -15,6 +29,72 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	accesstokenFields := schema.AccessToken{}.Fields()
+	_ = accesstokenFields
+	// accesstokenDescName is the schema descriptor for name field.
+	accesstokenDescName := accesstokenFields[1].Descriptor()
+	// accesstoken.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	accesstoken.NameValidator = func() func(string) error {
+		validators := accesstokenDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// accesstokenDescTokenHash is the schema descriptor for token_hash field.
+	accesstokenDescTokenHash := accesstokenFields[2].Descriptor()
+	// accesstoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	accesstoken.TokenHashValidator = accesstokenDescTokenHash.Validators[0].(func(string) error)
+	// accesstokenDescCreatedAt is the schema descriptor for created_at field.
+	accesstokenDescCreatedAt := accesstokenFields[9].Descriptor()
+	// accesstoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	accesstoken.DefaultCreatedAt = accesstokenDescCreatedAt.Default.(func() time.Time)
+	authfailureFields := schema.AuthFailure{}.Fields()
+	_ = authfailureFields
+	// authfailureDescUserAgent is the schema descriptor for user_agent field.
+	authfailureDescUserAgent := authfailureFields[5].Descriptor()
+	// authfailure.UserAgentValidator is a validator for the "user_agent" field. It is called by the builders before save.
+	authfailure.UserAgentValidator = authfailureDescUserAgent.Validators[0].(func(string) error)
+	// authfailureDescCreatedAt is the schema descriptor for created_at field.
+	authfailureDescCreatedAt := authfailureFields[6].Descriptor()
+	// authfailure.DefaultCreatedAt holds the default value on creation for the created_at field.
+	authfailure.DefaultCreatedAt = authfailureDescCreatedAt.Default.(func() time.Time)
+	auththrottleFields := schema.AuthThrottle{}.Fields()
+	_ = auththrottleFields
+	// auththrottleDescKey is the schema descriptor for key field.
+	auththrottleDescKey := auththrottleFields[0].Descriptor()
+	// auththrottle.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	auththrottle.KeyValidator = auththrottleDescKey.Validators[0].(func(string) error)
+	// auththrottleDescFailures is the schema descriptor for failures field.
+	auththrottleDescFailures := auththrottleFields[1].Descriptor()
+	// auththrottle.DefaultFailures holds the default value on creation for the failures field.
+	auththrottle.DefaultFailures = auththrottleDescFailures.Default.(int)
+	// auththrottleDescLastFailureAt is the schema descriptor for last_failure_at field.
+	auththrottleDescLastFailureAt := auththrottleFields[2].Descriptor()
+	// auththrottle.DefaultLastFailureAt holds the default value on creation for the last_failure_at field.
+	auththrottle.DefaultLastFailureAt = auththrottleDescLastFailureAt.Default.(func() time.Time)
+	// auththrottleDescLocked is the schema descriptor for locked field.
+	auththrottleDescLocked := auththrottleFields[4].Descriptor()
+	// auththrottle.DefaultLocked holds the default value on creation for the locked field.
+	auththrottle.DefaultLocked = auththrottleDescLocked.Default.(bool)
+	changeFields := schema.Change{}.Fields()
+	_ = changeFields
+	// changeDescSize is the schema descriptor for size field.
+	changeDescSize := changeFields[7].Descriptor()
+	// change.DefaultSize holds the default value on creation for the size field.
+	change.DefaultSize = changeDescSize.Default.(int64)
+	// changeDescCreatedAt is the schema descriptor for created_at field.
+	changeDescCreatedAt := changeFields[9].Descriptor()
+	// change.DefaultCreatedAt holds the default value on creation for the created_at field.
+	change.DefaultCreatedAt = changeDescCreatedAt.Default.(func() time.Time)
 	filehashFields := schema.FileHash{}.Fields()
 	_ = filehashFields
 	// filehashDescHash is the schema descriptor for hash field.
@@ -39,6 +119,42 @@ func init() {
 	filehash.DefaultUpdatedAt = filehashDescUpdatedAt.Default.(func() time.Time)
 	// filehash.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	filehash.UpdateDefaultUpdatedAt = filehashDescUpdatedAt.UpdateDefault.(func() time.Time)
+	groupFields := schema.Group{}.Fields()
+	_ = groupFields
+	// groupDescName is the schema descriptor for name field.
+	groupDescName := groupFields[0].Descriptor()
+	// group.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	group.NameValidator = groupDescName.Validators[0].(func(string) error)
+	// groupDescCreatedAt is the schema descriptor for created_at field.
+	groupDescCreatedAt := groupFields[2].Descriptor()
+	// group.DefaultCreatedAt holds the default value on creation for the created_at field.
+	group.DefaultCreatedAt = groupDescCreatedAt.Default.(func() time.Time)
+	// groupDescUpdatedAt is the schema descriptor for updated_at field.
+	groupDescUpdatedAt := groupFields[3].Descriptor()
+	// group.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	group.DefaultUpdatedAt = groupDescUpdatedAt.Default.(func() time.Time)
+	// group.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	group.UpdateDefaultUpdatedAt = groupDescUpdatedAt.UpdateDefault.(func() time.Time)
+	inviteFields := schema.Invite{}.Fields()
+	_ = inviteFields
+	// inviteDescCode is the schema descriptor for code field.
+	inviteDescCode := inviteFields[0].Descriptor()
+	// invite.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	invite.CodeValidator = inviteDescCode.Validators[0].(func(string) error)
+	// inviteDescMaxUses is the schema descriptor for max_uses field.
+	inviteDescMaxUses := inviteFields[4].Descriptor()
+	// invite.DefaultMaxUses holds the default value on creation for the max_uses field.
+	invite.DefaultMaxUses = inviteDescMaxUses.Default.(int)
+	// invite.MaxUsesValidator is a validator for the "max_uses" field. It is called by the builders before save.
+	invite.MaxUsesValidator = inviteDescMaxUses.Validators[0].(func(int) error)
+	// inviteDescUses is the schema descriptor for uses field.
+	inviteDescUses := inviteFields[5].Descriptor()
+	// invite.DefaultUses holds the default value on creation for the uses field.
+	invite.DefaultUses = inviteDescUses.Default.(int)
+	// inviteDescCreatedAt is the schema descriptor for created_at field.
+	inviteDescCreatedAt := inviteFields[8].Descriptor()
+	// invite.DefaultCreatedAt holds the default value on creation for the created_at field.
+	invite.DefaultCreatedAt = inviteDescCreatedAt.Default.(func() time.Time)
 	nodeFields := schema.Node{}.Fields()
 	_ = nodeFields
 	// nodeDescName is the schema descriptor for name field.
@@ -67,6 +183,122 @@ func init() {
 	node.DefaultUpdatedAt = nodeDescUpdatedAt.Default.(func() time.Time)
 	// node.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	node.UpdateDefaultUpdatedAt = nodeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	nodepermissionFields := schema.NodePermission{}.Fields()
+	_ = nodepermissionFields
+	// nodepermissionDescCreatedAt is the schema descriptor for created_at field.
+	nodepermissionDescCreatedAt := nodepermissionFields[1].Descriptor()
+	// nodepermission.DefaultCreatedAt holds the default value on creation for the created_at field.
+	nodepermission.DefaultCreatedAt = nodepermissionDescCreatedAt.Default.(func() time.Time)
+	// nodepermissionDescUpdatedAt is the schema descriptor for updated_at field.
+	nodepermissionDescUpdatedAt := nodepermissionFields[2].Descriptor()
+	// nodepermission.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	nodepermission.DefaultUpdatedAt = nodepermissionDescUpdatedAt.Default.(func() time.Time)
+	// nodepermission.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	nodepermission.UpdateDefaultUpdatedAt = nodepermissionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	s3keyFields := schema.S3Key{}.Fields()
+	_ = s3keyFields
+	// s3keyDescName is the schema descriptor for name field.
+	s3keyDescName := s3keyFields[1].Descriptor()
+	// s3key.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	s3key.NameValidator = func() func(string) error {
+		validators := s3keyDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// s3keyDescAccessKey is the schema descriptor for access_key field.
+	s3keyDescAccessKey := s3keyFields[2].Descriptor()
+	// s3key.AccessKeyValidator is a validator for the "access_key" field. It is called by the builders before save.
+	s3key.AccessKeyValidator = s3keyDescAccessKey.Validators[0].(func(string) error)
+	// s3keyDescSecretKey is the schema descriptor for secret_key field.
+	s3keyDescSecretKey := s3keyFields[3].Descriptor()
+	// s3key.SecretKeyValidator is a validator for the "secret_key" field. It is called by the builders before save.
+	s3key.SecretKeyValidator = s3keyDescSecretKey.Validators[0].(func(string) error)
+	// s3keyDescReadOnly is the schema descriptor for read_only field.
+	s3keyDescReadOnly := s3keyFields[4].Descriptor()
+	// s3key.DefaultReadOnly holds the default value on creation for the read_only field.
+	s3key.DefaultReadOnly = s3keyDescReadOnly.Default.(bool)
+	// s3keyDescCreatedAt is the schema descriptor for created_at field.
+	s3keyDescCreatedAt := s3keyFields[7].Descriptor()
+	// s3key.DefaultCreatedAt holds the default value on creation for the created_at field.
+	s3key.DefaultCreatedAt = s3keyDescCreatedAt.Default.(func() time.Time)
+	s3uploadFields := schema.S3Upload{}.Fields()
+	_ = s3uploadFields
+	// s3uploadDescUploadID is the schema descriptor for upload_id field.
+	s3uploadDescUploadID := s3uploadFields[0].Descriptor()
+	// s3upload.UploadIDValidator is a validator for the "upload_id" field. It is called by the builders before save.
+	s3upload.UploadIDValidator = s3uploadDescUploadID.Validators[0].(func(string) error)
+	// s3uploadDescBucket is the schema descriptor for bucket field.
+	s3uploadDescBucket := s3uploadFields[2].Descriptor()
+	// s3upload.BucketValidator is a validator for the "bucket" field. It is called by the builders before save.
+	s3upload.BucketValidator = s3uploadDescBucket.Validators[0].(func(string) error)
+	// s3uploadDescKey is the schema descriptor for key field.
+	s3uploadDescKey := s3uploadFields[3].Descriptor()
+	// s3upload.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	s3upload.KeyValidator = s3uploadDescKey.Validators[0].(func(string) error)
+	// s3uploadDescCreatedAt is the schema descriptor for created_at field.
+	s3uploadDescCreatedAt := s3uploadFields[4].Descriptor()
+	// s3upload.DefaultCreatedAt holds the default value on creation for the created_at field.
+	s3upload.DefaultCreatedAt = s3uploadDescCreatedAt.Default.(func() time.Time)
+	sshkeyFields := schema.SSHKey{}.Fields()
+	_ = sshkeyFields
+	// sshkeyDescName is the schema descriptor for name field.
+	sshkeyDescName := sshkeyFields[1].Descriptor()
+	// sshkey.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	sshkey.NameValidator = func() func(string) error {
+		validators := sshkeyDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// sshkeyDescPublicKey is the schema descriptor for public_key field.
+	sshkeyDescPublicKey := sshkeyFields[2].Descriptor()
+	// sshkey.PublicKeyValidator is a validator for the "public_key" field. It is called by the builders before save.
+	sshkey.PublicKeyValidator = sshkeyDescPublicKey.Validators[0].(func(string) error)
+	// sshkeyDescFingerprint is the schema descriptor for fingerprint field.
+	sshkeyDescFingerprint := sshkeyFields[3].Descriptor()
+	// sshkey.FingerprintValidator is a validator for the "fingerprint" field. It is called by the builders before save.
+	sshkey.FingerprintValidator = sshkeyDescFingerprint.Validators[0].(func(string) error)
+	// sshkeyDescCreatedAt is the schema descriptor for created_at field.
+	sshkeyDescCreatedAt := sshkeyFields[6].Descriptor()
+	// sshkey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	sshkey.DefaultCreatedAt = sshkeyDescCreatedAt.Default.(func() time.Time)
+	sessionFields := schema.Session{}.Fields()
+	_ = sessionFields
+	// sessionDescRefreshTokenHash is the schema descriptor for refresh_token_hash field.
+	sessionDescRefreshTokenHash := sessionFields[1].Descriptor()
+	// session.RefreshTokenHashValidator is a validator for the "refresh_token_hash" field. It is called by the builders before save.
+	session.RefreshTokenHashValidator = sessionDescRefreshTokenHash.Validators[0].(func(string) error)
+	// sessionDescUserAgent is the schema descriptor for user_agent field.
+	sessionDescUserAgent := sessionFields[4].Descriptor()
+	// session.UserAgentValidator is a validator for the "user_agent" field. It is called by the builders before save.
+	session.UserAgentValidator = sessionDescUserAgent.Validators[0].(func(string) error)
+	// sessionDescCreatedAt is the schema descriptor for created_at field.
+	sessionDescCreatedAt := sessionFields[5].Descriptor()
+	// session.DefaultCreatedAt holds the default value on creation for the created_at field.
+	session.DefaultCreatedAt = sessionDescCreatedAt.Default.(func() time.Time)
+	// sessionDescLastUsedAt is the schema descriptor for last_used_at field.
+	sessionDescLastUsedAt := sessionFields[6].Descriptor()
+	// session.DefaultLastUsedAt holds the default value on creation for the last_used_at field.
+	session.DefaultLastUsedAt = sessionDescLastUsedAt.Default.(func() time.Time)
 	shareFields := schema.Share{}.Fields()
 	_ = shareFields
 	// shareDescCode is the schema descriptor for code field.
@@ -74,23 +306,41 @@ func init() {
 	// share.CodeValidator is a validator for the "code" field. It is called by the builders before save.
 	share.CodeValidator = shareDescCode.Validators[0].(func(string) error)
 	// shareDescShareType is the schema descriptor for share_type field.
-	shareDescShareType := shareFields[1].Descriptor()
+	shareDescShareType := shareFields[2].Descriptor()
 	// share.DefaultShareType holds the default value on creation for the share_type field.
 	share.DefaultShareType = shareDescShareType.Default.(int)
 	// shareDescAccessCount is the schema descriptor for access_count field.
-	shareDescAccessCount := shareFields[4].Descriptor()
+	shareDescAccessCount := shareFields[5].Descriptor()
 	// share.DefaultAccessCount holds the default value on creation for the access_count field.
 	share.DefaultAccessCount = shareDescAccessCount.Default.(int)
+	// shareDescDownloadCount is the schema descriptor for download_count field.
+	shareDescDownloadCount := shareFields[7].Descriptor()
+	// share.DefaultDownloadCount holds the default value on creation for the download_count field.
+	share.DefaultDownloadCount = shareDescDownloadCount.Default.(int)
 	// shareDescCreatedAt is the schema descriptor for created_at field.
-	shareDescCreatedAt := shareFields[6].Descriptor()
+	shareDescCreatedAt := shareFields[11].Descriptor()
 	// share.DefaultCreatedAt holds the default value on creation for the created_at field.
 	share.DefaultCreatedAt = shareDescCreatedAt.Default.(func() time.Time)
 	// shareDescUpdatedAt is the schema descriptor for updated_at field.
-	shareDescUpdatedAt := shareFields[7].Descriptor()
+	shareDescUpdatedAt := shareFields[12].Descriptor()
 	// share.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	share.DefaultUpdatedAt = shareDescUpdatedAt.Default.(func() time.Time)
 	// share.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	share.UpdateDefaultUpdatedAt = shareDescUpdatedAt.UpdateDefault.(func() time.Time)
+	shareaccessFields := schema.ShareAccess{}.Fields()
+	_ = shareaccessFields
+	// shareaccessDescUserAgent is the schema descriptor for user_agent field.
+	shareaccessDescUserAgent := shareaccessFields[4].Descriptor()
+	// shareaccess.UserAgentValidator is a validator for the "user_agent" field. It is called by the builders before save.
+	shareaccess.UserAgentValidator = shareaccessDescUserAgent.Validators[0].(func(string) error)
+	// shareaccessDescBytes is the schema descriptor for bytes field.
+	shareaccessDescBytes := shareaccessFields[5].Descriptor()
+	// shareaccess.DefaultBytes holds the default value on creation for the bytes field.
+	shareaccess.DefaultBytes = shareaccessDescBytes.Default.(int64)
+	// shareaccessDescCreatedAt is the schema descriptor for created_at field.
+	shareaccessDescCreatedAt := shareaccessFields[6].Descriptor()
+	// shareaccess.DefaultCreatedAt holds the default value on creation for the created_at field.
+	shareaccess.DefaultCreatedAt = shareaccessDescCreatedAt.Default.(func() time.Time)
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescUsername is the schema descriptor for username field.
@@ -101,22 +351,66 @@ func init() {
 	userDescPasswordHash := userFields[1].Descriptor()
 	// user.PasswordHashValidator is a validator for the "password_hash" field. It is called by the builders before save.
 	user.PasswordHashValidator = userDescPasswordHash.Validators[0].(func(string) error)
-	// userDescTotalQuota is the schema descriptor for total_quota field.
-	userDescTotalQuota := userFields[3].Descriptor()
-	// user.DefaultTotalQuota holds the default value on creation for the total_quota field.
-	user.DefaultTotalQuota = userDescTotalQuota.Default.(int64)
+	// userDescEmailVerified is the schema descriptor for email_verified field.
+	userDescEmailVerified := userFields[3].Descriptor()
+	// user.DefaultEmailVerified holds the default value on creation for the email_verified field.
+	user.DefaultEmailVerified = userDescEmailVerified.Default.(bool)
+	// userDescIsDisabled is the schema descriptor for is_disabled field.
+	userDescIsDisabled := userFields[5].Descriptor()
+	// user.DefaultIsDisabled holds the default value on creation for the is_disabled field.
+	user.DefaultIsDisabled = userDescIsDisabled.Default.(bool)
 	// userDescTotalUsed is the schema descriptor for total_used field.
-	userDescTotalUsed := userFields[4].Descriptor()
+	userDescTotalUsed := userFields[9].Descriptor()
 	// user.DefaultTotalUsed holds the default value on creation for the total_used field.
 	user.DefaultTotalUsed = userDescTotalUsed.Default.(int64)
 	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[5].Descriptor()
+	userDescCreatedAt := userFields[10].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
 	// userDescUpdatedAt is the schema descriptor for updated_at field.
-	userDescUpdatedAt := userFields[6].Descriptor()
+	userDescUpdatedAt := userFields[11].Descriptor()
 	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
 	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// userDescTotpEnabled is the schema descriptor for totp_enabled field.
+	userDescTotpEnabled := userFields[14].Descriptor()
+	// user.DefaultTotpEnabled holds the default value on creation for the totp_enabled field.
+	user.DefaultTotpEnabled = userDescTotpEnabled.Default.(bool)
+	// userDescTotpLastStep is the schema descriptor for totp_last_step field.
+	userDescTotpLastStep := userFields[15].Descriptor()
+	// user.DefaultTotpLastStep holds the default value on creation for the totp_last_step field.
+	user.DefaultTotpLastStep = userDescTotpLastStep.Default.(int64)
+	// userDescChangeSeq is the schema descriptor for change_seq field.
+	userDescChangeSeq := userFields[17].Descriptor()
+	// user.DefaultChangeSeq holds the default value on creation for the change_seq field.
+	user.DefaultChangeSeq = userDescChangeSeq.Default.(int64)
+	useridentityFields := schema.UserIdentity{}.Fields()
+	_ = useridentityFields
+	// useridentityDescProvider is the schema descriptor for provider field.
+	useridentityDescProvider := useridentityFields[1].Descriptor()
+	// useridentity.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	useridentity.ProviderValidator = useridentityDescProvider.Validators[0].(func(string) error)
+	// useridentityDescIssuer is the schema descriptor for issuer field.
+	useridentityDescIssuer := useridentityFields[2].Descriptor()
+	// useridentity.IssuerValidator is a validator for the "issuer" field. It is called by the builders before save.
+	useridentity.IssuerValidator = useridentityDescIssuer.Validators[0].(func(string) error)
+	// useridentityDescSubject is the schema descriptor for subject field.
+	useridentityDescSubject := useridentityFields[3].Descriptor()
+	// useridentity.SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
+	useridentity.SubjectValidator = useridentityDescSubject.Validators[0].(func(string) error)
+	// useridentityDescCreatedAt is the schema descriptor for created_at field.
+	useridentityDescCreatedAt := useridentityFields[5].Descriptor()
+	// useridentity.DefaultCreatedAt holds the default value on creation for the created_at field.
+	useridentity.DefaultCreatedAt = useridentityDescCreatedAt.Default.(func() time.Time)
+	usertokenFields := schema.UserToken{}.Fields()
+	_ = usertokenFields
+	// usertokenDescTokenHash is the schema descriptor for token_hash field.
+	usertokenDescTokenHash := usertokenFields[2].Descriptor()
+	// usertoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	usertoken.TokenHashValidator = usertokenDescTokenHash.Validators[0].(func(string) error)
+	// usertokenDescCreatedAt is the schema descriptor for created_at field.
+	usertokenDescCreatedAt := usertokenFields[4].Descriptor()
+	// usertoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	usertoken.DefaultCreatedAt = usertokenDescCreatedAt.Default.(func() time.Time)
 }
@@ -0,0 +1,216 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"gopan-server/ent/s3key"
+	"gopan-server/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// S3Key is the model entity for the S3Key schema.
+type S3Key struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// AccessKey holds the value of the "access_key" field.
+	AccessKey string `json:"access_key,omitempty"`
+	// Kept in the clear, as SigV4 signatures are verified with it
+	SecretKey string `json:"-"`
+	// ReadOnly holds the value of the "read_only" field.
+	ReadOnly bool `json:"read_only,omitempty"`
+	// LastUsedAt holds the value of the "last_used_at" field.
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	// LastUsedIP holds the value of the "last_used_ip" field.
+	LastUsedIP string `json:"last_used_ip,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the S3KeyQuery when eager-loading is set.
+	Edges        S3KeyEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// S3KeyEdges holds the relations/edges for other nodes in the graph.
+type S3KeyEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e S3KeyEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*S3Key) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case s3key.FieldReadOnly:
+			values[i] = new(sql.NullBool)
+		case s3key.FieldID, s3key.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case s3key.FieldName, s3key.FieldAccessKey, s3key.FieldSecretKey, s3key.FieldLastUsedIP:
+			values[i] = new(sql.NullString)
+		case s3key.FieldLastUsedAt, s3key.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the S3Key fields.
+func (s *S3Key) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case s3key.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			s.ID = int(value.Int64)
+		case s3key.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				s.UserID = int(value.Int64)
+			}
+		case s3key.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				s.Name = value.String
+			}
+		case s3key.FieldAccessKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field access_key", values[i])
+			} else if value.Valid {
+				s.AccessKey = value.String
+			}
+		case s3key.FieldSecretKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field secret_key", values[i])
+			} else if value.Valid {
+				s.SecretKey = value.String
+			}
+		case s3key.FieldReadOnly:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field read_only", values[i])
+			} else if value.Valid {
+				s.ReadOnly = value.Bool
+			}
+		case s3key.FieldLastUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_at", values[i])
+			} else if value.Valid {
+				s.LastUsedAt = new(time.Time)
+				*s.LastUsedAt = value.Time
+			}
+		case s3key.FieldLastUsedIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_ip", values[i])
+			} else if value.Valid {
+				s.LastUsedIP = value.String
+			}
+		case s3key.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				s.CreatedAt = value.Time
+			}
+		default:
+			s.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the S3Key.
+// This includes values selected through modifiers, order, etc.
+func (s *S3Key) Value(name string) (ent.Value, error) {
+	return s.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the S3Key entity.
+func (s *S3Key) QueryUser() *UserQuery {
+	return NewS3KeyClient(s.config).QueryUser(s)
+}
+
+// Update returns a builder for updating this S3Key.
+// Note that you need to call S3Key.Unwrap() before calling this method if this S3Key
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (s *S3Key) Update() *S3KeyUpdateOne {
+	return NewS3KeyClient(s.config).UpdateOne(s)
+}
+
+// Unwrap unwraps the S3Key entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (s *S3Key) Unwrap() *S3Key {
+	_tx, ok := s.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: S3Key is not a transactional entity")
+	}
+	s.config.driver = _tx.drv
+	return s
+}
+
+// String implements the fmt.Stringer.
+func (s *S3Key) String() string {
+	var builder strings.Builder
+	builder.WriteString("S3Key(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", s.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", s.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(s.Name)
+	builder.WriteString(", ")
+	builder.WriteString("access_key=")
+	builder.WriteString(s.AccessKey)
+	builder.WriteString(", ")
+	builder.WriteString("secret_key=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("read_only=")
+	builder.WriteString(fmt.Sprintf("%v", s.ReadOnly))
+	builder.WriteString(", ")
+	if v := s.LastUsedAt; v != nil {
+		builder.WriteString("last_used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("last_used_ip=")
+	builder.WriteString(s.LastUsedIP)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(s.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// S3Keys is a parsable slice of S3Key.
+type S3Keys []*S3Key
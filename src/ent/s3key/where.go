@@ -0,0 +1,524 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s3key
+
+import (
+	"gopan-server/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.S3Key {
+	return predicate.S3Key(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.S3Key {
+	return predicate.S3Key(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.S3Key {
+	return predicate.S3Key(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.S3Key {
+	return predicate.S3Key(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.S3Key {
+	return predicate.S3Key(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.S3Key {
+	return predicate.S3Key(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.S3Key {
+	return predicate.S3Key(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.S3Key {
+	return predicate.S3Key(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.S3Key {
+	return predicate.S3Key(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.S3Key {
+	return predicate.S3Key(sql.FieldEQ(FieldUserID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldEQ(FieldName, v))
+}
+
+// AccessKey applies equality check predicate on the "access_key" field. It's identical to AccessKeyEQ.
+func AccessKey(v string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldEQ(FieldAccessKey, v))
+}
+
+// SecretKey applies equality check predicate on the "secret_key" field. It's identical to SecretKeyEQ.
+func SecretKey(v string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldEQ(FieldSecretKey, v))
+}
+
+// ReadOnly applies equality check predicate on the "read_only" field. It's identical to ReadOnlyEQ.
+func ReadOnly(v bool) predicate.S3Key {
+	return predicate.S3Key(sql.FieldEQ(FieldReadOnly, v))
+}
+
+// LastUsedAt applies equality check predicate on the "last_used_at" field. It's identical to LastUsedAtEQ.
+func LastUsedAt(v time.Time) predicate.S3Key {
+	return predicate.S3Key(sql.FieldEQ(FieldLastUsedAt, v))
+}
+
+// LastUsedIP applies equality check predicate on the "last_used_ip" field. It's identical to LastUsedIPEQ.
+func LastUsedIP(v string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldEQ(FieldLastUsedIP, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.S3Key {
+	return predicate.S3Key(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.S3Key {
+	return predicate.S3Key(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.S3Key {
+	return predicate.S3Key(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.S3Key {
+	return predicate.S3Key(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.S3Key {
+	return predicate.S3Key(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldContainsFold(FieldName, v))
+}
+
+// AccessKeyEQ applies the EQ predicate on the "access_key" field.
+func AccessKeyEQ(v string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldEQ(FieldAccessKey, v))
+}
+
+// AccessKeyNEQ applies the NEQ predicate on the "access_key" field.
+func AccessKeyNEQ(v string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldNEQ(FieldAccessKey, v))
+}
+
+// AccessKeyIn applies the In predicate on the "access_key" field.
+func AccessKeyIn(vs ...string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldIn(FieldAccessKey, vs...))
+}
+
+// AccessKeyNotIn applies the NotIn predicate on the "access_key" field.
+func AccessKeyNotIn(vs ...string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldNotIn(FieldAccessKey, vs...))
+}
+
+// AccessKeyGT applies the GT predicate on the "access_key" field.
+func AccessKeyGT(v string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldGT(FieldAccessKey, v))
+}
+
+// AccessKeyGTE applies the GTE predicate on the "access_key" field.
+func AccessKeyGTE(v string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldGTE(FieldAccessKey, v))
+}
+
+// AccessKeyLT applies the LT predicate on the "access_key" field.
+func AccessKeyLT(v string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldLT(FieldAccessKey, v))
+}
+
+// AccessKeyLTE applies the LTE predicate on the "access_key" field.
+func AccessKeyLTE(v string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldLTE(FieldAccessKey, v))
+}
+
+// AccessKeyContains applies the Contains predicate on the "access_key" field.
+func AccessKeyContains(v string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldContains(FieldAccessKey, v))
+}
+
+// AccessKeyHasPrefix applies the HasPrefix predicate on the "access_key" field.
+func AccessKeyHasPrefix(v string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldHasPrefix(FieldAccessKey, v))
+}
+
+// AccessKeyHasSuffix applies the HasSuffix predicate on the "access_key" field.
+func AccessKeyHasSuffix(v string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldHasSuffix(FieldAccessKey, v))
+}
+
+// AccessKeyEqualFold applies the EqualFold predicate on the "access_key" field.
+func AccessKeyEqualFold(v string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldEqualFold(FieldAccessKey, v))
+}
+
+// AccessKeyContainsFold applies the ContainsFold predicate on the "access_key" field.
+func AccessKeyContainsFold(v string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldContainsFold(FieldAccessKey, v))
+}
+
+// SecretKeyEQ applies the EQ predicate on the "secret_key" field.
+func SecretKeyEQ(v string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldEQ(FieldSecretKey, v))
+}
+
+// SecretKeyNEQ applies the NEQ predicate on the "secret_key" field.
+func SecretKeyNEQ(v string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldNEQ(FieldSecretKey, v))
+}
+
+// SecretKeyIn applies the In predicate on the "secret_key" field.
+func SecretKeyIn(vs ...string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldIn(FieldSecretKey, vs...))
+}
+
+// SecretKeyNotIn applies the NotIn predicate on the "secret_key" field.
+func SecretKeyNotIn(vs ...string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldNotIn(FieldSecretKey, vs...))
+}
+
+// SecretKeyGT applies the GT predicate on the "secret_key" field.
+func SecretKeyGT(v string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldGT(FieldSecretKey, v))
+}
+
+// SecretKeyGTE applies the GTE predicate on the "secret_key" field.
+func SecretKeyGTE(v string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldGTE(FieldSecretKey, v))
+}
+
+// SecretKeyLT applies the LT predicate on the "secret_key" field.
+func SecretKeyLT(v string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldLT(FieldSecretKey, v))
+}
+
+// SecretKeyLTE applies the LTE predicate on the "secret_key" field.
+func SecretKeyLTE(v string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldLTE(FieldSecretKey, v))
+}
+
+// SecretKeyContains applies the Contains predicate on the "secret_key" field.
+func SecretKeyContains(v string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldContains(FieldSecretKey, v))
+}
+
+// SecretKeyHasPrefix applies the HasPrefix predicate on the "secret_key" field.
+func SecretKeyHasPrefix(v string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldHasPrefix(FieldSecretKey, v))
+}
+
+// SecretKeyHasSuffix applies the HasSuffix predicate on the "secret_key" field.
+func SecretKeyHasSuffix(v string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldHasSuffix(FieldSecretKey, v))
+}
+
+// SecretKeyEqualFold applies the EqualFold predicate on the "secret_key" field.
+func SecretKeyEqualFold(v string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldEqualFold(FieldSecretKey, v))
+}
+
+// SecretKeyContainsFold applies the ContainsFold predicate on the "secret_key" field.
+func SecretKeyContainsFold(v string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldContainsFold(FieldSecretKey, v))
+}
+
+// ReadOnlyEQ applies the EQ predicate on the "read_only" field.
+func ReadOnlyEQ(v bool) predicate.S3Key {
+	return predicate.S3Key(sql.FieldEQ(FieldReadOnly, v))
+}
+
+// ReadOnlyNEQ applies the NEQ predicate on the "read_only" field.
+func ReadOnlyNEQ(v bool) predicate.S3Key {
+	return predicate.S3Key(sql.FieldNEQ(FieldReadOnly, v))
+}
+
+// LastUsedAtEQ applies the EQ predicate on the "last_used_at" field.
+func LastUsedAtEQ(v time.Time) predicate.S3Key {
+	return predicate.S3Key(sql.FieldEQ(FieldLastUsedAt, v))
+}
+
+// LastUsedAtNEQ applies the NEQ predicate on the "last_used_at" field.
+func LastUsedAtNEQ(v time.Time) predicate.S3Key {
+	return predicate.S3Key(sql.FieldNEQ(FieldLastUsedAt, v))
+}
+
+// LastUsedAtIn applies the In predicate on the "last_used_at" field.
+func LastUsedAtIn(vs ...time.Time) predicate.S3Key {
+	return predicate.S3Key(sql.FieldIn(FieldLastUsedAt, vs...))
+}
+
+// LastUsedAtNotIn applies the NotIn predicate on the "last_used_at" field.
+func LastUsedAtNotIn(vs ...time.Time) predicate.S3Key {
+	return predicate.S3Key(sql.FieldNotIn(FieldLastUsedAt, vs...))
+}
+
+// LastUsedAtGT applies the GT predicate on the "last_used_at" field.
+func LastUsedAtGT(v time.Time) predicate.S3Key {
+	return predicate.S3Key(sql.FieldGT(FieldLastUsedAt, v))
+}
+
+// LastUsedAtGTE applies the GTE predicate on the "last_used_at" field.
+func LastUsedAtGTE(v time.Time) predicate.S3Key {
+	return predicate.S3Key(sql.FieldGTE(FieldLastUsedAt, v))
+}
+
+// LastUsedAtLT applies the LT predicate on the "last_used_at" field.
+func LastUsedAtLT(v time.Time) predicate.S3Key {
+	return predicate.S3Key(sql.FieldLT(FieldLastUsedAt, v))
+}
+
+// LastUsedAtLTE applies the LTE predicate on the "last_used_at" field.
+func LastUsedAtLTE(v time.Time) predicate.S3Key {
+	return predicate.S3Key(sql.FieldLTE(FieldLastUsedAt, v))
+}
+
+// LastUsedAtIsNil applies the IsNil predicate on the "last_used_at" field.
+func LastUsedAtIsNil() predicate.S3Key {
+	return predicate.S3Key(sql.FieldIsNull(FieldLastUsedAt))
+}
+
+// LastUsedAtNotNil applies the NotNil predicate on the "last_used_at" field.
+func LastUsedAtNotNil() predicate.S3Key {
+	return predicate.S3Key(sql.FieldNotNull(FieldLastUsedAt))
+}
+
+// LastUsedIPEQ applies the EQ predicate on the "last_used_ip" field.
+func LastUsedIPEQ(v string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldEQ(FieldLastUsedIP, v))
+}
+
+// LastUsedIPNEQ applies the NEQ predicate on the "last_used_ip" field.
+func LastUsedIPNEQ(v string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldNEQ(FieldLastUsedIP, v))
+}
+
+// LastUsedIPIn applies the In predicate on the "last_used_ip" field.
+func LastUsedIPIn(vs ...string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldIn(FieldLastUsedIP, vs...))
+}
+
+// LastUsedIPNotIn applies the NotIn predicate on the "last_used_ip" field.
+func LastUsedIPNotIn(vs ...string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldNotIn(FieldLastUsedIP, vs...))
+}
+
+// LastUsedIPGT applies the GT predicate on the "last_used_ip" field.
+func LastUsedIPGT(v string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldGT(FieldLastUsedIP, v))
+}
+
+// LastUsedIPGTE applies the GTE predicate on the "last_used_ip" field.
+func LastUsedIPGTE(v string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldGTE(FieldLastUsedIP, v))
+}
+
+// LastUsedIPLT applies the LT predicate on the "last_used_ip" field.
+func LastUsedIPLT(v string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldLT(FieldLastUsedIP, v))
+}
+
+// LastUsedIPLTE applies the LTE predicate on the "last_used_ip" field.
+func LastUsedIPLTE(v string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldLTE(FieldLastUsedIP, v))
+}
+
+// LastUsedIPContains applies the Contains predicate on the "last_used_ip" field.
+func LastUsedIPContains(v string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldContains(FieldLastUsedIP, v))
+}
+
+// LastUsedIPHasPrefix applies the HasPrefix predicate on the "last_used_ip" field.
+func LastUsedIPHasPrefix(v string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldHasPrefix(FieldLastUsedIP, v))
+}
+
+// LastUsedIPHasSuffix applies the HasSuffix predicate on the "last_used_ip" field.
+func LastUsedIPHasSuffix(v string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldHasSuffix(FieldLastUsedIP, v))
+}
+
+// LastUsedIPIsNil applies the IsNil predicate on the "last_used_ip" field.
+func LastUsedIPIsNil() predicate.S3Key {
+	return predicate.S3Key(sql.FieldIsNull(FieldLastUsedIP))
+}
+
+// LastUsedIPNotNil applies the NotNil predicate on the "last_used_ip" field.
+func LastUsedIPNotNil() predicate.S3Key {
+	return predicate.S3Key(sql.FieldNotNull(FieldLastUsedIP))
+}
+
+// LastUsedIPEqualFold applies the EqualFold predicate on the "last_used_ip" field.
+func LastUsedIPEqualFold(v string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldEqualFold(FieldLastUsedIP, v))
+}
+
+// LastUsedIPContainsFold applies the ContainsFold predicate on the "last_used_ip" field.
+func LastUsedIPContainsFold(v string) predicate.S3Key {
+	return predicate.S3Key(sql.FieldContainsFold(FieldLastUsedIP, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.S3Key {
+	return predicate.S3Key(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.S3Key {
+	return predicate.S3Key(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.S3Key {
+	return predicate.S3Key(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.S3Key {
+	return predicate.S3Key(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.S3Key {
+	return predicate.S3Key(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.S3Key {
+	return predicate.S3Key(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.S3Key {
+	return predicate.S3Key(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.S3Key {
+	return predicate.S3Key(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.S3Key {
+	return predicate.S3Key(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.S3Key {
+	return predicate.S3Key(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.S3Key) predicate.S3Key {
+	return predicate.S3Key(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.S3Key) predicate.S3Key {
+	return predicate.S3Key(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.S3Key) predicate.S3Key {
+	return predicate.S3Key(sql.NotPredicates(p))
+}
@@ -0,0 +1,352 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gopan-server/ent/s3key"
+	"gopan-server/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// S3KeyCreate is the builder for creating a S3Key entity.
+type S3KeyCreate struct {
+	config
+	mutation *S3KeyMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (sc *S3KeyCreate) SetUserID(i int) *S3KeyCreate {
+	sc.mutation.SetUserID(i)
+	return sc
+}
+
+// SetName sets the "name" field.
+func (sc *S3KeyCreate) SetName(s string) *S3KeyCreate {
+	sc.mutation.SetName(s)
+	return sc
+}
+
+// SetAccessKey sets the "access_key" field.
+func (sc *S3KeyCreate) SetAccessKey(s string) *S3KeyCreate {
+	sc.mutation.SetAccessKey(s)
+	return sc
+}
+
+// SetSecretKey sets the "secret_key" field.
+func (sc *S3KeyCreate) SetSecretKey(s string) *S3KeyCreate {
+	sc.mutation.SetSecretKey(s)
+	return sc
+}
+
+// SetReadOnly sets the "read_only" field.
+func (sc *S3KeyCreate) SetReadOnly(b bool) *S3KeyCreate {
+	sc.mutation.SetReadOnly(b)
+	return sc
+}
+
+// SetNillableReadOnly sets the "read_only" field if the given value is not nil.
+func (sc *S3KeyCreate) SetNillableReadOnly(b *bool) *S3KeyCreate {
+	if b != nil {
+		sc.SetReadOnly(*b)
+	}
+	return sc
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (sc *S3KeyCreate) SetLastUsedAt(t time.Time) *S3KeyCreate {
+	sc.mutation.SetLastUsedAt(t)
+	return sc
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (sc *S3KeyCreate) SetNillableLastUsedAt(t *time.Time) *S3KeyCreate {
+	if t != nil {
+		sc.SetLastUsedAt(*t)
+	}
+	return sc
+}
+
+// SetLastUsedIP sets the "last_used_ip" field.
+func (sc *S3KeyCreate) SetLastUsedIP(s string) *S3KeyCreate {
+	sc.mutation.SetLastUsedIP(s)
+	return sc
+}
+
+// SetNillableLastUsedIP sets the "last_used_ip" field if the given value is not nil.
+func (sc *S3KeyCreate) SetNillableLastUsedIP(s *string) *S3KeyCreate {
+	if s != nil {
+		sc.SetLastUsedIP(*s)
+	}
+	return sc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sc *S3KeyCreate) SetCreatedAt(t time.Time) *S3KeyCreate {
+	sc.mutation.SetCreatedAt(t)
+	return sc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sc *S3KeyCreate) SetNillableCreatedAt(t *time.Time) *S3KeyCreate {
+	if t != nil {
+		sc.SetCreatedAt(*t)
+	}
+	return sc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (sc *S3KeyCreate) SetUser(u *User) *S3KeyCreate {
+	return sc.SetUserID(u.ID)
+}
+
+// Mutation returns the S3KeyMutation object of the builder.
+func (sc *S3KeyCreate) Mutation() *S3KeyMutation {
+	return sc.mutation
+}
+
+// Save creates the S3Key in the database.
+func (sc *S3KeyCreate) Save(ctx context.Context) (*S3Key, error) {
+	sc.defaults()
+	return withHooks(ctx, sc.sqlSave, sc.mutation, sc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sc *S3KeyCreate) SaveX(ctx context.Context) *S3Key {
+	v, err := sc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sc *S3KeyCreate) Exec(ctx context.Context) error {
+	_, err := sc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sc *S3KeyCreate) ExecX(ctx context.Context) {
+	if err := sc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sc *S3KeyCreate) defaults() {
+	if _, ok := sc.mutation.ReadOnly(); !ok {
+		v := s3key.DefaultReadOnly
+		sc.mutation.SetReadOnly(v)
+	}
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		v := s3key.DefaultCreatedAt()
+		sc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sc *S3KeyCreate) check() error {
+	if _, ok := sc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "S3Key.user_id"`)}
+	}
+	if _, ok := sc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "S3Key.name"`)}
+	}
+	if v, ok := sc.mutation.Name(); ok {
+		if err := s3key.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "S3Key.name": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.AccessKey(); !ok {
+		return &ValidationError{Name: "access_key", err: errors.New(`ent: missing required field "S3Key.access_key"`)}
+	}
+	if v, ok := sc.mutation.AccessKey(); ok {
+		if err := s3key.AccessKeyValidator(v); err != nil {
+			return &ValidationError{Name: "access_key", err: fmt.Errorf(`ent: validator failed for field "S3Key.access_key": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.SecretKey(); !ok {
+		return &ValidationError{Name: "secret_key", err: errors.New(`ent: missing required field "S3Key.secret_key"`)}
+	}
+	if v, ok := sc.mutation.SecretKey(); ok {
+		if err := s3key.SecretKeyValidator(v); err != nil {
+			return &ValidationError{Name: "secret_key", err: fmt.Errorf(`ent: validator failed for field "S3Key.secret_key": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.ReadOnly(); !ok {
+		return &ValidationError{Name: "read_only", err: errors.New(`ent: missing required field "S3Key.read_only"`)}
+	}
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "S3Key.created_at"`)}
+	}
+	if len(sc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "S3Key.user"`)}
+	}
+	return nil
+}
+
+func (sc *S3KeyCreate) sqlSave(ctx context.Context) (*S3Key, error) {
+	if err := sc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	sc.mutation.id = &_node.ID
+	sc.mutation.done = true
+	return _node, nil
+}
+
+func (sc *S3KeyCreate) createSpec() (*S3Key, *sqlgraph.CreateSpec) {
+	var (
+		_node = &S3Key{config: sc.config}
+		_spec = sqlgraph.NewCreateSpec(s3key.Table, sqlgraph.NewFieldSpec(s3key.FieldID, field.TypeInt))
+	)
+	if value, ok := sc.mutation.Name(); ok {
+		_spec.SetField(s3key.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := sc.mutation.AccessKey(); ok {
+		_spec.SetField(s3key.FieldAccessKey, field.TypeString, value)
+		_node.AccessKey = value
+	}
+	if value, ok := sc.mutation.SecretKey(); ok {
+		_spec.SetField(s3key.FieldSecretKey, field.TypeString, value)
+		_node.SecretKey = value
+	}
+	if value, ok := sc.mutation.ReadOnly(); ok {
+		_spec.SetField(s3key.FieldReadOnly, field.TypeBool, value)
+		_node.ReadOnly = value
+	}
+	if value, ok := sc.mutation.LastUsedAt(); ok {
+		_spec.SetField(s3key.FieldLastUsedAt, field.TypeTime, value)
+		_node.LastUsedAt = &value
+	}
+	if value, ok := sc.mutation.LastUsedIP(); ok {
+		_spec.SetField(s3key.FieldLastUsedIP, field.TypeString, value)
+		_node.LastUsedIP = value
+	}
+	if value, ok := sc.mutation.CreatedAt(); ok {
+		_spec.SetField(s3key.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := sc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   s3key.UserTable,
+			Columns: []string{s3key.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// S3KeyCreateBulk is the builder for creating many S3Key entities in bulk.
+type S3KeyCreateBulk struct {
+	config
+	err      error
+	builders []*S3KeyCreate
+}
+
+// Save creates the S3Key entities in the database.
+func (scb *S3KeyCreateBulk) Save(ctx context.Context) ([]*S3Key, error) {
+	if scb.err != nil {
+		return nil, scb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(scb.builders))
+	nodes := make([]*S3Key, len(scb.builders))
+	mutators := make([]Mutator, len(scb.builders))
+	for i := range scb.builders {
+		func(i int, root context.Context) {
+			builder := scb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*S3KeyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, scb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, scb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, scb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scb *S3KeyCreateBulk) SaveX(ctx context.Context) []*S3Key {
+	v, err := scb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scb *S3KeyCreateBulk) Exec(ctx context.Context) error {
+	_, err := scb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scb *S3KeyCreateBulk) ExecX(ctx context.Context) {
+	if err := scb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
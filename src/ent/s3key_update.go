@@ -0,0 +1,611 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gopan-server/ent/predicate"
+	"gopan-server/ent/s3key"
+	"gopan-server/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// S3KeyUpdate is the builder for updating S3Key entities.
+type S3KeyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *S3KeyMutation
+}
+
+// Where appends a list predicates to the S3KeyUpdate builder.
+func (su *S3KeyUpdate) Where(ps ...predicate.S3Key) *S3KeyUpdate {
+	su.mutation.Where(ps...)
+	return su
+}
+
+// SetUserID sets the "user_id" field.
+func (su *S3KeyUpdate) SetUserID(i int) *S3KeyUpdate {
+	su.mutation.SetUserID(i)
+	return su
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (su *S3KeyUpdate) SetNillableUserID(i *int) *S3KeyUpdate {
+	if i != nil {
+		su.SetUserID(*i)
+	}
+	return su
+}
+
+// SetName sets the "name" field.
+func (su *S3KeyUpdate) SetName(s string) *S3KeyUpdate {
+	su.mutation.SetName(s)
+	return su
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (su *S3KeyUpdate) SetNillableName(s *string) *S3KeyUpdate {
+	if s != nil {
+		su.SetName(*s)
+	}
+	return su
+}
+
+// SetAccessKey sets the "access_key" field.
+func (su *S3KeyUpdate) SetAccessKey(s string) *S3KeyUpdate {
+	su.mutation.SetAccessKey(s)
+	return su
+}
+
+// SetNillableAccessKey sets the "access_key" field if the given value is not nil.
+func (su *S3KeyUpdate) SetNillableAccessKey(s *string) *S3KeyUpdate {
+	if s != nil {
+		su.SetAccessKey(*s)
+	}
+	return su
+}
+
+// SetSecretKey sets the "secret_key" field.
+func (su *S3KeyUpdate) SetSecretKey(s string) *S3KeyUpdate {
+	su.mutation.SetSecretKey(s)
+	return su
+}
+
+// SetNillableSecretKey sets the "secret_key" field if the given value is not nil.
+func (su *S3KeyUpdate) SetNillableSecretKey(s *string) *S3KeyUpdate {
+	if s != nil {
+		su.SetSecretKey(*s)
+	}
+	return su
+}
+
+// SetReadOnly sets the "read_only" field.
+func (su *S3KeyUpdate) SetReadOnly(b bool) *S3KeyUpdate {
+	su.mutation.SetReadOnly(b)
+	return su
+}
+
+// SetNillableReadOnly sets the "read_only" field if the given value is not nil.
+func (su *S3KeyUpdate) SetNillableReadOnly(b *bool) *S3KeyUpdate {
+	if b != nil {
+		su.SetReadOnly(*b)
+	}
+	return su
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (su *S3KeyUpdate) SetLastUsedAt(t time.Time) *S3KeyUpdate {
+	su.mutation.SetLastUsedAt(t)
+	return su
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (su *S3KeyUpdate) SetNillableLastUsedAt(t *time.Time) *S3KeyUpdate {
+	if t != nil {
+		su.SetLastUsedAt(*t)
+	}
+	return su
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (su *S3KeyUpdate) ClearLastUsedAt() *S3KeyUpdate {
+	su.mutation.ClearLastUsedAt()
+	return su
+}
+
+// SetLastUsedIP sets the "last_used_ip" field.
+func (su *S3KeyUpdate) SetLastUsedIP(s string) *S3KeyUpdate {
+	su.mutation.SetLastUsedIP(s)
+	return su
+}
+
+// SetNillableLastUsedIP sets the "last_used_ip" field if the given value is not nil.
+func (su *S3KeyUpdate) SetNillableLastUsedIP(s *string) *S3KeyUpdate {
+	if s != nil {
+		su.SetLastUsedIP(*s)
+	}
+	return su
+}
+
+// ClearLastUsedIP clears the value of the "last_used_ip" field.
+func (su *S3KeyUpdate) ClearLastUsedIP() *S3KeyUpdate {
+	su.mutation.ClearLastUsedIP()
+	return su
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (su *S3KeyUpdate) SetCreatedAt(t time.Time) *S3KeyUpdate {
+	su.mutation.SetCreatedAt(t)
+	return su
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (su *S3KeyUpdate) SetNillableCreatedAt(t *time.Time) *S3KeyUpdate {
+	if t != nil {
+		su.SetCreatedAt(*t)
+	}
+	return su
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (su *S3KeyUpdate) SetUser(u *User) *S3KeyUpdate {
+	return su.SetUserID(u.ID)
+}
+
+// Mutation returns the S3KeyMutation object of the builder.
+func (su *S3KeyUpdate) Mutation() *S3KeyMutation {
+	return su.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (su *S3KeyUpdate) ClearUser() *S3KeyUpdate {
+	su.mutation.ClearUser()
+	return su
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (su *S3KeyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, su.sqlSave, su.mutation, su.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (su *S3KeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := su.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (su *S3KeyUpdate) Exec(ctx context.Context) error {
+	_, err := su.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (su *S3KeyUpdate) ExecX(ctx context.Context) {
+	if err := su.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (su *S3KeyUpdate) check() error {
+	if v, ok := su.mutation.Name(); ok {
+		if err := s3key.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "S3Key.name": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.AccessKey(); ok {
+		if err := s3key.AccessKeyValidator(v); err != nil {
+			return &ValidationError{Name: "access_key", err: fmt.Errorf(`ent: validator failed for field "S3Key.access_key": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.SecretKey(); ok {
+		if err := s3key.SecretKeyValidator(v); err != nil {
+			return &ValidationError{Name: "secret_key", err: fmt.Errorf(`ent: validator failed for field "S3Key.secret_key": %w`, err)}
+		}
+	}
+	if su.mutation.UserCleared() && len(su.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "S3Key.user"`)
+	}
+	return nil
+}
+
+func (su *S3KeyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := su.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(s3key.Table, s3key.Columns, sqlgraph.NewFieldSpec(s3key.FieldID, field.TypeInt))
+	if ps := su.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := su.mutation.Name(); ok {
+		_spec.SetField(s3key.FieldName, field.TypeString, value)
+	}
+	if value, ok := su.mutation.AccessKey(); ok {
+		_spec.SetField(s3key.FieldAccessKey, field.TypeString, value)
+	}
+	if value, ok := su.mutation.SecretKey(); ok {
+		_spec.SetField(s3key.FieldSecretKey, field.TypeString, value)
+	}
+	if value, ok := su.mutation.ReadOnly(); ok {
+		_spec.SetField(s3key.FieldReadOnly, field.TypeBool, value)
+	}
+	if value, ok := su.mutation.LastUsedAt(); ok {
+		_spec.SetField(s3key.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if su.mutation.LastUsedAtCleared() {
+		_spec.ClearField(s3key.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := su.mutation.LastUsedIP(); ok {
+		_spec.SetField(s3key.FieldLastUsedIP, field.TypeString, value)
+	}
+	if su.mutation.LastUsedIPCleared() {
+		_spec.ClearField(s3key.FieldLastUsedIP, field.TypeString)
+	}
+	if value, ok := su.mutation.CreatedAt(); ok {
+		_spec.SetField(s3key.FieldCreatedAt, field.TypeTime, value)
+	}
+	if su.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   s3key.UserTable,
+			Columns: []string{s3key.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   s3key.UserTable,
+			Columns: []string{s3key.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{s3key.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	su.mutation.done = true
+	return n, nil
+}
+
+// S3KeyUpdateOne is the builder for updating a single S3Key entity.
+type S3KeyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *S3KeyMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (suo *S3KeyUpdateOne) SetUserID(i int) *S3KeyUpdateOne {
+	suo.mutation.SetUserID(i)
+	return suo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (suo *S3KeyUpdateOne) SetNillableUserID(i *int) *S3KeyUpdateOne {
+	if i != nil {
+		suo.SetUserID(*i)
+	}
+	return suo
+}
+
+// SetName sets the "name" field.
+func (suo *S3KeyUpdateOne) SetName(s string) *S3KeyUpdateOne {
+	suo.mutation.SetName(s)
+	return suo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (suo *S3KeyUpdateOne) SetNillableName(s *string) *S3KeyUpdateOne {
+	if s != nil {
+		suo.SetName(*s)
+	}
+	return suo
+}
+
+// SetAccessKey sets the "access_key" field.
+func (suo *S3KeyUpdateOne) SetAccessKey(s string) *S3KeyUpdateOne {
+	suo.mutation.SetAccessKey(s)
+	return suo
+}
+
+// SetNillableAccessKey sets the "access_key" field if the given value is not nil.
+func (suo *S3KeyUpdateOne) SetNillableAccessKey(s *string) *S3KeyUpdateOne {
+	if s != nil {
+		suo.SetAccessKey(*s)
+	}
+	return suo
+}
+
+// SetSecretKey sets the "secret_key" field.
+func (suo *S3KeyUpdateOne) SetSecretKey(s string) *S3KeyUpdateOne {
+	suo.mutation.SetSecretKey(s)
+	return suo
+}
+
+// SetNillableSecretKey sets the "secret_key" field if the given value is not nil.
+func (suo *S3KeyUpdateOne) SetNillableSecretKey(s *string) *S3KeyUpdateOne {
+	if s != nil {
+		suo.SetSecretKey(*s)
+	}
+	return suo
+}
+
+// SetReadOnly sets the "read_only" field.
+func (suo *S3KeyUpdateOne) SetReadOnly(b bool) *S3KeyUpdateOne {
+	suo.mutation.SetReadOnly(b)
+	return suo
+}
+
+// SetNillableReadOnly sets the "read_only" field if the given value is not nil.
+func (suo *S3KeyUpdateOne) SetNillableReadOnly(b *bool) *S3KeyUpdateOne {
+	if b != nil {
+		suo.SetReadOnly(*b)
+	}
+	return suo
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (suo *S3KeyUpdateOne) SetLastUsedAt(t time.Time) *S3KeyUpdateOne {
+	suo.mutation.SetLastUsedAt(t)
+	return suo
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (suo *S3KeyUpdateOne) SetNillableLastUsedAt(t *time.Time) *S3KeyUpdateOne {
+	if t != nil {
+		suo.SetLastUsedAt(*t)
+	}
+	return suo
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (suo *S3KeyUpdateOne) ClearLastUsedAt() *S3KeyUpdateOne {
+	suo.mutation.ClearLastUsedAt()
+	return suo
+}
+
+// SetLastUsedIP sets the "last_used_ip" field.
+func (suo *S3KeyUpdateOne) SetLastUsedIP(s string) *S3KeyUpdateOne {
+	suo.mutation.SetLastUsedIP(s)
+	return suo
+}
+
+// SetNillableLastUsedIP sets the "last_used_ip" field if the given value is not nil.
+func (suo *S3KeyUpdateOne) SetNillableLastUsedIP(s *string) *S3KeyUpdateOne {
+	if s != nil {
+		suo.SetLastUsedIP(*s)
+	}
+	return suo
+}
+
+// ClearLastUsedIP clears the value of the "last_used_ip" field.
+func (suo *S3KeyUpdateOne) ClearLastUsedIP() *S3KeyUpdateOne {
+	suo.mutation.ClearLastUsedIP()
+	return suo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (suo *S3KeyUpdateOne) SetCreatedAt(t time.Time) *S3KeyUpdateOne {
+	suo.mutation.SetCreatedAt(t)
+	return suo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (suo *S3KeyUpdateOne) SetNillableCreatedAt(t *time.Time) *S3KeyUpdateOne {
+	if t != nil {
+		suo.SetCreatedAt(*t)
+	}
+	return suo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (suo *S3KeyUpdateOne) SetUser(u *User) *S3KeyUpdateOne {
+	return suo.SetUserID(u.ID)
+}
+
+// Mutation returns the S3KeyMutation object of the builder.
+func (suo *S3KeyUpdateOne) Mutation() *S3KeyMutation {
+	return suo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (suo *S3KeyUpdateOne) ClearUser() *S3KeyUpdateOne {
+	suo.mutation.ClearUser()
+	return suo
+}
+
+// Where appends a list predicates to the S3KeyUpdate builder.
+func (suo *S3KeyUpdateOne) Where(ps ...predicate.S3Key) *S3KeyUpdateOne {
+	suo.mutation.Where(ps...)
+	return suo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (suo *S3KeyUpdateOne) Select(field string, fields ...string) *S3KeyUpdateOne {
+	suo.fields = append([]string{field}, fields...)
+	return suo
+}
+
+// Save executes the query and returns the updated S3Key entity.
+func (suo *S3KeyUpdateOne) Save(ctx context.Context) (*S3Key, error) {
+	return withHooks(ctx, suo.sqlSave, suo.mutation, suo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (suo *S3KeyUpdateOne) SaveX(ctx context.Context) *S3Key {
+	node, err := suo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (suo *S3KeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := suo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (suo *S3KeyUpdateOne) ExecX(ctx context.Context) {
+	if err := suo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (suo *S3KeyUpdateOne) check() error {
+	if v, ok := suo.mutation.Name(); ok {
+		if err := s3key.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "S3Key.name": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.AccessKey(); ok {
+		if err := s3key.AccessKeyValidator(v); err != nil {
+			return &ValidationError{Name: "access_key", err: fmt.Errorf(`ent: validator failed for field "S3Key.access_key": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.SecretKey(); ok {
+		if err := s3key.SecretKeyValidator(v); err != nil {
+			return &ValidationError{Name: "secret_key", err: fmt.Errorf(`ent: validator failed for field "S3Key.secret_key": %w`, err)}
+		}
+	}
+	if suo.mutation.UserCleared() && len(suo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "S3Key.user"`)
+	}
+	return nil
+}
+
+func (suo *S3KeyUpdateOne) sqlSave(ctx context.Context) (_node *S3Key, err error) {
+	if err := suo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(s3key.Table, s3key.Columns, sqlgraph.NewFieldSpec(s3key.FieldID, field.TypeInt))
+	id, ok := suo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "S3Key.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := suo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, s3key.FieldID)
+		for _, f := range fields {
+			if !s3key.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != s3key.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := suo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := suo.mutation.Name(); ok {
+		_spec.SetField(s3key.FieldName, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.AccessKey(); ok {
+		_spec.SetField(s3key.FieldAccessKey, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.SecretKey(); ok {
+		_spec.SetField(s3key.FieldSecretKey, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.ReadOnly(); ok {
+		_spec.SetField(s3key.FieldReadOnly, field.TypeBool, value)
+	}
+	if value, ok := suo.mutation.LastUsedAt(); ok {
+		_spec.SetField(s3key.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if suo.mutation.LastUsedAtCleared() {
+		_spec.ClearField(s3key.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := suo.mutation.LastUsedIP(); ok {
+		_spec.SetField(s3key.FieldLastUsedIP, field.TypeString, value)
+	}
+	if suo.mutation.LastUsedIPCleared() {
+		_spec.ClearField(s3key.FieldLastUsedIP, field.TypeString)
+	}
+	if value, ok := suo.mutation.CreatedAt(); ok {
+		_spec.SetField(s3key.FieldCreatedAt, field.TypeTime, value)
+	}
+	if suo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   s3key.UserTable,
+			Columns: []string{s3key.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   s3key.UserTable,
+			Columns: []string{s3key.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &S3Key{config: suo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, suo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{s3key.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	suo.mutation.done = true
+	return _node, nil
+}
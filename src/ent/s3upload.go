@@ -0,0 +1,179 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"gopan-server/ent/s3upload"
+	"gopan-server/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// S3Upload is the model entity for the S3Upload schema.
+type S3Upload struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UploadID holds the value of the "upload_id" field.
+	UploadID string `json:"upload_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// Bucket holds the value of the "bucket" field.
+	Bucket string `json:"bucket,omitempty"`
+	// Key holds the value of the "key" field.
+	Key string `json:"key,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the S3UploadQuery when eager-loading is set.
+	Edges        S3UploadEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// S3UploadEdges holds the relations/edges for other nodes in the graph.
+type S3UploadEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e S3UploadEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*S3Upload) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case s3upload.FieldID, s3upload.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case s3upload.FieldUploadID, s3upload.FieldBucket, s3upload.FieldKey:
+			values[i] = new(sql.NullString)
+		case s3upload.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the S3Upload fields.
+func (s *S3Upload) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case s3upload.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			s.ID = int(value.Int64)
+		case s3upload.FieldUploadID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field upload_id", values[i])
+			} else if value.Valid {
+				s.UploadID = value.String
+			}
+		case s3upload.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				s.UserID = int(value.Int64)
+			}
+		case s3upload.FieldBucket:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field bucket", values[i])
+			} else if value.Valid {
+				s.Bucket = value.String
+			}
+		case s3upload.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				s.Key = value.String
+			}
+		case s3upload.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				s.CreatedAt = value.Time
+			}
+		default:
+			s.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the S3Upload.
+// This includes values selected through modifiers, order, etc.
+func (s *S3Upload) Value(name string) (ent.Value, error) {
+	return s.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the S3Upload entity.
+func (s *S3Upload) QueryUser() *UserQuery {
+	return NewS3UploadClient(s.config).QueryUser(s)
+}
+
+// Update returns a builder for updating this S3Upload.
+// Note that you need to call S3Upload.Unwrap() before calling this method if this S3Upload
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (s *S3Upload) Update() *S3UploadUpdateOne {
+	return NewS3UploadClient(s.config).UpdateOne(s)
+}
+
+// Unwrap unwraps the S3Upload entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (s *S3Upload) Unwrap() *S3Upload {
+	_tx, ok := s.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: S3Upload is not a transactional entity")
+	}
+	s.config.driver = _tx.drv
+	return s
+}
+
+// String implements the fmt.Stringer.
+func (s *S3Upload) String() string {
+	var builder strings.Builder
+	builder.WriteString("S3Upload(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", s.ID))
+	builder.WriteString("upload_id=")
+	builder.WriteString(s.UploadID)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", s.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("bucket=")
+	builder.WriteString(s.Bucket)
+	builder.WriteString(", ")
+	builder.WriteString("key=")
+	builder.WriteString(s.Key)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(s.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// S3Uploads is a parsable slice of S3Upload.
+type S3Uploads []*S3Upload
@@ -0,0 +1,291 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gopan-server/ent/s3upload"
+	"gopan-server/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// S3UploadCreate is the builder for creating a S3Upload entity.
+type S3UploadCreate struct {
+	config
+	mutation *S3UploadMutation
+	hooks    []Hook
+}
+
+// SetUploadID sets the "upload_id" field.
+func (sc *S3UploadCreate) SetUploadID(s string) *S3UploadCreate {
+	sc.mutation.SetUploadID(s)
+	return sc
+}
+
+// SetUserID sets the "user_id" field.
+func (sc *S3UploadCreate) SetUserID(i int) *S3UploadCreate {
+	sc.mutation.SetUserID(i)
+	return sc
+}
+
+// SetBucket sets the "bucket" field.
+func (sc *S3UploadCreate) SetBucket(s string) *S3UploadCreate {
+	sc.mutation.SetBucket(s)
+	return sc
+}
+
+// SetKey sets the "key" field.
+func (sc *S3UploadCreate) SetKey(s string) *S3UploadCreate {
+	sc.mutation.SetKey(s)
+	return sc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sc *S3UploadCreate) SetCreatedAt(t time.Time) *S3UploadCreate {
+	sc.mutation.SetCreatedAt(t)
+	return sc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sc *S3UploadCreate) SetNillableCreatedAt(t *time.Time) *S3UploadCreate {
+	if t != nil {
+		sc.SetCreatedAt(*t)
+	}
+	return sc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (sc *S3UploadCreate) SetUser(u *User) *S3UploadCreate {
+	return sc.SetUserID(u.ID)
+}
+
+// Mutation returns the S3UploadMutation object of the builder.
+func (sc *S3UploadCreate) Mutation() *S3UploadMutation {
+	return sc.mutation
+}
+
+// Save creates the S3Upload in the database.
+func (sc *S3UploadCreate) Save(ctx context.Context) (*S3Upload, error) {
+	sc.defaults()
+	return withHooks(ctx, sc.sqlSave, sc.mutation, sc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sc *S3UploadCreate) SaveX(ctx context.Context) *S3Upload {
+	v, err := sc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sc *S3UploadCreate) Exec(ctx context.Context) error {
+	_, err := sc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sc *S3UploadCreate) ExecX(ctx context.Context) {
+	if err := sc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sc *S3UploadCreate) defaults() {
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		v := s3upload.DefaultCreatedAt()
+		sc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sc *S3UploadCreate) check() error {
+	if _, ok := sc.mutation.UploadID(); !ok {
+		return &ValidationError{Name: "upload_id", err: errors.New(`ent: missing required field "S3Upload.upload_id"`)}
+	}
+	if v, ok := sc.mutation.UploadID(); ok {
+		if err := s3upload.UploadIDValidator(v); err != nil {
+			return &ValidationError{Name: "upload_id", err: fmt.Errorf(`ent: validator failed for field "S3Upload.upload_id": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "S3Upload.user_id"`)}
+	}
+	if _, ok := sc.mutation.Bucket(); !ok {
+		return &ValidationError{Name: "bucket", err: errors.New(`ent: missing required field "S3Upload.bucket"`)}
+	}
+	if v, ok := sc.mutation.Bucket(); ok {
+		if err := s3upload.BucketValidator(v); err != nil {
+			return &ValidationError{Name: "bucket", err: fmt.Errorf(`ent: validator failed for field "S3Upload.bucket": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "S3Upload.key"`)}
+	}
+	if v, ok := sc.mutation.Key(); ok {
+		if err := s3upload.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "S3Upload.key": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "S3Upload.created_at"`)}
+	}
+	if len(sc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "S3Upload.user"`)}
+	}
+	return nil
+}
+
+func (sc *S3UploadCreate) sqlSave(ctx context.Context) (*S3Upload, error) {
+	if err := sc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	sc.mutation.id = &_node.ID
+	sc.mutation.done = true
+	return _node, nil
+}
+
+func (sc *S3UploadCreate) createSpec() (*S3Upload, *sqlgraph.CreateSpec) {
+	var (
+		_node = &S3Upload{config: sc.config}
+		_spec = sqlgraph.NewCreateSpec(s3upload.Table, sqlgraph.NewFieldSpec(s3upload.FieldID, field.TypeInt))
+	)
+	if value, ok := sc.mutation.UploadID(); ok {
+		_spec.SetField(s3upload.FieldUploadID, field.TypeString, value)
+		_node.UploadID = value
+	}
+	if value, ok := sc.mutation.Bucket(); ok {
+		_spec.SetField(s3upload.FieldBucket, field.TypeString, value)
+		_node.Bucket = value
+	}
+	if value, ok := sc.mutation.Key(); ok {
+		_spec.SetField(s3upload.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := sc.mutation.CreatedAt(); ok {
+		_spec.SetField(s3upload.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := sc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   s3upload.UserTable,
+			Columns: []string{s3upload.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// S3UploadCreateBulk is the builder for creating many S3Upload entities in bulk.
+type S3UploadCreateBulk struct {
+	config
+	err      error
+	builders []*S3UploadCreate
+}
+
+// Save creates the S3Upload entities in the database.
+func (scb *S3UploadCreateBulk) Save(ctx context.Context) ([]*S3Upload, error) {
+	if scb.err != nil {
+		return nil, scb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(scb.builders))
+	nodes := make([]*S3Upload, len(scb.builders))
+	mutators := make([]Mutator, len(scb.builders))
+	for i := range scb.builders {
+		func(i int, root context.Context) {
+			builder := scb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*S3UploadMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, scb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, scb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, scb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scb *S3UploadCreateBulk) SaveX(ctx context.Context) []*S3Upload {
+	v, err := scb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scb *S3UploadCreateBulk) Exec(ctx context.Context) error {
+	_, err := scb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scb *S3UploadCreateBulk) ExecX(ctx context.Context) {
+	if err := scb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,473 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gopan-server/ent/predicate"
+	"gopan-server/ent/s3upload"
+	"gopan-server/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// S3UploadUpdate is the builder for updating S3Upload entities.
+type S3UploadUpdate struct {
+	config
+	hooks    []Hook
+	mutation *S3UploadMutation
+}
+
+// Where appends a list predicates to the S3UploadUpdate builder.
+func (su *S3UploadUpdate) Where(ps ...predicate.S3Upload) *S3UploadUpdate {
+	su.mutation.Where(ps...)
+	return su
+}
+
+// SetUploadID sets the "upload_id" field.
+func (su *S3UploadUpdate) SetUploadID(s string) *S3UploadUpdate {
+	su.mutation.SetUploadID(s)
+	return su
+}
+
+// SetNillableUploadID sets the "upload_id" field if the given value is not nil.
+func (su *S3UploadUpdate) SetNillableUploadID(s *string) *S3UploadUpdate {
+	if s != nil {
+		su.SetUploadID(*s)
+	}
+	return su
+}
+
+// SetUserID sets the "user_id" field.
+func (su *S3UploadUpdate) SetUserID(i int) *S3UploadUpdate {
+	su.mutation.SetUserID(i)
+	return su
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (su *S3UploadUpdate) SetNillableUserID(i *int) *S3UploadUpdate {
+	if i != nil {
+		su.SetUserID(*i)
+	}
+	return su
+}
+
+// SetBucket sets the "bucket" field.
+func (su *S3UploadUpdate) SetBucket(s string) *S3UploadUpdate {
+	su.mutation.SetBucket(s)
+	return su
+}
+
+// SetNillableBucket sets the "bucket" field if the given value is not nil.
+func (su *S3UploadUpdate) SetNillableBucket(s *string) *S3UploadUpdate {
+	if s != nil {
+		su.SetBucket(*s)
+	}
+	return su
+}
+
+// SetKey sets the "key" field.
+func (su *S3UploadUpdate) SetKey(s string) *S3UploadUpdate {
+	su.mutation.SetKey(s)
+	return su
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (su *S3UploadUpdate) SetNillableKey(s *string) *S3UploadUpdate {
+	if s != nil {
+		su.SetKey(*s)
+	}
+	return su
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (su *S3UploadUpdate) SetCreatedAt(t time.Time) *S3UploadUpdate {
+	su.mutation.SetCreatedAt(t)
+	return su
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (su *S3UploadUpdate) SetNillableCreatedAt(t *time.Time) *S3UploadUpdate {
+	if t != nil {
+		su.SetCreatedAt(*t)
+	}
+	return su
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (su *S3UploadUpdate) SetUser(u *User) *S3UploadUpdate {
+	return su.SetUserID(u.ID)
+}
+
+// Mutation returns the S3UploadMutation object of the builder.
+func (su *S3UploadUpdate) Mutation() *S3UploadMutation {
+	return su.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (su *S3UploadUpdate) ClearUser() *S3UploadUpdate {
+	su.mutation.ClearUser()
+	return su
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (su *S3UploadUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, su.sqlSave, su.mutation, su.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (su *S3UploadUpdate) SaveX(ctx context.Context) int {
+	affected, err := su.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (su *S3UploadUpdate) Exec(ctx context.Context) error {
+	_, err := su.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (su *S3UploadUpdate) ExecX(ctx context.Context) {
+	if err := su.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (su *S3UploadUpdate) check() error {
+	if v, ok := su.mutation.UploadID(); ok {
+		if err := s3upload.UploadIDValidator(v); err != nil {
+			return &ValidationError{Name: "upload_id", err: fmt.Errorf(`ent: validator failed for field "S3Upload.upload_id": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.Bucket(); ok {
+		if err := s3upload.BucketValidator(v); err != nil {
+			return &ValidationError{Name: "bucket", err: fmt.Errorf(`ent: validator failed for field "S3Upload.bucket": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.Key(); ok {
+		if err := s3upload.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "S3Upload.key": %w`, err)}
+		}
+	}
+	if su.mutation.UserCleared() && len(su.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "S3Upload.user"`)
+	}
+	return nil
+}
+
+func (su *S3UploadUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := su.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(s3upload.Table, s3upload.Columns, sqlgraph.NewFieldSpec(s3upload.FieldID, field.TypeInt))
+	if ps := su.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := su.mutation.UploadID(); ok {
+		_spec.SetField(s3upload.FieldUploadID, field.TypeString, value)
+	}
+	if value, ok := su.mutation.Bucket(); ok {
+		_spec.SetField(s3upload.FieldBucket, field.TypeString, value)
+	}
+	if value, ok := su.mutation.Key(); ok {
+		_spec.SetField(s3upload.FieldKey, field.TypeString, value)
+	}
+	if value, ok := su.mutation.CreatedAt(); ok {
+		_spec.SetField(s3upload.FieldCreatedAt, field.TypeTime, value)
+	}
+	if su.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   s3upload.UserTable,
+			Columns: []string{s3upload.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   s3upload.UserTable,
+			Columns: []string{s3upload.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{s3upload.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	su.mutation.done = true
+	return n, nil
+}
+
+// S3UploadUpdateOne is the builder for updating a single S3Upload entity.
+type S3UploadUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *S3UploadMutation
+}
+
+// SetUploadID sets the "upload_id" field.
+func (suo *S3UploadUpdateOne) SetUploadID(s string) *S3UploadUpdateOne {
+	suo.mutation.SetUploadID(s)
+	return suo
+}
+
+// SetNillableUploadID sets the "upload_id" field if the given value is not nil.
+func (suo *S3UploadUpdateOne) SetNillableUploadID(s *string) *S3UploadUpdateOne {
+	if s != nil {
+		suo.SetUploadID(*s)
+	}
+	return suo
+}
+
+// SetUserID sets the "user_id" field.
+func (suo *S3UploadUpdateOne) SetUserID(i int) *S3UploadUpdateOne {
+	suo.mutation.SetUserID(i)
+	return suo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (suo *S3UploadUpdateOne) SetNillableUserID(i *int) *S3UploadUpdateOne {
+	if i != nil {
+		suo.SetUserID(*i)
+	}
+	return suo
+}
+
+// SetBucket sets the "bucket" field.
+func (suo *S3UploadUpdateOne) SetBucket(s string) *S3UploadUpdateOne {
+	suo.mutation.SetBucket(s)
+	return suo
+}
+
+// SetNillableBucket sets the "bucket" field if the given value is not nil.
+func (suo *S3UploadUpdateOne) SetNillableBucket(s *string) *S3UploadUpdateOne {
+	if s != nil {
+		suo.SetBucket(*s)
+	}
+	return suo
+}
+
+// SetKey sets the "key" field.
+func (suo *S3UploadUpdateOne) SetKey(s string) *S3UploadUpdateOne {
+	suo.mutation.SetKey(s)
+	return suo
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (suo *S3UploadUpdateOne) SetNillableKey(s *string) *S3UploadUpdateOne {
+	if s != nil {
+		suo.SetKey(*s)
+	}
+	return suo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (suo *S3UploadUpdateOne) SetCreatedAt(t time.Time) *S3UploadUpdateOne {
+	suo.mutation.SetCreatedAt(t)
+	return suo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (suo *S3UploadUpdateOne) SetNillableCreatedAt(t *time.Time) *S3UploadUpdateOne {
+	if t != nil {
+		suo.SetCreatedAt(*t)
+	}
+	return suo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (suo *S3UploadUpdateOne) SetUser(u *User) *S3UploadUpdateOne {
+	return suo.SetUserID(u.ID)
+}
+
+// Mutation returns the S3UploadMutation object of the builder.
+func (suo *S3UploadUpdateOne) Mutation() *S3UploadMutation {
+	return suo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (suo *S3UploadUpdateOne) ClearUser() *S3UploadUpdateOne {
+	suo.mutation.ClearUser()
+	return suo
+}
+
+// Where appends a list predicates to the S3UploadUpdate builder.
+func (suo *S3UploadUpdateOne) Where(ps ...predicate.S3Upload) *S3UploadUpdateOne {
+	suo.mutation.Where(ps...)
+	return suo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (suo *S3UploadUpdateOne) Select(field string, fields ...string) *S3UploadUpdateOne {
+	suo.fields = append([]string{field}, fields...)
+	return suo
+}
+
+// Save executes the query and returns the updated S3Upload entity.
+func (suo *S3UploadUpdateOne) Save(ctx context.Context) (*S3Upload, error) {
+	return withHooks(ctx, suo.sqlSave, suo.mutation, suo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (suo *S3UploadUpdateOne) SaveX(ctx context.Context) *S3Upload {
+	node, err := suo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (suo *S3UploadUpdateOne) Exec(ctx context.Context) error {
+	_, err := suo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (suo *S3UploadUpdateOne) ExecX(ctx context.Context) {
+	if err := suo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (suo *S3UploadUpdateOne) check() error {
+	if v, ok := suo.mutation.UploadID(); ok {
+		if err := s3upload.UploadIDValidator(v); err != nil {
+			return &ValidationError{Name: "upload_id", err: fmt.Errorf(`ent: validator failed for field "S3Upload.upload_id": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.Bucket(); ok {
+		if err := s3upload.BucketValidator(v); err != nil {
+			return &ValidationError{Name: "bucket", err: fmt.Errorf(`ent: validator failed for field "S3Upload.bucket": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.Key(); ok {
+		if err := s3upload.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "S3Upload.key": %w`, err)}
+		}
+	}
+	if suo.mutation.UserCleared() && len(suo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "S3Upload.user"`)
+	}
+	return nil
+}
+
+func (suo *S3UploadUpdateOne) sqlSave(ctx context.Context) (_node *S3Upload, err error) {
+	if err := suo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(s3upload.Table, s3upload.Columns, sqlgraph.NewFieldSpec(s3upload.FieldID, field.TypeInt))
+	id, ok := suo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "S3Upload.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := suo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, s3upload.FieldID)
+		for _, f := range fields {
+			if !s3upload.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != s3upload.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := suo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := suo.mutation.UploadID(); ok {
+		_spec.SetField(s3upload.FieldUploadID, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.Bucket(); ok {
+		_spec.SetField(s3upload.FieldBucket, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.Key(); ok {
+		_spec.SetField(s3upload.FieldKey, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.CreatedAt(); ok {
+		_spec.SetField(s3upload.FieldCreatedAt, field.TypeTime, value)
+	}
+	if suo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   s3upload.UserTable,
+			Columns: []string{s3upload.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   s3upload.UserTable,
+			Columns: []string{s3upload.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &S3Upload{config: suo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, suo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{s3upload.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	suo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// AccessToken holds the schema definition for the AccessToken entity.
+// Personal access tokens let scripts call the API without a login session.
+type AccessToken struct {
+	ent.Schema
+}
+
+// Fields of the AccessToken.
+func (AccessToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		field.String("name").NotEmpty().MaxLen(100),
+		field.String("token_hash").Unique().NotEmpty().Sensitive().Comment("SHA-256 of the token"),
+		field.String("token_prefix").Comment("First characters of the token, to recognise it in lists"),
+		field.Enum("scope").Values("full", "read", "upload").Default("full").Comment("read: GET requests only, upload: uploads only"),
+		field.Int("folder_id").Optional().Nillable().Comment("Folder the token is restricted to, empty for all files"),
+		field.Time("expires_at").Optional().Nillable(),
+		field.Time("last_used_at").Optional().Nillable(),
+		field.String("last_used_ip").Optional(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the AccessToken.
+func (AccessToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("access_tokens").Field("user_id").Required().Unique(),
+		edge.From("folder", Node.Type).Ref("access_tokens").Field("folder_id").Unique(),
+	}
+}
+
+// Indexes of the AccessToken.
+func (AccessToken) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
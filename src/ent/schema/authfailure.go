@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// AuthFailure holds the schema definition for the AuthFailure entity.
+// Every failed or throttled login and share password attempt is recorded for auditing.
+type AuthFailure struct {
+	ent.Schema
+}
+
+// Fields of the AuthFailure.
+func (AuthFailure) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("kind").Values("login", "two_factor", "share").Comment("What was attempted"),
+		field.String("subject").Comment("Username or share code the attempt was for"),
+		field.Int("user_id").Optional().Nillable().Comment("User the attempt was for, if known"),
+		field.Enum("reason").Values("invalid_credentials", "invalid_code", "wrong_password", "throttled", "locked"),
+		field.String("ip").Optional(),
+		field.String("user_agent").Optional().MaxLen(512),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Indexes of the AuthFailure.
+func (AuthFailure) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+		index.Fields("subject", "created_at"),
+		index.Fields("ip", "created_at"),
+	}
+}
@@ -0,0 +1,33 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// AuthThrottle holds the schema definition for the AuthThrottle entity.
+// A throttle counts recent failed attempts for one username, IP or share, so
+// all server instances apply the same backoff and lockout.
+type AuthThrottle struct {
+	ent.Schema
+}
+
+// Fields of the AuthThrottle.
+func (AuthThrottle) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("key").Unique().NotEmpty().Comment("Throttled subject, e.g. user:alice, ip:10.0.0.1 or share:42"),
+		field.Int("failures").Default(0).Comment("Failed attempts since the last reset"),
+		field.Time("last_failure_at").Default(time.Now),
+		field.Time("blocked_until").Optional().Nillable().Comment("Attempts are rejected until this time"),
+		field.Bool("locked").Default(false).Comment("Whether the block is a lockout rather than a backoff delay"),
+	}
+}
+
+// Indexes of the AuthThrottle.
+func (AuthThrottle) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("last_failure_at"),
+	}
+}
@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// Change holds the schema definition for the Change entity.
+// Changes form the journal of a user's files, which sync clients read to
+// catch up without listing everything again.
+type Change struct {
+	ent.Schema
+}
+
+// Fields of the Change.
+func (Change) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		field.Int64("seq").Comment("Position in the journal of the user, counting up from 1"),
+		field.Enum("kind").Values("create", "update", "move", "rename", "trash", "restore", "purge"),
+		field.Int("node_id").Comment("Not an edge, as purged nodes are gone"),
+		field.Int("parent_id").Optional().Nillable().Comment("Parent folder after the change, nil for the top level"),
+		field.String("name"),
+		field.Int("type").Comment("0: folder, 1: file"),
+		field.Int64("size").Default(0),
+		field.String("file_hash").Optional(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the Change.
+func (Change) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("changes").Field("user_id").Required().Unique(),
+	}
+}
+
+// Indexes of the Change.
+func (Change) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "seq").Unique(),
+		index.Fields("created_at"),
+	}
+}
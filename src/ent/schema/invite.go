@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"time"
+)
+
+// Invite holds the schema definition for the Invite entity.
+// An invite code lets someone register while registration is invite-only.
+type Invite struct {
+	ent.Schema
+}
+
+// Fields of the Invite.
+func (Invite) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("code").Unique().NotEmpty(),
+		field.String("note").Optional().Comment("Who or what the invite is for"),
+		field.Enum("role").Values("admin", "user", "readonly").Default("user").Comment("Role of users registered with the invite"),
+		field.Int64("total_quota").Optional().Nillable().Comment("Storage quota in bytes, nil for registration.default_quota"),
+		field.Int("max_uses").Default(1).NonNegative().Comment("0 for unlimited"),
+		field.Int("uses").Default(0),
+		field.Time("expires_at").Optional().Nillable(),
+		field.Int("created_by").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the Invite.
+func (Invite) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("creator", User.Type).Ref("invites").Field("created_by").Unique().
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+	}
+}
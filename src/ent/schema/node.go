@@ -2,8 +2,10 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"time"
 )
 
@@ -35,5 +37,18 @@ func (Node) Edges() []ent.Edge {
 		edge.To("parent", Node.Type).Unique(),
 		edge.From("children", Node.Type).Ref("parent"),
 		edge.To("shares", Share.Type),
+		edge.To("permissions", NodePermission.Type),
+		edge.To("share_accesses", ShareAccess.Type),
+		// Tokens restricted to a deleted folder must not outlive it
+		edge.To("access_tokens", AccessToken.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the Node.
+func (Node) Indexes() []ent.Index {
+	return []ent.Index{
+		// Resolving a path looks up each element by name in its folder
+		index.Fields("name").Edges("parent"),
+		index.Edges("owner"),
 	}
 }
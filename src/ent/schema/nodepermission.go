@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"time"
+)
+
+// NodePermission holds the schema definition for the NodePermission entity.
+// A permission grants a user or a group access to a node (and its subtree)
+// owned by someone else.
+type NodePermission struct {
+	ent.Schema
+}
+
+// Fields of the NodePermission.
+func (NodePermission) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("role").Values("read", "write").Default("read").Comment("read: view and download, write: also upload, rename and delete"),
+		field.Time("created_at").Default(time.Now),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the NodePermission.
+func (NodePermission) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("node", Node.Type).Ref("permissions").Required().Unique(),
+		edge.From("granted_by", User.Type).Ref("granted_permissions").Required().Unique(),
+		edge.From("user", User.Type).Ref("node_permissions").Unique().Comment("Grantee user, empty when granted to a group"),
+		edge.From("group", Group.Type).Ref("node_permissions").Unique().Comment("Grantee group, empty when granted to a user"),
+	}
+}
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// S3Key holds the schema definition for the S3Key entity.
+// S3 access keys let S3 clients reach the files of a user through the S3 gateway.
+type S3Key struct {
+	ent.Schema
+}
+
+// Fields of the S3Key.
+func (S3Key) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		field.String("name").NotEmpty().MaxLen(100),
+		field.String("access_key").Unique().NotEmpty(),
+		field.String("secret_key").NotEmpty().Sensitive().Comment("Kept in the clear, as SigV4 signatures are verified with it"),
+		field.Bool("read_only").Default(false),
+		field.Time("last_used_at").Optional().Nillable(),
+		field.String("last_used_ip").Optional(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the S3Key.
+func (S3Key) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("s3_keys").Field("user_id").Required().Unique(),
+	}
+}
+
+// Indexes of the S3Key.
+func (S3Key) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
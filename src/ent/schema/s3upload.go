@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// S3Upload holds the schema definition for the S3Upload entity.
+// An S3 upload is a multipart upload in progress, whose parts are kept in
+// MinIO until the upload is completed or aborted.
+type S3Upload struct {
+	ent.Schema
+}
+
+// Fields of the S3Upload.
+func (S3Upload) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("upload_id").Unique().NotEmpty(),
+		field.Int("user_id"),
+		field.String("bucket").NotEmpty(),
+		field.String("key").NotEmpty(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the S3Upload.
+func (S3Upload) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("s3_uploads").Field("user_id").Required().Unique(),
+	}
+}
+
+// Indexes of the S3Upload.
+func (S3Upload) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+		index.Fields("created_at"),
+	}
+}
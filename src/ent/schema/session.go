@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// Session holds the schema definition for the Session entity.
+// A session is created on login and holds the refresh token of one device.
+type Session struct {
+	ent.Schema
+}
+
+// Fields of the Session.
+func (Session) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		field.String("refresh_token_hash").Unique().NotEmpty().Sensitive().Comment("SHA-256 of the current refresh token"),
+		field.String("previous_token_hash").Optional().Sensitive().Comment("SHA-256 of the refresh token replaced by the last rotation"),
+		field.String("ip").Optional(),
+		field.String("user_agent").Optional().MaxLen(512),
+		field.Time("created_at").Default(time.Now),
+		field.Time("last_used_at").Default(time.Now),
+		field.Time("expires_at"),
+		field.Time("revoked_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the Session.
+func (Session) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("sessions").Field("user_id").Required().Unique(),
+	}
+}
+
+// Indexes of the Session.
+func (Session) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+		index.Fields("previous_token_hash"),
+	}
+}
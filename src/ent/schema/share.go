@@ -2,6 +2,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"time"
@@ -16,11 +17,16 @@ type Share struct {
 func (Share) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("code").Unique().NotEmpty().Comment("Unique share code"),
+		field.String("slug").Optional().Nillable().Unique().Comment("Optional owner-chosen link name, usable instead of the code"),
 		field.Int("share_type").Default(0).Comment("0: permanent, 1: temporary"),
 		field.Time("expires_at").Optional().Comment("Expiration time for temporary shares"),
-		field.String("password").Optional().Comment("Optional password for share"),
-		field.Int("access_count").Default(0).Comment("Number of times accessed"),
-		field.Int("max_access_count").Optional().Comment("Maximum access count, 0 for unlimited"),
+		field.String("password").Optional().Comment("Optional password (extraction code) for share"),
+		field.Int("access_count").Default(0).Comment("Number of times the share was viewed"),
+		field.Int("max_access_count").Optional().Comment("Maximum view count, 0 for unlimited"),
+		field.Int("download_count").Default(0).Comment("Number of downloads via the share"),
+		field.Int("max_download_count").Optional().Comment("Maximum download count, 0 for unlimited"),
+		field.Enum("status").Values("active", "expired", "exhausted", "suspended").Default("active").Comment("suspended: shared node is in trash"),
+		field.Time("disabled_at").Optional().Nillable().Comment("When the share expired or was exhausted"),
 		field.Time("created_at").Default(time.Now),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 	}
@@ -31,6 +37,7 @@ func (Share) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("owner", User.Type).Ref("shares").Required().Unique(),
 		edge.From("node", Node.Type).Ref("shares").Required().Unique(),
+		edge.To("accesses", ShareAccess.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
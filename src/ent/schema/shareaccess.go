@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// ShareAccess holds the schema definition for the ShareAccess entity.
+// Every public access to a share is recorded as one event.
+type ShareAccess struct {
+	ent.Schema
+}
+
+// Fields of the ShareAccess.
+func (ShareAccess) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("share_id"),
+		field.Int("node_id").Optional().Nillable().Comment("Node accessed, empty if the node was deleted"),
+		field.Enum("action").Values("view", "list", "preview", "download"),
+		field.String("ip").Optional(),
+		field.String("user_agent").Optional().MaxLen(512),
+		field.Int64("bytes").Default(0).Comment("Bytes served by this access"),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the ShareAccess.
+func (ShareAccess) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("share", Share.Type).Ref("accesses").Field("share_id").Required().Unique(),
+		edge.From("node", Node.Type).Ref("share_accesses").Field("node_id").Unique(),
+	}
+}
+
+// Indexes of the ShareAccess.
+func (ShareAccess) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("share_id", "created_at"),
+	}
+}
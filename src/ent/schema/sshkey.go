@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// SSHKey holds the schema definition for the SSHKey entity.
+// SSH public keys let a user sign in to the SFTP server without a password.
+type SSHKey struct {
+	ent.Schema
+}
+
+// Fields of the SSHKey.
+func (SSHKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		field.String("name").NotEmpty().MaxLen(100),
+		field.Text("public_key").NotEmpty().Comment("In authorized_keys format, without options"),
+		field.String("fingerprint").NotEmpty().Comment("SHA256 fingerprint as shown by ssh-keygen -l"),
+		field.Time("last_used_at").Optional().Nillable(),
+		field.String("last_used_ip").Optional(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the SSHKey.
+func (SSHKey) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("ssh_keys").Field("user_id").Required().Unique(),
+	}
+}
+
+// Indexes of the SSHKey.
+func (SSHKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "fingerprint").Unique(),
+	}
+}
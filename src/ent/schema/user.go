@@ -2,6 +2,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"time"
@@ -18,11 +19,21 @@ func (User) Fields() []ent.Field {
 		field.String("username").Unique().NotEmpty(),
 		field.String("password_hash").NotEmpty().Comment("bcrypt hashed password"),
 		field.String("email").Optional(),
-		field.Int64("total_quota").Default(10737418240).Comment("Total storage quota in bytes, default 10GB"),
+		field.Bool("email_verified").Default(false).Comment("Whether the owner confirmed the email through a verification link"),
+		field.Enum("role").Values("admin", "user", "readonly").Default("user").Comment("admin: manages users, readonly: cannot modify files"),
+		field.Bool("is_disabled").Default(false).Comment("Disabled users cannot log in"),
+		field.Enum("auth_source").Values("local", "ldap").Default("local").Comment("local: bcrypt password, ldap: password checked by the directory"),
+		field.String("ldap_dn").Optional().Comment("Distinguished name of directory users"),
+		field.Int64("total_quota").Comment("Total storage quota in bytes, new users get registration.default_quota"),
 		field.Int64("total_used").Default(0).Comment("Total used storage in bytes"),
 		field.Time("created_at").Default(time.Now),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 		field.Time("last_login_at").Optional(),
+		field.String("totp_secret").Optional().Sensitive().Comment("Base32 TOTP secret, set during enrollment"),
+		field.Bool("totp_enabled").Default(false).Comment("Whether login requires a TOTP code"),
+		field.Int64("totp_last_step").Default(0).Comment("Last accepted TOTP time step, to reject replayed codes"),
+		field.Strings("totp_recovery_codes").Optional().Sensitive().Comment("SHA-256 of unused recovery codes"),
+		field.Int64("change_seq").Default(0).Comment("Sequence number of the latest entry in the change journal"),
 	}
 }
 
@@ -31,5 +42,18 @@ func (User) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("nodes", Node.Type),
 		edge.To("shares", Share.Type),
+		edge.To("owned_groups", Group.Type),
+		edge.From("groups", Group.Type).Ref("members"),
+		edge.To("granted_permissions", NodePermission.Type),
+		edge.To("node_permissions", NodePermission.Type),
+		edge.To("sessions", Session.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("access_tokens", AccessToken.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("identities", UserIdentity.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("tokens", UserToken.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("invites", Invite.Type),
+		edge.To("s3_keys", S3Key.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("s3_uploads", S3Upload.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("ssh_keys", SSHKey.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("changes", Change.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
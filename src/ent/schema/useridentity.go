@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// UserIdentity holds the schema definition for the UserIdentity entity.
+// An identity links a user to an account of an external identity provider.
+type UserIdentity struct {
+	ent.Schema
+}
+
+// Fields of the UserIdentity.
+func (UserIdentity) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		field.String("provider").NotEmpty().Comment("Kind of identity provider, e.g. oidc"),
+		field.String("issuer").NotEmpty().Comment("Issuer the subject belongs to"),
+		field.String("subject").NotEmpty().Comment("Stable account ID at the issuer"),
+		field.String("email").Optional(),
+		field.Time("created_at").Default(time.Now),
+		field.Time("last_login_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the UserIdentity.
+func (UserIdentity) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("identities").Field("user_id").Required().Unique(),
+	}
+}
+
+// Indexes of the UserIdentity.
+func (UserIdentity) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("issuer", "subject").Unique(),
+		index.Fields("user_id"),
+	}
+}
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// UserToken holds the schema definition for the UserToken entity.
+// A user token is a single-use secret sent by email, e.g. to reset a password.
+type UserToken struct {
+	ent.Schema
+}
+
+// Fields of the UserToken.
+func (UserToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		field.Enum("purpose").Values("password_reset", "email_verification"),
+		field.String("token_hash").Unique().NotEmpty().Sensitive().Comment("SHA-256 of the token"),
+		field.String("email").Comment("Address the token was sent to"),
+		field.Time("created_at").Default(time.Now),
+		field.Time("expires_at"),
+		field.Time("used_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the UserToken.
+func (UserToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("tokens").Field("user_id").Required().Unique(),
+	}
+}
+
+// Indexes of the UserToken.
+func (UserToken) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "purpose"),
+		index.Fields("expires_at"),
+	}
+}
@@ -21,16 +21,26 @@ type Share struct {
 	ID int `json:"id,omitempty"`
 	// Unique share code
 	Code string `json:"code,omitempty"`
+	// Optional owner-chosen link name, usable instead of the code
+	Slug *string `json:"slug,omitempty"`
 	// 0: permanent, 1: temporary
 	ShareType int `json:"share_type,omitempty"`
 	// Expiration time for temporary shares
 	ExpiresAt time.Time `json:"expires_at,omitempty"`
-	// Optional password for share
+	// Optional password (extraction code) for share
 	Password string `json:"password,omitempty"`
-	// Number of times accessed
+	// Number of times the share was viewed
 	AccessCount int `json:"access_count,omitempty"`
-	// Maximum access count, 0 for unlimited
+	// Maximum view count, 0 for unlimited
 	MaxAccessCount int `json:"max_access_count,omitempty"`
+	// Number of downloads via the share
+	DownloadCount int `json:"download_count,omitempty"`
+	// Maximum download count, 0 for unlimited
+	MaxDownloadCount int `json:"max_download_count,omitempty"`
+	// suspended: shared node is in trash
+	Status share.Status `json:"status,omitempty"`
+	// When the share expired or was exhausted
+	DisabledAt *time.Time `json:"disabled_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -49,9 +59,11 @@ type ShareEdges struct {
 	Owner *User `json:"owner,omitempty"`
 	// Node holds the value of the node edge.
 	Node *Node `json:"node,omitempty"`
+	// Accesses holds the value of the accesses edge.
+	Accesses []*ShareAccess `json:"accesses,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // OwnerOrErr returns the Owner value or an error if the edge
@@ -76,16 +88,25 @@ func (e ShareEdges) NodeOrErr() (*Node, error) {
 	return nil, &NotLoadedError{edge: "node"}
 }
 
+// AccessesOrErr returns the Accesses value or an error if the edge
+// was not loaded in eager-loading.
+func (e ShareEdges) AccessesOrErr() ([]*ShareAccess, error) {
+	if e.loadedTypes[2] {
+		return e.Accesses, nil
+	}
+	return nil, &NotLoadedError{edge: "accesses"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Share) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case share.FieldID, share.FieldShareType, share.FieldAccessCount, share.FieldMaxAccessCount:
+		case share.FieldID, share.FieldShareType, share.FieldAccessCount, share.FieldMaxAccessCount, share.FieldDownloadCount, share.FieldMaxDownloadCount:
 			values[i] = new(sql.NullInt64)
-		case share.FieldCode, share.FieldPassword:
+		case share.FieldCode, share.FieldSlug, share.FieldPassword, share.FieldStatus:
 			values[i] = new(sql.NullString)
-		case share.FieldExpiresAt, share.FieldCreatedAt, share.FieldUpdatedAt:
+		case share.FieldExpiresAt, share.FieldDisabledAt, share.FieldCreatedAt, share.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		case share.ForeignKeys[0]: // node_shares
 			values[i] = new(sql.NullInt64)
@@ -118,6 +139,13 @@ func (s *Share) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				s.Code = value.String
 			}
+		case share.FieldSlug:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field slug", values[i])
+			} else if value.Valid {
+				s.Slug = new(string)
+				*s.Slug = value.String
+			}
 		case share.FieldShareType:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field share_type", values[i])
@@ -148,6 +176,31 @@ func (s *Share) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				s.MaxAccessCount = int(value.Int64)
 			}
+		case share.FieldDownloadCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field download_count", values[i])
+			} else if value.Valid {
+				s.DownloadCount = int(value.Int64)
+			}
+		case share.FieldMaxDownloadCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_download_count", values[i])
+			} else if value.Valid {
+				s.MaxDownloadCount = int(value.Int64)
+			}
+		case share.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				s.Status = share.Status(value.String)
+			}
+		case share.FieldDisabledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field disabled_at", values[i])
+			} else if value.Valid {
+				s.DisabledAt = new(time.Time)
+				*s.DisabledAt = value.Time
+			}
 		case share.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -197,6 +250,11 @@ func (s *Share) QueryNode() *NodeQuery {
 	return NewShareClient(s.config).QueryNode(s)
 }
 
+// QueryAccesses queries the "accesses" edge of the Share entity.
+func (s *Share) QueryAccesses() *ShareAccessQuery {
+	return NewShareClient(s.config).QueryAccesses(s)
+}
+
 // Update returns a builder for updating this Share.
 // Note that you need to call Share.Unwrap() before calling this method if this Share
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -223,6 +281,11 @@ func (s *Share) String() string {
 	builder.WriteString("code=")
 	builder.WriteString(s.Code)
 	builder.WriteString(", ")
+	if v := s.Slug; v != nil {
+		builder.WriteString("slug=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("share_type=")
 	builder.WriteString(fmt.Sprintf("%v", s.ShareType))
 	builder.WriteString(", ")
@@ -238,6 +301,20 @@ func (s *Share) String() string {
 	builder.WriteString("max_access_count=")
 	builder.WriteString(fmt.Sprintf("%v", s.MaxAccessCount))
 	builder.WriteString(", ")
+	builder.WriteString("download_count=")
+	builder.WriteString(fmt.Sprintf("%v", s.DownloadCount))
+	builder.WriteString(", ")
+	builder.WriteString("max_download_count=")
+	builder.WriteString(fmt.Sprintf("%v", s.MaxDownloadCount))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", s.Status))
+	builder.WriteString(", ")
+	if v := s.DisabledAt; v != nil {
+		builder.WriteString("disabled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(s.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
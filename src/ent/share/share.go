@@ -3,6 +3,7 @@
 package share
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -16,6 +17,8 @@ const (
 	FieldID = "id"
 	// FieldCode holds the string denoting the code field in the database.
 	FieldCode = "code"
+	// FieldSlug holds the string denoting the slug field in the database.
+	FieldSlug = "slug"
 	// FieldShareType holds the string denoting the share_type field in the database.
 	FieldShareType = "share_type"
 	// FieldExpiresAt holds the string denoting the expires_at field in the database.
@@ -26,6 +29,14 @@ const (
 	FieldAccessCount = "access_count"
 	// FieldMaxAccessCount holds the string denoting the max_access_count field in the database.
 	FieldMaxAccessCount = "max_access_count"
+	// FieldDownloadCount holds the string denoting the download_count field in the database.
+	FieldDownloadCount = "download_count"
+	// FieldMaxDownloadCount holds the string denoting the max_download_count field in the database.
+	FieldMaxDownloadCount = "max_download_count"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldDisabledAt holds the string denoting the disabled_at field in the database.
+	FieldDisabledAt = "disabled_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -34,6 +45,8 @@ const (
 	EdgeOwner = "owner"
 	// EdgeNode holds the string denoting the node edge name in mutations.
 	EdgeNode = "node"
+	// EdgeAccesses holds the string denoting the accesses edge name in mutations.
+	EdgeAccesses = "accesses"
 	// Table holds the table name of the share in the database.
 	Table = "shares"
 	// OwnerTable is the table that holds the owner relation/edge.
@@ -50,17 +63,29 @@ const (
 	NodeInverseTable = "nodes"
 	// NodeColumn is the table column denoting the node relation/edge.
 	NodeColumn = "node_shares"
+	// AccessesTable is the table that holds the accesses relation/edge.
+	AccessesTable = "share_accesses"
+	// AccessesInverseTable is the table name for the ShareAccess entity.
+	// It exists in this package in order to avoid circular dependency with the "shareaccess" package.
+	AccessesInverseTable = "share_accesses"
+	// AccessesColumn is the table column denoting the accesses relation/edge.
+	AccessesColumn = "share_id"
 )
 
 // Columns holds all SQL columns for share fields.
 var Columns = []string{
 	FieldID,
 	FieldCode,
+	FieldSlug,
 	FieldShareType,
 	FieldExpiresAt,
 	FieldPassword,
 	FieldAccessCount,
 	FieldMaxAccessCount,
+	FieldDownloadCount,
+	FieldMaxDownloadCount,
+	FieldStatus,
+	FieldDisabledAt,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -94,6 +119,8 @@ var (
 	DefaultShareType int
 	// DefaultAccessCount holds the default value on creation for the "access_count" field.
 	DefaultAccessCount int
+	// DefaultDownloadCount holds the default value on creation for the "download_count" field.
+	DefaultDownloadCount int
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -102,6 +129,34 @@ var (
 	UpdateDefaultUpdatedAt func() time.Time
 )
 
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusActive is the default value of the Status enum.
+const DefaultStatus = StatusActive
+
+// Status values.
+const (
+	StatusActive    Status = "active"
+	StatusExpired   Status = "expired"
+	StatusExhausted Status = "exhausted"
+	StatusSuspended Status = "suspended"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusActive, StatusExpired, StatusExhausted, StatusSuspended:
+		return nil
+	default:
+		return fmt.Errorf("share: invalid enum value for status field: %q", s)
+	}
+}
+
 // OrderOption defines the ordering options for the Share queries.
 type OrderOption func(*sql.Selector)
 
@@ -115,6 +170,11 @@ func ByCode(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCode, opts...).ToFunc()
 }
 
+// BySlug orders the results by the slug field.
+func BySlug(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSlug, opts...).ToFunc()
+}
+
 // ByShareType orders the results by the share_type field.
 func ByShareType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldShareType, opts...).ToFunc()
@@ -140,6 +200,26 @@ func ByMaxAccessCount(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMaxAccessCount, opts...).ToFunc()
 }
 
+// ByDownloadCount orders the results by the download_count field.
+func ByDownloadCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDownloadCount, opts...).ToFunc()
+}
+
+// ByMaxDownloadCount orders the results by the max_download_count field.
+func ByMaxDownloadCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxDownloadCount, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByDisabledAt orders the results by the disabled_at field.
+func ByDisabledAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDisabledAt, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -163,6 +243,20 @@ func ByNodeField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newNodeStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByAccessesCount orders the results by accesses count.
+func ByAccessesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAccessesStep(), opts...)
+	}
+}
+
+// ByAccesses orders the results by accesses terms.
+func ByAccesses(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAccessesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newOwnerStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -177,3 +271,10 @@ func newNodeStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, NodeTable, NodeColumn),
 	)
 }
+func newAccessesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AccessesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AccessesTable, AccessesColumn),
+	)
+}
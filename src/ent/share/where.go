@@ -60,6 +60,11 @@ func Code(v string) predicate.Share {
 	return predicate.Share(sql.FieldEQ(FieldCode, v))
 }
 
+// Slug applies equality check predicate on the "slug" field. It's identical to SlugEQ.
+func Slug(v string) predicate.Share {
+	return predicate.Share(sql.FieldEQ(FieldSlug, v))
+}
+
 // ShareType applies equality check predicate on the "share_type" field. It's identical to ShareTypeEQ.
 func ShareType(v int) predicate.Share {
 	return predicate.Share(sql.FieldEQ(FieldShareType, v))
@@ -85,6 +90,21 @@ func MaxAccessCount(v int) predicate.Share {
 	return predicate.Share(sql.FieldEQ(FieldMaxAccessCount, v))
 }
 
+// DownloadCount applies equality check predicate on the "download_count" field. It's identical to DownloadCountEQ.
+func DownloadCount(v int) predicate.Share {
+	return predicate.Share(sql.FieldEQ(FieldDownloadCount, v))
+}
+
+// MaxDownloadCount applies equality check predicate on the "max_download_count" field. It's identical to MaxDownloadCountEQ.
+func MaxDownloadCount(v int) predicate.Share {
+	return predicate.Share(sql.FieldEQ(FieldMaxDownloadCount, v))
+}
+
+// DisabledAt applies equality check predicate on the "disabled_at" field. It's identical to DisabledAtEQ.
+func DisabledAt(v time.Time) predicate.Share {
+	return predicate.Share(sql.FieldEQ(FieldDisabledAt, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Share {
 	return predicate.Share(sql.FieldEQ(FieldCreatedAt, v))
@@ -160,6 +180,81 @@ func CodeContainsFold(v string) predicate.Share {
 	return predicate.Share(sql.FieldContainsFold(FieldCode, v))
 }
 
+// SlugEQ applies the EQ predicate on the "slug" field.
+func SlugEQ(v string) predicate.Share {
+	return predicate.Share(sql.FieldEQ(FieldSlug, v))
+}
+
+// SlugNEQ applies the NEQ predicate on the "slug" field.
+func SlugNEQ(v string) predicate.Share {
+	return predicate.Share(sql.FieldNEQ(FieldSlug, v))
+}
+
+// SlugIn applies the In predicate on the "slug" field.
+func SlugIn(vs ...string) predicate.Share {
+	return predicate.Share(sql.FieldIn(FieldSlug, vs...))
+}
+
+// SlugNotIn applies the NotIn predicate on the "slug" field.
+func SlugNotIn(vs ...string) predicate.Share {
+	return predicate.Share(sql.FieldNotIn(FieldSlug, vs...))
+}
+
+// SlugGT applies the GT predicate on the "slug" field.
+func SlugGT(v string) predicate.Share {
+	return predicate.Share(sql.FieldGT(FieldSlug, v))
+}
+
+// SlugGTE applies the GTE predicate on the "slug" field.
+func SlugGTE(v string) predicate.Share {
+	return predicate.Share(sql.FieldGTE(FieldSlug, v))
+}
+
+// SlugLT applies the LT predicate on the "slug" field.
+func SlugLT(v string) predicate.Share {
+	return predicate.Share(sql.FieldLT(FieldSlug, v))
+}
+
+// SlugLTE applies the LTE predicate on the "slug" field.
+func SlugLTE(v string) predicate.Share {
+	return predicate.Share(sql.FieldLTE(FieldSlug, v))
+}
+
+// SlugContains applies the Contains predicate on the "slug" field.
+func SlugContains(v string) predicate.Share {
+	return predicate.Share(sql.FieldContains(FieldSlug, v))
+}
+
+// SlugHasPrefix applies the HasPrefix predicate on the "slug" field.
+func SlugHasPrefix(v string) predicate.Share {
+	return predicate.Share(sql.FieldHasPrefix(FieldSlug, v))
+}
+
+// SlugHasSuffix applies the HasSuffix predicate on the "slug" field.
+func SlugHasSuffix(v string) predicate.Share {
+	return predicate.Share(sql.FieldHasSuffix(FieldSlug, v))
+}
+
+// SlugIsNil applies the IsNil predicate on the "slug" field.
+func SlugIsNil() predicate.Share {
+	return predicate.Share(sql.FieldIsNull(FieldSlug))
+}
+
+// SlugNotNil applies the NotNil predicate on the "slug" field.
+func SlugNotNil() predicate.Share {
+	return predicate.Share(sql.FieldNotNull(FieldSlug))
+}
+
+// SlugEqualFold applies the EqualFold predicate on the "slug" field.
+func SlugEqualFold(v string) predicate.Share {
+	return predicate.Share(sql.FieldEqualFold(FieldSlug, v))
+}
+
+// SlugContainsFold applies the ContainsFold predicate on the "slug" field.
+func SlugContainsFold(v string) predicate.Share {
+	return predicate.Share(sql.FieldContainsFold(FieldSlug, v))
+}
+
 // ShareTypeEQ applies the EQ predicate on the "share_type" field.
 func ShareTypeEQ(v int) predicate.Share {
 	return predicate.Share(sql.FieldEQ(FieldShareType, v))
@@ -415,6 +510,166 @@ func MaxAccessCountNotNil() predicate.Share {
 	return predicate.Share(sql.FieldNotNull(FieldMaxAccessCount))
 }
 
+// DownloadCountEQ applies the EQ predicate on the "download_count" field.
+func DownloadCountEQ(v int) predicate.Share {
+	return predicate.Share(sql.FieldEQ(FieldDownloadCount, v))
+}
+
+// DownloadCountNEQ applies the NEQ predicate on the "download_count" field.
+func DownloadCountNEQ(v int) predicate.Share {
+	return predicate.Share(sql.FieldNEQ(FieldDownloadCount, v))
+}
+
+// DownloadCountIn applies the In predicate on the "download_count" field.
+func DownloadCountIn(vs ...int) predicate.Share {
+	return predicate.Share(sql.FieldIn(FieldDownloadCount, vs...))
+}
+
+// DownloadCountNotIn applies the NotIn predicate on the "download_count" field.
+func DownloadCountNotIn(vs ...int) predicate.Share {
+	return predicate.Share(sql.FieldNotIn(FieldDownloadCount, vs...))
+}
+
+// DownloadCountGT applies the GT predicate on the "download_count" field.
+func DownloadCountGT(v int) predicate.Share {
+	return predicate.Share(sql.FieldGT(FieldDownloadCount, v))
+}
+
+// DownloadCountGTE applies the GTE predicate on the "download_count" field.
+func DownloadCountGTE(v int) predicate.Share {
+	return predicate.Share(sql.FieldGTE(FieldDownloadCount, v))
+}
+
+// DownloadCountLT applies the LT predicate on the "download_count" field.
+func DownloadCountLT(v int) predicate.Share {
+	return predicate.Share(sql.FieldLT(FieldDownloadCount, v))
+}
+
+// DownloadCountLTE applies the LTE predicate on the "download_count" field.
+func DownloadCountLTE(v int) predicate.Share {
+	return predicate.Share(sql.FieldLTE(FieldDownloadCount, v))
+}
+
+// MaxDownloadCountEQ applies the EQ predicate on the "max_download_count" field.
+func MaxDownloadCountEQ(v int) predicate.Share {
+	return predicate.Share(sql.FieldEQ(FieldMaxDownloadCount, v))
+}
+
+// MaxDownloadCountNEQ applies the NEQ predicate on the "max_download_count" field.
+func MaxDownloadCountNEQ(v int) predicate.Share {
+	return predicate.Share(sql.FieldNEQ(FieldMaxDownloadCount, v))
+}
+
+// MaxDownloadCountIn applies the In predicate on the "max_download_count" field.
+func MaxDownloadCountIn(vs ...int) predicate.Share {
+	return predicate.Share(sql.FieldIn(FieldMaxDownloadCount, vs...))
+}
+
+// MaxDownloadCountNotIn applies the NotIn predicate on the "max_download_count" field.
+func MaxDownloadCountNotIn(vs ...int) predicate.Share {
+	return predicate.Share(sql.FieldNotIn(FieldMaxDownloadCount, vs...))
+}
+
+// MaxDownloadCountGT applies the GT predicate on the "max_download_count" field.
+func MaxDownloadCountGT(v int) predicate.Share {
+	return predicate.Share(sql.FieldGT(FieldMaxDownloadCount, v))
+}
+
+// MaxDownloadCountGTE applies the GTE predicate on the "max_download_count" field.
+func MaxDownloadCountGTE(v int) predicate.Share {
+	return predicate.Share(sql.FieldGTE(FieldMaxDownloadCount, v))
+}
+
+// MaxDownloadCountLT applies the LT predicate on the "max_download_count" field.
+func MaxDownloadCountLT(v int) predicate.Share {
+	return predicate.Share(sql.FieldLT(FieldMaxDownloadCount, v))
+}
+
+// MaxDownloadCountLTE applies the LTE predicate on the "max_download_count" field.
+func MaxDownloadCountLTE(v int) predicate.Share {
+	return predicate.Share(sql.FieldLTE(FieldMaxDownloadCount, v))
+}
+
+// MaxDownloadCountIsNil applies the IsNil predicate on the "max_download_count" field.
+func MaxDownloadCountIsNil() predicate.Share {
+	return predicate.Share(sql.FieldIsNull(FieldMaxDownloadCount))
+}
+
+// MaxDownloadCountNotNil applies the NotNil predicate on the "max_download_count" field.
+func MaxDownloadCountNotNil() predicate.Share {
+	return predicate.Share(sql.FieldNotNull(FieldMaxDownloadCount))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Share {
+	return predicate.Share(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Share {
+	return predicate.Share(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Share {
+	return predicate.Share(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Share {
+	return predicate.Share(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// DisabledAtEQ applies the EQ predicate on the "disabled_at" field.
+func DisabledAtEQ(v time.Time) predicate.Share {
+	return predicate.Share(sql.FieldEQ(FieldDisabledAt, v))
+}
+
+// DisabledAtNEQ applies the NEQ predicate on the "disabled_at" field.
+func DisabledAtNEQ(v time.Time) predicate.Share {
+	return predicate.Share(sql.FieldNEQ(FieldDisabledAt, v))
+}
+
+// DisabledAtIn applies the In predicate on the "disabled_at" field.
+func DisabledAtIn(vs ...time.Time) predicate.Share {
+	return predicate.Share(sql.FieldIn(FieldDisabledAt, vs...))
+}
+
+// DisabledAtNotIn applies the NotIn predicate on the "disabled_at" field.
+func DisabledAtNotIn(vs ...time.Time) predicate.Share {
+	return predicate.Share(sql.FieldNotIn(FieldDisabledAt, vs...))
+}
+
+// DisabledAtGT applies the GT predicate on the "disabled_at" field.
+func DisabledAtGT(v time.Time) predicate.Share {
+	return predicate.Share(sql.FieldGT(FieldDisabledAt, v))
+}
+
+// DisabledAtGTE applies the GTE predicate on the "disabled_at" field.
+func DisabledAtGTE(v time.Time) predicate.Share {
+	return predicate.Share(sql.FieldGTE(FieldDisabledAt, v))
+}
+
+// DisabledAtLT applies the LT predicate on the "disabled_at" field.
+func DisabledAtLT(v time.Time) predicate.Share {
+	return predicate.Share(sql.FieldLT(FieldDisabledAt, v))
+}
+
+// DisabledAtLTE applies the LTE predicate on the "disabled_at" field.
+func DisabledAtLTE(v time.Time) predicate.Share {
+	return predicate.Share(sql.FieldLTE(FieldDisabledAt, v))
+}
+
+// DisabledAtIsNil applies the IsNil predicate on the "disabled_at" field.
+func DisabledAtIsNil() predicate.Share {
+	return predicate.Share(sql.FieldIsNull(FieldDisabledAt))
+}
+
+// DisabledAtNotNil applies the NotNil predicate on the "disabled_at" field.
+func DisabledAtNotNil() predicate.Share {
+	return predicate.Share(sql.FieldNotNull(FieldDisabledAt))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Share {
 	return predicate.Share(sql.FieldEQ(FieldCreatedAt, v))
@@ -541,6 +796,29 @@ func HasNodeWith(preds ...predicate.Node) predicate.Share {
 	})
 }
 
+// HasAccesses applies the HasEdge predicate on the "accesses" edge.
+func HasAccesses() predicate.Share {
+	return predicate.Share(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AccessesTable, AccessesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccessesWith applies the HasEdge predicate on the "accesses" edge with a given conditions (other predicates).
+func HasAccessesWith(preds ...predicate.ShareAccess) predicate.Share {
+	return predicate.Share(func(s *sql.Selector) {
+		step := newAccessesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Share) predicate.Share {
 	return predicate.Share(sql.AndPredicates(predicates...))
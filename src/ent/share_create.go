@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"gopan-server/ent/node"
 	"gopan-server/ent/share"
+	"gopan-server/ent/shareaccess"
 	"gopan-server/ent/user"
 	"time"
 
@@ -28,6 +29,20 @@ func (sc *ShareCreate) SetCode(s string) *ShareCreate {
 	return sc
 }
 
+// SetSlug sets the "slug" field.
+func (sc *ShareCreate) SetSlug(s string) *ShareCreate {
+	sc.mutation.SetSlug(s)
+	return sc
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (sc *ShareCreate) SetNillableSlug(s *string) *ShareCreate {
+	if s != nil {
+		sc.SetSlug(*s)
+	}
+	return sc
+}
+
 // SetShareType sets the "share_type" field.
 func (sc *ShareCreate) SetShareType(i int) *ShareCreate {
 	sc.mutation.SetShareType(i)
@@ -98,6 +113,62 @@ func (sc *ShareCreate) SetNillableMaxAccessCount(i *int) *ShareCreate {
 	return sc
 }
 
+// SetDownloadCount sets the "download_count" field.
+func (sc *ShareCreate) SetDownloadCount(i int) *ShareCreate {
+	sc.mutation.SetDownloadCount(i)
+	return sc
+}
+
+// SetNillableDownloadCount sets the "download_count" field if the given value is not nil.
+func (sc *ShareCreate) SetNillableDownloadCount(i *int) *ShareCreate {
+	if i != nil {
+		sc.SetDownloadCount(*i)
+	}
+	return sc
+}
+
+// SetMaxDownloadCount sets the "max_download_count" field.
+func (sc *ShareCreate) SetMaxDownloadCount(i int) *ShareCreate {
+	sc.mutation.SetMaxDownloadCount(i)
+	return sc
+}
+
+// SetNillableMaxDownloadCount sets the "max_download_count" field if the given value is not nil.
+func (sc *ShareCreate) SetNillableMaxDownloadCount(i *int) *ShareCreate {
+	if i != nil {
+		sc.SetMaxDownloadCount(*i)
+	}
+	return sc
+}
+
+// SetStatus sets the "status" field.
+func (sc *ShareCreate) SetStatus(s share.Status) *ShareCreate {
+	sc.mutation.SetStatus(s)
+	return sc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (sc *ShareCreate) SetNillableStatus(s *share.Status) *ShareCreate {
+	if s != nil {
+		sc.SetStatus(*s)
+	}
+	return sc
+}
+
+// SetDisabledAt sets the "disabled_at" field.
+func (sc *ShareCreate) SetDisabledAt(t time.Time) *ShareCreate {
+	sc.mutation.SetDisabledAt(t)
+	return sc
+}
+
+// SetNillableDisabledAt sets the "disabled_at" field if the given value is not nil.
+func (sc *ShareCreate) SetNillableDisabledAt(t *time.Time) *ShareCreate {
+	if t != nil {
+		sc.SetDisabledAt(*t)
+	}
+	return sc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (sc *ShareCreate) SetCreatedAt(t time.Time) *ShareCreate {
 	sc.mutation.SetCreatedAt(t)
@@ -148,6 +219,21 @@ func (sc *ShareCreate) SetNode(n *Node) *ShareCreate {
 	return sc.SetNodeID(n.ID)
 }
 
+// AddAccessIDs adds the "accesses" edge to the ShareAccess entity by IDs.
+func (sc *ShareCreate) AddAccessIDs(ids ...int) *ShareCreate {
+	sc.mutation.AddAccessIDs(ids...)
+	return sc
+}
+
+// AddAccesses adds the "accesses" edges to the ShareAccess entity.
+func (sc *ShareCreate) AddAccesses(s ...*ShareAccess) *ShareCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return sc.AddAccessIDs(ids...)
+}
+
 // Mutation returns the ShareMutation object of the builder.
 func (sc *ShareCreate) Mutation() *ShareMutation {
 	return sc.mutation
@@ -191,6 +277,14 @@ func (sc *ShareCreate) defaults() {
 		v := share.DefaultAccessCount
 		sc.mutation.SetAccessCount(v)
 	}
+	if _, ok := sc.mutation.DownloadCount(); !ok {
+		v := share.DefaultDownloadCount
+		sc.mutation.SetDownloadCount(v)
+	}
+	if _, ok := sc.mutation.Status(); !ok {
+		v := share.DefaultStatus
+		sc.mutation.SetStatus(v)
+	}
 	if _, ok := sc.mutation.CreatedAt(); !ok {
 		v := share.DefaultCreatedAt()
 		sc.mutation.SetCreatedAt(v)
@@ -217,6 +311,17 @@ func (sc *ShareCreate) check() error {
 	if _, ok := sc.mutation.AccessCount(); !ok {
 		return &ValidationError{Name: "access_count", err: errors.New(`ent: missing required field "Share.access_count"`)}
 	}
+	if _, ok := sc.mutation.DownloadCount(); !ok {
+		return &ValidationError{Name: "download_count", err: errors.New(`ent: missing required field "Share.download_count"`)}
+	}
+	if _, ok := sc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Share.status"`)}
+	}
+	if v, ok := sc.mutation.Status(); ok {
+		if err := share.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Share.status": %w`, err)}
+		}
+	}
 	if _, ok := sc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Share.created_at"`)}
 	}
@@ -259,6 +364,10 @@ func (sc *ShareCreate) createSpec() (*Share, *sqlgraph.CreateSpec) {
 		_spec.SetField(share.FieldCode, field.TypeString, value)
 		_node.Code = value
 	}
+	if value, ok := sc.mutation.Slug(); ok {
+		_spec.SetField(share.FieldSlug, field.TypeString, value)
+		_node.Slug = &value
+	}
 	if value, ok := sc.mutation.ShareType(); ok {
 		_spec.SetField(share.FieldShareType, field.TypeInt, value)
 		_node.ShareType = value
@@ -279,6 +388,22 @@ func (sc *ShareCreate) createSpec() (*Share, *sqlgraph.CreateSpec) {
 		_spec.SetField(share.FieldMaxAccessCount, field.TypeInt, value)
 		_node.MaxAccessCount = value
 	}
+	if value, ok := sc.mutation.DownloadCount(); ok {
+		_spec.SetField(share.FieldDownloadCount, field.TypeInt, value)
+		_node.DownloadCount = value
+	}
+	if value, ok := sc.mutation.MaxDownloadCount(); ok {
+		_spec.SetField(share.FieldMaxDownloadCount, field.TypeInt, value)
+		_node.MaxDownloadCount = value
+	}
+	if value, ok := sc.mutation.Status(); ok {
+		_spec.SetField(share.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := sc.mutation.DisabledAt(); ok {
+		_spec.SetField(share.FieldDisabledAt, field.TypeTime, value)
+		_node.DisabledAt = &value
+	}
 	if value, ok := sc.mutation.CreatedAt(); ok {
 		_spec.SetField(share.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -321,6 +446,22 @@ func (sc *ShareCreate) createSpec() (*Share, *sqlgraph.CreateSpec) {
 		_node.node_shares = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := sc.mutation.AccessesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   share.AccessesTable,
+			Columns: []string{share.AccessesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shareaccess.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
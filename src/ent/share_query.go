@@ -4,10 +4,12 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"gopan-server/ent/node"
 	"gopan-server/ent/predicate"
 	"gopan-server/ent/share"
+	"gopan-server/ent/shareaccess"
 	"gopan-server/ent/user"
 	"math"
 
@@ -20,13 +22,14 @@ import (
 // ShareQuery is the builder for querying Share entities.
 type ShareQuery struct {
 	config
-	ctx        *QueryContext
-	order      []share.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Share
-	withOwner  *UserQuery
-	withNode   *NodeQuery
-	withFKs    bool
+	ctx          *QueryContext
+	order        []share.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.Share
+	withOwner    *UserQuery
+	withNode     *NodeQuery
+	withAccesses *ShareAccessQuery
+	withFKs      bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -107,6 +110,28 @@ func (sq *ShareQuery) QueryNode() *NodeQuery {
 	return query
 }
 
+// QueryAccesses chains the current query on the "accesses" edge.
+func (sq *ShareQuery) QueryAccesses() *ShareAccessQuery {
+	query := (&ShareAccessClient{config: sq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(share.Table, share.FieldID, selector),
+			sqlgraph.To(shareaccess.Table, shareaccess.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, share.AccessesTable, share.AccessesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Share entity from the query.
 // Returns a *NotFoundError when no Share was found.
 func (sq *ShareQuery) First(ctx context.Context) (*Share, error) {
@@ -294,13 +319,14 @@ func (sq *ShareQuery) Clone() *ShareQuery {
 		return nil
 	}
 	return &ShareQuery{
-		config:     sq.config,
-		ctx:        sq.ctx.Clone(),
-		order:      append([]share.OrderOption{}, sq.order...),
-		inters:     append([]Interceptor{}, sq.inters...),
-		predicates: append([]predicate.Share{}, sq.predicates...),
-		withOwner:  sq.withOwner.Clone(),
-		withNode:   sq.withNode.Clone(),
+		config:       sq.config,
+		ctx:          sq.ctx.Clone(),
+		order:        append([]share.OrderOption{}, sq.order...),
+		inters:       append([]Interceptor{}, sq.inters...),
+		predicates:   append([]predicate.Share{}, sq.predicates...),
+		withOwner:    sq.withOwner.Clone(),
+		withNode:     sq.withNode.Clone(),
+		withAccesses: sq.withAccesses.Clone(),
 		// clone intermediate query.
 		sql:  sq.sql.Clone(),
 		path: sq.path,
@@ -329,6 +355,17 @@ func (sq *ShareQuery) WithNode(opts ...func(*NodeQuery)) *ShareQuery {
 	return sq
 }
 
+// WithAccesses tells the query-builder to eager-load the nodes that are connected to
+// the "accesses" edge. The optional arguments are used to configure the query builder of the edge.
+func (sq *ShareQuery) WithAccesses(opts ...func(*ShareAccessQuery)) *ShareQuery {
+	query := (&ShareAccessClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sq.withAccesses = query
+	return sq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -408,9 +445,10 @@ func (sq *ShareQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Share,
 		nodes       = []*Share{}
 		withFKs     = sq.withFKs
 		_spec       = sq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			sq.withOwner != nil,
 			sq.withNode != nil,
+			sq.withAccesses != nil,
 		}
 	)
 	if sq.withOwner != nil || sq.withNode != nil {
@@ -449,6 +487,13 @@ func (sq *ShareQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Share,
 			return nil, err
 		}
 	}
+	if query := sq.withAccesses; query != nil {
+		if err := sq.loadAccesses(ctx, query, nodes,
+			func(n *Share) { n.Edges.Accesses = []*ShareAccess{} },
+			func(n *Share, e *ShareAccess) { n.Edges.Accesses = append(n.Edges.Accesses, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -516,6 +561,36 @@ func (sq *ShareQuery) loadNode(ctx context.Context, query *NodeQuery, nodes []*S
 	}
 	return nil
 }
+func (sq *ShareQuery) loadAccesses(ctx context.Context, query *ShareAccessQuery, nodes []*Share, init func(*Share), assign func(*Share, *ShareAccess)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Share)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(shareaccess.FieldShareID)
+	}
+	query.Where(predicate.ShareAccess(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(share.AccessesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ShareID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "share_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (sq *ShareQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := sq.querySpec()
@@ -9,6 +9,7 @@ import (
 	"gopan-server/ent/node"
 	"gopan-server/ent/predicate"
 	"gopan-server/ent/share"
+	"gopan-server/ent/shareaccess"
 	"gopan-server/ent/user"
 	"time"
 
@@ -44,6 +45,26 @@ func (su *ShareUpdate) SetNillableCode(s *string) *ShareUpdate {
 	return su
 }
 
+// SetSlug sets the "slug" field.
+func (su *ShareUpdate) SetSlug(s string) *ShareUpdate {
+	su.mutation.SetSlug(s)
+	return su
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (su *ShareUpdate) SetNillableSlug(s *string) *ShareUpdate {
+	if s != nil {
+		su.SetSlug(*s)
+	}
+	return su
+}
+
+// ClearSlug clears the value of the "slug" field.
+func (su *ShareUpdate) ClearSlug() *ShareUpdate {
+	su.mutation.ClearSlug()
+	return su
+}
+
 // SetShareType sets the "share_type" field.
 func (su *ShareUpdate) SetShareType(i int) *ShareUpdate {
 	su.mutation.ResetShareType()
@@ -153,6 +174,88 @@ func (su *ShareUpdate) ClearMaxAccessCount() *ShareUpdate {
 	return su
 }
 
+// SetDownloadCount sets the "download_count" field.
+func (su *ShareUpdate) SetDownloadCount(i int) *ShareUpdate {
+	su.mutation.ResetDownloadCount()
+	su.mutation.SetDownloadCount(i)
+	return su
+}
+
+// SetNillableDownloadCount sets the "download_count" field if the given value is not nil.
+func (su *ShareUpdate) SetNillableDownloadCount(i *int) *ShareUpdate {
+	if i != nil {
+		su.SetDownloadCount(*i)
+	}
+	return su
+}
+
+// AddDownloadCount adds i to the "download_count" field.
+func (su *ShareUpdate) AddDownloadCount(i int) *ShareUpdate {
+	su.mutation.AddDownloadCount(i)
+	return su
+}
+
+// SetMaxDownloadCount sets the "max_download_count" field.
+func (su *ShareUpdate) SetMaxDownloadCount(i int) *ShareUpdate {
+	su.mutation.ResetMaxDownloadCount()
+	su.mutation.SetMaxDownloadCount(i)
+	return su
+}
+
+// SetNillableMaxDownloadCount sets the "max_download_count" field if the given value is not nil.
+func (su *ShareUpdate) SetNillableMaxDownloadCount(i *int) *ShareUpdate {
+	if i != nil {
+		su.SetMaxDownloadCount(*i)
+	}
+	return su
+}
+
+// AddMaxDownloadCount adds i to the "max_download_count" field.
+func (su *ShareUpdate) AddMaxDownloadCount(i int) *ShareUpdate {
+	su.mutation.AddMaxDownloadCount(i)
+	return su
+}
+
+// ClearMaxDownloadCount clears the value of the "max_download_count" field.
+func (su *ShareUpdate) ClearMaxDownloadCount() *ShareUpdate {
+	su.mutation.ClearMaxDownloadCount()
+	return su
+}
+
+// SetStatus sets the "status" field.
+func (su *ShareUpdate) SetStatus(s share.Status) *ShareUpdate {
+	su.mutation.SetStatus(s)
+	return su
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (su *ShareUpdate) SetNillableStatus(s *share.Status) *ShareUpdate {
+	if s != nil {
+		su.SetStatus(*s)
+	}
+	return su
+}
+
+// SetDisabledAt sets the "disabled_at" field.
+func (su *ShareUpdate) SetDisabledAt(t time.Time) *ShareUpdate {
+	su.mutation.SetDisabledAt(t)
+	return su
+}
+
+// SetNillableDisabledAt sets the "disabled_at" field if the given value is not nil.
+func (su *ShareUpdate) SetNillableDisabledAt(t *time.Time) *ShareUpdate {
+	if t != nil {
+		su.SetDisabledAt(*t)
+	}
+	return su
+}
+
+// ClearDisabledAt clears the value of the "disabled_at" field.
+func (su *ShareUpdate) ClearDisabledAt() *ShareUpdate {
+	su.mutation.ClearDisabledAt()
+	return su
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (su *ShareUpdate) SetCreatedAt(t time.Time) *ShareUpdate {
 	su.mutation.SetCreatedAt(t)
@@ -195,6 +298,21 @@ func (su *ShareUpdate) SetNode(n *Node) *ShareUpdate {
 	return su.SetNodeID(n.ID)
 }
 
+// AddAccessIDs adds the "accesses" edge to the ShareAccess entity by IDs.
+func (su *ShareUpdate) AddAccessIDs(ids ...int) *ShareUpdate {
+	su.mutation.AddAccessIDs(ids...)
+	return su
+}
+
+// AddAccesses adds the "accesses" edges to the ShareAccess entity.
+func (su *ShareUpdate) AddAccesses(s ...*ShareAccess) *ShareUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return su.AddAccessIDs(ids...)
+}
+
 // Mutation returns the ShareMutation object of the builder.
 func (su *ShareUpdate) Mutation() *ShareMutation {
 	return su.mutation
@@ -212,6 +330,27 @@ func (su *ShareUpdate) ClearNode() *ShareUpdate {
 	return su
 }
 
+// ClearAccesses clears all "accesses" edges to the ShareAccess entity.
+func (su *ShareUpdate) ClearAccesses() *ShareUpdate {
+	su.mutation.ClearAccesses()
+	return su
+}
+
+// RemoveAccessIDs removes the "accesses" edge to ShareAccess entities by IDs.
+func (su *ShareUpdate) RemoveAccessIDs(ids ...int) *ShareUpdate {
+	su.mutation.RemoveAccessIDs(ids...)
+	return su
+}
+
+// RemoveAccesses removes "accesses" edges to ShareAccess entities.
+func (su *ShareUpdate) RemoveAccesses(s ...*ShareAccess) *ShareUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return su.RemoveAccessIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (su *ShareUpdate) Save(ctx context.Context) (int, error) {
 	su.defaults()
@@ -255,6 +394,11 @@ func (su *ShareUpdate) check() error {
 			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "Share.code": %w`, err)}
 		}
 	}
+	if v, ok := su.mutation.Status(); ok {
+		if err := share.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Share.status": %w`, err)}
+		}
+	}
 	if su.mutation.OwnerCleared() && len(su.mutation.OwnerIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Share.owner"`)
 	}
@@ -279,6 +423,12 @@ func (su *ShareUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := su.mutation.Code(); ok {
 		_spec.SetField(share.FieldCode, field.TypeString, value)
 	}
+	if value, ok := su.mutation.Slug(); ok {
+		_spec.SetField(share.FieldSlug, field.TypeString, value)
+	}
+	if su.mutation.SlugCleared() {
+		_spec.ClearField(share.FieldSlug, field.TypeString)
+	}
 	if value, ok := su.mutation.ShareType(); ok {
 		_spec.SetField(share.FieldShareType, field.TypeInt, value)
 	}
@@ -312,6 +462,30 @@ func (su *ShareUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if su.mutation.MaxAccessCountCleared() {
 		_spec.ClearField(share.FieldMaxAccessCount, field.TypeInt)
 	}
+	if value, ok := su.mutation.DownloadCount(); ok {
+		_spec.SetField(share.FieldDownloadCount, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedDownloadCount(); ok {
+		_spec.AddField(share.FieldDownloadCount, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.MaxDownloadCount(); ok {
+		_spec.SetField(share.FieldMaxDownloadCount, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedMaxDownloadCount(); ok {
+		_spec.AddField(share.FieldMaxDownloadCount, field.TypeInt, value)
+	}
+	if su.mutation.MaxDownloadCountCleared() {
+		_spec.ClearField(share.FieldMaxDownloadCount, field.TypeInt)
+	}
+	if value, ok := su.mutation.Status(); ok {
+		_spec.SetField(share.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := su.mutation.DisabledAt(); ok {
+		_spec.SetField(share.FieldDisabledAt, field.TypeTime, value)
+	}
+	if su.mutation.DisabledAtCleared() {
+		_spec.ClearField(share.FieldDisabledAt, field.TypeTime)
+	}
 	if value, ok := su.mutation.CreatedAt(); ok {
 		_spec.SetField(share.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -376,6 +550,51 @@ func (su *ShareUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if su.mutation.AccessesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   share.AccessesTable,
+			Columns: []string{share.AccessesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shareaccess.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedAccessesIDs(); len(nodes) > 0 && !su.mutation.AccessesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   share.AccessesTable,
+			Columns: []string{share.AccessesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shareaccess.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.AccessesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   share.AccessesTable,
+			Columns: []string{share.AccessesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shareaccess.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{share.Label}
@@ -410,6 +629,26 @@ func (suo *ShareUpdateOne) SetNillableCode(s *string) *ShareUpdateOne {
 	return suo
 }
 
+// SetSlug sets the "slug" field.
+func (suo *ShareUpdateOne) SetSlug(s string) *ShareUpdateOne {
+	suo.mutation.SetSlug(s)
+	return suo
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (suo *ShareUpdateOne) SetNillableSlug(s *string) *ShareUpdateOne {
+	if s != nil {
+		suo.SetSlug(*s)
+	}
+	return suo
+}
+
+// ClearSlug clears the value of the "slug" field.
+func (suo *ShareUpdateOne) ClearSlug() *ShareUpdateOne {
+	suo.mutation.ClearSlug()
+	return suo
+}
+
 // SetShareType sets the "share_type" field.
 func (suo *ShareUpdateOne) SetShareType(i int) *ShareUpdateOne {
 	suo.mutation.ResetShareType()
@@ -519,6 +758,88 @@ func (suo *ShareUpdateOne) ClearMaxAccessCount() *ShareUpdateOne {
 	return suo
 }
 
+// SetDownloadCount sets the "download_count" field.
+func (suo *ShareUpdateOne) SetDownloadCount(i int) *ShareUpdateOne {
+	suo.mutation.ResetDownloadCount()
+	suo.mutation.SetDownloadCount(i)
+	return suo
+}
+
+// SetNillableDownloadCount sets the "download_count" field if the given value is not nil.
+func (suo *ShareUpdateOne) SetNillableDownloadCount(i *int) *ShareUpdateOne {
+	if i != nil {
+		suo.SetDownloadCount(*i)
+	}
+	return suo
+}
+
+// AddDownloadCount adds i to the "download_count" field.
+func (suo *ShareUpdateOne) AddDownloadCount(i int) *ShareUpdateOne {
+	suo.mutation.AddDownloadCount(i)
+	return suo
+}
+
+// SetMaxDownloadCount sets the "max_download_count" field.
+func (suo *ShareUpdateOne) SetMaxDownloadCount(i int) *ShareUpdateOne {
+	suo.mutation.ResetMaxDownloadCount()
+	suo.mutation.SetMaxDownloadCount(i)
+	return suo
+}
+
+// SetNillableMaxDownloadCount sets the "max_download_count" field if the given value is not nil.
+func (suo *ShareUpdateOne) SetNillableMaxDownloadCount(i *int) *ShareUpdateOne {
+	if i != nil {
+		suo.SetMaxDownloadCount(*i)
+	}
+	return suo
+}
+
+// AddMaxDownloadCount adds i to the "max_download_count" field.
+func (suo *ShareUpdateOne) AddMaxDownloadCount(i int) *ShareUpdateOne {
+	suo.mutation.AddMaxDownloadCount(i)
+	return suo
+}
+
+// ClearMaxDownloadCount clears the value of the "max_download_count" field.
+func (suo *ShareUpdateOne) ClearMaxDownloadCount() *ShareUpdateOne {
+	suo.mutation.ClearMaxDownloadCount()
+	return suo
+}
+
+// SetStatus sets the "status" field.
+func (suo *ShareUpdateOne) SetStatus(s share.Status) *ShareUpdateOne {
+	suo.mutation.SetStatus(s)
+	return suo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (suo *ShareUpdateOne) SetNillableStatus(s *share.Status) *ShareUpdateOne {
+	if s != nil {
+		suo.SetStatus(*s)
+	}
+	return suo
+}
+
+// SetDisabledAt sets the "disabled_at" field.
+func (suo *ShareUpdateOne) SetDisabledAt(t time.Time) *ShareUpdateOne {
+	suo.mutation.SetDisabledAt(t)
+	return suo
+}
+
+// SetNillableDisabledAt sets the "disabled_at" field if the given value is not nil.
+func (suo *ShareUpdateOne) SetNillableDisabledAt(t *time.Time) *ShareUpdateOne {
+	if t != nil {
+		suo.SetDisabledAt(*t)
+	}
+	return suo
+}
+
+// ClearDisabledAt clears the value of the "disabled_at" field.
+func (suo *ShareUpdateOne) ClearDisabledAt() *ShareUpdateOne {
+	suo.mutation.ClearDisabledAt()
+	return suo
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (suo *ShareUpdateOne) SetCreatedAt(t time.Time) *ShareUpdateOne {
 	suo.mutation.SetCreatedAt(t)
@@ -561,6 +882,21 @@ func (suo *ShareUpdateOne) SetNode(n *Node) *ShareUpdateOne {
 	return suo.SetNodeID(n.ID)
 }
 
+// AddAccessIDs adds the "accesses" edge to the ShareAccess entity by IDs.
+func (suo *ShareUpdateOne) AddAccessIDs(ids ...int) *ShareUpdateOne {
+	suo.mutation.AddAccessIDs(ids...)
+	return suo
+}
+
+// AddAccesses adds the "accesses" edges to the ShareAccess entity.
+func (suo *ShareUpdateOne) AddAccesses(s ...*ShareAccess) *ShareUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return suo.AddAccessIDs(ids...)
+}
+
 // Mutation returns the ShareMutation object of the builder.
 func (suo *ShareUpdateOne) Mutation() *ShareMutation {
 	return suo.mutation
@@ -578,6 +914,27 @@ func (suo *ShareUpdateOne) ClearNode() *ShareUpdateOne {
 	return suo
 }
 
+// ClearAccesses clears all "accesses" edges to the ShareAccess entity.
+func (suo *ShareUpdateOne) ClearAccesses() *ShareUpdateOne {
+	suo.mutation.ClearAccesses()
+	return suo
+}
+
+// RemoveAccessIDs removes the "accesses" edge to ShareAccess entities by IDs.
+func (suo *ShareUpdateOne) RemoveAccessIDs(ids ...int) *ShareUpdateOne {
+	suo.mutation.RemoveAccessIDs(ids...)
+	return suo
+}
+
+// RemoveAccesses removes "accesses" edges to ShareAccess entities.
+func (suo *ShareUpdateOne) RemoveAccesses(s ...*ShareAccess) *ShareUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return suo.RemoveAccessIDs(ids...)
+}
+
 // Where appends a list predicates to the ShareUpdate builder.
 func (suo *ShareUpdateOne) Where(ps ...predicate.Share) *ShareUpdateOne {
 	suo.mutation.Where(ps...)
@@ -634,6 +991,11 @@ func (suo *ShareUpdateOne) check() error {
 			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "Share.code": %w`, err)}
 		}
 	}
+	if v, ok := suo.mutation.Status(); ok {
+		if err := share.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Share.status": %w`, err)}
+		}
+	}
 	if suo.mutation.OwnerCleared() && len(suo.mutation.OwnerIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Share.owner"`)
 	}
@@ -675,6 +1037,12 @@ func (suo *ShareUpdateOne) sqlSave(ctx context.Context) (_node *Share, err error
 	if value, ok := suo.mutation.Code(); ok {
 		_spec.SetField(share.FieldCode, field.TypeString, value)
 	}
+	if value, ok := suo.mutation.Slug(); ok {
+		_spec.SetField(share.FieldSlug, field.TypeString, value)
+	}
+	if suo.mutation.SlugCleared() {
+		_spec.ClearField(share.FieldSlug, field.TypeString)
+	}
 	if value, ok := suo.mutation.ShareType(); ok {
 		_spec.SetField(share.FieldShareType, field.TypeInt, value)
 	}
@@ -708,6 +1076,30 @@ func (suo *ShareUpdateOne) sqlSave(ctx context.Context) (_node *Share, err error
 	if suo.mutation.MaxAccessCountCleared() {
 		_spec.ClearField(share.FieldMaxAccessCount, field.TypeInt)
 	}
+	if value, ok := suo.mutation.DownloadCount(); ok {
+		_spec.SetField(share.FieldDownloadCount, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedDownloadCount(); ok {
+		_spec.AddField(share.FieldDownloadCount, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.MaxDownloadCount(); ok {
+		_spec.SetField(share.FieldMaxDownloadCount, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedMaxDownloadCount(); ok {
+		_spec.AddField(share.FieldMaxDownloadCount, field.TypeInt, value)
+	}
+	if suo.mutation.MaxDownloadCountCleared() {
+		_spec.ClearField(share.FieldMaxDownloadCount, field.TypeInt)
+	}
+	if value, ok := suo.mutation.Status(); ok {
+		_spec.SetField(share.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := suo.mutation.DisabledAt(); ok {
+		_spec.SetField(share.FieldDisabledAt, field.TypeTime, value)
+	}
+	if suo.mutation.DisabledAtCleared() {
+		_spec.ClearField(share.FieldDisabledAt, field.TypeTime)
+	}
 	if value, ok := suo.mutation.CreatedAt(); ok {
 		_spec.SetField(share.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -772,6 +1164,51 @@ func (suo *ShareUpdateOne) sqlSave(ctx context.Context) (_node *Share, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if suo.mutation.AccessesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   share.AccessesTable,
+			Columns: []string{share.AccessesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shareaccess.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedAccessesIDs(); len(nodes) > 0 && !suo.mutation.AccessesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   share.AccessesTable,
+			Columns: []string{share.AccessesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shareaccess.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.AccessesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   share.AccessesTable,
+			Columns: []string{share.AccessesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shareaccess.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Share{config: suo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,223 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"gopan-server/ent/node"
+	"gopan-server/ent/share"
+	"gopan-server/ent/shareaccess"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ShareAccess is the model entity for the ShareAccess schema.
+type ShareAccess struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ShareID holds the value of the "share_id" field.
+	ShareID int `json:"share_id,omitempty"`
+	// Node accessed, empty if the node was deleted
+	NodeID *int `json:"node_id,omitempty"`
+	// Action holds the value of the "action" field.
+	Action shareaccess.Action `json:"action,omitempty"`
+	// IP holds the value of the "ip" field.
+	IP string `json:"ip,omitempty"`
+	// UserAgent holds the value of the "user_agent" field.
+	UserAgent string `json:"user_agent,omitempty"`
+	// Bytes served by this access
+	Bytes int64 `json:"bytes,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ShareAccessQuery when eager-loading is set.
+	Edges        ShareAccessEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ShareAccessEdges holds the relations/edges for other nodes in the graph.
+type ShareAccessEdges struct {
+	// Share holds the value of the share edge.
+	Share *Share `json:"share,omitempty"`
+	// Node holds the value of the node edge.
+	Node *Node `json:"node,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ShareOrErr returns the Share value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ShareAccessEdges) ShareOrErr() (*Share, error) {
+	if e.Share != nil {
+		return e.Share, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: share.Label}
+	}
+	return nil, &NotLoadedError{edge: "share"}
+}
+
+// NodeOrErr returns the Node value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ShareAccessEdges) NodeOrErr() (*Node, error) {
+	if e.Node != nil {
+		return e.Node, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: node.Label}
+	}
+	return nil, &NotLoadedError{edge: "node"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ShareAccess) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case shareaccess.FieldID, shareaccess.FieldShareID, shareaccess.FieldNodeID, shareaccess.FieldBytes:
+			values[i] = new(sql.NullInt64)
+		case shareaccess.FieldAction, shareaccess.FieldIP, shareaccess.FieldUserAgent:
+			values[i] = new(sql.NullString)
+		case shareaccess.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ShareAccess fields.
+func (sa *ShareAccess) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case shareaccess.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sa.ID = int(value.Int64)
+		case shareaccess.FieldShareID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field share_id", values[i])
+			} else if value.Valid {
+				sa.ShareID = int(value.Int64)
+			}
+		case shareaccess.FieldNodeID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field node_id", values[i])
+			} else if value.Valid {
+				sa.NodeID = new(int)
+				*sa.NodeID = int(value.Int64)
+			}
+		case shareaccess.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				sa.Action = shareaccess.Action(value.String)
+			}
+		case shareaccess.FieldIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip", values[i])
+			} else if value.Valid {
+				sa.IP = value.String
+			}
+		case shareaccess.FieldUserAgent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_agent", values[i])
+			} else if value.Valid {
+				sa.UserAgent = value.String
+			}
+		case shareaccess.FieldBytes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field bytes", values[i])
+			} else if value.Valid {
+				sa.Bytes = value.Int64
+			}
+		case shareaccess.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sa.CreatedAt = value.Time
+			}
+		default:
+			sa.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ShareAccess.
+// This includes values selected through modifiers, order, etc.
+func (sa *ShareAccess) Value(name string) (ent.Value, error) {
+	return sa.selectValues.Get(name)
+}
+
+// QueryShare queries the "share" edge of the ShareAccess entity.
+func (sa *ShareAccess) QueryShare() *ShareQuery {
+	return NewShareAccessClient(sa.config).QueryShare(sa)
+}
+
+// QueryNode queries the "node" edge of the ShareAccess entity.
+func (sa *ShareAccess) QueryNode() *NodeQuery {
+	return NewShareAccessClient(sa.config).QueryNode(sa)
+}
+
+// Update returns a builder for updating this ShareAccess.
+// Note that you need to call ShareAccess.Unwrap() before calling this method if this ShareAccess
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sa *ShareAccess) Update() *ShareAccessUpdateOne {
+	return NewShareAccessClient(sa.config).UpdateOne(sa)
+}
+
+// Unwrap unwraps the ShareAccess entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sa *ShareAccess) Unwrap() *ShareAccess {
+	_tx, ok := sa.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ShareAccess is not a transactional entity")
+	}
+	sa.config.driver = _tx.drv
+	return sa
+}
+
+// String implements the fmt.Stringer.
+func (sa *ShareAccess) String() string {
+	var builder strings.Builder
+	builder.WriteString("ShareAccess(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sa.ID))
+	builder.WriteString("share_id=")
+	builder.WriteString(fmt.Sprintf("%v", sa.ShareID))
+	builder.WriteString(", ")
+	if v := sa.NodeID; v != nil {
+		builder.WriteString("node_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(fmt.Sprintf("%v", sa.Action))
+	builder.WriteString(", ")
+	builder.WriteString("ip=")
+	builder.WriteString(sa.IP)
+	builder.WriteString(", ")
+	builder.WriteString("user_agent=")
+	builder.WriteString(sa.UserAgent)
+	builder.WriteString(", ")
+	builder.WriteString("bytes=")
+	builder.WriteString(fmt.Sprintf("%v", sa.Bytes))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(sa.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ShareAccesses is a parsable slice of ShareAccess.
+type ShareAccesses []*ShareAccess
@@ -0,0 +1,358 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gopan-server/ent/node"
+	"gopan-server/ent/share"
+	"gopan-server/ent/shareaccess"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ShareAccessCreate is the builder for creating a ShareAccess entity.
+type ShareAccessCreate struct {
+	config
+	mutation *ShareAccessMutation
+	hooks    []Hook
+}
+
+// SetShareID sets the "share_id" field.
+func (sac *ShareAccessCreate) SetShareID(i int) *ShareAccessCreate {
+	sac.mutation.SetShareID(i)
+	return sac
+}
+
+// SetNodeID sets the "node_id" field.
+func (sac *ShareAccessCreate) SetNodeID(i int) *ShareAccessCreate {
+	sac.mutation.SetNodeID(i)
+	return sac
+}
+
+// SetNillableNodeID sets the "node_id" field if the given value is not nil.
+func (sac *ShareAccessCreate) SetNillableNodeID(i *int) *ShareAccessCreate {
+	if i != nil {
+		sac.SetNodeID(*i)
+	}
+	return sac
+}
+
+// SetAction sets the "action" field.
+func (sac *ShareAccessCreate) SetAction(s shareaccess.Action) *ShareAccessCreate {
+	sac.mutation.SetAction(s)
+	return sac
+}
+
+// SetIP sets the "ip" field.
+func (sac *ShareAccessCreate) SetIP(s string) *ShareAccessCreate {
+	sac.mutation.SetIP(s)
+	return sac
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (sac *ShareAccessCreate) SetNillableIP(s *string) *ShareAccessCreate {
+	if s != nil {
+		sac.SetIP(*s)
+	}
+	return sac
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (sac *ShareAccessCreate) SetUserAgent(s string) *ShareAccessCreate {
+	sac.mutation.SetUserAgent(s)
+	return sac
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (sac *ShareAccessCreate) SetNillableUserAgent(s *string) *ShareAccessCreate {
+	if s != nil {
+		sac.SetUserAgent(*s)
+	}
+	return sac
+}
+
+// SetBytes sets the "bytes" field.
+func (sac *ShareAccessCreate) SetBytes(i int64) *ShareAccessCreate {
+	sac.mutation.SetBytes(i)
+	return sac
+}
+
+// SetNillableBytes sets the "bytes" field if the given value is not nil.
+func (sac *ShareAccessCreate) SetNillableBytes(i *int64) *ShareAccessCreate {
+	if i != nil {
+		sac.SetBytes(*i)
+	}
+	return sac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sac *ShareAccessCreate) SetCreatedAt(t time.Time) *ShareAccessCreate {
+	sac.mutation.SetCreatedAt(t)
+	return sac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sac *ShareAccessCreate) SetNillableCreatedAt(t *time.Time) *ShareAccessCreate {
+	if t != nil {
+		sac.SetCreatedAt(*t)
+	}
+	return sac
+}
+
+// SetShare sets the "share" edge to the Share entity.
+func (sac *ShareAccessCreate) SetShare(s *Share) *ShareAccessCreate {
+	return sac.SetShareID(s.ID)
+}
+
+// SetNode sets the "node" edge to the Node entity.
+func (sac *ShareAccessCreate) SetNode(n *Node) *ShareAccessCreate {
+	return sac.SetNodeID(n.ID)
+}
+
+// Mutation returns the ShareAccessMutation object of the builder.
+func (sac *ShareAccessCreate) Mutation() *ShareAccessMutation {
+	return sac.mutation
+}
+
+// Save creates the ShareAccess in the database.
+func (sac *ShareAccessCreate) Save(ctx context.Context) (*ShareAccess, error) {
+	sac.defaults()
+	return withHooks(ctx, sac.sqlSave, sac.mutation, sac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sac *ShareAccessCreate) SaveX(ctx context.Context) *ShareAccess {
+	v, err := sac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sac *ShareAccessCreate) Exec(ctx context.Context) error {
+	_, err := sac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sac *ShareAccessCreate) ExecX(ctx context.Context) {
+	if err := sac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sac *ShareAccessCreate) defaults() {
+	if _, ok := sac.mutation.Bytes(); !ok {
+		v := shareaccess.DefaultBytes
+		sac.mutation.SetBytes(v)
+	}
+	if _, ok := sac.mutation.CreatedAt(); !ok {
+		v := shareaccess.DefaultCreatedAt()
+		sac.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sac *ShareAccessCreate) check() error {
+	if _, ok := sac.mutation.ShareID(); !ok {
+		return &ValidationError{Name: "share_id", err: errors.New(`ent: missing required field "ShareAccess.share_id"`)}
+	}
+	if _, ok := sac.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "ShareAccess.action"`)}
+	}
+	if v, ok := sac.mutation.Action(); ok {
+		if err := shareaccess.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "ShareAccess.action": %w`, err)}
+		}
+	}
+	if v, ok := sac.mutation.UserAgent(); ok {
+		if err := shareaccess.UserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "ShareAccess.user_agent": %w`, err)}
+		}
+	}
+	if _, ok := sac.mutation.Bytes(); !ok {
+		return &ValidationError{Name: "bytes", err: errors.New(`ent: missing required field "ShareAccess.bytes"`)}
+	}
+	if _, ok := sac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ShareAccess.created_at"`)}
+	}
+	if len(sac.mutation.ShareIDs()) == 0 {
+		return &ValidationError{Name: "share", err: errors.New(`ent: missing required edge "ShareAccess.share"`)}
+	}
+	return nil
+}
+
+func (sac *ShareAccessCreate) sqlSave(ctx context.Context) (*ShareAccess, error) {
+	if err := sac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	sac.mutation.id = &_node.ID
+	sac.mutation.done = true
+	return _node, nil
+}
+
+func (sac *ShareAccessCreate) createSpec() (*ShareAccess, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ShareAccess{config: sac.config}
+		_spec = sqlgraph.NewCreateSpec(shareaccess.Table, sqlgraph.NewFieldSpec(shareaccess.FieldID, field.TypeInt))
+	)
+	if value, ok := sac.mutation.Action(); ok {
+		_spec.SetField(shareaccess.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := sac.mutation.IP(); ok {
+		_spec.SetField(shareaccess.FieldIP, field.TypeString, value)
+		_node.IP = value
+	}
+	if value, ok := sac.mutation.UserAgent(); ok {
+		_spec.SetField(shareaccess.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = value
+	}
+	if value, ok := sac.mutation.Bytes(); ok {
+		_spec.SetField(shareaccess.FieldBytes, field.TypeInt64, value)
+		_node.Bytes = value
+	}
+	if value, ok := sac.mutation.CreatedAt(); ok {
+		_spec.SetField(shareaccess.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := sac.mutation.ShareIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shareaccess.ShareTable,
+			Columns: []string{shareaccess.ShareColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(share.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ShareID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sac.mutation.NodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shareaccess.NodeTable,
+			Columns: []string{shareaccess.NodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.NodeID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ShareAccessCreateBulk is the builder for creating many ShareAccess entities in bulk.
+type ShareAccessCreateBulk struct {
+	config
+	err      error
+	builders []*ShareAccessCreate
+}
+
+// Save creates the ShareAccess entities in the database.
+func (sacb *ShareAccessCreateBulk) Save(ctx context.Context) ([]*ShareAccess, error) {
+	if sacb.err != nil {
+		return nil, sacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sacb.builders))
+	nodes := make([]*ShareAccess, len(sacb.builders))
+	mutators := make([]Mutator, len(sacb.builders))
+	for i := range sacb.builders {
+		func(i int, root context.Context) {
+			builder := sacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ShareAccessMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sacb *ShareAccessCreateBulk) SaveX(ctx context.Context) []*ShareAccess {
+	v, err := sacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sacb *ShareAccessCreateBulk) Exec(ctx context.Context) error {
+	_, err := sacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sacb *ShareAccessCreateBulk) ExecX(ctx context.Context) {
+	if err := sacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
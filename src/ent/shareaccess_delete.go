@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"gopan-server/ent/predicate"
+	"gopan-server/ent/shareaccess"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ShareAccessDelete is the builder for deleting a ShareAccess entity.
+type ShareAccessDelete struct {
+	config
+	hooks    []Hook
+	mutation *ShareAccessMutation
+}
+
+// Where appends a list predicates to the ShareAccessDelete builder.
+func (sad *ShareAccessDelete) Where(ps ...predicate.ShareAccess) *ShareAccessDelete {
+	sad.mutation.Where(ps...)
+	return sad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (sad *ShareAccessDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, sad.sqlExec, sad.mutation, sad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sad *ShareAccessDelete) ExecX(ctx context.Context) int {
+	n, err := sad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (sad *ShareAccessDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(shareaccess.Table, sqlgraph.NewFieldSpec(shareaccess.FieldID, field.TypeInt))
+	if ps := sad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, sad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	sad.mutation.done = true
+	return affected, err
+}
+
+// ShareAccessDeleteOne is the builder for deleting a single ShareAccess entity.
+type ShareAccessDeleteOne struct {
+	sad *ShareAccessDelete
+}
+
+// Where appends a list predicates to the ShareAccessDelete builder.
+func (sado *ShareAccessDeleteOne) Where(ps ...predicate.ShareAccess) *ShareAccessDeleteOne {
+	sado.sad.mutation.Where(ps...)
+	return sado
+}
+
+// Exec executes the deletion query.
+func (sado *ShareAccessDeleteOne) Exec(ctx context.Context) error {
+	n, err := sado.sad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{shareaccess.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sado *ShareAccessDeleteOne) ExecX(ctx context.Context) {
+	if err := sado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
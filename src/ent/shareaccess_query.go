@@ -0,0 +1,684 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"gopan-server/ent/node"
+	"gopan-server/ent/predicate"
+	"gopan-server/ent/share"
+	"gopan-server/ent/shareaccess"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ShareAccessQuery is the builder for querying ShareAccess entities.
+type ShareAccessQuery struct {
+	config
+	ctx        *QueryContext
+	order      []shareaccess.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ShareAccess
+	withShare  *ShareQuery
+	withNode   *NodeQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ShareAccessQuery builder.
+func (saq *ShareAccessQuery) Where(ps ...predicate.ShareAccess) *ShareAccessQuery {
+	saq.predicates = append(saq.predicates, ps...)
+	return saq
+}
+
+// Limit the number of records to be returned by this query.
+func (saq *ShareAccessQuery) Limit(limit int) *ShareAccessQuery {
+	saq.ctx.Limit = &limit
+	return saq
+}
+
+// Offset to start from.
+func (saq *ShareAccessQuery) Offset(offset int) *ShareAccessQuery {
+	saq.ctx.Offset = &offset
+	return saq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (saq *ShareAccessQuery) Unique(unique bool) *ShareAccessQuery {
+	saq.ctx.Unique = &unique
+	return saq
+}
+
+// Order specifies how the records should be ordered.
+func (saq *ShareAccessQuery) Order(o ...shareaccess.OrderOption) *ShareAccessQuery {
+	saq.order = append(saq.order, o...)
+	return saq
+}
+
+// QueryShare chains the current query on the "share" edge.
+func (saq *ShareAccessQuery) QueryShare() *ShareQuery {
+	query := (&ShareClient{config: saq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := saq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := saq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(shareaccess.Table, shareaccess.FieldID, selector),
+			sqlgraph.To(share.Table, share.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, shareaccess.ShareTable, shareaccess.ShareColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(saq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryNode chains the current query on the "node" edge.
+func (saq *ShareAccessQuery) QueryNode() *NodeQuery {
+	query := (&NodeClient{config: saq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := saq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := saq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(shareaccess.Table, shareaccess.FieldID, selector),
+			sqlgraph.To(node.Table, node.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, shareaccess.NodeTable, shareaccess.NodeColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(saq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ShareAccess entity from the query.
+// Returns a *NotFoundError when no ShareAccess was found.
+func (saq *ShareAccessQuery) First(ctx context.Context) (*ShareAccess, error) {
+	nodes, err := saq.Limit(1).All(setContextOp(ctx, saq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{shareaccess.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (saq *ShareAccessQuery) FirstX(ctx context.Context) *ShareAccess {
+	node, err := saq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ShareAccess ID from the query.
+// Returns a *NotFoundError when no ShareAccess ID was found.
+func (saq *ShareAccessQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = saq.Limit(1).IDs(setContextOp(ctx, saq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{shareaccess.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (saq *ShareAccessQuery) FirstIDX(ctx context.Context) int {
+	id, err := saq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ShareAccess entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ShareAccess entity is found.
+// Returns a *NotFoundError when no ShareAccess entities are found.
+func (saq *ShareAccessQuery) Only(ctx context.Context) (*ShareAccess, error) {
+	nodes, err := saq.Limit(2).All(setContextOp(ctx, saq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{shareaccess.Label}
+	default:
+		return nil, &NotSingularError{shareaccess.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (saq *ShareAccessQuery) OnlyX(ctx context.Context) *ShareAccess {
+	node, err := saq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ShareAccess ID in the query.
+// Returns a *NotSingularError when more than one ShareAccess ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (saq *ShareAccessQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = saq.Limit(2).IDs(setContextOp(ctx, saq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{shareaccess.Label}
+	default:
+		err = &NotSingularError{shareaccess.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (saq *ShareAccessQuery) OnlyIDX(ctx context.Context) int {
+	id, err := saq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ShareAccesses.
+func (saq *ShareAccessQuery) All(ctx context.Context) ([]*ShareAccess, error) {
+	ctx = setContextOp(ctx, saq.ctx, ent.OpQueryAll)
+	if err := saq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ShareAccess, *ShareAccessQuery]()
+	return withInterceptors[[]*ShareAccess](ctx, saq, qr, saq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (saq *ShareAccessQuery) AllX(ctx context.Context) []*ShareAccess {
+	nodes, err := saq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ShareAccess IDs.
+func (saq *ShareAccessQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if saq.ctx.Unique == nil && saq.path != nil {
+		saq.Unique(true)
+	}
+	ctx = setContextOp(ctx, saq.ctx, ent.OpQueryIDs)
+	if err = saq.Select(shareaccess.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (saq *ShareAccessQuery) IDsX(ctx context.Context) []int {
+	ids, err := saq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (saq *ShareAccessQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, saq.ctx, ent.OpQueryCount)
+	if err := saq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, saq, querierCount[*ShareAccessQuery](), saq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (saq *ShareAccessQuery) CountX(ctx context.Context) int {
+	count, err := saq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (saq *ShareAccessQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, saq.ctx, ent.OpQueryExist)
+	switch _, err := saq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (saq *ShareAccessQuery) ExistX(ctx context.Context) bool {
+	exist, err := saq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ShareAccessQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (saq *ShareAccessQuery) Clone() *ShareAccessQuery {
+	if saq == nil {
+		return nil
+	}
+	return &ShareAccessQuery{
+		config:     saq.config,
+		ctx:        saq.ctx.Clone(),
+		order:      append([]shareaccess.OrderOption{}, saq.order...),
+		inters:     append([]Interceptor{}, saq.inters...),
+		predicates: append([]predicate.ShareAccess{}, saq.predicates...),
+		withShare:  saq.withShare.Clone(),
+		withNode:   saq.withNode.Clone(),
+		// clone intermediate query.
+		sql:  saq.sql.Clone(),
+		path: saq.path,
+	}
+}
+
+// WithShare tells the query-builder to eager-load the nodes that are connected to
+// the "share" edge. The optional arguments are used to configure the query builder of the edge.
+func (saq *ShareAccessQuery) WithShare(opts ...func(*ShareQuery)) *ShareAccessQuery {
+	query := (&ShareClient{config: saq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	saq.withShare = query
+	return saq
+}
+
+// WithNode tells the query-builder to eager-load the nodes that are connected to
+// the "node" edge. The optional arguments are used to configure the query builder of the edge.
+func (saq *ShareAccessQuery) WithNode(opts ...func(*NodeQuery)) *ShareAccessQuery {
+	query := (&NodeClient{config: saq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	saq.withNode = query
+	return saq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ShareID int `json:"share_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ShareAccess.Query().
+//		GroupBy(shareaccess.FieldShareID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (saq *ShareAccessQuery) GroupBy(field string, fields ...string) *ShareAccessGroupBy {
+	saq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ShareAccessGroupBy{build: saq}
+	grbuild.flds = &saq.ctx.Fields
+	grbuild.label = shareaccess.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ShareID int `json:"share_id,omitempty"`
+//	}
+//
+//	client.ShareAccess.Query().
+//		Select(shareaccess.FieldShareID).
+//		Scan(ctx, &v)
+func (saq *ShareAccessQuery) Select(fields ...string) *ShareAccessSelect {
+	saq.ctx.Fields = append(saq.ctx.Fields, fields...)
+	sbuild := &ShareAccessSelect{ShareAccessQuery: saq}
+	sbuild.label = shareaccess.Label
+	sbuild.flds, sbuild.scan = &saq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ShareAccessSelect configured with the given aggregations.
+func (saq *ShareAccessQuery) Aggregate(fns ...AggregateFunc) *ShareAccessSelect {
+	return saq.Select().Aggregate(fns...)
+}
+
+func (saq *ShareAccessQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range saq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, saq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range saq.ctx.Fields {
+		if !shareaccess.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if saq.path != nil {
+		prev, err := saq.path(ctx)
+		if err != nil {
+			return err
+		}
+		saq.sql = prev
+	}
+	return nil
+}
+
+func (saq *ShareAccessQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ShareAccess, error) {
+	var (
+		nodes       = []*ShareAccess{}
+		_spec       = saq.querySpec()
+		loadedTypes = [2]bool{
+			saq.withShare != nil,
+			saq.withNode != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ShareAccess).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ShareAccess{config: saq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, saq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := saq.withShare; query != nil {
+		if err := saq.loadShare(ctx, query, nodes, nil,
+			func(n *ShareAccess, e *Share) { n.Edges.Share = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := saq.withNode; query != nil {
+		if err := saq.loadNode(ctx, query, nodes, nil,
+			func(n *ShareAccess, e *Node) { n.Edges.Node = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (saq *ShareAccessQuery) loadShare(ctx context.Context, query *ShareQuery, nodes []*ShareAccess, init func(*ShareAccess), assign func(*ShareAccess, *Share)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*ShareAccess)
+	for i := range nodes {
+		fk := nodes[i].ShareID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(share.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "share_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (saq *ShareAccessQuery) loadNode(ctx context.Context, query *NodeQuery, nodes []*ShareAccess, init func(*ShareAccess), assign func(*ShareAccess, *Node)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*ShareAccess)
+	for i := range nodes {
+		if nodes[i].NodeID == nil {
+			continue
+		}
+		fk := *nodes[i].NodeID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(node.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "node_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (saq *ShareAccessQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := saq.querySpec()
+	_spec.Node.Columns = saq.ctx.Fields
+	if len(saq.ctx.Fields) > 0 {
+		_spec.Unique = saq.ctx.Unique != nil && *saq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, saq.driver, _spec)
+}
+
+func (saq *ShareAccessQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(shareaccess.Table, shareaccess.Columns, sqlgraph.NewFieldSpec(shareaccess.FieldID, field.TypeInt))
+	_spec.From = saq.sql
+	if unique := saq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if saq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := saq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, shareaccess.FieldID)
+		for i := range fields {
+			if fields[i] != shareaccess.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if saq.withShare != nil {
+			_spec.Node.AddColumnOnce(shareaccess.FieldShareID)
+		}
+		if saq.withNode != nil {
+			_spec.Node.AddColumnOnce(shareaccess.FieldNodeID)
+		}
+	}
+	if ps := saq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := saq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := saq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := saq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (saq *ShareAccessQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(saq.driver.Dialect())
+	t1 := builder.Table(shareaccess.Table)
+	columns := saq.ctx.Fields
+	if len(columns) == 0 {
+		columns = shareaccess.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if saq.sql != nil {
+		selector = saq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if saq.ctx.Unique != nil && *saq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range saq.predicates {
+		p(selector)
+	}
+	for _, p := range saq.order {
+		p(selector)
+	}
+	if offset := saq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := saq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ShareAccessGroupBy is the group-by builder for ShareAccess entities.
+type ShareAccessGroupBy struct {
+	selector
+	build *ShareAccessQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (sagb *ShareAccessGroupBy) Aggregate(fns ...AggregateFunc) *ShareAccessGroupBy {
+	sagb.fns = append(sagb.fns, fns...)
+	return sagb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sagb *ShareAccessGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sagb.build.ctx, ent.OpQueryGroupBy)
+	if err := sagb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ShareAccessQuery, *ShareAccessGroupBy](ctx, sagb.build, sagb, sagb.build.inters, v)
+}
+
+func (sagb *ShareAccessGroupBy) sqlScan(ctx context.Context, root *ShareAccessQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(sagb.fns))
+	for _, fn := range sagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*sagb.flds)+len(sagb.fns))
+		for _, f := range *sagb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*sagb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sagb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ShareAccessSelect is the builder for selecting fields of ShareAccess entities.
+type ShareAccessSelect struct {
+	*ShareAccessQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sas *ShareAccessSelect) Aggregate(fns ...AggregateFunc) *ShareAccessSelect {
+	sas.fns = append(sas.fns, fns...)
+	return sas
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sas *ShareAccessSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sas.ctx, ent.OpQuerySelect)
+	if err := sas.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ShareAccessQuery, *ShareAccessSelect](ctx, sas.ShareAccessQuery, sas, sas.inters, v)
+}
+
+func (sas *ShareAccessSelect) sqlScan(ctx context.Context, root *ShareAccessQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(sas.fns))
+	for _, fn := range sas.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*sas.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sas.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
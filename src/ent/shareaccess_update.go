@@ -0,0 +1,674 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gopan-server/ent/node"
+	"gopan-server/ent/predicate"
+	"gopan-server/ent/share"
+	"gopan-server/ent/shareaccess"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ShareAccessUpdate is the builder for updating ShareAccess entities.
+type ShareAccessUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ShareAccessMutation
+}
+
+// Where appends a list predicates to the ShareAccessUpdate builder.
+func (sau *ShareAccessUpdate) Where(ps ...predicate.ShareAccess) *ShareAccessUpdate {
+	sau.mutation.Where(ps...)
+	return sau
+}
+
+// SetShareID sets the "share_id" field.
+func (sau *ShareAccessUpdate) SetShareID(i int) *ShareAccessUpdate {
+	sau.mutation.SetShareID(i)
+	return sau
+}
+
+// SetNillableShareID sets the "share_id" field if the given value is not nil.
+func (sau *ShareAccessUpdate) SetNillableShareID(i *int) *ShareAccessUpdate {
+	if i != nil {
+		sau.SetShareID(*i)
+	}
+	return sau
+}
+
+// SetNodeID sets the "node_id" field.
+func (sau *ShareAccessUpdate) SetNodeID(i int) *ShareAccessUpdate {
+	sau.mutation.SetNodeID(i)
+	return sau
+}
+
+// SetNillableNodeID sets the "node_id" field if the given value is not nil.
+func (sau *ShareAccessUpdate) SetNillableNodeID(i *int) *ShareAccessUpdate {
+	if i != nil {
+		sau.SetNodeID(*i)
+	}
+	return sau
+}
+
+// ClearNodeID clears the value of the "node_id" field.
+func (sau *ShareAccessUpdate) ClearNodeID() *ShareAccessUpdate {
+	sau.mutation.ClearNodeID()
+	return sau
+}
+
+// SetAction sets the "action" field.
+func (sau *ShareAccessUpdate) SetAction(s shareaccess.Action) *ShareAccessUpdate {
+	sau.mutation.SetAction(s)
+	return sau
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (sau *ShareAccessUpdate) SetNillableAction(s *shareaccess.Action) *ShareAccessUpdate {
+	if s != nil {
+		sau.SetAction(*s)
+	}
+	return sau
+}
+
+// SetIP sets the "ip" field.
+func (sau *ShareAccessUpdate) SetIP(s string) *ShareAccessUpdate {
+	sau.mutation.SetIP(s)
+	return sau
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (sau *ShareAccessUpdate) SetNillableIP(s *string) *ShareAccessUpdate {
+	if s != nil {
+		sau.SetIP(*s)
+	}
+	return sau
+}
+
+// ClearIP clears the value of the "ip" field.
+func (sau *ShareAccessUpdate) ClearIP() *ShareAccessUpdate {
+	sau.mutation.ClearIP()
+	return sau
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (sau *ShareAccessUpdate) SetUserAgent(s string) *ShareAccessUpdate {
+	sau.mutation.SetUserAgent(s)
+	return sau
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (sau *ShareAccessUpdate) SetNillableUserAgent(s *string) *ShareAccessUpdate {
+	if s != nil {
+		sau.SetUserAgent(*s)
+	}
+	return sau
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (sau *ShareAccessUpdate) ClearUserAgent() *ShareAccessUpdate {
+	sau.mutation.ClearUserAgent()
+	return sau
+}
+
+// SetBytes sets the "bytes" field.
+func (sau *ShareAccessUpdate) SetBytes(i int64) *ShareAccessUpdate {
+	sau.mutation.ResetBytes()
+	sau.mutation.SetBytes(i)
+	return sau
+}
+
+// SetNillableBytes sets the "bytes" field if the given value is not nil.
+func (sau *ShareAccessUpdate) SetNillableBytes(i *int64) *ShareAccessUpdate {
+	if i != nil {
+		sau.SetBytes(*i)
+	}
+	return sau
+}
+
+// AddBytes adds i to the "bytes" field.
+func (sau *ShareAccessUpdate) AddBytes(i int64) *ShareAccessUpdate {
+	sau.mutation.AddBytes(i)
+	return sau
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sau *ShareAccessUpdate) SetCreatedAt(t time.Time) *ShareAccessUpdate {
+	sau.mutation.SetCreatedAt(t)
+	return sau
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sau *ShareAccessUpdate) SetNillableCreatedAt(t *time.Time) *ShareAccessUpdate {
+	if t != nil {
+		sau.SetCreatedAt(*t)
+	}
+	return sau
+}
+
+// SetShare sets the "share" edge to the Share entity.
+func (sau *ShareAccessUpdate) SetShare(s *Share) *ShareAccessUpdate {
+	return sau.SetShareID(s.ID)
+}
+
+// SetNode sets the "node" edge to the Node entity.
+func (sau *ShareAccessUpdate) SetNode(n *Node) *ShareAccessUpdate {
+	return sau.SetNodeID(n.ID)
+}
+
+// Mutation returns the ShareAccessMutation object of the builder.
+func (sau *ShareAccessUpdate) Mutation() *ShareAccessMutation {
+	return sau.mutation
+}
+
+// ClearShare clears the "share" edge to the Share entity.
+func (sau *ShareAccessUpdate) ClearShare() *ShareAccessUpdate {
+	sau.mutation.ClearShare()
+	return sau
+}
+
+// ClearNode clears the "node" edge to the Node entity.
+func (sau *ShareAccessUpdate) ClearNode() *ShareAccessUpdate {
+	sau.mutation.ClearNode()
+	return sau
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sau *ShareAccessUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, sau.sqlSave, sau.mutation, sau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sau *ShareAccessUpdate) SaveX(ctx context.Context) int {
+	affected, err := sau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sau *ShareAccessUpdate) Exec(ctx context.Context) error {
+	_, err := sau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sau *ShareAccessUpdate) ExecX(ctx context.Context) {
+	if err := sau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sau *ShareAccessUpdate) check() error {
+	if v, ok := sau.mutation.Action(); ok {
+		if err := shareaccess.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "ShareAccess.action": %w`, err)}
+		}
+	}
+	if v, ok := sau.mutation.UserAgent(); ok {
+		if err := shareaccess.UserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "ShareAccess.user_agent": %w`, err)}
+		}
+	}
+	if sau.mutation.ShareCleared() && len(sau.mutation.ShareIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ShareAccess.share"`)
+	}
+	return nil
+}
+
+func (sau *ShareAccessUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := sau.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(shareaccess.Table, shareaccess.Columns, sqlgraph.NewFieldSpec(shareaccess.FieldID, field.TypeInt))
+	if ps := sau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sau.mutation.Action(); ok {
+		_spec.SetField(shareaccess.FieldAction, field.TypeEnum, value)
+	}
+	if value, ok := sau.mutation.IP(); ok {
+		_spec.SetField(shareaccess.FieldIP, field.TypeString, value)
+	}
+	if sau.mutation.IPCleared() {
+		_spec.ClearField(shareaccess.FieldIP, field.TypeString)
+	}
+	if value, ok := sau.mutation.UserAgent(); ok {
+		_spec.SetField(shareaccess.FieldUserAgent, field.TypeString, value)
+	}
+	if sau.mutation.UserAgentCleared() {
+		_spec.ClearField(shareaccess.FieldUserAgent, field.TypeString)
+	}
+	if value, ok := sau.mutation.Bytes(); ok {
+		_spec.SetField(shareaccess.FieldBytes, field.TypeInt64, value)
+	}
+	if value, ok := sau.mutation.AddedBytes(); ok {
+		_spec.AddField(shareaccess.FieldBytes, field.TypeInt64, value)
+	}
+	if value, ok := sau.mutation.CreatedAt(); ok {
+		_spec.SetField(shareaccess.FieldCreatedAt, field.TypeTime, value)
+	}
+	if sau.mutation.ShareCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shareaccess.ShareTable,
+			Columns: []string{shareaccess.ShareColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(share.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sau.mutation.ShareIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shareaccess.ShareTable,
+			Columns: []string{shareaccess.ShareColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(share.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if sau.mutation.NodeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shareaccess.NodeTable,
+			Columns: []string{shareaccess.NodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sau.mutation.NodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shareaccess.NodeTable,
+			Columns: []string{shareaccess.NodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, sau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{shareaccess.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sau.mutation.done = true
+	return n, nil
+}
+
+// ShareAccessUpdateOne is the builder for updating a single ShareAccess entity.
+type ShareAccessUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ShareAccessMutation
+}
+
+// SetShareID sets the "share_id" field.
+func (sauo *ShareAccessUpdateOne) SetShareID(i int) *ShareAccessUpdateOne {
+	sauo.mutation.SetShareID(i)
+	return sauo
+}
+
+// SetNillableShareID sets the "share_id" field if the given value is not nil.
+func (sauo *ShareAccessUpdateOne) SetNillableShareID(i *int) *ShareAccessUpdateOne {
+	if i != nil {
+		sauo.SetShareID(*i)
+	}
+	return sauo
+}
+
+// SetNodeID sets the "node_id" field.
+func (sauo *ShareAccessUpdateOne) SetNodeID(i int) *ShareAccessUpdateOne {
+	sauo.mutation.SetNodeID(i)
+	return sauo
+}
+
+// SetNillableNodeID sets the "node_id" field if the given value is not nil.
+func (sauo *ShareAccessUpdateOne) SetNillableNodeID(i *int) *ShareAccessUpdateOne {
+	if i != nil {
+		sauo.SetNodeID(*i)
+	}
+	return sauo
+}
+
+// ClearNodeID clears the value of the "node_id" field.
+func (sauo *ShareAccessUpdateOne) ClearNodeID() *ShareAccessUpdateOne {
+	sauo.mutation.ClearNodeID()
+	return sauo
+}
+
+// SetAction sets the "action" field.
+func (sauo *ShareAccessUpdateOne) SetAction(s shareaccess.Action) *ShareAccessUpdateOne {
+	sauo.mutation.SetAction(s)
+	return sauo
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (sauo *ShareAccessUpdateOne) SetNillableAction(s *shareaccess.Action) *ShareAccessUpdateOne {
+	if s != nil {
+		sauo.SetAction(*s)
+	}
+	return sauo
+}
+
+// SetIP sets the "ip" field.
+func (sauo *ShareAccessUpdateOne) SetIP(s string) *ShareAccessUpdateOne {
+	sauo.mutation.SetIP(s)
+	return sauo
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (sauo *ShareAccessUpdateOne) SetNillableIP(s *string) *ShareAccessUpdateOne {
+	if s != nil {
+		sauo.SetIP(*s)
+	}
+	return sauo
+}
+
+// ClearIP clears the value of the "ip" field.
+func (sauo *ShareAccessUpdateOne) ClearIP() *ShareAccessUpdateOne {
+	sauo.mutation.ClearIP()
+	return sauo
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (sauo *ShareAccessUpdateOne) SetUserAgent(s string) *ShareAccessUpdateOne {
+	sauo.mutation.SetUserAgent(s)
+	return sauo
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (sauo *ShareAccessUpdateOne) SetNillableUserAgent(s *string) *ShareAccessUpdateOne {
+	if s != nil {
+		sauo.SetUserAgent(*s)
+	}
+	return sauo
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (sauo *ShareAccessUpdateOne) ClearUserAgent() *ShareAccessUpdateOne {
+	sauo.mutation.ClearUserAgent()
+	return sauo
+}
+
+// SetBytes sets the "bytes" field.
+func (sauo *ShareAccessUpdateOne) SetBytes(i int64) *ShareAccessUpdateOne {
+	sauo.mutation.ResetBytes()
+	sauo.mutation.SetBytes(i)
+	return sauo
+}
+
+// SetNillableBytes sets the "bytes" field if the given value is not nil.
+func (sauo *ShareAccessUpdateOne) SetNillableBytes(i *int64) *ShareAccessUpdateOne {
+	if i != nil {
+		sauo.SetBytes(*i)
+	}
+	return sauo
+}
+
+// AddBytes adds i to the "bytes" field.
+func (sauo *ShareAccessUpdateOne) AddBytes(i int64) *ShareAccessUpdateOne {
+	sauo.mutation.AddBytes(i)
+	return sauo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sauo *ShareAccessUpdateOne) SetCreatedAt(t time.Time) *ShareAccessUpdateOne {
+	sauo.mutation.SetCreatedAt(t)
+	return sauo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sauo *ShareAccessUpdateOne) SetNillableCreatedAt(t *time.Time) *ShareAccessUpdateOne {
+	if t != nil {
+		sauo.SetCreatedAt(*t)
+	}
+	return sauo
+}
+
+// SetShare sets the "share" edge to the Share entity.
+func (sauo *ShareAccessUpdateOne) SetShare(s *Share) *ShareAccessUpdateOne {
+	return sauo.SetShareID(s.ID)
+}
+
+// SetNode sets the "node" edge to the Node entity.
+func (sauo *ShareAccessUpdateOne) SetNode(n *Node) *ShareAccessUpdateOne {
+	return sauo.SetNodeID(n.ID)
+}
+
+// Mutation returns the ShareAccessMutation object of the builder.
+func (sauo *ShareAccessUpdateOne) Mutation() *ShareAccessMutation {
+	return sauo.mutation
+}
+
+// ClearShare clears the "share" edge to the Share entity.
+func (sauo *ShareAccessUpdateOne) ClearShare() *ShareAccessUpdateOne {
+	sauo.mutation.ClearShare()
+	return sauo
+}
+
+// ClearNode clears the "node" edge to the Node entity.
+func (sauo *ShareAccessUpdateOne) ClearNode() *ShareAccessUpdateOne {
+	sauo.mutation.ClearNode()
+	return sauo
+}
+
+// Where appends a list predicates to the ShareAccessUpdate builder.
+func (sauo *ShareAccessUpdateOne) Where(ps ...predicate.ShareAccess) *ShareAccessUpdateOne {
+	sauo.mutation.Where(ps...)
+	return sauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (sauo *ShareAccessUpdateOne) Select(field string, fields ...string) *ShareAccessUpdateOne {
+	sauo.fields = append([]string{field}, fields...)
+	return sauo
+}
+
+// Save executes the query and returns the updated ShareAccess entity.
+func (sauo *ShareAccessUpdateOne) Save(ctx context.Context) (*ShareAccess, error) {
+	return withHooks(ctx, sauo.sqlSave, sauo.mutation, sauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sauo *ShareAccessUpdateOne) SaveX(ctx context.Context) *ShareAccess {
+	node, err := sauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (sauo *ShareAccessUpdateOne) Exec(ctx context.Context) error {
+	_, err := sauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sauo *ShareAccessUpdateOne) ExecX(ctx context.Context) {
+	if err := sauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sauo *ShareAccessUpdateOne) check() error {
+	if v, ok := sauo.mutation.Action(); ok {
+		if err := shareaccess.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "ShareAccess.action": %w`, err)}
+		}
+	}
+	if v, ok := sauo.mutation.UserAgent(); ok {
+		if err := shareaccess.UserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "ShareAccess.user_agent": %w`, err)}
+		}
+	}
+	if sauo.mutation.ShareCleared() && len(sauo.mutation.ShareIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ShareAccess.share"`)
+	}
+	return nil
+}
+
+func (sauo *ShareAccessUpdateOne) sqlSave(ctx context.Context) (_node *ShareAccess, err error) {
+	if err := sauo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(shareaccess.Table, shareaccess.Columns, sqlgraph.NewFieldSpec(shareaccess.FieldID, field.TypeInt))
+	id, ok := sauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ShareAccess.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := sauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, shareaccess.FieldID)
+		for _, f := range fields {
+			if !shareaccess.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != shareaccess.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := sauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sauo.mutation.Action(); ok {
+		_spec.SetField(shareaccess.FieldAction, field.TypeEnum, value)
+	}
+	if value, ok := sauo.mutation.IP(); ok {
+		_spec.SetField(shareaccess.FieldIP, field.TypeString, value)
+	}
+	if sauo.mutation.IPCleared() {
+		_spec.ClearField(shareaccess.FieldIP, field.TypeString)
+	}
+	if value, ok := sauo.mutation.UserAgent(); ok {
+		_spec.SetField(shareaccess.FieldUserAgent, field.TypeString, value)
+	}
+	if sauo.mutation.UserAgentCleared() {
+		_spec.ClearField(shareaccess.FieldUserAgent, field.TypeString)
+	}
+	if value, ok := sauo.mutation.Bytes(); ok {
+		_spec.SetField(shareaccess.FieldBytes, field.TypeInt64, value)
+	}
+	if value, ok := sauo.mutation.AddedBytes(); ok {
+		_spec.AddField(shareaccess.FieldBytes, field.TypeInt64, value)
+	}
+	if value, ok := sauo.mutation.CreatedAt(); ok {
+		_spec.SetField(shareaccess.FieldCreatedAt, field.TypeTime, value)
+	}
+	if sauo.mutation.ShareCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shareaccess.ShareTable,
+			Columns: []string{shareaccess.ShareColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(share.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sauo.mutation.ShareIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shareaccess.ShareTable,
+			Columns: []string{shareaccess.ShareColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(share.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if sauo.mutation.NodeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shareaccess.NodeTable,
+			Columns: []string{shareaccess.NodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sauo.mutation.NodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shareaccess.NodeTable,
+			Columns: []string{shareaccess.NodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(node.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ShareAccess{config: sauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, sauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{shareaccess.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	sauo.mutation.done = true
+	return _node, nil
+}
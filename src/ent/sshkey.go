@@ -0,0 +1,204 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"gopan-server/ent/sshkey"
+	"gopan-server/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// SSHKey is the model entity for the SSHKey schema.
+type SSHKey struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// In authorized_keys format, without options
+	PublicKey string `json:"public_key,omitempty"`
+	// SHA256 fingerprint as shown by ssh-keygen -l
+	Fingerprint string `json:"fingerprint,omitempty"`
+	// LastUsedAt holds the value of the "last_used_at" field.
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	// LastUsedIP holds the value of the "last_used_ip" field.
+	LastUsedIP string `json:"last_used_ip,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SSHKeyQuery when eager-loading is set.
+	Edges        SSHKeyEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SSHKeyEdges holds the relations/edges for other nodes in the graph.
+type SSHKeyEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SSHKeyEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SSHKey) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case sshkey.FieldID, sshkey.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case sshkey.FieldName, sshkey.FieldPublicKey, sshkey.FieldFingerprint, sshkey.FieldLastUsedIP:
+			values[i] = new(sql.NullString)
+		case sshkey.FieldLastUsedAt, sshkey.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SSHKey fields.
+func (sk *SSHKey) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case sshkey.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sk.ID = int(value.Int64)
+		case sshkey.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				sk.UserID = int(value.Int64)
+			}
+		case sshkey.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				sk.Name = value.String
+			}
+		case sshkey.FieldPublicKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field public_key", values[i])
+			} else if value.Valid {
+				sk.PublicKey = value.String
+			}
+		case sshkey.FieldFingerprint:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fingerprint", values[i])
+			} else if value.Valid {
+				sk.Fingerprint = value.String
+			}
+		case sshkey.FieldLastUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_at", values[i])
+			} else if value.Valid {
+				sk.LastUsedAt = new(time.Time)
+				*sk.LastUsedAt = value.Time
+			}
+		case sshkey.FieldLastUsedIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_ip", values[i])
+			} else if value.Valid {
+				sk.LastUsedIP = value.String
+			}
+		case sshkey.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sk.CreatedAt = value.Time
+			}
+		default:
+			sk.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SSHKey.
+// This includes values selected through modifiers, order, etc.
+func (sk *SSHKey) Value(name string) (ent.Value, error) {
+	return sk.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the SSHKey entity.
+func (sk *SSHKey) QueryUser() *UserQuery {
+	return NewSSHKeyClient(sk.config).QueryUser(sk)
+}
+
+// Update returns a builder for updating this SSHKey.
+// Note that you need to call SSHKey.Unwrap() before calling this method if this SSHKey
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sk *SSHKey) Update() *SSHKeyUpdateOne {
+	return NewSSHKeyClient(sk.config).UpdateOne(sk)
+}
+
+// Unwrap unwraps the SSHKey entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sk *SSHKey) Unwrap() *SSHKey {
+	_tx, ok := sk.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SSHKey is not a transactional entity")
+	}
+	sk.config.driver = _tx.drv
+	return sk
+}
+
+// String implements the fmt.Stringer.
+func (sk *SSHKey) String() string {
+	var builder strings.Builder
+	builder.WriteString("SSHKey(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sk.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", sk.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(sk.Name)
+	builder.WriteString(", ")
+	builder.WriteString("public_key=")
+	builder.WriteString(sk.PublicKey)
+	builder.WriteString(", ")
+	builder.WriteString("fingerprint=")
+	builder.WriteString(sk.Fingerprint)
+	builder.WriteString(", ")
+	if v := sk.LastUsedAt; v != nil {
+		builder.WriteString("last_used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("last_used_ip=")
+	builder.WriteString(sk.LastUsedIP)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(sk.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SSHKeys is a parsable slice of SSHKey.
+type SSHKeys []*SSHKey
@@ -0,0 +1,327 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gopan-server/ent/sshkey"
+	"gopan-server/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SSHKeyCreate is the builder for creating a SSHKey entity.
+type SSHKeyCreate struct {
+	config
+	mutation *SSHKeyMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (skc *SSHKeyCreate) SetUserID(i int) *SSHKeyCreate {
+	skc.mutation.SetUserID(i)
+	return skc
+}
+
+// SetName sets the "name" field.
+func (skc *SSHKeyCreate) SetName(s string) *SSHKeyCreate {
+	skc.mutation.SetName(s)
+	return skc
+}
+
+// SetPublicKey sets the "public_key" field.
+func (skc *SSHKeyCreate) SetPublicKey(s string) *SSHKeyCreate {
+	skc.mutation.SetPublicKey(s)
+	return skc
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (skc *SSHKeyCreate) SetFingerprint(s string) *SSHKeyCreate {
+	skc.mutation.SetFingerprint(s)
+	return skc
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (skc *SSHKeyCreate) SetLastUsedAt(t time.Time) *SSHKeyCreate {
+	skc.mutation.SetLastUsedAt(t)
+	return skc
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (skc *SSHKeyCreate) SetNillableLastUsedAt(t *time.Time) *SSHKeyCreate {
+	if t != nil {
+		skc.SetLastUsedAt(*t)
+	}
+	return skc
+}
+
+// SetLastUsedIP sets the "last_used_ip" field.
+func (skc *SSHKeyCreate) SetLastUsedIP(s string) *SSHKeyCreate {
+	skc.mutation.SetLastUsedIP(s)
+	return skc
+}
+
+// SetNillableLastUsedIP sets the "last_used_ip" field if the given value is not nil.
+func (skc *SSHKeyCreate) SetNillableLastUsedIP(s *string) *SSHKeyCreate {
+	if s != nil {
+		skc.SetLastUsedIP(*s)
+	}
+	return skc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (skc *SSHKeyCreate) SetCreatedAt(t time.Time) *SSHKeyCreate {
+	skc.mutation.SetCreatedAt(t)
+	return skc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (skc *SSHKeyCreate) SetNillableCreatedAt(t *time.Time) *SSHKeyCreate {
+	if t != nil {
+		skc.SetCreatedAt(*t)
+	}
+	return skc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (skc *SSHKeyCreate) SetUser(u *User) *SSHKeyCreate {
+	return skc.SetUserID(u.ID)
+}
+
+// Mutation returns the SSHKeyMutation object of the builder.
+func (skc *SSHKeyCreate) Mutation() *SSHKeyMutation {
+	return skc.mutation
+}
+
+// Save creates the SSHKey in the database.
+func (skc *SSHKeyCreate) Save(ctx context.Context) (*SSHKey, error) {
+	skc.defaults()
+	return withHooks(ctx, skc.sqlSave, skc.mutation, skc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (skc *SSHKeyCreate) SaveX(ctx context.Context) *SSHKey {
+	v, err := skc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (skc *SSHKeyCreate) Exec(ctx context.Context) error {
+	_, err := skc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (skc *SSHKeyCreate) ExecX(ctx context.Context) {
+	if err := skc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (skc *SSHKeyCreate) defaults() {
+	if _, ok := skc.mutation.CreatedAt(); !ok {
+		v := sshkey.DefaultCreatedAt()
+		skc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (skc *SSHKeyCreate) check() error {
+	if _, ok := skc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "SSHKey.user_id"`)}
+	}
+	if _, ok := skc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "SSHKey.name"`)}
+	}
+	if v, ok := skc.mutation.Name(); ok {
+		if err := sshkey.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SSHKey.name": %w`, err)}
+		}
+	}
+	if _, ok := skc.mutation.PublicKey(); !ok {
+		return &ValidationError{Name: "public_key", err: errors.New(`ent: missing required field "SSHKey.public_key"`)}
+	}
+	if v, ok := skc.mutation.PublicKey(); ok {
+		if err := sshkey.PublicKeyValidator(v); err != nil {
+			return &ValidationError{Name: "public_key", err: fmt.Errorf(`ent: validator failed for field "SSHKey.public_key": %w`, err)}
+		}
+	}
+	if _, ok := skc.mutation.Fingerprint(); !ok {
+		return &ValidationError{Name: "fingerprint", err: errors.New(`ent: missing required field "SSHKey.fingerprint"`)}
+	}
+	if v, ok := skc.mutation.Fingerprint(); ok {
+		if err := sshkey.FingerprintValidator(v); err != nil {
+			return &ValidationError{Name: "fingerprint", err: fmt.Errorf(`ent: validator failed for field "SSHKey.fingerprint": %w`, err)}
+		}
+	}
+	if _, ok := skc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SSHKey.created_at"`)}
+	}
+	if len(skc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "SSHKey.user"`)}
+	}
+	return nil
+}
+
+func (skc *SSHKeyCreate) sqlSave(ctx context.Context) (*SSHKey, error) {
+	if err := skc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := skc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, skc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	skc.mutation.id = &_node.ID
+	skc.mutation.done = true
+	return _node, nil
+}
+
+func (skc *SSHKeyCreate) createSpec() (*SSHKey, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SSHKey{config: skc.config}
+		_spec = sqlgraph.NewCreateSpec(sshkey.Table, sqlgraph.NewFieldSpec(sshkey.FieldID, field.TypeInt))
+	)
+	if value, ok := skc.mutation.Name(); ok {
+		_spec.SetField(sshkey.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := skc.mutation.PublicKey(); ok {
+		_spec.SetField(sshkey.FieldPublicKey, field.TypeString, value)
+		_node.PublicKey = value
+	}
+	if value, ok := skc.mutation.Fingerprint(); ok {
+		_spec.SetField(sshkey.FieldFingerprint, field.TypeString, value)
+		_node.Fingerprint = value
+	}
+	if value, ok := skc.mutation.LastUsedAt(); ok {
+		_spec.SetField(sshkey.FieldLastUsedAt, field.TypeTime, value)
+		_node.LastUsedAt = &value
+	}
+	if value, ok := skc.mutation.LastUsedIP(); ok {
+		_spec.SetField(sshkey.FieldLastUsedIP, field.TypeString, value)
+		_node.LastUsedIP = value
+	}
+	if value, ok := skc.mutation.CreatedAt(); ok {
+		_spec.SetField(sshkey.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := skc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sshkey.UserTable,
+			Columns: []string{sshkey.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SSHKeyCreateBulk is the builder for creating many SSHKey entities in bulk.
+type SSHKeyCreateBulk struct {
+	config
+	err      error
+	builders []*SSHKeyCreate
+}
+
+// Save creates the SSHKey entities in the database.
+func (skcb *SSHKeyCreateBulk) Save(ctx context.Context) ([]*SSHKey, error) {
+	if skcb.err != nil {
+		return nil, skcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(skcb.builders))
+	nodes := make([]*SSHKey, len(skcb.builders))
+	mutators := make([]Mutator, len(skcb.builders))
+	for i := range skcb.builders {
+		func(i int, root context.Context) {
+			builder := skcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SSHKeyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, skcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, skcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, skcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (skcb *SSHKeyCreateBulk) SaveX(ctx context.Context) []*SSHKey {
+	v, err := skcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (skcb *SSHKeyCreateBulk) Exec(ctx context.Context) error {
+	_, err := skcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (skcb *SSHKeyCreateBulk) ExecX(ctx context.Context) {
+	if err := skcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"gopan-server/ent/predicate"
+	"gopan-server/ent/sshkey"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SSHKeyDelete is the builder for deleting a SSHKey entity.
+type SSHKeyDelete struct {
+	config
+	hooks    []Hook
+	mutation *SSHKeyMutation
+}
+
+// Where appends a list predicates to the SSHKeyDelete builder.
+func (skd *SSHKeyDelete) Where(ps ...predicate.SSHKey) *SSHKeyDelete {
+	skd.mutation.Where(ps...)
+	return skd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (skd *SSHKeyDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, skd.sqlExec, skd.mutation, skd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (skd *SSHKeyDelete) ExecX(ctx context.Context) int {
+	n, err := skd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (skd *SSHKeyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(sshkey.Table, sqlgraph.NewFieldSpec(sshkey.FieldID, field.TypeInt))
+	if ps := skd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, skd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	skd.mutation.done = true
+	return affected, err
+}
+
+// SSHKeyDeleteOne is the builder for deleting a single SSHKey entity.
+type SSHKeyDeleteOne struct {
+	skd *SSHKeyDelete
+}
+
+// Where appends a list predicates to the SSHKeyDelete builder.
+func (skdo *SSHKeyDeleteOne) Where(ps ...predicate.SSHKey) *SSHKeyDeleteOne {
+	skdo.skd.mutation.Where(ps...)
+	return skdo
+}
+
+// Exec executes the deletion query.
+func (skdo *SSHKeyDeleteOne) Exec(ctx context.Context) error {
+	n, err := skdo.skd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{sshkey.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (skdo *SSHKeyDeleteOne) ExecX(ctx context.Context) {
+	if err := skdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,577 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gopan-server/ent/predicate"
+	"gopan-server/ent/sshkey"
+	"gopan-server/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SSHKeyUpdate is the builder for updating SSHKey entities.
+type SSHKeyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SSHKeyMutation
+}
+
+// Where appends a list predicates to the SSHKeyUpdate builder.
+func (sku *SSHKeyUpdate) Where(ps ...predicate.SSHKey) *SSHKeyUpdate {
+	sku.mutation.Where(ps...)
+	return sku
+}
+
+// SetUserID sets the "user_id" field.
+func (sku *SSHKeyUpdate) SetUserID(i int) *SSHKeyUpdate {
+	sku.mutation.SetUserID(i)
+	return sku
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (sku *SSHKeyUpdate) SetNillableUserID(i *int) *SSHKeyUpdate {
+	if i != nil {
+		sku.SetUserID(*i)
+	}
+	return sku
+}
+
+// SetName sets the "name" field.
+func (sku *SSHKeyUpdate) SetName(s string) *SSHKeyUpdate {
+	sku.mutation.SetName(s)
+	return sku
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (sku *SSHKeyUpdate) SetNillableName(s *string) *SSHKeyUpdate {
+	if s != nil {
+		sku.SetName(*s)
+	}
+	return sku
+}
+
+// SetPublicKey sets the "public_key" field.
+func (sku *SSHKeyUpdate) SetPublicKey(s string) *SSHKeyUpdate {
+	sku.mutation.SetPublicKey(s)
+	return sku
+}
+
+// SetNillablePublicKey sets the "public_key" field if the given value is not nil.
+func (sku *SSHKeyUpdate) SetNillablePublicKey(s *string) *SSHKeyUpdate {
+	if s != nil {
+		sku.SetPublicKey(*s)
+	}
+	return sku
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (sku *SSHKeyUpdate) SetFingerprint(s string) *SSHKeyUpdate {
+	sku.mutation.SetFingerprint(s)
+	return sku
+}
+
+// SetNillableFingerprint sets the "fingerprint" field if the given value is not nil.
+func (sku *SSHKeyUpdate) SetNillableFingerprint(s *string) *SSHKeyUpdate {
+	if s != nil {
+		sku.SetFingerprint(*s)
+	}
+	return sku
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (sku *SSHKeyUpdate) SetLastUsedAt(t time.Time) *SSHKeyUpdate {
+	sku.mutation.SetLastUsedAt(t)
+	return sku
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (sku *SSHKeyUpdate) SetNillableLastUsedAt(t *time.Time) *SSHKeyUpdate {
+	if t != nil {
+		sku.SetLastUsedAt(*t)
+	}
+	return sku
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (sku *SSHKeyUpdate) ClearLastUsedAt() *SSHKeyUpdate {
+	sku.mutation.ClearLastUsedAt()
+	return sku
+}
+
+// SetLastUsedIP sets the "last_used_ip" field.
+func (sku *SSHKeyUpdate) SetLastUsedIP(s string) *SSHKeyUpdate {
+	sku.mutation.SetLastUsedIP(s)
+	return sku
+}
+
+// SetNillableLastUsedIP sets the "last_used_ip" field if the given value is not nil.
+func (sku *SSHKeyUpdate) SetNillableLastUsedIP(s *string) *SSHKeyUpdate {
+	if s != nil {
+		sku.SetLastUsedIP(*s)
+	}
+	return sku
+}
+
+// ClearLastUsedIP clears the value of the "last_used_ip" field.
+func (sku *SSHKeyUpdate) ClearLastUsedIP() *SSHKeyUpdate {
+	sku.mutation.ClearLastUsedIP()
+	return sku
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sku *SSHKeyUpdate) SetCreatedAt(t time.Time) *SSHKeyUpdate {
+	sku.mutation.SetCreatedAt(t)
+	return sku
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sku *SSHKeyUpdate) SetNillableCreatedAt(t *time.Time) *SSHKeyUpdate {
+	if t != nil {
+		sku.SetCreatedAt(*t)
+	}
+	return sku
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (sku *SSHKeyUpdate) SetUser(u *User) *SSHKeyUpdate {
+	return sku.SetUserID(u.ID)
+}
+
+// Mutation returns the SSHKeyMutation object of the builder.
+func (sku *SSHKeyUpdate) Mutation() *SSHKeyMutation {
+	return sku.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (sku *SSHKeyUpdate) ClearUser() *SSHKeyUpdate {
+	sku.mutation.ClearUser()
+	return sku
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sku *SSHKeyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, sku.sqlSave, sku.mutation, sku.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sku *SSHKeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := sku.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sku *SSHKeyUpdate) Exec(ctx context.Context) error {
+	_, err := sku.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sku *SSHKeyUpdate) ExecX(ctx context.Context) {
+	if err := sku.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sku *SSHKeyUpdate) check() error {
+	if v, ok := sku.mutation.Name(); ok {
+		if err := sshkey.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SSHKey.name": %w`, err)}
+		}
+	}
+	if v, ok := sku.mutation.PublicKey(); ok {
+		if err := sshkey.PublicKeyValidator(v); err != nil {
+			return &ValidationError{Name: "public_key", err: fmt.Errorf(`ent: validator failed for field "SSHKey.public_key": %w`, err)}
+		}
+	}
+	if v, ok := sku.mutation.Fingerprint(); ok {
+		if err := sshkey.FingerprintValidator(v); err != nil {
+			return &ValidationError{Name: "fingerprint", err: fmt.Errorf(`ent: validator failed for field "SSHKey.fingerprint": %w`, err)}
+		}
+	}
+	if sku.mutation.UserCleared() && len(sku.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SSHKey.user"`)
+	}
+	return nil
+}
+
+func (sku *SSHKeyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := sku.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sshkey.Table, sshkey.Columns, sqlgraph.NewFieldSpec(sshkey.FieldID, field.TypeInt))
+	if ps := sku.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sku.mutation.Name(); ok {
+		_spec.SetField(sshkey.FieldName, field.TypeString, value)
+	}
+	if value, ok := sku.mutation.PublicKey(); ok {
+		_spec.SetField(sshkey.FieldPublicKey, field.TypeString, value)
+	}
+	if value, ok := sku.mutation.Fingerprint(); ok {
+		_spec.SetField(sshkey.FieldFingerprint, field.TypeString, value)
+	}
+	if value, ok := sku.mutation.LastUsedAt(); ok {
+		_spec.SetField(sshkey.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if sku.mutation.LastUsedAtCleared() {
+		_spec.ClearField(sshkey.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := sku.mutation.LastUsedIP(); ok {
+		_spec.SetField(sshkey.FieldLastUsedIP, field.TypeString, value)
+	}
+	if sku.mutation.LastUsedIPCleared() {
+		_spec.ClearField(sshkey.FieldLastUsedIP, field.TypeString)
+	}
+	if value, ok := sku.mutation.CreatedAt(); ok {
+		_spec.SetField(sshkey.FieldCreatedAt, field.TypeTime, value)
+	}
+	if sku.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sshkey.UserTable,
+			Columns: []string{sshkey.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sku.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sshkey.UserTable,
+			Columns: []string{sshkey.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, sku.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sshkey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sku.mutation.done = true
+	return n, nil
+}
+
+// SSHKeyUpdateOne is the builder for updating a single SSHKey entity.
+type SSHKeyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SSHKeyMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (skuo *SSHKeyUpdateOne) SetUserID(i int) *SSHKeyUpdateOne {
+	skuo.mutation.SetUserID(i)
+	return skuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (skuo *SSHKeyUpdateOne) SetNillableUserID(i *int) *SSHKeyUpdateOne {
+	if i != nil {
+		skuo.SetUserID(*i)
+	}
+	return skuo
+}
+
+// SetName sets the "name" field.
+func (skuo *SSHKeyUpdateOne) SetName(s string) *SSHKeyUpdateOne {
+	skuo.mutation.SetName(s)
+	return skuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (skuo *SSHKeyUpdateOne) SetNillableName(s *string) *SSHKeyUpdateOne {
+	if s != nil {
+		skuo.SetName(*s)
+	}
+	return skuo
+}
+
+// SetPublicKey sets the "public_key" field.
+func (skuo *SSHKeyUpdateOne) SetPublicKey(s string) *SSHKeyUpdateOne {
+	skuo.mutation.SetPublicKey(s)
+	return skuo
+}
+
+// SetNillablePublicKey sets the "public_key" field if the given value is not nil.
+func (skuo *SSHKeyUpdateOne) SetNillablePublicKey(s *string) *SSHKeyUpdateOne {
+	if s != nil {
+		skuo.SetPublicKey(*s)
+	}
+	return skuo
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (skuo *SSHKeyUpdateOne) SetFingerprint(s string) *SSHKeyUpdateOne {
+	skuo.mutation.SetFingerprint(s)
+	return skuo
+}
+
+// SetNillableFingerprint sets the "fingerprint" field if the given value is not nil.
+func (skuo *SSHKeyUpdateOne) SetNillableFingerprint(s *string) *SSHKeyUpdateOne {
+	if s != nil {
+		skuo.SetFingerprint(*s)
+	}
+	return skuo
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (skuo *SSHKeyUpdateOne) SetLastUsedAt(t time.Time) *SSHKeyUpdateOne {
+	skuo.mutation.SetLastUsedAt(t)
+	return skuo
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (skuo *SSHKeyUpdateOne) SetNillableLastUsedAt(t *time.Time) *SSHKeyUpdateOne {
+	if t != nil {
+		skuo.SetLastUsedAt(*t)
+	}
+	return skuo
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (skuo *SSHKeyUpdateOne) ClearLastUsedAt() *SSHKeyUpdateOne {
+	skuo.mutation.ClearLastUsedAt()
+	return skuo
+}
+
+// SetLastUsedIP sets the "last_used_ip" field.
+func (skuo *SSHKeyUpdateOne) SetLastUsedIP(s string) *SSHKeyUpdateOne {
+	skuo.mutation.SetLastUsedIP(s)
+	return skuo
+}
+
+// SetNillableLastUsedIP sets the "last_used_ip" field if the given value is not nil.
+func (skuo *SSHKeyUpdateOne) SetNillableLastUsedIP(s *string) *SSHKeyUpdateOne {
+	if s != nil {
+		skuo.SetLastUsedIP(*s)
+	}
+	return skuo
+}
+
+// ClearLastUsedIP clears the value of the "last_used_ip" field.
+func (skuo *SSHKeyUpdateOne) ClearLastUsedIP() *SSHKeyUpdateOne {
+	skuo.mutation.ClearLastUsedIP()
+	return skuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (skuo *SSHKeyUpdateOne) SetCreatedAt(t time.Time) *SSHKeyUpdateOne {
+	skuo.mutation.SetCreatedAt(t)
+	return skuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (skuo *SSHKeyUpdateOne) SetNillableCreatedAt(t *time.Time) *SSHKeyUpdateOne {
+	if t != nil {
+		skuo.SetCreatedAt(*t)
+	}
+	return skuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (skuo *SSHKeyUpdateOne) SetUser(u *User) *SSHKeyUpdateOne {
+	return skuo.SetUserID(u.ID)
+}
+
+// Mutation returns the SSHKeyMutation object of the builder.
+func (skuo *SSHKeyUpdateOne) Mutation() *SSHKeyMutation {
+	return skuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (skuo *SSHKeyUpdateOne) ClearUser() *SSHKeyUpdateOne {
+	skuo.mutation.ClearUser()
+	return skuo
+}
+
+// Where appends a list predicates to the SSHKeyUpdate builder.
+func (skuo *SSHKeyUpdateOne) Where(ps ...predicate.SSHKey) *SSHKeyUpdateOne {
+	skuo.mutation.Where(ps...)
+	return skuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (skuo *SSHKeyUpdateOne) Select(field string, fields ...string) *SSHKeyUpdateOne {
+	skuo.fields = append([]string{field}, fields...)
+	return skuo
+}
+
+// Save executes the query and returns the updated SSHKey entity.
+func (skuo *SSHKeyUpdateOne) Save(ctx context.Context) (*SSHKey, error) {
+	return withHooks(ctx, skuo.sqlSave, skuo.mutation, skuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (skuo *SSHKeyUpdateOne) SaveX(ctx context.Context) *SSHKey {
+	node, err := skuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (skuo *SSHKeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := skuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (skuo *SSHKeyUpdateOne) ExecX(ctx context.Context) {
+	if err := skuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (skuo *SSHKeyUpdateOne) check() error {
+	if v, ok := skuo.mutation.Name(); ok {
+		if err := sshkey.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SSHKey.name": %w`, err)}
+		}
+	}
+	if v, ok := skuo.mutation.PublicKey(); ok {
+		if err := sshkey.PublicKeyValidator(v); err != nil {
+			return &ValidationError{Name: "public_key", err: fmt.Errorf(`ent: validator failed for field "SSHKey.public_key": %w`, err)}
+		}
+	}
+	if v, ok := skuo.mutation.Fingerprint(); ok {
+		if err := sshkey.FingerprintValidator(v); err != nil {
+			return &ValidationError{Name: "fingerprint", err: fmt.Errorf(`ent: validator failed for field "SSHKey.fingerprint": %w`, err)}
+		}
+	}
+	if skuo.mutation.UserCleared() && len(skuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SSHKey.user"`)
+	}
+	return nil
+}
+
+func (skuo *SSHKeyUpdateOne) sqlSave(ctx context.Context) (_node *SSHKey, err error) {
+	if err := skuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sshkey.Table, sshkey.Columns, sqlgraph.NewFieldSpec(sshkey.FieldID, field.TypeInt))
+	id, ok := skuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SSHKey.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := skuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sshkey.FieldID)
+		for _, f := range fields {
+			if !sshkey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != sshkey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := skuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := skuo.mutation.Name(); ok {
+		_spec.SetField(sshkey.FieldName, field.TypeString, value)
+	}
+	if value, ok := skuo.mutation.PublicKey(); ok {
+		_spec.SetField(sshkey.FieldPublicKey, field.TypeString, value)
+	}
+	if value, ok := skuo.mutation.Fingerprint(); ok {
+		_spec.SetField(sshkey.FieldFingerprint, field.TypeString, value)
+	}
+	if value, ok := skuo.mutation.LastUsedAt(); ok {
+		_spec.SetField(sshkey.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if skuo.mutation.LastUsedAtCleared() {
+		_spec.ClearField(sshkey.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := skuo.mutation.LastUsedIP(); ok {
+		_spec.SetField(sshkey.FieldLastUsedIP, field.TypeString, value)
+	}
+	if skuo.mutation.LastUsedIPCleared() {
+		_spec.ClearField(sshkey.FieldLastUsedIP, field.TypeString)
+	}
+	if value, ok := skuo.mutation.CreatedAt(); ok {
+		_spec.SetField(sshkey.FieldCreatedAt, field.TypeTime, value)
+	}
+	if skuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sshkey.UserTable,
+			Columns: []string{sshkey.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := skuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sshkey.UserTable,
+			Columns: []string{sshkey.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &SSHKey{config: skuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, skuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sshkey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	skuo.mutation.done = true
+	return _node, nil
+}
@@ -12,14 +12,42 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AccessToken is the client for interacting with the AccessToken builders.
+	AccessToken *AccessTokenClient
+	// AuthFailure is the client for interacting with the AuthFailure builders.
+	AuthFailure *AuthFailureClient
+	// AuthThrottle is the client for interacting with the AuthThrottle builders.
+	AuthThrottle *AuthThrottleClient
+	// Change is the client for interacting with the Change builders.
+	Change *ChangeClient
 	// FileHash is the client for interacting with the FileHash builders.
 	FileHash *FileHashClient
+	// Group is the client for interacting with the Group builders.
+	Group *GroupClient
+	// Invite is the client for interacting with the Invite builders.
+	Invite *InviteClient
 	// Node is the client for interacting with the Node builders.
 	Node *NodeClient
+	// NodePermission is the client for interacting with the NodePermission builders.
+	NodePermission *NodePermissionClient
+	// S3Key is the client for interacting with the S3Key builders.
+	S3Key *S3KeyClient
+	// S3Upload is the client for interacting with the S3Upload builders.
+	S3Upload *S3UploadClient
+	// SSHKey is the client for interacting with the SSHKey builders.
+	SSHKey *SSHKeyClient
+	// Session is the client for interacting with the Session builders.
+	Session *SessionClient
 	// Share is the client for interacting with the Share builders.
 	Share *ShareClient
+	// ShareAccess is the client for interacting with the ShareAccess builders.
+	ShareAccess *ShareAccessClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// UserIdentity is the client for interacting with the UserIdentity builders.
+	UserIdentity *UserIdentityClient
+	// UserToken is the client for interacting with the UserToken builders.
+	UserToken *UserTokenClient
 
 	// lazily loaded.
 	client     *Client
@@ -151,10 +179,24 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AccessToken = NewAccessTokenClient(tx.config)
+	tx.AuthFailure = NewAuthFailureClient(tx.config)
+	tx.AuthThrottle = NewAuthThrottleClient(tx.config)
+	tx.Change = NewChangeClient(tx.config)
 	tx.FileHash = NewFileHashClient(tx.config)
+	tx.Group = NewGroupClient(tx.config)
+	tx.Invite = NewInviteClient(tx.config)
 	tx.Node = NewNodeClient(tx.config)
+	tx.NodePermission = NewNodePermissionClient(tx.config)
+	tx.S3Key = NewS3KeyClient(tx.config)
+	tx.S3Upload = NewS3UploadClient(tx.config)
+	tx.SSHKey = NewSSHKeyClient(tx.config)
+	tx.Session = NewSessionClient(tx.config)
 	tx.Share = NewShareClient(tx.config)
+	tx.ShareAccess = NewShareAccessClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.UserIdentity = NewUserIdentityClient(tx.config)
+	tx.UserToken = NewUserTokenClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -164,7 +206,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: FileHash.QueryXXX(), the query will be executed
+// applies a query, for example: AccessToken.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
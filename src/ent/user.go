@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"gopan-server/ent/user"
 	"strings"
@@ -23,7 +24,17 @@ type User struct {
 	PasswordHash string `json:"password_hash,omitempty"`
 	// Email holds the value of the "email" field.
 	Email string `json:"email,omitempty"`
-	// Total storage quota in bytes, default 10GB
+	// Whether the owner confirmed the email through a verification link
+	EmailVerified bool `json:"email_verified,omitempty"`
+	// admin: manages users, readonly: cannot modify files
+	Role user.Role `json:"role,omitempty"`
+	// Disabled users cannot log in
+	IsDisabled bool `json:"is_disabled,omitempty"`
+	// local: bcrypt password, ldap: password checked by the directory
+	AuthSource user.AuthSource `json:"auth_source,omitempty"`
+	// Distinguished name of directory users
+	LdapDn string `json:"ldap_dn,omitempty"`
+	// Total storage quota in bytes, new users get registration.default_quota
 	TotalQuota int64 `json:"total_quota,omitempty"`
 	// Total used storage in bytes
 	TotalUsed int64 `json:"total_used,omitempty"`
@@ -33,6 +44,16 @@ type User struct {
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// LastLoginAt holds the value of the "last_login_at" field.
 	LastLoginAt time.Time `json:"last_login_at,omitempty"`
+	// Base32 TOTP secret, set during enrollment
+	TotpSecret string `json:"-"`
+	// Whether login requires a TOTP code
+	TotpEnabled bool `json:"totp_enabled,omitempty"`
+	// Last accepted TOTP time step, to reject replayed codes
+	TotpLastStep int64 `json:"totp_last_step,omitempty"`
+	// SHA-256 of unused recovery codes
+	TotpRecoveryCodes []string `json:"-"`
+	// Sequence number of the latest entry in the change journal
+	ChangeSeq int64 `json:"change_seq,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
 	Edges        UserEdges `json:"edges"`
@@ -45,9 +66,35 @@ type UserEdges struct {
 	Nodes []*Node `json:"nodes,omitempty"`
 	// Shares holds the value of the shares edge.
 	Shares []*Share `json:"shares,omitempty"`
+	// OwnedGroups holds the value of the owned_groups edge.
+	OwnedGroups []*Group `json:"owned_groups,omitempty"`
+	// Groups holds the value of the groups edge.
+	Groups []*Group `json:"groups,omitempty"`
+	// GrantedPermissions holds the value of the granted_permissions edge.
+	GrantedPermissions []*NodePermission `json:"granted_permissions,omitempty"`
+	// NodePermissions holds the value of the node_permissions edge.
+	NodePermissions []*NodePermission `json:"node_permissions,omitempty"`
+	// Sessions holds the value of the sessions edge.
+	Sessions []*Session `json:"sessions,omitempty"`
+	// AccessTokens holds the value of the access_tokens edge.
+	AccessTokens []*AccessToken `json:"access_tokens,omitempty"`
+	// Identities holds the value of the identities edge.
+	Identities []*UserIdentity `json:"identities,omitempty"`
+	// Tokens holds the value of the tokens edge.
+	Tokens []*UserToken `json:"tokens,omitempty"`
+	// Invites holds the value of the invites edge.
+	Invites []*Invite `json:"invites,omitempty"`
+	// S3Keys holds the value of the s3_keys edge.
+	S3Keys []*S3Key `json:"s3_keys,omitempty"`
+	// S3Uploads holds the value of the s3_uploads edge.
+	S3Uploads []*S3Upload `json:"s3_uploads,omitempty"`
+	// SSHKeys holds the value of the ssh_keys edge.
+	SSHKeys []*SSHKey `json:"ssh_keys,omitempty"`
+	// Changes holds the value of the changes edge.
+	Changes []*Change `json:"changes,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [15]bool
 }
 
 // NodesOrErr returns the Nodes value or an error if the edge
@@ -68,14 +115,135 @@ func (e UserEdges) SharesOrErr() ([]*Share, error) {
 	return nil, &NotLoadedError{edge: "shares"}
 }
 
+// OwnedGroupsOrErr returns the OwnedGroups value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) OwnedGroupsOrErr() ([]*Group, error) {
+	if e.loadedTypes[2] {
+		return e.OwnedGroups, nil
+	}
+	return nil, &NotLoadedError{edge: "owned_groups"}
+}
+
+// GroupsOrErr returns the Groups value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) GroupsOrErr() ([]*Group, error) {
+	if e.loadedTypes[3] {
+		return e.Groups, nil
+	}
+	return nil, &NotLoadedError{edge: "groups"}
+}
+
+// GrantedPermissionsOrErr returns the GrantedPermissions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) GrantedPermissionsOrErr() ([]*NodePermission, error) {
+	if e.loadedTypes[4] {
+		return e.GrantedPermissions, nil
+	}
+	return nil, &NotLoadedError{edge: "granted_permissions"}
+}
+
+// NodePermissionsOrErr returns the NodePermissions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) NodePermissionsOrErr() ([]*NodePermission, error) {
+	if e.loadedTypes[5] {
+		return e.NodePermissions, nil
+	}
+	return nil, &NotLoadedError{edge: "node_permissions"}
+}
+
+// SessionsOrErr returns the Sessions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SessionsOrErr() ([]*Session, error) {
+	if e.loadedTypes[6] {
+		return e.Sessions, nil
+	}
+	return nil, &NotLoadedError{edge: "sessions"}
+}
+
+// AccessTokensOrErr returns the AccessTokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) AccessTokensOrErr() ([]*AccessToken, error) {
+	if e.loadedTypes[7] {
+		return e.AccessTokens, nil
+	}
+	return nil, &NotLoadedError{edge: "access_tokens"}
+}
+
+// IdentitiesOrErr returns the Identities value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) IdentitiesOrErr() ([]*UserIdentity, error) {
+	if e.loadedTypes[8] {
+		return e.Identities, nil
+	}
+	return nil, &NotLoadedError{edge: "identities"}
+}
+
+// TokensOrErr returns the Tokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) TokensOrErr() ([]*UserToken, error) {
+	if e.loadedTypes[9] {
+		return e.Tokens, nil
+	}
+	return nil, &NotLoadedError{edge: "tokens"}
+}
+
+// InvitesOrErr returns the Invites value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) InvitesOrErr() ([]*Invite, error) {
+	if e.loadedTypes[10] {
+		return e.Invites, nil
+	}
+	return nil, &NotLoadedError{edge: "invites"}
+}
+
+// S3KeysOrErr returns the S3Keys value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) S3KeysOrErr() ([]*S3Key, error) {
+	if e.loadedTypes[11] {
+		return e.S3Keys, nil
+	}
+	return nil, &NotLoadedError{edge: "s3_keys"}
+}
+
+// S3UploadsOrErr returns the S3Uploads value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) S3UploadsOrErr() ([]*S3Upload, error) {
+	if e.loadedTypes[12] {
+		return e.S3Uploads, nil
+	}
+	return nil, &NotLoadedError{edge: "s3_uploads"}
+}
+
+// SSHKeysOrErr returns the SSHKeys value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SSHKeysOrErr() ([]*SSHKey, error) {
+	if e.loadedTypes[13] {
+		return e.SSHKeys, nil
+	}
+	return nil, &NotLoadedError{edge: "ssh_keys"}
+}
+
+// ChangesOrErr returns the Changes value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ChangesOrErr() ([]*Change, error) {
+	if e.loadedTypes[14] {
+		return e.Changes, nil
+	}
+	return nil, &NotLoadedError{edge: "changes"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldID, user.FieldTotalQuota, user.FieldTotalUsed:
+		case user.FieldTotpRecoveryCodes:
+			values[i] = new([]byte)
+		case user.FieldEmailVerified, user.FieldIsDisabled, user.FieldTotpEnabled:
+			values[i] = new(sql.NullBool)
+		case user.FieldID, user.FieldTotalQuota, user.FieldTotalUsed, user.FieldTotpLastStep, user.FieldChangeSeq:
 			values[i] = new(sql.NullInt64)
-		case user.FieldUsername, user.FieldPasswordHash, user.FieldEmail:
+		case user.FieldUsername, user.FieldPasswordHash, user.FieldEmail, user.FieldRole, user.FieldAuthSource, user.FieldLdapDn, user.FieldTotpSecret:
 			values[i] = new(sql.NullString)
 		case user.FieldCreatedAt, user.FieldUpdatedAt, user.FieldLastLoginAt:
 			values[i] = new(sql.NullTime)
@@ -118,6 +286,36 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				u.Email = value.String
 			}
+		case user.FieldEmailVerified:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field email_verified", values[i])
+			} else if value.Valid {
+				u.EmailVerified = value.Bool
+			}
+		case user.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				u.Role = user.Role(value.String)
+			}
+		case user.FieldIsDisabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_disabled", values[i])
+			} else if value.Valid {
+				u.IsDisabled = value.Bool
+			}
+		case user.FieldAuthSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field auth_source", values[i])
+			} else if value.Valid {
+				u.AuthSource = user.AuthSource(value.String)
+			}
+		case user.FieldLdapDn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ldap_dn", values[i])
+			} else if value.Valid {
+				u.LdapDn = value.String
+			}
 		case user.FieldTotalQuota:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field total_quota", values[i])
@@ -148,6 +346,38 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				u.LastLoginAt = value.Time
 			}
+		case user.FieldTotpSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_secret", values[i])
+			} else if value.Valid {
+				u.TotpSecret = value.String
+			}
+		case user.FieldTotpEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_enabled", values[i])
+			} else if value.Valid {
+				u.TotpEnabled = value.Bool
+			}
+		case user.FieldTotpLastStep:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_last_step", values[i])
+			} else if value.Valid {
+				u.TotpLastStep = value.Int64
+			}
+		case user.FieldTotpRecoveryCodes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_recovery_codes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &u.TotpRecoveryCodes); err != nil {
+					return fmt.Errorf("unmarshal field totp_recovery_codes: %w", err)
+				}
+			}
+		case user.FieldChangeSeq:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field change_seq", values[i])
+			} else if value.Valid {
+				u.ChangeSeq = value.Int64
+			}
 		default:
 			u.selectValues.Set(columns[i], values[i])
 		}
@@ -171,6 +401,71 @@ func (u *User) QueryShares() *ShareQuery {
 	return NewUserClient(u.config).QueryShares(u)
 }
 
+// QueryOwnedGroups queries the "owned_groups" edge of the User entity.
+func (u *User) QueryOwnedGroups() *GroupQuery {
+	return NewUserClient(u.config).QueryOwnedGroups(u)
+}
+
+// QueryGroups queries the "groups" edge of the User entity.
+func (u *User) QueryGroups() *GroupQuery {
+	return NewUserClient(u.config).QueryGroups(u)
+}
+
+// QueryGrantedPermissions queries the "granted_permissions" edge of the User entity.
+func (u *User) QueryGrantedPermissions() *NodePermissionQuery {
+	return NewUserClient(u.config).QueryGrantedPermissions(u)
+}
+
+// QueryNodePermissions queries the "node_permissions" edge of the User entity.
+func (u *User) QueryNodePermissions() *NodePermissionQuery {
+	return NewUserClient(u.config).QueryNodePermissions(u)
+}
+
+// QuerySessions queries the "sessions" edge of the User entity.
+func (u *User) QuerySessions() *SessionQuery {
+	return NewUserClient(u.config).QuerySessions(u)
+}
+
+// QueryAccessTokens queries the "access_tokens" edge of the User entity.
+func (u *User) QueryAccessTokens() *AccessTokenQuery {
+	return NewUserClient(u.config).QueryAccessTokens(u)
+}
+
+// QueryIdentities queries the "identities" edge of the User entity.
+func (u *User) QueryIdentities() *UserIdentityQuery {
+	return NewUserClient(u.config).QueryIdentities(u)
+}
+
+// QueryTokens queries the "tokens" edge of the User entity.
+func (u *User) QueryTokens() *UserTokenQuery {
+	return NewUserClient(u.config).QueryTokens(u)
+}
+
+// QueryInvites queries the "invites" edge of the User entity.
+func (u *User) QueryInvites() *InviteQuery {
+	return NewUserClient(u.config).QueryInvites(u)
+}
+
+// QueryS3Keys queries the "s3_keys" edge of the User entity.
+func (u *User) QueryS3Keys() *S3KeyQuery {
+	return NewUserClient(u.config).QueryS3Keys(u)
+}
+
+// QueryS3Uploads queries the "s3_uploads" edge of the User entity.
+func (u *User) QueryS3Uploads() *S3UploadQuery {
+	return NewUserClient(u.config).QueryS3Uploads(u)
+}
+
+// QuerySSHKeys queries the "ssh_keys" edge of the User entity.
+func (u *User) QuerySSHKeys() *SSHKeyQuery {
+	return NewUserClient(u.config).QuerySSHKeys(u)
+}
+
+// QueryChanges queries the "changes" edge of the User entity.
+func (u *User) QueryChanges() *ChangeQuery {
+	return NewUserClient(u.config).QueryChanges(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -203,6 +498,21 @@ func (u *User) String() string {
 	builder.WriteString("email=")
 	builder.WriteString(u.Email)
 	builder.WriteString(", ")
+	builder.WriteString("email_verified=")
+	builder.WriteString(fmt.Sprintf("%v", u.EmailVerified))
+	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(fmt.Sprintf("%v", u.Role))
+	builder.WriteString(", ")
+	builder.WriteString("is_disabled=")
+	builder.WriteString(fmt.Sprintf("%v", u.IsDisabled))
+	builder.WriteString(", ")
+	builder.WriteString("auth_source=")
+	builder.WriteString(fmt.Sprintf("%v", u.AuthSource))
+	builder.WriteString(", ")
+	builder.WriteString("ldap_dn=")
+	builder.WriteString(u.LdapDn)
+	builder.WriteString(", ")
 	builder.WriteString("total_quota=")
 	builder.WriteString(fmt.Sprintf("%v", u.TotalQuota))
 	builder.WriteString(", ")
@@ -217,6 +527,19 @@ func (u *User) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("last_login_at=")
 	builder.WriteString(u.LastLoginAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("totp_secret=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("totp_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", u.TotpEnabled))
+	builder.WriteString(", ")
+	builder.WriteString("totp_last_step=")
+	builder.WriteString(fmt.Sprintf("%v", u.TotpLastStep))
+	builder.WriteString(", ")
+	builder.WriteString("totp_recovery_codes=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("change_seq=")
+	builder.WriteString(fmt.Sprintf("%v", u.ChangeSeq))
 	builder.WriteByte(')')
 	return builder.String()
 }
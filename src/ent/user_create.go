@@ -6,9 +6,20 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"gopan-server/ent/accesstoken"
+	"gopan-server/ent/change"
+	"gopan-server/ent/group"
+	"gopan-server/ent/invite"
 	"gopan-server/ent/node"
+	"gopan-server/ent/nodepermission"
+	"gopan-server/ent/s3key"
+	"gopan-server/ent/s3upload"
+	"gopan-server/ent/session"
 	"gopan-server/ent/share"
+	"gopan-server/ent/sshkey"
 	"gopan-server/ent/user"
+	"gopan-server/ent/useridentity"
+	"gopan-server/ent/usertoken"
 	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -48,20 +59,82 @@ func (uc *UserCreate) SetNillableEmail(s *string) *UserCreate {
 	return uc
 }
 
-// SetTotalQuota sets the "total_quota" field.
-func (uc *UserCreate) SetTotalQuota(i int64) *UserCreate {
-	uc.mutation.SetTotalQuota(i)
+// SetEmailVerified sets the "email_verified" field.
+func (uc *UserCreate) SetEmailVerified(b bool) *UserCreate {
+	uc.mutation.SetEmailVerified(b)
 	return uc
 }
 
-// SetNillableTotalQuota sets the "total_quota" field if the given value is not nil.
-func (uc *UserCreate) SetNillableTotalQuota(i *int64) *UserCreate {
-	if i != nil {
-		uc.SetTotalQuota(*i)
+// SetNillableEmailVerified sets the "email_verified" field if the given value is not nil.
+func (uc *UserCreate) SetNillableEmailVerified(b *bool) *UserCreate {
+	if b != nil {
+		uc.SetEmailVerified(*b)
+	}
+	return uc
+}
+
+// SetRole sets the "role" field.
+func (uc *UserCreate) SetRole(u user.Role) *UserCreate {
+	uc.mutation.SetRole(u)
+	return uc
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (uc *UserCreate) SetNillableRole(u *user.Role) *UserCreate {
+	if u != nil {
+		uc.SetRole(*u)
+	}
+	return uc
+}
+
+// SetIsDisabled sets the "is_disabled" field.
+func (uc *UserCreate) SetIsDisabled(b bool) *UserCreate {
+	uc.mutation.SetIsDisabled(b)
+	return uc
+}
+
+// SetNillableIsDisabled sets the "is_disabled" field if the given value is not nil.
+func (uc *UserCreate) SetNillableIsDisabled(b *bool) *UserCreate {
+	if b != nil {
+		uc.SetIsDisabled(*b)
+	}
+	return uc
+}
+
+// SetAuthSource sets the "auth_source" field.
+func (uc *UserCreate) SetAuthSource(us user.AuthSource) *UserCreate {
+	uc.mutation.SetAuthSource(us)
+	return uc
+}
+
+// SetNillableAuthSource sets the "auth_source" field if the given value is not nil.
+func (uc *UserCreate) SetNillableAuthSource(us *user.AuthSource) *UserCreate {
+	if us != nil {
+		uc.SetAuthSource(*us)
+	}
+	return uc
+}
+
+// SetLdapDn sets the "ldap_dn" field.
+func (uc *UserCreate) SetLdapDn(s string) *UserCreate {
+	uc.mutation.SetLdapDn(s)
+	return uc
+}
+
+// SetNillableLdapDn sets the "ldap_dn" field if the given value is not nil.
+func (uc *UserCreate) SetNillableLdapDn(s *string) *UserCreate {
+	if s != nil {
+		uc.SetLdapDn(*s)
 	}
 	return uc
 }
 
+// SetTotalQuota sets the "total_quota" field.
+func (uc *UserCreate) SetTotalQuota(i int64) *UserCreate {
+	uc.mutation.SetTotalQuota(i)
+	return uc
+}
+
 // SetTotalUsed sets the "total_used" field.
 func (uc *UserCreate) SetTotalUsed(i int64) *UserCreate {
 	uc.mutation.SetTotalUsed(i)
@@ -118,6 +191,68 @@ func (uc *UserCreate) SetNillableLastLoginAt(t *time.Time) *UserCreate {
 	return uc
 }
 
+// SetTotpSecret sets the "totp_secret" field.
+func (uc *UserCreate) SetTotpSecret(s string) *UserCreate {
+	uc.mutation.SetTotpSecret(s)
+	return uc
+}
+
+// SetNillableTotpSecret sets the "totp_secret" field if the given value is not nil.
+func (uc *UserCreate) SetNillableTotpSecret(s *string) *UserCreate {
+	if s != nil {
+		uc.SetTotpSecret(*s)
+	}
+	return uc
+}
+
+// SetTotpEnabled sets the "totp_enabled" field.
+func (uc *UserCreate) SetTotpEnabled(b bool) *UserCreate {
+	uc.mutation.SetTotpEnabled(b)
+	return uc
+}
+
+// SetNillableTotpEnabled sets the "totp_enabled" field if the given value is not nil.
+func (uc *UserCreate) SetNillableTotpEnabled(b *bool) *UserCreate {
+	if b != nil {
+		uc.SetTotpEnabled(*b)
+	}
+	return uc
+}
+
+// SetTotpLastStep sets the "totp_last_step" field.
+func (uc *UserCreate) SetTotpLastStep(i int64) *UserCreate {
+	uc.mutation.SetTotpLastStep(i)
+	return uc
+}
+
+// SetNillableTotpLastStep sets the "totp_last_step" field if the given value is not nil.
+func (uc *UserCreate) SetNillableTotpLastStep(i *int64) *UserCreate {
+	if i != nil {
+		uc.SetTotpLastStep(*i)
+	}
+	return uc
+}
+
+// SetTotpRecoveryCodes sets the "totp_recovery_codes" field.
+func (uc *UserCreate) SetTotpRecoveryCodes(s []string) *UserCreate {
+	uc.mutation.SetTotpRecoveryCodes(s)
+	return uc
+}
+
+// SetChangeSeq sets the "change_seq" field.
+func (uc *UserCreate) SetChangeSeq(i int64) *UserCreate {
+	uc.mutation.SetChangeSeq(i)
+	return uc
+}
+
+// SetNillableChangeSeq sets the "change_seq" field if the given value is not nil.
+func (uc *UserCreate) SetNillableChangeSeq(i *int64) *UserCreate {
+	if i != nil {
+		uc.SetChangeSeq(*i)
+	}
+	return uc
+}
+
 // AddNodeIDs adds the "nodes" edge to the Node entity by IDs.
 func (uc *UserCreate) AddNodeIDs(ids ...int) *UserCreate {
 	uc.mutation.AddNodeIDs(ids...)
@@ -148,6 +283,201 @@ func (uc *UserCreate) AddShares(s ...*Share) *UserCreate {
 	return uc.AddShareIDs(ids...)
 }
 
+// AddOwnedGroupIDs adds the "owned_groups" edge to the Group entity by IDs.
+func (uc *UserCreate) AddOwnedGroupIDs(ids ...int) *UserCreate {
+	uc.mutation.AddOwnedGroupIDs(ids...)
+	return uc
+}
+
+// AddOwnedGroups adds the "owned_groups" edges to the Group entity.
+func (uc *UserCreate) AddOwnedGroups(g ...*Group) *UserCreate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uc.AddOwnedGroupIDs(ids...)
+}
+
+// AddGroupIDs adds the "groups" edge to the Group entity by IDs.
+func (uc *UserCreate) AddGroupIDs(ids ...int) *UserCreate {
+	uc.mutation.AddGroupIDs(ids...)
+	return uc
+}
+
+// AddGroups adds the "groups" edges to the Group entity.
+func (uc *UserCreate) AddGroups(g ...*Group) *UserCreate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uc.AddGroupIDs(ids...)
+}
+
+// AddGrantedPermissionIDs adds the "granted_permissions" edge to the NodePermission entity by IDs.
+func (uc *UserCreate) AddGrantedPermissionIDs(ids ...int) *UserCreate {
+	uc.mutation.AddGrantedPermissionIDs(ids...)
+	return uc
+}
+
+// AddGrantedPermissions adds the "granted_permissions" edges to the NodePermission entity.
+func (uc *UserCreate) AddGrantedPermissions(n ...*NodePermission) *UserCreate {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return uc.AddGrantedPermissionIDs(ids...)
+}
+
+// AddNodePermissionIDs adds the "node_permissions" edge to the NodePermission entity by IDs.
+func (uc *UserCreate) AddNodePermissionIDs(ids ...int) *UserCreate {
+	uc.mutation.AddNodePermissionIDs(ids...)
+	return uc
+}
+
+// AddNodePermissions adds the "node_permissions" edges to the NodePermission entity.
+func (uc *UserCreate) AddNodePermissions(n ...*NodePermission) *UserCreate {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return uc.AddNodePermissionIDs(ids...)
+}
+
+// AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
+func (uc *UserCreate) AddSessionIDs(ids ...int) *UserCreate {
+	uc.mutation.AddSessionIDs(ids...)
+	return uc
+}
+
+// AddSessions adds the "sessions" edges to the Session entity.
+func (uc *UserCreate) AddSessions(s ...*Session) *UserCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uc.AddSessionIDs(ids...)
+}
+
+// AddAccessTokenIDs adds the "access_tokens" edge to the AccessToken entity by IDs.
+func (uc *UserCreate) AddAccessTokenIDs(ids ...int) *UserCreate {
+	uc.mutation.AddAccessTokenIDs(ids...)
+	return uc
+}
+
+// AddAccessTokens adds the "access_tokens" edges to the AccessToken entity.
+func (uc *UserCreate) AddAccessTokens(a ...*AccessToken) *UserCreate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uc.AddAccessTokenIDs(ids...)
+}
+
+// AddIdentityIDs adds the "identities" edge to the UserIdentity entity by IDs.
+func (uc *UserCreate) AddIdentityIDs(ids ...int) *UserCreate {
+	uc.mutation.AddIdentityIDs(ids...)
+	return uc
+}
+
+// AddIdentities adds the "identities" edges to the UserIdentity entity.
+func (uc *UserCreate) AddIdentities(u ...*UserIdentity) *UserCreate {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uc.AddIdentityIDs(ids...)
+}
+
+// AddTokenIDs adds the "tokens" edge to the UserToken entity by IDs.
+func (uc *UserCreate) AddTokenIDs(ids ...int) *UserCreate {
+	uc.mutation.AddTokenIDs(ids...)
+	return uc
+}
+
+// AddTokens adds the "tokens" edges to the UserToken entity.
+func (uc *UserCreate) AddTokens(u ...*UserToken) *UserCreate {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uc.AddTokenIDs(ids...)
+}
+
+// AddInviteIDs adds the "invites" edge to the Invite entity by IDs.
+func (uc *UserCreate) AddInviteIDs(ids ...int) *UserCreate {
+	uc.mutation.AddInviteIDs(ids...)
+	return uc
+}
+
+// AddInvites adds the "invites" edges to the Invite entity.
+func (uc *UserCreate) AddInvites(i ...*Invite) *UserCreate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uc.AddInviteIDs(ids...)
+}
+
+// AddS3KeyIDs adds the "s3_keys" edge to the S3Key entity by IDs.
+func (uc *UserCreate) AddS3KeyIDs(ids ...int) *UserCreate {
+	uc.mutation.AddS3KeyIDs(ids...)
+	return uc
+}
+
+// AddS3Keys adds the "s3_keys" edges to the S3Key entity.
+func (uc *UserCreate) AddS3Keys(s ...*S3Key) *UserCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uc.AddS3KeyIDs(ids...)
+}
+
+// AddS3UploadIDs adds the "s3_uploads" edge to the S3Upload entity by IDs.
+func (uc *UserCreate) AddS3UploadIDs(ids ...int) *UserCreate {
+	uc.mutation.AddS3UploadIDs(ids...)
+	return uc
+}
+
+// AddS3Uploads adds the "s3_uploads" edges to the S3Upload entity.
+func (uc *UserCreate) AddS3Uploads(s ...*S3Upload) *UserCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uc.AddS3UploadIDs(ids...)
+}
+
+// AddSSHKeyIDs adds the "ssh_keys" edge to the SSHKey entity by IDs.
+func (uc *UserCreate) AddSSHKeyIDs(ids ...int) *UserCreate {
+	uc.mutation.AddSSHKeyIDs(ids...)
+	return uc
+}
+
+// AddSSHKeys adds the "ssh_keys" edges to the SSHKey entity.
+func (uc *UserCreate) AddSSHKeys(s ...*SSHKey) *UserCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uc.AddSSHKeyIDs(ids...)
+}
+
+// AddChangeIDs adds the "changes" edge to the Change entity by IDs.
+func (uc *UserCreate) AddChangeIDs(ids ...int) *UserCreate {
+	uc.mutation.AddChangeIDs(ids...)
+	return uc
+}
+
+// AddChanges adds the "changes" edges to the Change entity.
+func (uc *UserCreate) AddChanges(c ...*Change) *UserCreate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uc.AddChangeIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uc *UserCreate) Mutation() *UserMutation {
 	return uc.mutation
@@ -183,9 +513,21 @@ func (uc *UserCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (uc *UserCreate) defaults() {
-	if _, ok := uc.mutation.TotalQuota(); !ok {
-		v := user.DefaultTotalQuota
-		uc.mutation.SetTotalQuota(v)
+	if _, ok := uc.mutation.EmailVerified(); !ok {
+		v := user.DefaultEmailVerified
+		uc.mutation.SetEmailVerified(v)
+	}
+	if _, ok := uc.mutation.Role(); !ok {
+		v := user.DefaultRole
+		uc.mutation.SetRole(v)
+	}
+	if _, ok := uc.mutation.IsDisabled(); !ok {
+		v := user.DefaultIsDisabled
+		uc.mutation.SetIsDisabled(v)
+	}
+	if _, ok := uc.mutation.AuthSource(); !ok {
+		v := user.DefaultAuthSource
+		uc.mutation.SetAuthSource(v)
 	}
 	if _, ok := uc.mutation.TotalUsed(); !ok {
 		v := user.DefaultTotalUsed
@@ -199,6 +541,18 @@ func (uc *UserCreate) defaults() {
 		v := user.DefaultUpdatedAt()
 		uc.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := uc.mutation.TotpEnabled(); !ok {
+		v := user.DefaultTotpEnabled
+		uc.mutation.SetTotpEnabled(v)
+	}
+	if _, ok := uc.mutation.TotpLastStep(); !ok {
+		v := user.DefaultTotpLastStep
+		uc.mutation.SetTotpLastStep(v)
+	}
+	if _, ok := uc.mutation.ChangeSeq(); !ok {
+		v := user.DefaultChangeSeq
+		uc.mutation.SetChangeSeq(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -219,6 +573,28 @@ func (uc *UserCreate) check() error {
 			return &ValidationError{Name: "password_hash", err: fmt.Errorf(`ent: validator failed for field "User.password_hash": %w`, err)}
 		}
 	}
+	if _, ok := uc.mutation.EmailVerified(); !ok {
+		return &ValidationError{Name: "email_verified", err: errors.New(`ent: missing required field "User.email_verified"`)}
+	}
+	if _, ok := uc.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "User.role"`)}
+	}
+	if v, ok := uc.mutation.Role(); ok {
+		if err := user.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
+		}
+	}
+	if _, ok := uc.mutation.IsDisabled(); !ok {
+		return &ValidationError{Name: "is_disabled", err: errors.New(`ent: missing required field "User.is_disabled"`)}
+	}
+	if _, ok := uc.mutation.AuthSource(); !ok {
+		return &ValidationError{Name: "auth_source", err: errors.New(`ent: missing required field "User.auth_source"`)}
+	}
+	if v, ok := uc.mutation.AuthSource(); ok {
+		if err := user.AuthSourceValidator(v); err != nil {
+			return &ValidationError{Name: "auth_source", err: fmt.Errorf(`ent: validator failed for field "User.auth_source": %w`, err)}
+		}
+	}
 	if _, ok := uc.mutation.TotalQuota(); !ok {
 		return &ValidationError{Name: "total_quota", err: errors.New(`ent: missing required field "User.total_quota"`)}
 	}
@@ -231,6 +607,15 @@ func (uc *UserCreate) check() error {
 	if _, ok := uc.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "User.updated_at"`)}
 	}
+	if _, ok := uc.mutation.TotpEnabled(); !ok {
+		return &ValidationError{Name: "totp_enabled", err: errors.New(`ent: missing required field "User.totp_enabled"`)}
+	}
+	if _, ok := uc.mutation.TotpLastStep(); !ok {
+		return &ValidationError{Name: "totp_last_step", err: errors.New(`ent: missing required field "User.totp_last_step"`)}
+	}
+	if _, ok := uc.mutation.ChangeSeq(); !ok {
+		return &ValidationError{Name: "change_seq", err: errors.New(`ent: missing required field "User.change_seq"`)}
+	}
 	return nil
 }
 
@@ -269,6 +654,26 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldEmail, field.TypeString, value)
 		_node.Email = value
 	}
+	if value, ok := uc.mutation.EmailVerified(); ok {
+		_spec.SetField(user.FieldEmailVerified, field.TypeBool, value)
+		_node.EmailVerified = value
+	}
+	if value, ok := uc.mutation.Role(); ok {
+		_spec.SetField(user.FieldRole, field.TypeEnum, value)
+		_node.Role = value
+	}
+	if value, ok := uc.mutation.IsDisabled(); ok {
+		_spec.SetField(user.FieldIsDisabled, field.TypeBool, value)
+		_node.IsDisabled = value
+	}
+	if value, ok := uc.mutation.AuthSource(); ok {
+		_spec.SetField(user.FieldAuthSource, field.TypeEnum, value)
+		_node.AuthSource = value
+	}
+	if value, ok := uc.mutation.LdapDn(); ok {
+		_spec.SetField(user.FieldLdapDn, field.TypeString, value)
+		_node.LdapDn = value
+	}
 	if value, ok := uc.mutation.TotalQuota(); ok {
 		_spec.SetField(user.FieldTotalQuota, field.TypeInt64, value)
 		_node.TotalQuota = value
@@ -289,6 +694,26 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldLastLoginAt, field.TypeTime, value)
 		_node.LastLoginAt = value
 	}
+	if value, ok := uc.mutation.TotpSecret(); ok {
+		_spec.SetField(user.FieldTotpSecret, field.TypeString, value)
+		_node.TotpSecret = value
+	}
+	if value, ok := uc.mutation.TotpEnabled(); ok {
+		_spec.SetField(user.FieldTotpEnabled, field.TypeBool, value)
+		_node.TotpEnabled = value
+	}
+	if value, ok := uc.mutation.TotpLastStep(); ok {
+		_spec.SetField(user.FieldTotpLastStep, field.TypeInt64, value)
+		_node.TotpLastStep = value
+	}
+	if value, ok := uc.mutation.TotpRecoveryCodes(); ok {
+		_spec.SetField(user.FieldTotpRecoveryCodes, field.TypeJSON, value)
+		_node.TotpRecoveryCodes = value
+	}
+	if value, ok := uc.mutation.ChangeSeq(); ok {
+		_spec.SetField(user.FieldChangeSeq, field.TypeInt64, value)
+		_node.ChangeSeq = value
+	}
 	if nodes := uc.mutation.NodesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -321,6 +746,214 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := uc.mutation.OwnedGroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OwnedGroupsTable,
+			Columns: []string{user.OwnedGroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.GroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.GroupsTable,
+			Columns: user.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.GrantedPermissionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GrantedPermissionsTable,
+			Columns: []string{user.GrantedPermissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(nodepermission.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.NodePermissionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NodePermissionsTable,
+			Columns: []string{user.NodePermissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(nodepermission.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.AccessTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AccessTokensTable,
+			Columns: []string{user.AccessTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accesstoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.IdentitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IdentitiesTable,
+			Columns: []string{user.IdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useridentity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.TokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TokensTable,
+			Columns: []string{user.TokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usertoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.InvitesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.InvitesTable,
+			Columns: []string{user.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invite.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.S3KeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.S3KeysTable,
+			Columns: []string{user.S3KeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(s3key.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.S3UploadsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.S3UploadsTable,
+			Columns: []string{user.S3UploadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(s3upload.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.SSHKeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SSHKeysTable,
+			Columns: []string{user.SSHKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sshkey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.ChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ChangesTable,
+			Columns: []string{user.ChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(change.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
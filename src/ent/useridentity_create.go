@@ -0,0 +1,327 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gopan-server/ent/user"
+	"gopan-server/ent/useridentity"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// UserIdentityCreate is the builder for creating a UserIdentity entity.
+type UserIdentityCreate struct {
+	config
+	mutation *UserIdentityMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (uic *UserIdentityCreate) SetUserID(i int) *UserIdentityCreate {
+	uic.mutation.SetUserID(i)
+	return uic
+}
+
+// SetProvider sets the "provider" field.
+func (uic *UserIdentityCreate) SetProvider(s string) *UserIdentityCreate {
+	uic.mutation.SetProvider(s)
+	return uic
+}
+
+// SetIssuer sets the "issuer" field.
+func (uic *UserIdentityCreate) SetIssuer(s string) *UserIdentityCreate {
+	uic.mutation.SetIssuer(s)
+	return uic
+}
+
+// SetSubject sets the "subject" field.
+func (uic *UserIdentityCreate) SetSubject(s string) *UserIdentityCreate {
+	uic.mutation.SetSubject(s)
+	return uic
+}
+
+// SetEmail sets the "email" field.
+func (uic *UserIdentityCreate) SetEmail(s string) *UserIdentityCreate {
+	uic.mutation.SetEmail(s)
+	return uic
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (uic *UserIdentityCreate) SetNillableEmail(s *string) *UserIdentityCreate {
+	if s != nil {
+		uic.SetEmail(*s)
+	}
+	return uic
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (uic *UserIdentityCreate) SetCreatedAt(t time.Time) *UserIdentityCreate {
+	uic.mutation.SetCreatedAt(t)
+	return uic
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (uic *UserIdentityCreate) SetNillableCreatedAt(t *time.Time) *UserIdentityCreate {
+	if t != nil {
+		uic.SetCreatedAt(*t)
+	}
+	return uic
+}
+
+// SetLastLoginAt sets the "last_login_at" field.
+func (uic *UserIdentityCreate) SetLastLoginAt(t time.Time) *UserIdentityCreate {
+	uic.mutation.SetLastLoginAt(t)
+	return uic
+}
+
+// SetNillableLastLoginAt sets the "last_login_at" field if the given value is not nil.
+func (uic *UserIdentityCreate) SetNillableLastLoginAt(t *time.Time) *UserIdentityCreate {
+	if t != nil {
+		uic.SetLastLoginAt(*t)
+	}
+	return uic
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (uic *UserIdentityCreate) SetUser(u *User) *UserIdentityCreate {
+	return uic.SetUserID(u.ID)
+}
+
+// Mutation returns the UserIdentityMutation object of the builder.
+func (uic *UserIdentityCreate) Mutation() *UserIdentityMutation {
+	return uic.mutation
+}
+
+// Save creates the UserIdentity in the database.
+func (uic *UserIdentityCreate) Save(ctx context.Context) (*UserIdentity, error) {
+	uic.defaults()
+	return withHooks(ctx, uic.sqlSave, uic.mutation, uic.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (uic *UserIdentityCreate) SaveX(ctx context.Context) *UserIdentity {
+	v, err := uic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (uic *UserIdentityCreate) Exec(ctx context.Context) error {
+	_, err := uic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uic *UserIdentityCreate) ExecX(ctx context.Context) {
+	if err := uic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (uic *UserIdentityCreate) defaults() {
+	if _, ok := uic.mutation.CreatedAt(); !ok {
+		v := useridentity.DefaultCreatedAt()
+		uic.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uic *UserIdentityCreate) check() error {
+	if _, ok := uic.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "UserIdentity.user_id"`)}
+	}
+	if _, ok := uic.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required field "UserIdentity.provider"`)}
+	}
+	if v, ok := uic.mutation.Provider(); ok {
+		if err := useridentity.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "UserIdentity.provider": %w`, err)}
+		}
+	}
+	if _, ok := uic.mutation.Issuer(); !ok {
+		return &ValidationError{Name: "issuer", err: errors.New(`ent: missing required field "UserIdentity.issuer"`)}
+	}
+	if v, ok := uic.mutation.Issuer(); ok {
+		if err := useridentity.IssuerValidator(v); err != nil {
+			return &ValidationError{Name: "issuer", err: fmt.Errorf(`ent: validator failed for field "UserIdentity.issuer": %w`, err)}
+		}
+	}
+	if _, ok := uic.mutation.Subject(); !ok {
+		return &ValidationError{Name: "subject", err: errors.New(`ent: missing required field "UserIdentity.subject"`)}
+	}
+	if v, ok := uic.mutation.Subject(); ok {
+		if err := useridentity.SubjectValidator(v); err != nil {
+			return &ValidationError{Name: "subject", err: fmt.Errorf(`ent: validator failed for field "UserIdentity.subject": %w`, err)}
+		}
+	}
+	if _, ok := uic.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "UserIdentity.created_at"`)}
+	}
+	if len(uic.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "UserIdentity.user"`)}
+	}
+	return nil
+}
+
+func (uic *UserIdentityCreate) sqlSave(ctx context.Context) (*UserIdentity, error) {
+	if err := uic.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := uic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, uic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	uic.mutation.id = &_node.ID
+	uic.mutation.done = true
+	return _node, nil
+}
+
+func (uic *UserIdentityCreate) createSpec() (*UserIdentity, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UserIdentity{config: uic.config}
+		_spec = sqlgraph.NewCreateSpec(useridentity.Table, sqlgraph.NewFieldSpec(useridentity.FieldID, field.TypeInt))
+	)
+	if value, ok := uic.mutation.Provider(); ok {
+		_spec.SetField(useridentity.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := uic.mutation.Issuer(); ok {
+		_spec.SetField(useridentity.FieldIssuer, field.TypeString, value)
+		_node.Issuer = value
+	}
+	if value, ok := uic.mutation.Subject(); ok {
+		_spec.SetField(useridentity.FieldSubject, field.TypeString, value)
+		_node.Subject = value
+	}
+	if value, ok := uic.mutation.Email(); ok {
+		_spec.SetField(useridentity.FieldEmail, field.TypeString, value)
+		_node.Email = value
+	}
+	if value, ok := uic.mutation.CreatedAt(); ok {
+		_spec.SetField(useridentity.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := uic.mutation.LastLoginAt(); ok {
+		_spec.SetField(useridentity.FieldLastLoginAt, field.TypeTime, value)
+		_node.LastLoginAt = &value
+	}
+	if nodes := uic.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   useridentity.UserTable,
+			Columns: []string{useridentity.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// UserIdentityCreateBulk is the builder for creating many UserIdentity entities in bulk.
+type UserIdentityCreateBulk struct {
+	config
+	err      error
+	builders []*UserIdentityCreate
+}
+
+// Save creates the UserIdentity entities in the database.
+func (uicb *UserIdentityCreateBulk) Save(ctx context.Context) ([]*UserIdentity, error) {
+	if uicb.err != nil {
+		return nil, uicb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(uicb.builders))
+	nodes := make([]*UserIdentity, len(uicb.builders))
+	mutators := make([]Mutator, len(uicb.builders))
+	for i := range uicb.builders {
+		func(i int, root context.Context) {
+			builder := uicb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UserIdentityMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, uicb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, uicb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, uicb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uicb *UserIdentityCreateBulk) SaveX(ctx context.Context) []*UserIdentity {
+	v, err := uicb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (uicb *UserIdentityCreateBulk) Exec(ctx context.Context) error {
+	_, err := uicb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uicb *UserIdentityCreateBulk) ExecX(ctx context.Context) {
+	if err := uicb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,549 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gopan-server/ent/predicate"
+	"gopan-server/ent/user"
+	"gopan-server/ent/usertoken"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// UserTokenUpdate is the builder for updating UserToken entities.
+type UserTokenUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UserTokenMutation
+}
+
+// Where appends a list predicates to the UserTokenUpdate builder.
+func (utu *UserTokenUpdate) Where(ps ...predicate.UserToken) *UserTokenUpdate {
+	utu.mutation.Where(ps...)
+	return utu
+}
+
+// SetUserID sets the "user_id" field.
+func (utu *UserTokenUpdate) SetUserID(i int) *UserTokenUpdate {
+	utu.mutation.SetUserID(i)
+	return utu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (utu *UserTokenUpdate) SetNillableUserID(i *int) *UserTokenUpdate {
+	if i != nil {
+		utu.SetUserID(*i)
+	}
+	return utu
+}
+
+// SetPurpose sets the "purpose" field.
+func (utu *UserTokenUpdate) SetPurpose(u usertoken.Purpose) *UserTokenUpdate {
+	utu.mutation.SetPurpose(u)
+	return utu
+}
+
+// SetNillablePurpose sets the "purpose" field if the given value is not nil.
+func (utu *UserTokenUpdate) SetNillablePurpose(u *usertoken.Purpose) *UserTokenUpdate {
+	if u != nil {
+		utu.SetPurpose(*u)
+	}
+	return utu
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (utu *UserTokenUpdate) SetTokenHash(s string) *UserTokenUpdate {
+	utu.mutation.SetTokenHash(s)
+	return utu
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (utu *UserTokenUpdate) SetNillableTokenHash(s *string) *UserTokenUpdate {
+	if s != nil {
+		utu.SetTokenHash(*s)
+	}
+	return utu
+}
+
+// SetEmail sets the "email" field.
+func (utu *UserTokenUpdate) SetEmail(s string) *UserTokenUpdate {
+	utu.mutation.SetEmail(s)
+	return utu
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (utu *UserTokenUpdate) SetNillableEmail(s *string) *UserTokenUpdate {
+	if s != nil {
+		utu.SetEmail(*s)
+	}
+	return utu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (utu *UserTokenUpdate) SetCreatedAt(t time.Time) *UserTokenUpdate {
+	utu.mutation.SetCreatedAt(t)
+	return utu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (utu *UserTokenUpdate) SetNillableCreatedAt(t *time.Time) *UserTokenUpdate {
+	if t != nil {
+		utu.SetCreatedAt(*t)
+	}
+	return utu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (utu *UserTokenUpdate) SetExpiresAt(t time.Time) *UserTokenUpdate {
+	utu.mutation.SetExpiresAt(t)
+	return utu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (utu *UserTokenUpdate) SetNillableExpiresAt(t *time.Time) *UserTokenUpdate {
+	if t != nil {
+		utu.SetExpiresAt(*t)
+	}
+	return utu
+}
+
+// SetUsedAt sets the "used_at" field.
+func (utu *UserTokenUpdate) SetUsedAt(t time.Time) *UserTokenUpdate {
+	utu.mutation.SetUsedAt(t)
+	return utu
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (utu *UserTokenUpdate) SetNillableUsedAt(t *time.Time) *UserTokenUpdate {
+	if t != nil {
+		utu.SetUsedAt(*t)
+	}
+	return utu
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (utu *UserTokenUpdate) ClearUsedAt() *UserTokenUpdate {
+	utu.mutation.ClearUsedAt()
+	return utu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (utu *UserTokenUpdate) SetUser(u *User) *UserTokenUpdate {
+	return utu.SetUserID(u.ID)
+}
+
+// Mutation returns the UserTokenMutation object of the builder.
+func (utu *UserTokenUpdate) Mutation() *UserTokenMutation {
+	return utu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (utu *UserTokenUpdate) ClearUser() *UserTokenUpdate {
+	utu.mutation.ClearUser()
+	return utu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (utu *UserTokenUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, utu.sqlSave, utu.mutation, utu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (utu *UserTokenUpdate) SaveX(ctx context.Context) int {
+	affected, err := utu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (utu *UserTokenUpdate) Exec(ctx context.Context) error {
+	_, err := utu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (utu *UserTokenUpdate) ExecX(ctx context.Context) {
+	if err := utu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (utu *UserTokenUpdate) check() error {
+	if v, ok := utu.mutation.Purpose(); ok {
+		if err := usertoken.PurposeValidator(v); err != nil {
+			return &ValidationError{Name: "purpose", err: fmt.Errorf(`ent: validator failed for field "UserToken.purpose": %w`, err)}
+		}
+	}
+	if v, ok := utu.mutation.TokenHash(); ok {
+		if err := usertoken.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "UserToken.token_hash": %w`, err)}
+		}
+	}
+	if utu.mutation.UserCleared() && len(utu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "UserToken.user"`)
+	}
+	return nil
+}
+
+func (utu *UserTokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := utu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usertoken.Table, usertoken.Columns, sqlgraph.NewFieldSpec(usertoken.FieldID, field.TypeInt))
+	if ps := utu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := utu.mutation.Purpose(); ok {
+		_spec.SetField(usertoken.FieldPurpose, field.TypeEnum, value)
+	}
+	if value, ok := utu.mutation.TokenHash(); ok {
+		_spec.SetField(usertoken.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := utu.mutation.Email(); ok {
+		_spec.SetField(usertoken.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := utu.mutation.CreatedAt(); ok {
+		_spec.SetField(usertoken.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := utu.mutation.ExpiresAt(); ok {
+		_spec.SetField(usertoken.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := utu.mutation.UsedAt(); ok {
+		_spec.SetField(usertoken.FieldUsedAt, field.TypeTime, value)
+	}
+	if utu.mutation.UsedAtCleared() {
+		_spec.ClearField(usertoken.FieldUsedAt, field.TypeTime)
+	}
+	if utu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   usertoken.UserTable,
+			Columns: []string{usertoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := utu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   usertoken.UserTable,
+			Columns: []string{usertoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, utu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usertoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	utu.mutation.done = true
+	return n, nil
+}
+
+// UserTokenUpdateOne is the builder for updating a single UserToken entity.
+type UserTokenUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserTokenMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (utuo *UserTokenUpdateOne) SetUserID(i int) *UserTokenUpdateOne {
+	utuo.mutation.SetUserID(i)
+	return utuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (utuo *UserTokenUpdateOne) SetNillableUserID(i *int) *UserTokenUpdateOne {
+	if i != nil {
+		utuo.SetUserID(*i)
+	}
+	return utuo
+}
+
+// SetPurpose sets the "purpose" field.
+func (utuo *UserTokenUpdateOne) SetPurpose(u usertoken.Purpose) *UserTokenUpdateOne {
+	utuo.mutation.SetPurpose(u)
+	return utuo
+}
+
+// SetNillablePurpose sets the "purpose" field if the given value is not nil.
+func (utuo *UserTokenUpdateOne) SetNillablePurpose(u *usertoken.Purpose) *UserTokenUpdateOne {
+	if u != nil {
+		utuo.SetPurpose(*u)
+	}
+	return utuo
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (utuo *UserTokenUpdateOne) SetTokenHash(s string) *UserTokenUpdateOne {
+	utuo.mutation.SetTokenHash(s)
+	return utuo
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (utuo *UserTokenUpdateOne) SetNillableTokenHash(s *string) *UserTokenUpdateOne {
+	if s != nil {
+		utuo.SetTokenHash(*s)
+	}
+	return utuo
+}
+
+// SetEmail sets the "email" field.
+func (utuo *UserTokenUpdateOne) SetEmail(s string) *UserTokenUpdateOne {
+	utuo.mutation.SetEmail(s)
+	return utuo
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (utuo *UserTokenUpdateOne) SetNillableEmail(s *string) *UserTokenUpdateOne {
+	if s != nil {
+		utuo.SetEmail(*s)
+	}
+	return utuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (utuo *UserTokenUpdateOne) SetCreatedAt(t time.Time) *UserTokenUpdateOne {
+	utuo.mutation.SetCreatedAt(t)
+	return utuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (utuo *UserTokenUpdateOne) SetNillableCreatedAt(t *time.Time) *UserTokenUpdateOne {
+	if t != nil {
+		utuo.SetCreatedAt(*t)
+	}
+	return utuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (utuo *UserTokenUpdateOne) SetExpiresAt(t time.Time) *UserTokenUpdateOne {
+	utuo.mutation.SetExpiresAt(t)
+	return utuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (utuo *UserTokenUpdateOne) SetNillableExpiresAt(t *time.Time) *UserTokenUpdateOne {
+	if t != nil {
+		utuo.SetExpiresAt(*t)
+	}
+	return utuo
+}
+
+// SetUsedAt sets the "used_at" field.
+func (utuo *UserTokenUpdateOne) SetUsedAt(t time.Time) *UserTokenUpdateOne {
+	utuo.mutation.SetUsedAt(t)
+	return utuo
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (utuo *UserTokenUpdateOne) SetNillableUsedAt(t *time.Time) *UserTokenUpdateOne {
+	if t != nil {
+		utuo.SetUsedAt(*t)
+	}
+	return utuo
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (utuo *UserTokenUpdateOne) ClearUsedAt() *UserTokenUpdateOne {
+	utuo.mutation.ClearUsedAt()
+	return utuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (utuo *UserTokenUpdateOne) SetUser(u *User) *UserTokenUpdateOne {
+	return utuo.SetUserID(u.ID)
+}
+
+// Mutation returns the UserTokenMutation object of the builder.
+func (utuo *UserTokenUpdateOne) Mutation() *UserTokenMutation {
+	return utuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (utuo *UserTokenUpdateOne) ClearUser() *UserTokenUpdateOne {
+	utuo.mutation.ClearUser()
+	return utuo
+}
+
+// Where appends a list predicates to the UserTokenUpdate builder.
+func (utuo *UserTokenUpdateOne) Where(ps ...predicate.UserToken) *UserTokenUpdateOne {
+	utuo.mutation.Where(ps...)
+	return utuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (utuo *UserTokenUpdateOne) Select(field string, fields ...string) *UserTokenUpdateOne {
+	utuo.fields = append([]string{field}, fields...)
+	return utuo
+}
+
+// Save executes the query and returns the updated UserToken entity.
+func (utuo *UserTokenUpdateOne) Save(ctx context.Context) (*UserToken, error) {
+	return withHooks(ctx, utuo.sqlSave, utuo.mutation, utuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (utuo *UserTokenUpdateOne) SaveX(ctx context.Context) *UserToken {
+	node, err := utuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (utuo *UserTokenUpdateOne) Exec(ctx context.Context) error {
+	_, err := utuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (utuo *UserTokenUpdateOne) ExecX(ctx context.Context) {
+	if err := utuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (utuo *UserTokenUpdateOne) check() error {
+	if v, ok := utuo.mutation.Purpose(); ok {
+		if err := usertoken.PurposeValidator(v); err != nil {
+			return &ValidationError{Name: "purpose", err: fmt.Errorf(`ent: validator failed for field "UserToken.purpose": %w`, err)}
+		}
+	}
+	if v, ok := utuo.mutation.TokenHash(); ok {
+		if err := usertoken.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "UserToken.token_hash": %w`, err)}
+		}
+	}
+	if utuo.mutation.UserCleared() && len(utuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "UserToken.user"`)
+	}
+	return nil
+}
+
+func (utuo *UserTokenUpdateOne) sqlSave(ctx context.Context) (_node *UserToken, err error) {
+	if err := utuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usertoken.Table, usertoken.Columns, sqlgraph.NewFieldSpec(usertoken.FieldID, field.TypeInt))
+	id, ok := utuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UserToken.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := utuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, usertoken.FieldID)
+		for _, f := range fields {
+			if !usertoken.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != usertoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := utuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := utuo.mutation.Purpose(); ok {
+		_spec.SetField(usertoken.FieldPurpose, field.TypeEnum, value)
+	}
+	if value, ok := utuo.mutation.TokenHash(); ok {
+		_spec.SetField(usertoken.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := utuo.mutation.Email(); ok {
+		_spec.SetField(usertoken.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := utuo.mutation.CreatedAt(); ok {
+		_spec.SetField(usertoken.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := utuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(usertoken.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := utuo.mutation.UsedAt(); ok {
+		_spec.SetField(usertoken.FieldUsedAt, field.TypeTime, value)
+	}
+	if utuo.mutation.UsedAtCleared() {
+		_spec.ClearField(usertoken.FieldUsedAt, field.TypeTime)
+	}
+	if utuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   usertoken.UserTable,
+			Columns: []string{usertoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := utuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   usertoken.UserTable,
+			Columns: []string{usertoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &UserToken{config: utuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, utuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usertoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	utuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,91 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"gopan-server/config"
+	"gopan-server/ent"
+	"gopan-server/ent/user"
+	"gopan-server/internal/auth"
+	"gopan-server/internal/database"
+	"gopan-server/internal/logger"
+)
+
+var (
+	ErrUsernameRequired = errors.New("username is required")
+	ErrPasswordRequired = errors.New("password of at least 6 characters is required to create a new admin")
+)
+
+// CreateAdmin promotes an existing user to admin, or creates a new admin user
+// when the username is free. A given password replaces the current one.
+func CreateAdmin(ctx context.Context, username, password, email string, quota int64) (*ent.User, bool, error) {
+	if username == "" {
+		return nil, false, ErrUsernameRequired
+	}
+
+	u, err := database.Client.User.Query().
+		Where(user.UsernameEQ(username)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, false, err
+	}
+
+	if u != nil {
+		update := u.Update().
+			SetRole(user.RoleAdmin).
+			SetIsDisabled(false)
+		if password != "" {
+			hashedPassword, err := auth.HashPassword(password)
+			if err != nil {
+				return nil, false, err
+			}
+			update.SetPasswordHash(hashedPassword)
+		}
+		u, err = update.Save(ctx)
+		return u, false, err
+	}
+
+	if len(password) < 6 {
+		return nil, false, ErrPasswordRequired
+	}
+	hashedPassword, err := auth.HashPassword(password)
+	if err != nil {
+		return nil, false, err
+	}
+
+	create := database.Client.User.Create().
+		SetUsername(username).
+		SetPasswordHash(hashedPassword).
+		SetRole(user.RoleAdmin).
+		SetTotalQuota(quota)
+	if email != "" {
+		create.SetEmail(email)
+	}
+	u, err = create.Save(ctx)
+	return u, true, err
+}
+
+// EnsureAdmin bootstraps the admin account from the config when no admin exists yet
+func EnsureAdmin(ctx context.Context, cfg *config.Config) error {
+	if cfg.Admin.Username == "" {
+		return nil
+	}
+
+	exists, err := database.Client.User.Query().
+		Where(user.RoleEQ(user.RoleAdmin)).
+		Exist(ctx)
+	if err != nil || exists {
+		return err
+	}
+
+	u, created, err := CreateAdmin(ctx, cfg.Admin.Username, cfg.Admin.Password, cfg.Admin.Email, cfg.Registration.DefaultQuota)
+	if err != nil {
+		return err
+	}
+	if created {
+		logger.Info.Printf("Created admin user %s", u.Username)
+	} else {
+		logger.Info.Printf("Promoted user %s to admin", u.Username)
+	}
+	return nil
+}
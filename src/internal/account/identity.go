@@ -0,0 +1,204 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gopan-server/config"
+	"gopan-server/ent"
+	"gopan-server/ent/user"
+	"gopan-server/ent/useridentity"
+	"gopan-server/internal/auth"
+	"gopan-server/internal/database"
+	"gopan-server/internal/logger"
+	"gopan-server/internal/sso"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// ProviderOIDC is the provider kind of OpenID Connect identities
+const ProviderOIDC = "oidc"
+
+// maxUsernameLength matches the registration limit
+const maxUsernameLength = 50
+
+var (
+	ErrIdentityNotLinked = errors.New("no user is linked to this identity")
+	ErrIdentityInUse     = errors.New("identity is linked to another user")
+	ErrUserDisabled      = errors.New("user is disabled")
+)
+
+// usernameInvalidChars matches characters replaced when deriving a username from claims
+var usernameInvalidChars = regexp.MustCompile(`[^A-Za-z0-9._@-]+`)
+
+// ResolveIdentity returns the user an identity logs in as. Unknown identities
+// are linked to the local user with the same verified email when enabled, or
+// provisioned as a new user. Role and quota claims are applied on every login.
+func ResolveIdentity(ctx context.Context, cfg *config.Config, id *sso.Identity) (*ent.User, error) {
+	ident, err := database.Client.UserIdentity.Query().
+		Where(useridentity.IssuerEQ(id.Issuer)).
+		Where(useridentity.SubjectEQ(id.Subject)).
+		WithUser().
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	var u *ent.User
+	if ident != nil {
+		u = ident.Edges.User
+	} else {
+		u, err = findOrCreateUser(ctx, cfg, id)
+		if err != nil {
+			return nil, err
+		}
+		ident, err = database.Client.UserIdentity.Create().
+			SetUserID(u.ID).
+			SetProvider(ProviderOIDC).
+			SetIssuer(id.Issuer).
+			SetSubject(id.Subject).
+			SetEmail(id.Email).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if u.IsDisabled {
+		return nil, ErrUserDisabled
+	}
+
+	// Claims are authoritative for the fields they are mapped to
+	update := u.Update()
+	if id.Role != "" && user.Role(id.Role) != u.Role {
+		update.SetRole(user.Role(id.Role))
+	}
+	if id.Quota != nil && *id.Quota != u.TotalQuota {
+		update.SetTotalQuota(*id.Quota)
+	}
+	if u, err = update.Save(ctx); err != nil {
+		return nil, err
+	}
+
+	err = ident.Update().
+		SetEmail(id.Email).
+		SetLastLoginAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// LinkIdentity links an identity to an existing user
+func LinkIdentity(ctx context.Context, uid int, id *sso.Identity) (*ent.UserIdentity, error) {
+	ident, err := database.Client.UserIdentity.Query().
+		Where(useridentity.IssuerEQ(id.Issuer)).
+		Where(useridentity.SubjectEQ(id.Subject)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+	if ident != nil {
+		if ident.UserID != uid {
+			return nil, ErrIdentityInUse
+		}
+		return ident, nil
+	}
+
+	return database.Client.UserIdentity.Create().
+		SetUserID(uid).
+		SetProvider(ProviderOIDC).
+		SetIssuer(id.Issuer).
+		SetSubject(id.Subject).
+		SetEmail(id.Email).
+		Save(ctx)
+}
+
+// findOrCreateUser finds the local user an unknown identity belongs to, or
+// provisions a new one
+func findOrCreateUser(ctx context.Context, cfg *config.Config, id *sso.Identity) (*ent.User, error) {
+	// Only trust emails the provider has verified, otherwise anyone could
+	// claim a local account by setting its email at the provider
+	if cfg.OIDC.LinkByEmail && id.Email != "" && id.EmailVerified {
+		users, err := database.Client.User.Query().
+			Where(user.EmailEqualFold(id.Email)).
+			Limit(2).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if len(users) == 1 {
+			logger.Info.Printf("Linked %s identity %s to user %s by email", id.Issuer, id.Subject, users[0].Username)
+			return users[0], nil
+		}
+	}
+
+	if !cfg.OIDC.AutoCreate {
+		return nil, ErrIdentityNotLinked
+	}
+
+	username, err := availableUsername(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// SSO users have no usable password until they set one
+	unusable, err := auth.GenerateOpaqueToken()
+	if err != nil {
+		return nil, err
+	}
+	hashedPassword, err := auth.HashPassword(unusable)
+	if err != nil {
+		return nil, err
+	}
+
+	create := database.Client.User.Create().
+		SetUsername(username).
+		SetPasswordHash(hashedPassword).
+		SetTotalQuota(cfg.Registration.DefaultQuota)
+	if id.Email != "" {
+		create.SetEmail(id.Email).SetEmailVerified(id.EmailVerified)
+	}
+	u, err := create.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info.Printf("Created user %s for %s identity %s", u.Username, id.Issuer, id.Subject)
+	return u, nil
+}
+
+// availableUsername derives a free username from the identity claims,
+// appending a number when the preferred name is taken
+func availableUsername(ctx context.Context, id *sso.Identity) (string, error) {
+	base := id.Username
+	if base == "" && id.Email != "" {
+		base = strings.SplitN(id.Email, "@", 2)[0]
+	}
+	base = strings.Trim(usernameInvalidChars.ReplaceAllString(base, "_"), "_")
+	if len(base) < 3 {
+		base = "user"
+	}
+	if len(base) > maxUsernameLength-4 {
+		base = base[:maxUsernameLength-4]
+	}
+
+	for i := 1; i < 1000; i++ {
+		candidate := base
+		if i > 1 {
+			candidate = fmt.Sprintf("%s-%d", base, i)
+		}
+		exists, err := database.Client.User.Query().
+			Where(user.UsernameEQ(candidate)).
+			Exist(ctx)
+		if err != nil {
+			return "", err
+		}
+		if !exists {
+			return candidate, nil
+		}
+	}
+	return "", fmt.Errorf("no free username for %q", base)
+}
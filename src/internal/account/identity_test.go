@@ -0,0 +1,132 @@
+package account_test
+
+import (
+	"context"
+	"errors"
+	"gopan-server/config"
+	"gopan-server/ent"
+	"gopan-server/ent/user"
+	"gopan-server/internal/account"
+	"gopan-server/internal/dbtest"
+	"gopan-server/internal/sso"
+	"testing"
+)
+
+func oidcConfig(t *testing.T, linkByEmail, autoCreate bool) *config.Config {
+	t.Helper()
+	cfg, err := config.Parse([]byte(`{"jwt": {"secret": "test"}}`))
+	if err != nil {
+		t.Fatalf("parse config: %v", err)
+	}
+	cfg.OIDC.LinkByEmail = linkByEmail
+	cfg.OIDC.AutoCreate = autoCreate
+	return cfg
+}
+
+func createUser(t *testing.T, client *ent.Client, username, email string) *ent.User {
+	t.Helper()
+	u, err := client.User.Create().
+		SetUsername(username).
+		SetPasswordHash("x").
+		SetEmail(email).
+		SetTotalQuota(1 << 30).
+		Save(context.Background())
+	if err != nil {
+		t.Fatalf("create user: %v", err)
+	}
+	return u
+}
+
+func TestResolveIdentityLinksVerifiedEmail(t *testing.T) {
+	client := dbtest.Open(t)
+	ctx := context.Background()
+	alice := createUser(t, client, "alice", "Alice@Example.com")
+
+	id := &sso.Identity{
+		Issuer:        "https://idp.example.com",
+		Subject:       "alice-id",
+		Username:      "alice.sso",
+		Email:         "alice@example.com",
+		EmailVerified: true,
+		Role:          "readonly",
+	}
+	u, err := account.ResolveIdentity(ctx, oidcConfig(t, true, false), id)
+	if err != nil {
+		t.Fatalf("ResolveIdentity: %v", err)
+	}
+	if u.ID != alice.ID {
+		t.Fatalf("logged in as %s, want alice", u.Username)
+	}
+	if u.Role != user.RoleReadonly {
+		t.Errorf("role = %s, want readonly from the role claim", u.Role)
+	}
+
+	// Later logins find the identity without looking at the email
+	id.Email = "changed@example.com"
+	u, err = account.ResolveIdentity(ctx, oidcConfig(t, false, false), id)
+	if err != nil {
+		t.Fatalf("ResolveIdentity of a linked identity: %v", err)
+	}
+	if u.ID != alice.ID {
+		t.Errorf("linked identity logged in as %s, want alice", u.Username)
+	}
+}
+
+func TestResolveIdentityIgnoresUnverifiedEmail(t *testing.T) {
+	client := dbtest.Open(t)
+	ctx := context.Background()
+	alice := createUser(t, client, "alice", "alice@example.com")
+
+	id := &sso.Identity{
+		Issuer:   "https://idp.example.com",
+		Subject:  "mallory-id",
+		Username: "mallory",
+		Email:    "alice@example.com",
+	}
+	if _, err := account.ResolveIdentity(ctx, oidcConfig(t, true, false), id); !errors.Is(err, account.ErrIdentityNotLinked) {
+		t.Fatalf("ResolveIdentity error = %v, want ErrIdentityNotLinked", err)
+	}
+
+	u, err := account.ResolveIdentity(ctx, oidcConfig(t, true, true), id)
+	if err != nil {
+		t.Fatalf("ResolveIdentity with auto create: %v", err)
+	}
+	if u.ID == alice.ID || u.Username != "mallory" {
+		t.Errorf("logged in as %s (ID %d), want a new user mallory", u.Username, u.ID)
+	}
+}
+
+func TestResolveIdentityNeedsUniqueEmail(t *testing.T) {
+	client := dbtest.Open(t)
+	createUser(t, client, "alice", "shared@example.com")
+	createUser(t, client, "bob", "shared@example.com")
+
+	id := &sso.Identity{
+		Issuer:        "https://idp.example.com",
+		Subject:       "shared-id",
+		Email:         "shared@example.com",
+		EmailVerified: true,
+	}
+	_, err := account.ResolveIdentity(context.Background(), oidcConfig(t, true, false), id)
+	if !errors.Is(err, account.ErrIdentityNotLinked) {
+		t.Fatalf("ResolveIdentity error = %v, want ErrIdentityNotLinked", err)
+	}
+}
+
+func TestLinkIdentity(t *testing.T) {
+	client := dbtest.Open(t)
+	ctx := context.Background()
+	alice := createUser(t, client, "alice", "")
+	bob := createUser(t, client, "bob", "")
+
+	id := &sso.Identity{Issuer: "https://idp.example.com", Subject: "alice-id"}
+	if _, err := account.LinkIdentity(ctx, alice.ID, id); err != nil {
+		t.Fatalf("LinkIdentity: %v", err)
+	}
+	if _, err := account.LinkIdentity(ctx, alice.ID, id); err != nil {
+		t.Errorf("linking again to the same user: %v", err)
+	}
+	if _, err := account.LinkIdentity(ctx, bob.ID, id); !errors.Is(err, account.ErrIdentityInUse) {
+		t.Errorf("linking to another user: err = %v, want ErrIdentityInUse", err)
+	}
+}
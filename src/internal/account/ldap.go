@@ -0,0 +1,189 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"gopan-server/config"
+	"gopan-server/ent"
+	"gopan-server/ent/user"
+	"gopan-server/internal/auth"
+	"gopan-server/internal/database"
+	"gopan-server/internal/directory"
+	"gopan-server/internal/logger"
+	"gopan-server/internal/session"
+)
+
+var ErrInvalidCredentials = errors.New("invalid username or password")
+
+// Authenticate checks a username and password against the backend of the
+// deployment. With LDAP enabled the directory is authoritative and local
+// passwords only work for local admins, so they can still log in while the
+// directory is unreachable or misconfigured.
+func Authenticate(ctx context.Context, cfg *config.Config, username, password string) (*ent.User, error) {
+	local, err := database.Client.User.Query().
+		Where(user.UsernameEQ(username)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	dir := directory.New(&cfg.LDAP)
+	if !dir.Enabled() {
+		if local == nil || local.AuthSource != user.AuthSourceLocal || !auth.CheckPassword(password, local.PasswordHash) {
+			return nil, ErrInvalidCredentials
+		}
+		return local, nil
+	}
+
+	// Local admin fallback
+	if local != nil && local.AuthSource == user.AuthSourceLocal && local.Role == user.RoleAdmin {
+		if auth.CheckPassword(password, local.PasswordHash) {
+			return local, nil
+		}
+	}
+
+	entry, err := dir.Authenticate(username, password)
+	if errors.Is(err, directory.ErrInvalidCredentials) || errors.Is(err, directory.ErrUserNotFound) {
+		return nil, ErrInvalidCredentials
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !dir.Allowed(entry) {
+		return nil, ErrInvalidCredentials
+	}
+
+	return provisionDirectoryUser(ctx, dir, entry, cfg.Registration.DefaultQuota)
+}
+
+// VerifyPassword checks the password of a logged in user, for confirming
+// sensitive changes
+func VerifyPassword(cfg *config.Config, u *ent.User, password string) bool {
+	if u.AuthSource == user.AuthSourceLdap {
+		dir := directory.New(&cfg.LDAP)
+		entry, err := dir.Authenticate(u.Username, password)
+		return err == nil && entry.DN == u.LdapDn
+	}
+	return auth.CheckPassword(password, u.PasswordHash)
+}
+
+// provisionDirectoryUser creates or updates the local user of a directory
+// entry. New users without a quota group get defaultQuota.
+func provisionDirectoryUser(ctx context.Context, dir *directory.Client, entry *directory.Entry, defaultQuota int64) (*ent.User, error) {
+	u, err := database.Client.User.Query().
+		Where(user.UsernameEQ(entry.Username)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	role := user.Role(dir.Role(entry))
+	quota, hasQuota := dir.Quota(entry)
+
+	if u == nil {
+		// Directory users have no usable local password
+		unusable, err := auth.GenerateOpaqueToken()
+		if err != nil {
+			return nil, err
+		}
+		hashedPassword, err := auth.HashPassword(unusable)
+		if err != nil {
+			return nil, err
+		}
+
+		create := database.Client.User.Create().
+			SetUsername(entry.Username).
+			SetPasswordHash(hashedPassword).
+			SetAuthSource(user.AuthSourceLdap).
+			SetLdapDn(entry.DN).
+			SetRole(role).
+			SetTotalQuota(defaultQuota)
+		if entry.Email != "" {
+			create.SetEmail(entry.Email).SetEmailVerified(true)
+		}
+		if hasQuota {
+			create.SetTotalQuota(quota)
+		}
+		u, err = create.Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		logger.Info.Printf("Created user %s from directory entry %s", u.Username, entry.DN)
+		return u, nil
+	}
+
+	// Local admins are never taken over by a directory account of the same name
+	if u.AuthSource == user.AuthSourceLocal && u.Role == user.RoleAdmin {
+		return nil, ErrInvalidCredentials
+	}
+	if u.IsDisabled {
+		return nil, ErrUserDisabled
+	}
+
+	if u.AuthSource == user.AuthSourceLocal {
+		logger.Info.Printf("User %s is now managed by directory entry %s", u.Username, entry.DN)
+	}
+	return applyDirectoryEntry(ctx, u, entry, role, quota, hasQuota)
+}
+
+// applyDirectoryEntry updates a user from its directory entry
+func applyDirectoryEntry(ctx context.Context, u *ent.User, entry *directory.Entry, role user.Role, quota int64, hasQuota bool) (*ent.User, error) {
+	update := u.Update().
+		SetAuthSource(user.AuthSourceLdap).
+		SetLdapDn(entry.DN).
+		SetRole(role)
+	if entry.Email != "" {
+		update.SetEmail(entry.Email).SetEmailVerified(true)
+	}
+	if hasQuota {
+		update.SetTotalQuota(quota)
+	}
+	return update.Save(ctx)
+}
+
+// SyncDirectory updates directory users from the directory and disables the
+// users that were removed from it or from the required group. It returns the
+// number of users updated and disabled.
+func SyncDirectory(ctx context.Context, cfg *config.LDAPConfig) (int, int, error) {
+	dir := directory.New(cfg)
+
+	users, err := database.Client.User.Query().
+		Where(user.AuthSourceEQ(user.AuthSourceLdap)).
+		Where(user.IsDisabledEQ(false)).
+		All(ctx)
+	if err != nil || len(users) == 0 {
+		return 0, 0, err
+	}
+
+	usernames := make([]string, len(users))
+	for i, u := range users {
+		usernames[i] = u.Username
+	}
+	entries, err := dir.LookupAll(usernames)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	updated, disabled := 0, 0
+	for _, u := range users {
+		entry, ok := entries[u.Username]
+		if !ok || !dir.Allowed(entry) {
+			if err := u.Update().SetIsDisabled(true).Exec(ctx); err != nil {
+				return updated, disabled, err
+			}
+			if _, err := session.RevokeAll(ctx, u.ID, 0); err != nil {
+				return updated, disabled, err
+			}
+			logger.Info.Printf("Disabled user %s removed from the directory", u.Username)
+			disabled++
+			continue
+		}
+
+		quota, hasQuota := dir.Quota(entry)
+		if _, err := applyDirectoryEntry(ctx, u, entry, user.Role(dir.Role(entry)), quota, hasQuota); err != nil {
+			return updated, disabled, err
+		}
+		updated++
+	}
+	return updated, disabled, nil
+}
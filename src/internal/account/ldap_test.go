@@ -0,0 +1,179 @@
+package account_test
+
+import (
+	"context"
+	"errors"
+	"gopan-server/config"
+	"gopan-server/ent/user"
+	"gopan-server/internal/account"
+	"gopan-server/internal/dbtest"
+	"gopan-server/internal/directory/ldaptest"
+	"gopan-server/internal/session"
+	"testing"
+	"time"
+)
+
+const (
+	peopleDN = "ou=people,dc=example,dc=com"
+	staffDN  = "cn=staff,ou=groups,dc=example,dc=com"
+	adminsDN = "cn=admins,ou=groups,dc=example,dc=com"
+)
+
+// ldapConfig starts a directory with the people subtree, where only members
+// of the staff group may log in, and returns a configuration using it
+func ldapConfig(t *testing.T) (*ldaptest.Server, *config.Config) {
+	t.Helper()
+	srv := ldaptest.NewServer(t)
+	srv.Add(peopleDN, "", nil)
+
+	cfg, err := config.Parse([]byte(`{
+		"jwt": {"secret": "test"},
+		"ldap": {
+			"enabled": true,
+			"base_dn": "` + peopleDN + `",
+			"required_group": "` + staffDN + `",
+			"role_groups": {"` + adminsDN + `": "admin"},
+			"quota_groups": {"` + staffDN + `": 4096}
+		}
+	}`))
+	if err != nil {
+		t.Fatalf("parse config: %v", err)
+	}
+	cfg.LDAP.URL = srv.URL
+	return srv, cfg
+}
+
+// addPerson adds a directory user with a password equal to its name
+func addPerson(srv *ldaptest.Server, uid string, groups ...string) {
+	srv.Add("uid="+uid+","+peopleDN, uid+"-secret", map[string][]string{
+		"uid":      {uid},
+		"mail":     {uid + "@example.com"},
+		"memberOf": groups,
+	})
+}
+
+func TestAuthenticateProvisionsDirectoryUser(t *testing.T) {
+	dbtest.Open(t)
+	srv, cfg := ldapConfig(t)
+	addPerson(srv, "alice", staffDN, adminsDN)
+	addPerson(srv, "bob")
+	ctx := context.Background()
+
+	u, err := account.Authenticate(ctx, cfg, "alice", "alice-secret")
+	if err != nil {
+		t.Fatalf("Authenticate: %v", err)
+	}
+	if u.AuthSource != user.AuthSourceLdap || u.LdapDn != "uid=alice,"+peopleDN ||
+		u.Role != user.RoleAdmin || u.TotalQuota != 4096 || u.Email != "alice@example.com" || !u.EmailVerified {
+		t.Errorf("provisioned user = %+v", u)
+	}
+
+	if _, err := account.Authenticate(ctx, cfg, "alice", "wrong"); !errors.Is(err, account.ErrInvalidCredentials) {
+		t.Errorf("wrong password: err = %v, want ErrInvalidCredentials", err)
+	}
+	if _, err := account.Authenticate(ctx, cfg, "bob", "bob-secret"); !errors.Is(err, account.ErrInvalidCredentials) {
+		t.Errorf("user outside the required group: err = %v, want ErrInvalidCredentials", err)
+	}
+
+	// Group changes apply on the next login
+	addPerson(srv, "alice", staffDN)
+	u, err = account.Authenticate(ctx, cfg, "alice", "alice-secret")
+	if err != nil {
+		t.Fatalf("Authenticate again: %v", err)
+	}
+	if u.Role != user.RoleUser {
+		t.Errorf("role after leaving the admins group = %s, want user", u.Role)
+	}
+}
+
+func TestSyncDirectoryDisablesRemovedUsers(t *testing.T) {
+	client := dbtest.Open(t)
+	srv, cfg := ldapConfig(t)
+	ctx := context.Background()
+	for _, uid := range []string{"alice", "bob", "carol"} {
+		addPerson(srv, uid, staffDN)
+		if _, err := account.Authenticate(ctx, cfg, uid, uid+"-secret"); err != nil {
+			t.Fatalf("Authenticate %s: %v", uid, err)
+		}
+	}
+	bob, err := client.User.Query().Where(user.UsernameEQ("bob")).Only(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bobSession, _, err := session.Create(ctx, bob.ID, "127.0.0.1", "test", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Bob leaves the company, carol leaves the required group, alice becomes an admin
+	srv.Remove("uid=bob," + peopleDN)
+	addPerson(srv, "carol")
+	addPerson(srv, "alice", staffDN, adminsDN)
+
+	updated, disabled, err := account.SyncDirectory(ctx, &cfg.LDAP)
+	if err != nil {
+		t.Fatalf("SyncDirectory: %v", err)
+	}
+	if updated != 1 || disabled != 2 {
+		t.Errorf("updated %d and disabled %d users, want 1 and 2", updated, disabled)
+	}
+
+	users, err := client.User.Query().Order(user.ByUsername()).All(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantDisabled := map[string]bool{"alice": false, "bob": true, "carol": true}
+	for _, u := range users {
+		if u.IsDisabled != wantDisabled[u.Username] {
+			t.Errorf("%s disabled = %v, want %v", u.Username, u.IsDisabled, wantDisabled[u.Username])
+		}
+	}
+	if users[0].Role != user.RoleAdmin {
+		t.Errorf("alice role = %s, want admin", users[0].Role)
+	}
+	if s, err := client.Session.Get(ctx, bobSession.ID); err != nil || s.RevokedAt == nil {
+		t.Errorf("session of bob was not revoked (err %v)", err)
+	}
+
+	// Disabled users can't log in again through the directory
+	addPerson(srv, "carol", staffDN)
+	if _, err := account.Authenticate(ctx, cfg, "carol", "carol-secret"); !errors.Is(err, account.ErrUserDisabled) {
+		t.Errorf("disabled user login: err = %v, want ErrUserDisabled", err)
+	}
+}
+
+func TestAuthenticateLocalAdminFallback(t *testing.T) {
+	dbtest.Open(t)
+	srv, cfg := ldapConfig(t)
+	addPerson(srv, "alice", staffDN)
+	ctx := context.Background()
+
+	if _, _, err := account.CreateAdmin(ctx, "root", "root-password", "", 1<<30); err != nil {
+		t.Fatalf("CreateAdmin: %v", err)
+	}
+	if _, _, err := account.CreateAdmin(ctx, "alice", "local-password", "", 1<<30); err != nil {
+		t.Fatalf("CreateAdmin: %v", err)
+	}
+
+	// A directory account can't take over a local admin of the same name
+	if _, err := account.Authenticate(ctx, cfg, "alice", "alice-secret"); !errors.Is(err, account.ErrInvalidCredentials) {
+		t.Errorf("directory login as a local admin: err = %v, want ErrInvalidCredentials", err)
+	}
+
+	srv.Close()
+
+	u, err := account.Authenticate(ctx, cfg, "root", "root-password")
+	if err != nil {
+		t.Fatalf("local admin login with the directory down: %v", err)
+	}
+	if u.Username != "root" || u.AuthSource != user.AuthSourceLocal {
+		t.Errorf("logged in as %s (%s), want local root", u.Username, u.AuthSource)
+	}
+
+	if _, err := account.Authenticate(ctx, cfg, "root", "wrong"); err == nil {
+		t.Error("local admin login with a wrong password succeeded")
+	}
+	if _, err := account.Authenticate(ctx, cfg, "bob", "bob-secret"); err == nil || errors.Is(err, account.ErrInvalidCredentials) {
+		t.Errorf("directory login with the directory down: err = %v, want a connection error", err)
+	}
+}
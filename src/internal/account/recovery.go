@@ -0,0 +1,252 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gopan-server/config"
+	"gopan-server/ent"
+	"gopan-server/ent/user"
+	"gopan-server/ent/usertoken"
+	"gopan-server/internal/auth"
+	"gopan-server/internal/database"
+	"gopan-server/internal/logger"
+	"gopan-server/internal/mail"
+	"gopan-server/internal/session"
+	"net/url"
+	"time"
+)
+
+const (
+	// resetTokenTTL is how long a password reset link works
+	resetTokenTTL = time.Hour
+	// verificationTokenTTL is how long an email verification link works
+	verificationTokenTTL = 72 * time.Hour
+	// tokenResendInterval prevents flooding a mailbox with tokens
+	tokenResendInterval = time.Minute
+)
+
+var (
+	ErrInvalidUserToken  = errors.New("invalid or expired token")
+	ErrTokenRecentlySent = errors.New("a token was sent recently")
+	ErrExternalPassword  = errors.New("password is managed by the directory")
+)
+
+// issueToken creates a single-use token for purpose, replacing the user's
+// unused tokens of the same purpose
+func issueToken(ctx context.Context, uid int, purpose usertoken.Purpose, email string, ttl time.Duration) (string, error) {
+	recent, err := database.Client.UserToken.Query().
+		Where(usertoken.UserIDEQ(uid)).
+		Where(usertoken.PurposeEQ(purpose)).
+		Where(usertoken.UsedAtIsNil()).
+		Where(usertoken.CreatedAtGT(time.Now().Add(-tokenResendInterval))).
+		Exist(ctx)
+	if err != nil {
+		return "", err
+	}
+	if recent {
+		return "", ErrTokenRecentlySent
+	}
+
+	token, err := auth.GenerateOpaqueToken()
+	if err != nil {
+		return "", err
+	}
+
+	_, err = database.Client.UserToken.Delete().
+		Where(usertoken.UserIDEQ(uid)).
+		Where(usertoken.PurposeEQ(purpose)).
+		Where(usertoken.UsedAtIsNil()).
+		Exec(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	err = database.Client.UserToken.Create().
+		SetUserID(uid).
+		SetPurpose(purpose).
+		SetTokenHash(auth.HashToken(token)).
+		SetEmail(email).
+		SetExpiresAt(time.Now().Add(ttl)).
+		Exec(ctx)
+	if err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// consumeToken marks a valid token of purpose as used and returns it. The
+// update only matches unused tokens, so a token works exactly once even
+// when it is submitted concurrently.
+func consumeToken(ctx context.Context, token string, purpose usertoken.Purpose) (*ent.UserToken, error) {
+	hash := auth.HashToken(token)
+	now := time.Now()
+
+	updated, err := database.Client.UserToken.Update().
+		Where(usertoken.TokenHashEQ(hash)).
+		Where(usertoken.PurposeEQ(purpose)).
+		Where(usertoken.UsedAtIsNil()).
+		Where(usertoken.ExpiresAtGT(now)).
+		SetUsedAt(now).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if updated == 0 {
+		return nil, ErrInvalidUserToken
+	}
+
+	return database.Client.UserToken.Query().
+		Where(usertoken.TokenHashEQ(hash)).
+		WithUser().
+		Only(ctx)
+}
+
+// SetPassword replaces the local password of a user
+func SetPassword(ctx context.Context, u *ent.User, password string) error {
+	if u.AuthSource != user.AuthSourceLocal {
+		return ErrExternalPassword
+	}
+
+	hashedPassword, err := auth.HashPassword(password)
+	if err != nil {
+		return err
+	}
+	return u.Update().SetPasswordHash(hashedPassword).Exec(ctx)
+}
+
+// SendPasswordReset emails a password reset link to the user with the given
+// username or verified email. Unknown users are silently ignored so the
+// response doesn't reveal which accounts exist.
+func SendPasswordReset(ctx context.Context, cfg *config.Config, login string) error {
+	mailer := mail.New(&cfg.Mail)
+	if !mailer.Enabled() {
+		return mail.ErrDisabled
+	}
+
+	// A username wins over the email of another user that looks the same
+	u, err := database.Client.User.Query().
+		Where(user.UsernameEQ(login)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		u, err = userByVerifiedEmail(ctx, login)
+	}
+	if err != nil {
+		return err
+	}
+	if u == nil {
+		return nil
+	}
+
+	// Resets are only sent to verified addresses: an unconfirmed address may
+	// belong to somebody else, who could take the account over
+	if u.Email == "" || !u.EmailVerified || u.IsDisabled || u.AuthSource != user.AuthSourceLocal {
+		return nil
+	}
+
+	token, err := issueToken(ctx, u.ID, usertoken.PurposePasswordReset, u.Email, resetTokenTTL)
+	if errors.Is(err, ErrTokenRecentlySent) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	link := fmt.Sprintf("%s/index.html#reset_token=%s", cfg.Mail.BaseURL, url.QueryEscape(token))
+	body := fmt.Sprintf("%s，您好：\n\n我们收到了重置您 GoPan 账号密码的请求。请在 %d 分钟内打开以下链接设置新密码：\n\n%s\n\n如果这不是您本人的操作，请忽略此邮件，您的密码不会改变。\n",
+		u.Username, int(resetTokenTTL.Minutes()), link)
+	sendAsync(mailer, u.Email, "GoPan 密码重置", body)
+	return nil
+}
+
+// userByVerifiedEmail returns the only user with a verified email, or nil.
+// An address verified by several users identifies none of them.
+func userByVerifiedEmail(ctx context.Context, email string) (*ent.User, error) {
+	users, err := database.Client.User.Query().
+		Where(user.EmailEqualFold(email)).
+		Where(user.EmailVerified(true)).
+		Limit(2).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(users) != 1 {
+		return nil, nil
+	}
+	return users[0], nil
+}
+
+// ResetPassword sets a new password with a reset token and signs the user
+// out everywhere
+func ResetPassword(ctx context.Context, token, password string) (*ent.User, error) {
+	t, err := consumeToken(ctx, token, usertoken.PurposePasswordReset)
+	if err != nil {
+		return nil, err
+	}
+
+	u := t.Edges.User
+	if u == nil || u.IsDisabled || u.Email != t.Email {
+		return nil, ErrInvalidUserToken
+	}
+	if err := SetPassword(ctx, u, password); err != nil {
+		return nil, err
+	}
+	if _, err := session.RevokeAll(ctx, u.ID, 0); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// SendEmailVerification emails a verification link for the user's current email
+func SendEmailVerification(ctx context.Context, cfg *config.Config, u *ent.User) error {
+	mailer := mail.New(&cfg.Mail)
+	if !mailer.Enabled() {
+		return mail.ErrDisabled
+	}
+	if u.Email == "" {
+		return nil
+	}
+
+	token, err := issueToken(ctx, u.ID, usertoken.PurposeEmailVerification, u.Email, verificationTokenTTL)
+	if err != nil {
+		return err
+	}
+
+	link := fmt.Sprintf("%s/index.html#verify_token=%s", cfg.Mail.BaseURL, url.QueryEscape(token))
+	body := fmt.Sprintf("%s，您好：\n\n请打开以下链接验证您在 GoPan 使用的邮箱地址（%d 小时内有效）：\n\n%s\n\n验证后即可通过邮箱找回密码。如果您没有注册 GoPan，请忽略此邮件。\n",
+		u.Username, int(verificationTokenTTL.Hours()), link)
+	sendAsync(mailer, u.Email, "验证您的 GoPan 邮箱", body)
+	return nil
+}
+
+// VerifyEmail marks the email a verification token was sent to as verified,
+// as long as it is still the user's email
+func VerifyEmail(ctx context.Context, token string) (*ent.User, error) {
+	t, err := consumeToken(ctx, token, usertoken.PurposeEmailVerification)
+	if err != nil {
+		return nil, err
+	}
+
+	u := t.Edges.User
+	if u == nil || u.Email != t.Email {
+		return nil, ErrInvalidUserToken
+	}
+	return u.Update().SetEmailVerified(true).Save(ctx)
+}
+
+// PurgeUserTokens deletes tokens that expired before cutoff
+func PurgeUserTokens(ctx context.Context, cutoff time.Time) (int, error) {
+	return database.Client.UserToken.Delete().
+		Where(usertoken.ExpiresAtLT(cutoff)).
+		Exec(ctx)
+}
+
+// sendAsync delivers an email in the background, so response times don't
+// reveal whether an email was sent
+func sendAsync(mailer *mail.Mailer, to, subject, body string) {
+	go func() {
+		if err := mailer.Send(to, subject, body); err != nil {
+			logger.Error.Printf("Failed to send email to %s: %v", to, err)
+		}
+	}()
+}
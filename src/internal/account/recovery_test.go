@@ -0,0 +1,190 @@
+package account_test
+
+import (
+	"context"
+	"errors"
+	"gopan-server/config"
+	"gopan-server/ent"
+	"gopan-server/internal/account"
+	"gopan-server/internal/dbtest"
+	"gopan-server/internal/mail/mailtest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// mailConfig returns a configuration sending email to a local sink
+func mailConfig(t *testing.T) (*mailtest.Sink, *config.Config) {
+	t.Helper()
+	sink := mailtest.NewSink(t)
+	cfg, err := config.Parse([]byte(`{
+		"jwt": {"secret": "test"},
+		"mail": {
+			"enabled": true,
+			"host": "` + sink.Host + `",
+			"port": ` + strconv.Itoa(sink.Port) + `,
+			"security": "none",
+			"from": "GoPan <noreply@example.com>",
+			"base_url": "https://pan.example.com"
+		}
+	}`))
+	if err != nil {
+		t.Fatalf("parse config: %v", err)
+	}
+	return sink, cfg
+}
+
+// createVerifiedUser creates a local user with a password and a verified email
+func createVerifiedUser(t *testing.T, client *ent.Client, username, password, email string) *ent.User {
+	t.Helper()
+	u := createUser(t, client, username, email)
+	if err := account.SetPassword(context.Background(), u, password); err != nil {
+		t.Fatalf("set password: %v", err)
+	}
+	return u.Update().SetEmailVerified(true).SaveX(context.Background())
+}
+
+// linkToken returns the token of the link in an email body
+func linkToken(t *testing.T, msg mailtest.Message, param string) string {
+	t.Helper()
+	_, rest, ok := strings.Cut(msg.Body, "#"+param+"=")
+	if !ok {
+		t.Fatalf("email has no %s link:\n%s", param, msg.Body)
+	}
+	token, err := url.QueryUnescape(strings.Fields(rest)[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func TestPasswordResetTokenIsSingleUse(t *testing.T) {
+	client := dbtest.Open(t)
+	sink, cfg := mailConfig(t)
+	ctx := context.Background()
+	createVerifiedUser(t, client, "alice", "old-password", "alice@example.com")
+
+	if err := account.SendPasswordReset(ctx, cfg, "ALICE@example.com"); err != nil {
+		t.Fatalf("SendPasswordReset: %v", err)
+	}
+	msg := sink.Wait(t)
+	if len(msg.To) != 1 || msg.To[0] != "alice@example.com" || msg.Subject != "GoPan 密码重置" {
+		t.Fatalf("email to %v with subject %q", msg.To, msg.Subject)
+	}
+	token := linkToken(t, msg, "reset_token")
+
+	// Another request within the resend interval doesn't mail a second link
+	if err := account.SendPasswordReset(ctx, cfg, "alice"); err != nil {
+		t.Fatalf("SendPasswordReset again: %v", err)
+	}
+	if !sink.Empty() {
+		t.Error("a second reset email was sent within the resend interval")
+	}
+
+	if _, err := account.ResetPassword(ctx, token, "new-password"); err != nil {
+		t.Fatalf("ResetPassword: %v", err)
+	}
+	if _, err := account.Authenticate(ctx, cfg, "alice", "new-password"); err != nil {
+		t.Errorf("login with the new password: %v", err)
+	}
+	if _, err := account.ResetPassword(ctx, token, "other-password"); !errors.Is(err, account.ErrInvalidUserToken) {
+		t.Errorf("reused token: err = %v, want ErrInvalidUserToken", err)
+	}
+}
+
+func TestPasswordResetTokenExpires(t *testing.T) {
+	client := dbtest.Open(t)
+	sink, cfg := mailConfig(t)
+	ctx := context.Background()
+	createVerifiedUser(t, client, "alice", "old-password", "alice@example.com")
+
+	if err := account.SendPasswordReset(ctx, cfg, "alice"); err != nil {
+		t.Fatalf("SendPasswordReset: %v", err)
+	}
+	token := linkToken(t, sink.Wait(t), "reset_token")
+	client.UserToken.Update().SetExpiresAt(time.Now().Add(-time.Second)).ExecX(ctx)
+
+	if _, err := account.ResetPassword(ctx, token, "new-password"); !errors.Is(err, account.ErrInvalidUserToken) {
+		t.Errorf("expired token: err = %v, want ErrInvalidUserToken", err)
+	}
+	if _, err := account.Authenticate(ctx, cfg, "alice", "old-password"); err != nil {
+		t.Errorf("login with the old password: %v", err)
+	}
+}
+
+func TestPasswordResetRecipient(t *testing.T) {
+	client := dbtest.Open(t)
+	sink, cfg := mailConfig(t)
+	ctx := context.Background()
+	createVerifiedUser(t, client, "mallory", "secret", "Alice@Example.com")
+	createVerifiedUser(t, client, "alice@example.com", "secret", "alice@work.example")
+	createVerifiedUser(t, client, "bob", "secret", "shared@example.com")
+	createVerifiedUser(t, client, "carol", "secret", "shared@example.com")
+	createUser(t, client, "dave", "dave@example.com")
+
+	tests := []struct {
+		name, login string
+		to          string // Empty when no email is sent
+	}{
+		{"username wins over another user's email", "alice@example.com", "alice@work.example"},
+		{"email", "alice@work.example", "alice@work.example"},
+		{"email shared by several users", "shared@example.com", ""},
+		{"unverified email", "dave@example.com", ""},
+		{"unknown user", "erin", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Start over so the resend interval doesn't hide emails
+			client.UserToken.Delete().ExecX(ctx)
+			if err := account.SendPasswordReset(ctx, cfg, tt.login); err != nil {
+				t.Fatalf("SendPasswordReset: %v", err)
+			}
+			if tt.to == "" {
+				if !sink.Empty() {
+					t.Error("an email was sent")
+				}
+				return
+			}
+			if msg := sink.Wait(t); len(msg.To) != 1 || msg.To[0] != tt.to {
+				t.Errorf("email sent to %v, want %s", msg.To, tt.to)
+			}
+		})
+	}
+}
+
+func TestEmailVerification(t *testing.T) {
+	client := dbtest.Open(t)
+	sink, cfg := mailConfig(t)
+	ctx := context.Background()
+	u := createUser(t, client, "alice", "alice@example.com")
+
+	if err := account.SendEmailVerification(ctx, cfg, u); err != nil {
+		t.Fatalf("SendEmailVerification: %v", err)
+	}
+	msg := sink.Wait(t)
+	if len(msg.To) != 1 || msg.To[0] != "alice@example.com" || msg.Subject != "验证您的 GoPan 邮箱" {
+		t.Fatalf("email to %v with subject %q", msg.To, msg.Subject)
+	}
+	if !strings.Contains(msg.Body, "https://pan.example.com/index.html#verify_token=") {
+		t.Errorf("email lacks the verification link:\n%s", msg.Body)
+	}
+	token := linkToken(t, msg, "verify_token")
+
+	// Tokens only work for their own purpose
+	if _, err := account.ResetPassword(ctx, token, "new-password"); !errors.Is(err, account.ErrInvalidUserToken) {
+		t.Errorf("verification token used for a reset: err = %v, want ErrInvalidUserToken", err)
+	}
+
+	verified, err := account.VerifyEmail(ctx, token)
+	if err != nil {
+		t.Fatalf("VerifyEmail: %v", err)
+	}
+	if !verified.EmailVerified {
+		t.Error("email was not marked as verified")
+	}
+	if _, err := account.VerifyEmail(ctx, token); !errors.Is(err, account.ErrInvalidUserToken) {
+		t.Errorf("reused token: err = %v, want ErrInvalidUserToken", err)
+	}
+}
@@ -0,0 +1,148 @@
+package account
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"gopan-server/config"
+	"gopan-server/ent"
+	"gopan-server/ent/invite"
+	"gopan-server/ent/user"
+	"gopan-server/internal/auth"
+	"gopan-server/internal/database"
+	"strings"
+	"time"
+)
+
+var (
+	ErrRegistrationClosed    = errors.New("registration is closed")
+	ErrInviteRequired        = errors.New("an invite code is required")
+	ErrInvalidInvite         = errors.New("invalid or expired invite code")
+	ErrEmailDomainNotAllowed = errors.New("email domain is not allowed to register")
+	ErrUsernameTaken         = errors.New("username already exists")
+)
+
+// inviteAlphabet has 32 characters, so every random byte maps without bias
+const inviteAlphabet = "23456789abcdefghijkmnpqrstuvwxyz"
+
+// GenerateInviteCode returns a random invite code like "k3f9-q2hx-7mzc-8tb4"
+func GenerateInviteCode() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	var sb strings.Builder
+	for i, v := range b {
+		if i > 0 && i%4 == 0 {
+			sb.WriteByte('-')
+		}
+		sb.WriteByte(inviteAlphabet[int(v)%len(inviteAlphabet)])
+	}
+	return sb.String(), nil
+}
+
+// EmailDomainAllowed reports whether email belongs to one of the allowed
+// domains. An empty allowlist allows every address.
+func EmailDomainAllowed(cfg *config.RegistrationConfig, email string) bool {
+	if len(cfg.AllowedDomains) == 0 {
+		return true
+	}
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return false
+	}
+	domain := strings.ToLower(email[at+1:])
+	for _, allowed := range cfg.AllowedDomains {
+		if domain == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// Register creates a self-registered user according to the registration
+// policy. An invite code sets the role and quota of the user and lifts the
+// email domain restriction.
+func Register(ctx context.Context, cfg *config.RegistrationConfig, username, password, email, inviteCode string) (*ent.User, error) {
+	if cfg.Mode == config.RegistrationClosed {
+		return nil, ErrRegistrationClosed
+	}
+	if inviteCode == "" {
+		if cfg.Mode == config.RegistrationInvite {
+			return nil, ErrInviteRequired
+		}
+		if !EmailDomainAllowed(cfg, email) {
+			return nil, ErrEmailDomainNotAllowed
+		}
+	}
+
+	exists, err := database.Client.User.Query().
+		Where(user.UsernameEQ(username)).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, ErrUsernameTaken
+	}
+
+	hashedPassword, err := auth.HashPassword(password)
+	if err != nil {
+		return nil, err
+	}
+
+	create := database.Client.User.Create().
+		SetUsername(username).
+		SetPasswordHash(hashedPassword).
+		SetTotalQuota(cfg.DefaultQuota)
+	if email != "" {
+		create.SetEmail(email)
+	}
+
+	var inv *ent.Invite
+	if inviteCode != "" {
+		inv, err = useInvite(ctx, inviteCode)
+		if err != nil {
+			return nil, err
+		}
+		create.SetRole(user.Role(inv.Role))
+		if inv.TotalQuota != nil {
+			create.SetTotalQuota(*inv.TotalQuota)
+		}
+	}
+
+	u, err := create.Save(ctx)
+	if err != nil && inv != nil {
+		// Give the use back, e.g. when the username was taken meanwhile
+		database.Client.Invite.UpdateOneID(inv.ID).AddUses(-1).Exec(ctx)
+	}
+	return u, err
+}
+
+// useInvite counts one use of a valid invite. The conditional update keeps
+// concurrent registrations from exceeding max_uses.
+func useInvite(ctx context.Context, code string) (*ent.Invite, error) {
+	inv, err := database.Client.Invite.Query().
+		Where(invite.CodeEQ(strings.ToLower(strings.TrimSpace(code)))).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrInvalidInvite
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := database.Client.Invite.Update().
+		Where(invite.IDEQ(inv.ID)).
+		Where(invite.Or(invite.MaxUsesEQ(0), invite.UsesLT(inv.MaxUses))).
+		Where(invite.Or(invite.ExpiresAtIsNil(), invite.ExpiresAtGT(time.Now()))).
+		AddUses(1).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, ErrInvalidInvite
+	}
+	return inv, nil
+}
@@ -0,0 +1,82 @@
+package account
+
+import (
+	"context"
+	"crypto/subtle"
+	"gopan-server/ent"
+	"gopan-server/ent/user"
+	"gopan-server/internal/auth"
+	"gopan-server/internal/database"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+)
+
+// recoveryCodeCount is the number of recovery codes generated at once
+const recoveryCodeCount = 10
+
+// NewRecoveryCodes generates recovery codes and returns them with the hashes to store
+func NewRecoveryCodes() ([]string, []string, error) {
+	codes, err := auth.GenerateRecoveryCodes(recoveryCodeCount)
+	if err != nil {
+		return nil, nil, err
+	}
+	hashes := make([]string, len(codes))
+	for i, code := range codes {
+		hashes[i] = auth.HashToken(code)
+	}
+	return codes, hashes, nil
+}
+
+// VerifySecondFactor checks a TOTP or recovery code against the user's
+// enrolled secret. Accepted codes are consumed, so each works only once.
+func VerifySecondFactor(ctx context.Context, u *ent.User, code string) (bool, error) {
+	code = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(code), " ", ""))
+	if u.TotpSecret == "" || code == "" {
+		return false, nil
+	}
+
+	if step, ok := auth.ValidateTOTP(u.TotpSecret, code, time.Now()); ok {
+		// Only the first request using a time step wins
+		n, err := database.Client.User.Update().
+			Where(user.IDEQ(u.ID)).
+			Where(user.TotpLastStepLT(step)).
+			SetTotpLastStep(step).
+			Save(ctx)
+		return n > 0, err
+	}
+
+	hash := auth.HashToken(code)
+	for i, h := range u.TotpRecoveryCodes {
+		if subtle.ConstantTimeCompare([]byte(h), []byte(hash)) != 1 {
+			continue
+		}
+		remaining := make([]string, 0, len(u.TotpRecoveryCodes)-1)
+		remaining = append(remaining, u.TotpRecoveryCodes[:i]...)
+		remaining = append(remaining, u.TotpRecoveryCodes[i+1:]...)
+		// Only the first request using the code wins; the update also fails
+		// when another code was used meanwhile, as it would bring that back
+		n, err := database.Client.User.Update().
+			Where(user.IDEQ(u.ID)).
+			Where(func(s *sql.Selector) {
+				s.Where(sqljson.ValueContains(user.FieldTotpRecoveryCodes, hash))
+				s.Where(sqljson.LenEQ(user.FieldTotpRecoveryCodes, len(u.TotpRecoveryCodes)))
+			}).
+			SetTotpRecoveryCodes(remaining).
+			Save(ctx)
+		return n > 0, err
+	}
+	return false, nil
+}
+
+// ResetTwoFactor turns off two-factor authentication of a user and forgets the secret
+func ResetTwoFactor(ctx context.Context, userID int) error {
+	return database.Client.User.UpdateOneID(userID).
+		SetTotpEnabled(false).
+		ClearTotpSecret().
+		SetTotpLastStep(0).
+		ClearTotpRecoveryCodes().
+		Exec(ctx)
+}
@@ -0,0 +1,576 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"gopan-server/config"
+	"gopan-server/ent"
+	"gopan-server/ent/filehash"
+	"gopan-server/ent/group"
+	"gopan-server/ent/node"
+	"gopan-server/ent/nodepermission"
+	"gopan-server/ent/predicate"
+	"gopan-server/ent/session"
+	"gopan-server/ent/share"
+	"gopan-server/ent/user"
+	"gopan-server/internal/account"
+	"gopan-server/internal/apierr"
+	"gopan-server/internal/auth"
+	"gopan-server/internal/database"
+	usersession "gopan-server/internal/session"
+	"gopan-server/internal/storage"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/minio/minio-go/v7"
+)
+
+// AdminHandler handles user administration, all routes require the admin role
+type AdminHandler struct {
+	cfg *config.Config
+}
+
+func NewAdminHandler(cfg *config.Config) *AdminHandler {
+	return &AdminHandler{cfg: cfg}
+}
+
+// AdminCreateUserRequest represents a request to create a user
+type AdminCreateUserRequest struct {
+	Username   string `json:"username" binding:"required,min=3,max=50"`
+	Password   string `json:"password" binding:"required,min=6"`
+	Email      string `json:"email"`
+	Role       string `json:"role" binding:"omitempty,oneof=admin user readonly"`
+	TotalQuota int64  `json:"total_quota" binding:"omitempty,min=1073741824"` // Minimum 1GB
+}
+
+// AdminUpdateUserRequest represents a request to update a user, nil fields are left unchanged
+type AdminUpdateUserRequest struct {
+	Email      *string `json:"email"`
+	Role       *string `json:"role" binding:"omitempty,oneof=admin user readonly"`
+	TotalQuota *int64  `json:"total_quota" binding:"omitempty,min=1073741824"`
+}
+
+// AdminResetPasswordRequest represents a request to reset a user's password
+type AdminResetPasswordRequest struct {
+	Password string `json:"password" binding:"required,min=6"`
+}
+
+// AdminUserResponse represents a user in the admin API
+type AdminUserResponse struct {
+	ID            int             `json:"id"`
+	Username      string          `json:"username"`
+	Email         string          `json:"email"`
+	EmailVerified bool            `json:"email_verified"`
+	Role          user.Role       `json:"role"`
+	IsDisabled    bool            `json:"is_disabled"`
+	AuthSource    user.AuthSource `json:"auth_source"`
+	TwoFactor     bool            `json:"two_factor"`
+	TotalQuota    int64           `json:"total_quota"`
+	TotalUsed     int64           `json:"total_used"`
+	CreatedAt     time.Time       `json:"created_at"`
+	LastLoginAt   time.Time       `json:"last_login_at"`
+}
+
+// AdminUserListResponse represents a page of users
+type AdminUserListResponse struct {
+	Users    []AdminUserResponse `json:"users"`
+	Total    int                 `json:"total"`
+	Page     int                 `json:"page"`
+	PageSize int                 `json:"page_size"`
+}
+
+// UserUsageResponse represents the storage and activity of a user
+type UserUsageResponse struct {
+	TotalQuota     int64 `json:"total_quota"`
+	TotalUsed      int64 `json:"total_used"`
+	FileCount      int   `json:"file_count"`
+	FolderCount    int   `json:"folder_count"`
+	FilesSize      int64 `json:"files_size"`
+	TrashCount     int   `json:"trash_count"` // Files and folders in the trash
+	TrashSize      int64 `json:"trash_size"`
+	ShareCount     int   `json:"share_count"`
+	ActiveSessions int   `json:"active_sessions"`
+}
+
+// formatAdminUser formats a user for the admin API
+func formatAdminUser(u *ent.User) AdminUserResponse {
+	return AdminUserResponse{
+		ID:            u.ID,
+		Username:      u.Username,
+		Email:         u.Email,
+		EmailVerified: u.EmailVerified,
+		Role:          u.Role,
+		IsDisabled:    u.IsDisabled,
+		AuthSource:    u.AuthSource,
+		TwoFactor:     u.TotpEnabled,
+		TotalQuota:    u.TotalQuota,
+		TotalUsed:     u.TotalUsed,
+		CreatedAt:     u.CreatedAt,
+		LastLoginAt:   u.LastLoginAt,
+	}
+}
+
+// getTargetUser loads the user from the :id param, writing an error response on failure
+func getTargetUser(c *gin.Context) (*ent.User, bool) {
+	targetID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		apierr.AbortInvalid(c, apierr.FieldError{Field: "id", Rule: "number"})
+		return nil, false
+	}
+
+	u, err := database.Client.User.Get(c.Request.Context(), targetID)
+	if err != nil {
+		apierr.Abort(c, apierr.UserNotFound)
+		return nil, false
+	}
+	return u, true
+}
+
+// isSelf reports whether the target user is the admin making the request
+func isSelf(c *gin.Context, u *ent.User) bool {
+	return c.GetString("userID") == strconv.Itoa(u.ID)
+}
+
+// sumNodeSize sums the size of the nodes matching the predicates
+func sumNodeSize(ctx context.Context, ps ...predicate.Node) (int64, error) {
+	var v []struct {
+		Sum sql.NullInt64 `json:"sum"`
+	}
+	err := database.Client.Node.Query().
+		Where(ps...).
+		Aggregate(ent.Sum(node.FieldSize)).
+		Scan(ctx, &v)
+	if err != nil || len(v) == 0 {
+		return 0, err
+	}
+	return v[0].Sum.Int64, nil
+}
+
+// userUsage collects storage and activity numbers of a user
+func userUsage(ctx context.Context, u *ent.User) (*UserUsageResponse, error) {
+	owned := node.HasOwnerWith(user.IDEQ(u.ID))
+
+	files, err := database.Client.Node.Query().
+		Where(owned, node.TypeEQ(1), node.IsDeletedEQ(false)).
+		Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	folders, err := database.Client.Node.Query().
+		Where(owned, node.TypeEQ(0), node.IsDeletedEQ(false)).
+		Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	filesSize, err := sumNodeSize(ctx, owned, node.TypeEQ(1), node.IsDeletedEQ(false))
+	if err != nil {
+		return nil, err
+	}
+	trashCount, err := database.Client.Node.Query().
+		Where(owned, node.IsDeletedEQ(true)).
+		Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	trashSize, err := sumNodeSize(ctx, owned, node.TypeEQ(1), node.IsDeletedEQ(true))
+	if err != nil {
+		return nil, err
+	}
+	shares, err := database.Client.Share.Query().
+		Where(share.HasOwnerWith(user.IDEQ(u.ID))).
+		Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sessions, err := database.Client.Session.Query().
+		Where(session.UserIDEQ(u.ID)).
+		Where(session.RevokedAtIsNil()).
+		Where(session.ExpiresAtGT(time.Now())).
+		Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UserUsageResponse{
+		TotalQuota:     u.TotalQuota,
+		TotalUsed:      u.TotalUsed,
+		FileCount:      files,
+		FolderCount:    folders,
+		FilesSize:      filesSize,
+		TrashCount:     trashCount,
+		TrashSize:      trashSize,
+		ShareCount:     shares,
+		ActiveSessions: sessions,
+	}, nil
+}
+
+// ListUsers handles GET /api/admin/users - List and search users
+func (h *AdminHandler) ListUsers(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "50"))
+	q := c.Query("q")
+	role := c.Query("role")
+	disabled := c.Query("disabled")
+
+	ctx := c.Request.Context()
+
+	query := database.Client.User.Query()
+	if q != "" {
+		query = query.Where(user.Or(
+			user.UsernameContainsFold(q),
+			user.EmailContainsFold(q),
+		))
+	}
+	if role != "" {
+		if err := user.RoleValidator(user.Role(role)); err != nil {
+			apierr.AbortInvalid(c, apierr.FieldError{Field: "role", Rule: "oneof", Param: "admin user readonly"})
+			return
+		}
+		query = query.Where(user.RoleEQ(user.Role(role)))
+	}
+	if disabled != "" {
+		isDisabled, err := strconv.ParseBool(disabled)
+		if err != nil {
+			apierr.AbortInvalid(c, apierr.FieldError{Field: "disabled", Rule: "boolean"})
+			return
+		}
+		query = query.Where(user.IsDisabledEQ(isDisabled))
+	}
+
+	// Get total count
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to count users", err)
+		return
+	}
+
+	// Apply pagination
+	offset := (page - 1) * pageSize
+	users, err := query.
+		Order(ent.Asc(user.FieldID)).
+		Offset(offset).
+		Limit(pageSize).
+		All(ctx)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to get users", err)
+		return
+	}
+
+	result := make([]AdminUserResponse, len(users))
+	for i, u := range users {
+		result[i] = formatAdminUser(u)
+	}
+
+	c.JSON(http.StatusOK, AdminUserListResponse{
+		Users:    result,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// GetUser handles GET /api/admin/users/:id - Get user details
+func (h *AdminHandler) GetUser(c *gin.Context) {
+	u, ok := getTargetUser(c)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, formatAdminUser(u))
+}
+
+// GetUserUsage handles GET /api/admin/users/:id/usage - Get storage usage of a user
+func (h *AdminHandler) GetUserUsage(c *gin.Context) {
+	u, ok := getTargetUser(c)
+	if !ok {
+		return
+	}
+
+	usage, err := userUsage(c.Request.Context(), u)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to get usage", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, usage)
+}
+
+// CreateUser handles POST /api/admin/users - Create user
+func (h *AdminHandler) CreateUser(c *gin.Context) {
+	var req AdminCreateUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apierr.AbortBind(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	// Check if username already exists
+	exists, err := database.Client.User.Query().
+		Where(user.UsernameEQ(req.Username)).
+		Exist(ctx)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to check username", err)
+		return
+	}
+	if exists {
+		apierr.Abort(c, apierr.UsernameTaken)
+		return
+	}
+
+	// Hash password
+	hashedPassword, err := auth.HashPassword(req.Password)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to hash password", err)
+		return
+	}
+
+	create := database.Client.User.Create().
+		SetUsername(req.Username).
+		SetPasswordHash(hashedPassword)
+	if req.Email != "" {
+		create.SetEmail(req.Email)
+	}
+	if req.Role != "" {
+		create.SetRole(user.Role(req.Role))
+	}
+	if req.TotalQuota > 0 {
+		create.SetTotalQuota(req.TotalQuota)
+	} else {
+		create.SetTotalQuota(h.cfg.Registration.DefaultQuota)
+	}
+	u, err := create.Save(ctx)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to create user", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, formatAdminUser(u))
+}
+
+// UpdateUser handles PUT /api/admin/users/:id - Update email, role or quota
+func (h *AdminHandler) UpdateUser(c *gin.Context) {
+	var req AdminUpdateUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apierr.AbortBind(c, err)
+		return
+	}
+
+	u, ok := getTargetUser(c)
+	if !ok {
+		return
+	}
+
+	// Admins cannot lock themselves out
+	if req.Role != nil && *req.Role != string(user.RoleAdmin) && isSelf(c, u) {
+		apierr.Abort(c, apierr.NotAllowedOnSelf)
+		return
+	}
+
+	update := u.Update()
+	if req.Email != nil && *req.Email != u.Email {
+		update.SetEmail(*req.Email).SetEmailVerified(false)
+	}
+	if req.Role != nil {
+		update.SetRole(user.Role(*req.Role))
+	}
+	if req.TotalQuota != nil {
+		update.SetTotalQuota(*req.TotalQuota)
+	}
+	u, err := update.Save(c.Request.Context())
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to update user", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, formatAdminUser(u))
+}
+
+// DisableUser handles POST /api/admin/users/:id/disable - Disable user and end all sessions
+func (h *AdminHandler) DisableUser(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	u, ok := getTargetUser(c)
+	if !ok {
+		return
+	}
+	if isSelf(c, u) {
+		apierr.Abort(c, apierr.NotAllowedOnSelf)
+		return
+	}
+
+	u, err := u.Update().SetIsDisabled(true).Save(ctx)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to disable user", err)
+		return
+	}
+
+	if _, err := usersession.RevokeAll(ctx, u.ID, 0); err != nil {
+		apierr.AbortInternal(c, "Failed to revoke sessions", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, formatAdminUser(u))
+}
+
+// EnableUser handles POST /api/admin/users/:id/enable - Enable a disabled user
+func (h *AdminHandler) EnableUser(c *gin.Context) {
+	u, ok := getTargetUser(c)
+	if !ok {
+		return
+	}
+
+	u, err := u.Update().SetIsDisabled(false).Save(c.Request.Context())
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to enable user", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, formatAdminUser(u))
+}
+
+// ResetPassword handles POST /api/admin/users/:id/password - Set a new password and end all sessions
+func (h *AdminHandler) ResetPassword(c *gin.Context) {
+	var req AdminResetPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apierr.AbortBind(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	u, ok := getTargetUser(c)
+	if !ok {
+		return
+	}
+	if u.AuthSource != user.AuthSourceLocal {
+		apierr.Abort(c, apierr.PasswordManagedExternally)
+		return
+	}
+
+	// Hash password
+	hashedPassword, err := auth.HashPassword(req.Password)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to hash password", err)
+		return
+	}
+
+	_, err = u.Update().SetPasswordHash(hashedPassword).Save(ctx)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to reset password", err)
+		return
+	}
+
+	// Keep the session of an admin resetting their own password
+	keep := 0
+	if isSelf(c, u) {
+		keep = c.GetInt("sessionID")
+	}
+	if _, err := usersession.RevokeAll(ctx, u.ID, keep); err != nil {
+		apierr.AbortInternal(c, "Failed to revoke sessions", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MessageResponse{Message: "Password reset"})
+}
+
+// ResetTwoFactor handles DELETE /api/admin/users/:id/2fa - Turn off two-factor authentication of a user
+func (h *AdminHandler) ResetTwoFactor(c *gin.Context) {
+	u, ok := getTargetUser(c)
+	if !ok {
+		return
+	}
+
+	if err := account.ResetTwoFactor(c.Request.Context(), u.ID); err != nil {
+		apierr.AbortInternal(c, "Failed to reset two-factor authentication", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MessageResponse{Message: "Two-factor authentication reset"})
+}
+
+// DeleteUser handles DELETE /api/admin/users/:id - Delete user with all files, shares and groups
+func (h *AdminHandler) DeleteUser(c *gin.Context) {
+	u, ok := getTargetUser(c)
+	if !ok {
+		return
+	}
+	if isSelf(c, u) {
+		apierr.Abort(c, apierr.NotAllowedOnSelf)
+		return
+	}
+
+	if err := h.deleteUserData(c.Request.Context(), u.ID); err != nil {
+		apierr.AbortInternal(c, "Failed to delete user", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MessageResponse{Message: "User deleted"})
+}
+
+// deleteUserData removes a user and everything the user owns. Stored objects
+// are only removed once no other file references their hash.
+func (h *AdminHandler) deleteUserData(ctx context.Context, uid int) error {
+	owned := node.HasOwnerWith(user.IDEQ(uid))
+
+	files, err := database.Client.Node.Query().
+		Where(owned, node.TypeEQ(1), node.FileHashNEQ("")).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range files {
+		fileHashRecord, err := database.Client.FileHash.Query().
+			Where(filehash.HashEQ(n.FileHash)).
+			Only(ctx)
+		if err != nil {
+			continue
+		}
+		if fileHashRecord.ReferenceCount <= 1 {
+			storage.GetClient().RemoveObject(ctx, h.cfg.MinIO.BucketName, fileHashRecord.MinioObject, minio.RemoveObjectOptions{})
+			database.Client.FileHash.DeleteOne(fileHashRecord).Exec(ctx)
+		} else {
+			fileHashRecord.Update().AddReferenceCount(-1).Save(ctx)
+		}
+	}
+
+	_, err = database.Client.Share.Delete().
+		Where(share.Or(
+			share.HasOwnerWith(user.IDEQ(uid)),
+			share.HasNodeWith(owned),
+		)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = database.Client.NodePermission.Delete().
+		Where(nodepermission.Or(
+			nodepermission.HasNodeWith(owned),
+			nodepermission.HasUserWith(user.IDEQ(uid)),
+			nodepermission.HasGrantedByWith(user.IDEQ(uid)),
+			nodepermission.HasGroupWith(group.HasOwnerWith(user.IDEQ(uid))),
+		)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = database.Client.Group.Delete().
+		Where(group.HasOwnerWith(user.IDEQ(uid))).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = database.Client.Node.Delete().
+		Where(owned).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return database.Client.User.DeleteOneID(uid).Exec(ctx)
+}
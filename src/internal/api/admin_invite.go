@@ -0,0 +1,146 @@
+package api
+
+import (
+	"gopan-server/ent"
+	"gopan-server/ent/invite"
+	"gopan-server/internal/account"
+	"gopan-server/internal/apierr"
+	"gopan-server/internal/database"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CreateInviteRequest represents a request to create an invite code
+type CreateInviteRequest struct {
+	Note       string     `json:"note" binding:"max=200"`
+	Role       string     `json:"role" binding:"omitempty,oneof=admin user readonly"`
+	TotalQuota *int64     `json:"total_quota" binding:"omitempty,min=1073741824"` // Minimum 1GB, empty for the default quota
+	MaxUses    *int       `json:"max_uses" binding:"omitempty,min=0"`             // 0 for unlimited (default: 1)
+	ExpiresAt  *time.Time `json:"expires_at"`
+}
+
+// InviteResponse represents an invite code
+type InviteResponse struct {
+	ID         int         `json:"id"`
+	Code       string      `json:"code"`
+	Note       string      `json:"note"`
+	Role       invite.Role `json:"role"`
+	TotalQuota *int64      `json:"total_quota"` // Null for the default quota
+	MaxUses    int         `json:"max_uses"`    // 0 for unlimited
+	Uses       int         `json:"uses"`
+	ExpiresAt  *time.Time  `json:"expires_at"`
+	CreatedBy  *int        `json:"created_by"`
+	CreatedAt  time.Time   `json:"created_at"`
+}
+
+// InviteListResponse represents the invite codes
+type InviteListResponse struct {
+	Invites          []InviteResponse `json:"invites"`
+	RegistrationMode string           `json:"registration_mode"`
+}
+
+// formatInvite formats an invite code
+func formatInvite(inv *ent.Invite) InviteResponse {
+	return InviteResponse{
+		ID:         inv.ID,
+		Code:       inv.Code,
+		Note:       inv.Note,
+		Role:       inv.Role,
+		TotalQuota: inv.TotalQuota,
+		MaxUses:    inv.MaxUses,
+		Uses:       inv.Uses,
+		ExpiresAt:  inv.ExpiresAt,
+		CreatedBy:  inv.CreatedBy,
+		CreatedAt:  inv.CreatedAt,
+	}
+}
+
+// ListInvites handles GET /api/admin/invites - List invite codes
+func (h *AdminHandler) ListInvites(c *gin.Context) {
+	invites, err := database.Client.Invite.Query().
+		Order(ent.Desc(invite.FieldCreatedAt)).
+		All(c.Request.Context())
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to get invites", err)
+		return
+	}
+
+	result := make([]InviteResponse, len(invites))
+	for i, inv := range invites {
+		result[i] = formatInvite(inv)
+	}
+
+	c.JSON(http.StatusOK, InviteListResponse{
+		Invites:          result,
+		RegistrationMode: h.cfg.Registration.Mode,
+	})
+}
+
+// CreateInvite handles POST /api/admin/invites - Create an invite code
+func (h *AdminHandler) CreateInvite(c *gin.Context) {
+	var req CreateInviteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apierr.AbortBind(c, err)
+		return
+	}
+
+	if req.ExpiresAt != nil && req.ExpiresAt.Before(time.Now()) {
+		apierr.AbortInvalid(c, apierr.FieldError{Field: "expires_at", Rule: "future"})
+		return
+	}
+
+	// Parse user ID
+	uid, err := parseUserID(c.GetString("userID"))
+	if err != nil {
+		apierr.Abort(c, apierr.Unauthenticated)
+		return
+	}
+
+	code, err := account.GenerateInviteCode()
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to generate invite code", err)
+		return
+	}
+
+	create := database.Client.Invite.Create().
+		SetCode(code).
+		SetNote(req.Note).
+		SetNillableTotalQuota(req.TotalQuota).
+		SetNillableMaxUses(req.MaxUses).
+		SetNillableExpiresAt(req.ExpiresAt).
+		SetCreatedBy(uid)
+	if req.Role != "" {
+		create.SetRole(invite.Role(req.Role))
+	}
+	inv, err := create.Save(c.Request.Context())
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to create invite", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, formatInvite(inv))
+}
+
+// DeleteInvite handles DELETE /api/admin/invites/:id - Revoke an invite code
+func (h *AdminHandler) DeleteInvite(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		apierr.AbortInvalid(c, apierr.FieldError{Field: "id", Rule: "number"})
+		return
+	}
+
+	err = database.Client.Invite.DeleteOneID(id).Exec(c.Request.Context())
+	if ent.IsNotFound(err) {
+		apierr.Abort(c, apierr.InviteNotFound)
+		return
+	}
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to delete invite", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MessageResponse{Message: "Invite deleted"})
+}
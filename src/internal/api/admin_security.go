@@ -0,0 +1,152 @@
+package api
+
+import (
+	"gopan-server/ent"
+	"gopan-server/ent/authfailure"
+	"gopan-server/ent/auththrottle"
+	"gopan-server/internal/apierr"
+	"gopan-server/internal/database"
+	"gopan-server/internal/throttle"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AuthFailureResponse represents a failed login or share password attempt
+type AuthFailureResponse struct {
+	ID        int                `json:"id"`
+	Kind      authfailure.Kind   `json:"kind"`
+	Subject   string             `json:"subject"` // Username or share code
+	UserID    *int               `json:"user_id"`
+	Reason    authfailure.Reason `json:"reason"`
+	IP        string             `json:"ip"`
+	UserAgent string             `json:"user_agent"`
+	CreatedAt time.Time          `json:"created_at"`
+}
+
+// AuthFailureListResponse represents a page of failed attempts
+type AuthFailureListResponse struct {
+	Failures []AuthFailureResponse `json:"failures"`
+	Total    int                   `json:"total"`
+	Page     int                   `json:"page"`
+	PageSize int                   `json:"page_size"`
+}
+
+// LockoutResponse represents the failed attempt state of a user
+type LockoutResponse struct {
+	Failures      int        `json:"failures"`
+	Locked        bool       `json:"locked"`
+	BlockedUntil  *time.Time `json:"blocked_until"`
+	LastFailureAt *time.Time `json:"last_failure_at,omitempty"`
+}
+
+// GetAuthFailures handles GET /api/admin/auth-failures - List failed login and share password attempts
+func (h *AdminHandler) GetAuthFailures(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "50"))
+	kind := c.Query("kind")
+	subject := c.Query("subject")
+	ip := c.Query("ip")
+
+	ctx := c.Request.Context()
+
+	query := database.Client.AuthFailure.Query()
+	if kind != "" {
+		if err := authfailure.KindValidator(authfailure.Kind(kind)); err != nil {
+			apierr.AbortInvalid(c, apierr.FieldError{Field: "kind", Rule: "oneof", Param: "login two_factor share"})
+			return
+		}
+		query = query.Where(authfailure.KindEQ(authfailure.Kind(kind)))
+	}
+	if subject != "" {
+		query = query.Where(authfailure.SubjectEqualFold(subject))
+	}
+	if ip != "" {
+		query = query.Where(authfailure.IPEQ(ip))
+	}
+
+	// Get total count
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to count failed attempts", err)
+		return
+	}
+
+	// Apply pagination
+	offset := (page - 1) * pageSize
+	failures, err := query.
+		Order(ent.Desc(authfailure.FieldCreatedAt)).
+		Offset(offset).
+		Limit(pageSize).
+		All(ctx)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to get failed attempts", err)
+		return
+	}
+
+	result := make([]AuthFailureResponse, len(failures))
+	for i, f := range failures {
+		result[i] = AuthFailureResponse{
+			ID:        f.ID,
+			Kind:      f.Kind,
+			Subject:   f.Subject,
+			UserID:    f.UserID,
+			Reason:    f.Reason,
+			IP:        f.IP,
+			UserAgent: f.UserAgent,
+			CreatedAt: f.CreatedAt,
+		}
+	}
+
+	c.JSON(http.StatusOK, AuthFailureListResponse{
+		Failures: result,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
+
+// GetLockout handles GET /api/admin/users/:id/lockout - Get failed attempt state of a user
+func (h *AdminHandler) GetLockout(c *gin.Context) {
+	u, ok := getTargetUser(c)
+	if !ok {
+		return
+	}
+
+	th, err := database.Client.AuthThrottle.Query().
+		Where(auththrottle.KeyEQ(throttle.UserKey(u.Username))).
+		Only(c.Request.Context())
+	if ent.IsNotFound(err) {
+		c.JSON(http.StatusOK, LockoutResponse{})
+		return
+	}
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to get lockout", err)
+		return
+	}
+
+	blocked := th.BlockedUntil != nil && th.BlockedUntil.After(time.Now())
+	c.JSON(http.StatusOK, LockoutResponse{
+		Failures:      th.Failures,
+		Locked:        blocked && th.Locked,
+		BlockedUntil:  th.BlockedUntil,
+		LastFailureAt: &th.LastFailureAt,
+	})
+}
+
+// Unlock handles DELETE /api/admin/users/:id/lockout - Clear failed attempts and lockout of a user
+func (h *AdminHandler) Unlock(c *gin.Context) {
+	u, ok := getTargetUser(c)
+	if !ok {
+		return
+	}
+
+	if err := throttle.New(&h.cfg.Security).Reset(c.Request.Context(), throttle.UserKey(u.Username)); err != nil {
+		apierr.AbortInternal(c, "Failed to unlock user", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MessageResponse{Message: "User unlocked"})
+}
@@ -1,11 +1,20 @@
 package api
 
 import (
+	"errors"
 	"fmt"
 	"gopan-server/config"
+	"gopan-server/ent"
+	"gopan-server/ent/authfailure"
 	"gopan-server/ent/user"
+	"gopan-server/internal/account"
+	"gopan-server/internal/apierr"
 	"gopan-server/internal/auth"
 	"gopan-server/internal/database"
+	"gopan-server/internal/logger"
+	"gopan-server/internal/mail"
+	"gopan-server/internal/session"
+	"gopan-server/internal/throttle"
 	"net/http"
 	"strconv"
 	"time"
@@ -13,19 +22,24 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
+// challengeTTL is how long a two-step login waits for the second factor
+const challengeTTL = 5 * time.Minute
+
 type AuthHandler struct {
-	cfg *config.Config
+	cfg      *config.Config
+	throttle *throttle.Throttler
 }
 
 func NewAuthHandler(cfg *config.Config) *AuthHandler {
-	return &AuthHandler{cfg: cfg}
+	return &AuthHandler{cfg: cfg, throttle: throttle.New(&cfg.Security)}
 }
 
 // RegisterRequest represents a registration request
 type RegisterRequest struct {
-	Username string `json:"username" binding:"required,min=3,max=50"`
-	Password string `json:"password" binding:"required,min=6"`
-	Email    string `json:"email"`
+	Username   string `json:"username" binding:"required,min=3,max=50"`
+	Password   string `json:"password" binding:"required,min=6"`
+	Email      string `json:"email" binding:"omitempty,email"`
+	InviteCode string `json:"invite_code"` // Required when registration is invite-only
 }
 
 // LoginRequest represents a login request
@@ -34,124 +48,344 @@ type LoginRequest struct {
 	Password string `json:"password" binding:"required"`
 }
 
+// LoginTwoFactorRequest represents the second step of a two-step login
+type LoginTwoFactorRequest struct {
+	ChallengeToken string `json:"challenge_token" binding:"required"`
+	Code           string `json:"code" binding:"required"`
+}
+
+// RefreshRequest represents a token refresh request
+type RefreshRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
+
+// LoginResponse represents the tokens of a new session
+type LoginResponse struct {
+	Token        string    `json:"token"`
+	RefreshToken string    `json:"refresh_token"`
+	ExpiresIn    int       `json:"expires_in"` // Seconds until the access token expires
+	User         LoginUser `json:"user"`
+}
+
+// LoginUser represents the user signed in by a login response
+type LoginUser struct {
+	ID       int       `json:"id"`
+	Username string    `json:"username"`
+	Email    string    `json:"email"`
+	Role     user.Role `json:"role"`
+}
+
+// TwoFactorChallengeResponse asks for the second factor of a two-step login
+type TwoFactorChallengeResponse struct {
+	TwoFactorRequired bool   `json:"two_factor_required"`
+	ChallengeToken    string `json:"challenge_token"`
+	ExpiresIn         int    `json:"expires_in"`
+}
+
+// RegistrationConfigResponse represents the registration policy
+type RegistrationConfigResponse struct {
+	Mode           string   `json:"mode"`            // open, invite or closed
+	AllowedDomains []string `json:"allowed_domains"` // Email domains allowed to register, empty for any
+}
+
+// MeResponse represents the current user
+type MeResponse struct {
+	ID            int             `json:"id"`
+	Username      string          `json:"username"`
+	Email         string          `json:"email"`
+	EmailVerified bool            `json:"email_verified"`
+	Role          user.Role       `json:"role"`
+	AuthSource    user.AuthSource `json:"auth_source"`
+	TwoFactor     bool            `json:"two_factor"`
+	CreatedAt     time.Time       `json:"created_at"`
+	LastLoginAt   time.Time       `json:"last_login_at"`
+}
+
+// startSession creates a session for the user on the requesting device and
+// responds with its tokens
+func (h *AuthHandler) startSession(c *gin.Context, u *ent.User) {
+	s, refreshToken, err := session.Create(c.Request.Context(), u.ID, c.ClientIP(), c.Request.UserAgent(), h.cfg.JWT.GetRefreshExpiration())
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to create session", err)
+		return
+	}
+	h.issueTokens(c, u, s, refreshToken)
+}
+
+// issueTokens signs an access token for the session and writes the login response
+func (h *AuthHandler) issueTokens(c *gin.Context, u *ent.User, s *ent.Session, refreshToken string) {
+	resp, err := loginResponse(h.cfg, u, s, refreshToken)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to generate token", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// loginResponse signs an access token for the session and builds the login response
+func loginResponse(cfg *config.Config, u *ent.User, s *ent.Session, refreshToken string) (*LoginResponse, error) {
+	token, err := auth.GenerateToken(fmt.Sprintf("%d", u.ID), u.Username, s.ID, &cfg.JWT)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoginResponse{
+		Token:        token,
+		RefreshToken: refreshToken,
+		ExpiresIn:    int(cfg.JWT.GetExpiration().Seconds()),
+		User: LoginUser{
+			ID:       u.ID,
+			Username: u.Username,
+			Email:    u.Email,
+			Role:     u.Role,
+		},
+	}, nil
+}
+
+// GetRegistrationConfig handles GET /api/auth/registration - Get the registration policy for the login page
+func (h *AuthHandler) GetRegistrationConfig(c *gin.Context) {
+	c.JSON(http.StatusOK, RegistrationConfigResponse{
+		Mode:           h.cfg.Registration.Mode,
+		AllowedDomains: h.cfg.Registration.AllowedDomains,
+	})
+}
+
 // Register handles user registration
 func (h *AuthHandler) Register(c *gin.Context) {
 	var req RegisterRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		apierr.AbortBind(c, err)
 		return
 	}
 
 	ctx := c.Request.Context()
 
-	// Check if username already exists
-	exists, err := database.Client.User.Query().
-		Where(user.UsernameEQ(req.Username)).
-		Exist(ctx)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check username"})
+	// Create user according to the registration policy
+	user, err := account.Register(ctx, &h.cfg.Registration, req.Username, req.Password, req.Email, req.InviteCode)
+	switch {
+	case errors.Is(err, account.ErrRegistrationClosed):
+		apierr.Abort(c, apierr.RegistrationClosed)
+		return
+	case errors.Is(err, account.ErrInviteRequired):
+		apierr.Abort(c, apierr.InviteRequired)
+		return
+	case errors.Is(err, account.ErrInvalidInvite):
+		apierr.Abort(c, apierr.InvalidInvite)
+		return
+	case errors.Is(err, account.ErrEmailDomainNotAllowed):
+		apierr.Abort(c, apierr.EmailDomainNotAllowed)
+		return
+	case errors.Is(err, account.ErrUsernameTaken):
+		apierr.Abort(c, apierr.UsernameTaken)
+		return
+	case err != nil:
+		apierr.AbortInternal(c, "Failed to create user", err)
 		return
 	}
-	if exists {
-		c.JSON(http.StatusConflict, gin.H{"error": "Username already exists"})
+
+	// Ask the user to confirm their email when mail delivery is configured
+	if user.Email != "" {
+		err := account.SendEmailVerification(ctx, h.cfg, user)
+		if err != nil && !errors.Is(err, mail.ErrDisabled) {
+			logger.Error.Printf("Failed to send verification email to user %s: %v", user.Username, err)
+		}
+	}
+
+	h.startSession(c, user)
+}
+
+// Login handles user login
+func (h *AuthHandler) Login(c *gin.Context) {
+	var req LoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apierr.AbortBind(c, err)
 		return
 	}
 
-	// Hash password
-	hashedPassword, err := auth.HashPassword(req.Password)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to hash password"})
+	ctx := c.Request.Context()
+
+	// Throttle repeated failures per username and per client IP
+	limits := h.throttle.LoginLimits(req.Username, c.ClientIP())
+	attempt := newAttempt(c, authfailure.KindLogin, req.Username)
+	if !checkThrottle(c, h.throttle, limits, attempt) {
 		return
 	}
 
-	// Create user with default 10GB capacity
-	user, err := database.Client.User.Create().
-		SetUsername(req.Username).
-		SetPasswordHash(hashedPassword).
-		SetNillableEmail(&req.Email).
-		SetTotalQuota(10737418240). // 10GB = 10 * 1024 * 1024 * 1024
-		Save(ctx)
+	// Check password against the local database or the directory
+	user, err := account.Authenticate(ctx, h.cfg, req.Username, req.Password)
+	if errors.Is(err, account.ErrInvalidCredentials) {
+		failAttempt(c, h.throttle, limits, attempt, authfailure.ReasonInvalidCredentials)
+		apierr.Abort(c, apierr.InvalidCredentials)
+		return
+	}
+	if errors.Is(err, account.ErrUserDisabled) {
+		apierr.Abort(c, apierr.AccountDisabled)
+		return
+	}
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create user"})
+		logger.Error.Printf("Authentication of %s failed: %v", req.Username, err)
+		apierr.Abort(c, apierr.AuthUnavailable)
 		return
 	}
 
-	// Generate token
-	token, err := auth.GenerateToken(fmt.Sprintf("%d", user.ID), user.Username, &h.cfg.JWT)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate token"})
+	if user.IsDisabled {
+		apierr.Abort(c, apierr.AccountDisabled)
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{
-		"token": token,
-		"user": gin.H{
-			"id":       user.ID,
-			"username": user.Username,
-			"email":    user.Email,
-		},
-	})
+	// With two-factor authentication the client exchanges a challenge token
+	// and a code for the session in LoginTwoFactor
+	if user.TotpEnabled {
+		challenge, err := auth.GenerateChallengeToken(fmt.Sprintf("%d", user.ID), user.Username, challengeTTL, &h.cfg.JWT)
+		if err != nil {
+			apierr.AbortInternal(c, "Failed to generate token", err)
+			return
+		}
+		c.JSON(http.StatusOK, TwoFactorChallengeResponse{
+			TwoFactorRequired: true,
+			ChallengeToken:    challenge,
+			ExpiresIn:         int(challengeTTL.Seconds()),
+		})
+		return
+	}
+
+	// The username's failures are forgotten once the login succeeds (with two-factor
+	// only after the code). The IP's are kept so one valid account can't reset them.
+	if err := h.throttle.Reset(ctx, throttle.UserKey(req.Username)); err != nil {
+		logger.Error.Printf("Failed to reset throttle: %v", err)
+	}
+
+	h.completeLogin(c, user)
 }
 
-// Login handles user login
-func (h *AuthHandler) Login(c *gin.Context) {
-	var req LoginRequest
+// LoginTwoFactor handles POST /api/auth/login/2fa - Finish a two-step login with a TOTP or recovery code
+func (h *AuthHandler) LoginTwoFactor(c *gin.Context) {
+	var req LoginTwoFactorRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		apierr.AbortBind(c, err)
 		return
 	}
 
 	ctx := c.Request.Context()
 
-	// Find user by username
-	user, err := database.Client.User.Query().
-		Where(user.UsernameEQ(req.Username)).
-		Only(ctx)
+	claims, err := auth.ValidateChallengeToken(req.ChallengeToken, &h.cfg.JWT)
 	if err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid username or password"})
+		apierr.Abort(c, apierr.LoginChallengeExpired)
 		return
 	}
 
-	// Check password
-	if !auth.CheckPassword(req.Password, user.PasswordHash) {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid username or password"})
+	uid, err := parseUserID(claims.UserID)
+	if err != nil {
+		apierr.Abort(c, apierr.LoginChallengeExpired)
+		return
+	}
+	u, err := database.Client.User.Get(ctx, uid)
+	if err != nil || !u.TotpEnabled {
+		apierr.Abort(c, apierr.LoginChallengeExpired)
+		return
+	}
+	if u.IsDisabled {
+		apierr.Abort(c, apierr.AccountDisabled)
+		return
+	}
+
+	// Codes are throttled like passwords, a 6 digit code is easy to guess otherwise
+	limits := h.throttle.LoginLimits(u.Username, c.ClientIP())
+	attempt := newAttempt(c, authfailure.KindTwoFactor, u.Username)
+	attempt.UserID = &u.ID
+	if !checkThrottle(c, h.throttle, limits, attempt) {
+		return
+	}
+
+	valid, err := account.VerifySecondFactor(ctx, u, req.Code)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to verify code", err)
+		return
+	}
+	if !valid {
+		failAttempt(c, h.throttle, limits, attempt, authfailure.ReasonInvalidCode)
+		apierr.Abort(c, apierr.InvalidVerificationCode)
 		return
 	}
 
+	if err := h.throttle.Reset(ctx, throttle.UserKey(u.Username)); err != nil {
+		logger.Error.Printf("Failed to reset throttle: %v", err)
+	}
+
+	h.completeLogin(c, u)
+}
+
+// completeLogin records the login and starts a session
+func (h *AuthHandler) completeLogin(c *gin.Context, u *ent.User) {
 	// Update last login time
-	_, err = user.Update().SetLastLoginAt(time.Now()).Save(ctx)
+	_, err := u.Update().SetLastLoginAt(time.Now()).Save(c.Request.Context())
 	if err != nil {
 		// Log error but don't fail the login
 		_ = err
 	}
 
-	// Generate token
-	token, err := auth.GenerateToken(fmt.Sprintf("%d", user.ID), user.Username, &h.cfg.JWT)
+	h.startSession(c, u)
+}
+
+// Refresh handles POST /api/auth/refresh - Exchange a refresh token for new tokens
+// Refresh tokens are single use: every refresh returns a new one.
+func (h *AuthHandler) Refresh(c *gin.Context) {
+	var req RefreshRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apierr.AbortBind(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	s, refreshToken, err := session.Rotate(ctx, req.RefreshToken, c.ClientIP())
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate token"})
+		switch err {
+		case session.ErrTokenReused:
+			apierr.Abort(c, apierr.SessionRevoked)
+		case session.ErrInvalidToken:
+			apierr.Abort(c, apierr.InvalidToken)
+		default:
+			apierr.AbortInternal(c, "Failed to refresh session", err)
+		}
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{
-		"token": token,
-		"user": gin.H{
-			"id":       user.ID,
-			"username": user.Username,
-			"email":    user.Email,
-		},
-	})
+	u, err := database.Client.User.Get(ctx, s.UserID)
+	if err != nil {
+		apierr.Abort(c, apierr.SessionRevoked)
+		return
+	}
+	if u.IsDisabled {
+		apierr.Abort(c, apierr.AccountDisabled)
+		return
+	}
+
+	h.issueTokens(c, u, s, refreshToken)
 }
 
-// Logout handles user logout (client-side token removal)
+// Logout handles POST /api/auth/logout - Revoke the current session
 func (h *AuthHandler) Logout(c *gin.Context) {
-	c.JSON(http.StatusOK, gin.H{"message": "Logged out successfully"})
+	uid, err := parseUserID(c.GetString("userID"))
+	if err != nil {
+		apierr.Abort(c, apierr.Unauthenticated)
+		return
+	}
+
+	if _, err := session.Revoke(c.Request.Context(), c.GetInt("sessionID"), uid); err != nil {
+		apierr.AbortInternal(c, "Failed to revoke session", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MessageResponse{Message: "Logged out successfully"})
 }
 
 // Me returns current user information
 func (h *AuthHandler) Me(c *gin.Context) {
 	userID, exists := c.Get("userID")
 	if !exists {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		apierr.Abort(c, apierr.Unauthenticated)
 		return
 	}
 
@@ -160,23 +394,27 @@ func (h *AuthHandler) Me(c *gin.Context) {
 	// Parse user ID
 	id, err := strconv.Atoi(userID.(string))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		apierr.Abort(c, apierr.Unauthenticated)
 		return
 	}
 
 	// Get user
 	user, err := database.Client.User.Get(ctx, id)
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+		apierr.Abort(c, apierr.UserNotFound)
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{
-		"id":         user.ID,
-		"username":   user.Username,
-		"email":      user.Email,
-		"created_at": user.CreatedAt,
-		"last_login": user.LastLoginAt,
+	c.JSON(http.StatusOK, MeResponse{
+		ID:            user.ID,
+		Username:      user.Username,
+		Email:         user.Email,
+		EmailVerified: user.EmailVerified,
+		Role:          user.Role,
+		AuthSource:    user.AuthSource,
+		TwoFactor:     user.TotpEnabled,
+		CreatedAt:     user.CreatedAt,
+		LastLoginAt:   user.LastLoginAt,
 	})
 }
 
@@ -4,7 +4,9 @@ import (
 	"gopan-server/config"
 	"gopan-server/ent/node"
 	"gopan-server/ent/user"
+	"gopan-server/internal/apierr"
 	"gopan-server/internal/database"
+	"gopan-server/internal/events"
 	"net/http"
 	"strconv"
 
@@ -28,11 +30,17 @@ type GetCapacityResponse struct {
 	Percentage float64 `json:"percentage"`   // Usage percentage (0-100)
 }
 
+// RecalculateCapacityResponse represents the result of recalculating used storage
+type RecalculateCapacityResponse struct {
+	Message   string `json:"message"`
+	TotalUsed int64  `json:"total_used"` // Total used in bytes
+}
+
 // GetCapacity returns the current user's storage capacity information
 func (h *CapacityHandler) GetCapacity(c *gin.Context) {
 	userIDStr := c.GetString("userID")
 	if userIDStr == "" {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		apierr.Abort(c, apierr.Unauthenticated)
 		return
 	}
 
@@ -41,14 +49,14 @@ func (h *CapacityHandler) GetCapacity(c *gin.Context) {
 	// Parse user ID
 	userID, err := strconv.Atoi(userIDStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		apierr.Abort(c, apierr.Unauthenticated)
 		return
 	}
 
 	// Get user with capacity information
 	u, err := database.Client.User.Get(ctx, userID)
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+		apierr.Abort(c, apierr.UserNotFound)
 		return
 	}
 
@@ -77,7 +85,7 @@ type UpdateCapacityRequest struct {
 func (h *CapacityHandler) UpdateCapacity(c *gin.Context) {
 	userIDStr := c.Param("id")
 	if userIDStr == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "User ID is required"})
+		apierr.AbortInvalid(c, apierr.FieldError{Field: "user_id", Rule: "required"})
 		return
 	}
 
@@ -86,27 +94,28 @@ func (h *CapacityHandler) UpdateCapacity(c *gin.Context) {
 	// Parse user ID to update
 	userID, err := strconv.Atoi(userIDStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		apierr.AbortInvalid(c, apierr.FieldError{Field: "id", Rule: "number"})
 		return
 	}
 
 	// Parse request body
 	var req UpdateCapacityRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		apierr.AbortBind(c, err)
 		return
 	}
 
 	// Update user capacity
-	_, err = database.Client.User.UpdateOneID(userID).
+	u, err := database.Client.User.UpdateOneID(userID).
 		SetTotalQuota(req.TotalQuota).
 		Save(ctx)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update capacity"})
+		apierr.AbortInternal(c, "Failed to update capacity", err)
 		return
 	}
+	events.CheckQuota(ctx, u)
 
-	c.JSON(http.StatusOK, gin.H{"message": "Capacity updated successfully"})
+	c.JSON(http.StatusOK, MessageResponse{Message: "Capacity updated successfully"})
 }
 
 // RecalculateUsedCapacity recalculates the user's used storage based on actual files
@@ -114,7 +123,7 @@ func (h *CapacityHandler) UpdateCapacity(c *gin.Context) {
 func (h *CapacityHandler) RecalculateUsedCapacity(c *gin.Context) {
 	userIDStr := c.GetString("userID")
 	if userIDStr == "" {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		apierr.Abort(c, apierr.Unauthenticated)
 		return
 	}
 
@@ -123,7 +132,7 @@ func (h *CapacityHandler) RecalculateUsedCapacity(c *gin.Context) {
 	// Parse user ID
 	userID, err := strconv.Atoi(userIDStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		apierr.Abort(c, apierr.Unauthenticated)
 		return
 	}
 
@@ -136,7 +145,7 @@ func (h *CapacityHandler) RecalculateUsedCapacity(c *gin.Context) {
 		Where(node.TypeEQ(1)). // Only files, not folders
 		All(ctx)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to query files"})
+		apierr.AbortInternal(c, "Failed to query files", err)
 		return
 	}
 
@@ -145,16 +154,17 @@ func (h *CapacityHandler) RecalculateUsedCapacity(c *gin.Context) {
 	}
 
 	// Update user's used storage
-	_, err = database.Client.User.UpdateOneID(userID).
+	u, err := database.Client.User.UpdateOneID(userID).
 		SetTotalUsed(totalUsed).
 		Save(ctx)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update used storage"})
+		apierr.AbortInternal(c, "Failed to update used storage", err)
 		return
 	}
+	events.CheckQuota(ctx, u)
 
-	c.JSON(http.StatusOK, gin.H{
-		"message":    "Used storage recalculated successfully",
-		"total_used":  totalUsed,
+	c.JSON(http.StatusOK, RecalculateCapacityResponse{
+		Message:   "Used storage recalculated successfully",
+		TotalUsed: totalUsed,
 	})
 }
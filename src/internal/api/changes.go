@@ -0,0 +1,111 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gopan-server/config"
+	"gopan-server/internal/apierr"
+	"gopan-server/internal/changes"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxChangeWait bounds how long a request waits for changes
+const maxChangeWait = 60 * time.Second
+
+// ChangePageResponse represents a page of the change feed
+type ChangePageResponse struct {
+	Changes []changes.Entry `json:"changes"`
+	Cursor  string          `json:"cursor"` // Passed back to get the changes that follow
+	HasMore bool            `json:"has_more"`
+}
+
+// ChangeHandler handles the change feed of the current user's files
+type ChangeHandler struct {
+	cfg *config.Config
+}
+
+func NewChangeHandler(cfg *config.Config) *ChangeHandler {
+	return &ChangeHandler{cfg: cfg}
+}
+
+// GetChanges handles GET /api/changes - List changes of my files since a cursor
+// Without a cursor only the latest cursor is returned, to be used after
+// listing all files. With timeout (seconds) the request waits for changes
+// when there are none yet. An expired cursor is answered with 410 Gone.
+func (h *ChangeHandler) GetChanges(c *gin.Context) {
+	userID := c.GetString("userID")
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "500"))
+	timeout, _ := strconv.Atoi(c.DefaultQuery("timeout", "0"))
+
+	ctx := c.Request.Context()
+
+	// Parse user ID
+	uid, err := parseUserID(userID)
+	if err != nil {
+		apierr.Abort(c, apierr.Unauthenticated)
+		return
+	}
+
+	if c.Query("cursor") == "" {
+		latest, err := changes.Latest(ctx, uid)
+		if err != nil {
+			apierr.AbortInternal(c, "Failed to get changes", err)
+			return
+		}
+		c.JSON(http.StatusOK, ChangePageResponse{
+			Changes: []changes.Entry{},
+			Cursor:  strconv.FormatInt(latest, 10),
+		})
+		return
+	}
+
+	cursor, err := strconv.ParseInt(c.Query("cursor"), 10, 64)
+	if err != nil {
+		apierr.AbortInvalid(c, apierr.FieldError{Field: "cursor", Rule: "number"})
+		return
+	}
+	if limit < 1 || limit > 1000 {
+		limit = 500
+	}
+	wait := min(time.Duration(max(timeout, 0))*time.Second, maxChangeWait)
+
+	page, err := changes.List(ctx, uid, cursor, limit)
+	if err == nil && len(page.Changes) == 0 && wait > 0 {
+		// The server write timeout is shorter than a long poll
+		http.NewResponseController(c.Writer).SetWriteDeadline(time.Now().Add(wait + 15*time.Second))
+
+		waitCtx, cancel := context.WithTimeout(ctx, wait)
+		err = changes.Wait(waitCtx, uid, cursor)
+		cancel()
+		if ctx.Err() != nil {
+			return
+		}
+		if err == nil || errors.Is(err, context.DeadlineExceeded) {
+			page, err = changes.List(ctx, uid, cursor, limit)
+		}
+	}
+	if errors.Is(err, changes.ErrCursorExpired) {
+		apierr.Abort(c, apierr.CursorExpired)
+		return
+	}
+	if err != nil {
+		apierr.AbortInternal(c, fmt.Sprintf("Failed to get changes of user %d", uid), err)
+		return
+	}
+
+	result := make([]changes.Entry, len(page.Changes))
+	for i, ch := range page.Changes {
+		result[i] = changes.Format(ch)
+	}
+
+	c.JSON(http.StatusOK, ChangePageResponse{
+		Changes: result,
+		Cursor:  strconv.FormatInt(page.Cursor, 10),
+		HasMore: page.HasMore,
+	})
+}
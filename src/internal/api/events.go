@@ -0,0 +1,173 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"gopan-server/config"
+	"gopan-server/internal/apierr"
+	"gopan-server/internal/events"
+	"gopan-server/internal/pat"
+	"gopan-server/internal/session"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/net/websocket"
+)
+
+// heartbeatInterval keeps proxies from closing idle event streams, and is
+// how often a stream checks that its session is still valid
+var heartbeatInterval = 30 * time.Second
+
+// EventHandler streams real-time events to the current user's clients.
+// Events only tell clients what to look at again: a client that was not
+// connected catches up through the change feed.
+type EventHandler struct {
+	cfg *config.Config
+}
+
+func NewEventHandler(cfg *config.Config) *EventHandler {
+	return &EventHandler{cfg: cfg}
+}
+
+// stillSignedIn reports whether the session or access token a stream was
+// opened with is still valid. Streams outlive the access token lifetime,
+// but not a logout, revocation or disabled account.
+func stillSignedIn(c *gin.Context, uid int) bool {
+	ctx := c.Request.Context()
+	if sessionID := c.GetInt("sessionID"); sessionID != 0 {
+		u, err := session.ActiveUser(ctx, sessionID, uid)
+		return err != nil || u != nil
+	}
+	if tokenID := c.GetInt("tokenID"); tokenID != 0 {
+		ok, err := pat.Active(ctx, tokenID)
+		return err != nil || ok
+	}
+	return false
+}
+
+// Stream handles GET /api/events - Stream my events as server-sent events
+// Each event is sent with its type as the event name and the whole event
+// as JSON data. Browsers pass the token as the token parameter.
+func (h *EventHandler) Stream(c *gin.Context) {
+	userID := c.GetString("userID")
+
+	ctx := c.Request.Context()
+
+	// Parse user ID
+	uid, err := parseUserID(userID)
+	if err != nil {
+		apierr.Abort(c, apierr.Unauthenticated)
+		return
+	}
+
+	sub := events.Subscribe(uid)
+	defer sub.Close()
+
+	// The stream is not bound by the server write timeout
+	rc := http.NewResponseController(c.Writer)
+	rc.SetWriteDeadline(time.Time{})
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	fmt.Fprint(c.Writer, "retry: 5000\n\n")
+	if err := rc.Flush(); err != nil {
+		return
+	}
+
+	ticker := time.NewTicker(heartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case e, ok := <-sub.C:
+			if !ok {
+				return
+			}
+			data, err := json.Marshal(e)
+			if err != nil {
+				return
+			}
+			fmt.Fprintf(c.Writer, "event: %s\ndata: %s\n\n", e.Type, data)
+		case <-ticker.C:
+			if !stillSignedIn(c, uid) {
+				return
+			}
+			fmt.Fprint(c.Writer, ": ping\n\n")
+		}
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+// WebSocket handles GET /api/events/ws - Stream my events over a WebSocket
+// Each event is sent as a JSON text message. Messages from the client are
+// ignored.
+func (h *EventHandler) WebSocket(c *gin.Context) {
+	userID := c.GetString("userID")
+
+	// Parse user ID
+	uid, err := parseUserID(userID)
+	if err != nil {
+		apierr.Abort(c, apierr.Unauthenticated)
+		return
+	}
+
+	server := websocket.Server{
+		// The origin is not checked, as the token is passed explicitly and
+		// not taken from cookies another site could make the browser send
+		Handshake: func(*websocket.Config, *http.Request) error { return nil },
+		Handler: func(ws *websocket.Conn) {
+			h.serveWebSocket(c, ws, uid)
+		},
+	}
+	server.ServeHTTP(c.Writer, c.Request)
+}
+
+// serveWebSocket sends the events of a user until the connection closes
+func (h *EventHandler) serveWebSocket(c *gin.Context, ws *websocket.Conn, uid int) {
+	defer ws.Close()
+
+	sub := events.Subscribe(uid)
+	defer sub.Close()
+
+	// Read until the client goes away
+	closed := make(chan struct{})
+	ws.SetReadDeadline(time.Time{})
+	go func() {
+		defer close(closed)
+		var msg []byte
+		for websocket.Message.Receive(ws, &msg) == nil {
+		}
+	}()
+
+	ticker := time.NewTicker(heartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		var msg any
+		select {
+		case <-closed:
+			return
+		case e, ok := <-sub.C:
+			if !ok {
+				return
+			}
+			msg = e
+		case <-ticker.C:
+			if !stillSignedIn(c, uid) {
+				return
+			}
+			msg = gin.H{"type": "ping", "time": time.Now()}
+		}
+		ws.SetWriteDeadline(time.Now().Add(heartbeatInterval))
+		if err := websocket.JSON.Send(ws, msg); err != nil {
+			return
+		}
+	}
+}
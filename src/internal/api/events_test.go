@@ -0,0 +1,85 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"gopan-server/client"
+	"gopan-server/internal/dbtest"
+	"gopan-server/internal/events"
+	"io"
+	"testing"
+	"time"
+)
+
+func TestEventStreamEndsOnRevocation(t *testing.T) {
+	db := dbtest.Open(t)
+	cfg := testConfig(t, `{"jwt": {"secret": "test"}}`)
+	url := newTestServer(t, cfg, nil).URL
+	ctx := context.Background()
+
+	// Check the sign in often rather than every 30 seconds
+	interval := heartbeatInterval
+	heartbeatInterval = 50 * time.Millisecond
+	t.Cleanup(func() { heartbeatInterval = interval })
+
+	owner := client.New(url)
+	if _, err := owner.Register(ctx, client.RegisterRequest{Username: "alice", Password: "alice-password"}); err != nil {
+		t.Fatalf("register: %v", err)
+	}
+	uid := db.User.Query().OnlyX(ctx).ID
+
+	tests := []struct {
+		name string
+		// open returns a client signed in the way under test, and revoke
+		// ends that sign in
+		open func(t *testing.T) (c *client.Client, revoke func() error)
+	}{
+		{"logout", func(t *testing.T) (*client.Client, func() error) {
+			c := client.New(url)
+			if _, _, err := c.Login(ctx, "alice", "alice-password"); err != nil {
+				t.Fatalf("login: %v", err)
+			}
+			return c, func() error { return c.Logout(ctx) }
+		}},
+		{"deleted token", func(t *testing.T) (*client.Client, func() error) {
+			token, err := owner.CreateToken(ctx, client.CreateTokenRequest{Name: "events"})
+			if err != nil {
+				t.Fatalf("CreateToken: %v", err)
+			}
+			c := client.New(url)
+			c.SetToken(token.Token)
+			return c, func() error { return owner.DeleteToken(ctx, token.ID) }
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, revoke := tt.open(t)
+			streamCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+			defer cancel()
+			stream, err := c.Events(streamCtx)
+			if err != nil {
+				t.Fatalf("Events: %v", err)
+			}
+			defer stream.Close()
+
+			// Events keep coming across heartbeats while signed in
+			time.Sleep(3 * heartbeatInterval)
+			events.Publish(ctx, events.TypeQuota, client.QuotaEvent{TotalUsed: 1}, uid)
+			e, err := stream.Next()
+			if err != nil || e.Type != client.EventQuota {
+				t.Fatalf("Next = %+v, %v, want a quota event", e, err)
+			}
+
+			if err := revoke(); err != nil {
+				t.Fatalf("revoke: %v", err)
+			}
+			start := time.Now()
+			if e, err := stream.Next(); !errors.Is(err, io.EOF) {
+				t.Fatalf("Next after revoking = %+v, %v, want the stream to end", e, err)
+			}
+			if elapsed := time.Since(start); elapsed > 2*time.Second {
+				t.Errorf("stream ended %s after revoking", elapsed)
+			}
+		})
+	}
+}
@@ -6,13 +6,22 @@ import (
 	"fmt"
 	"gopan-server/config"
 	"gopan-server/ent"
+	"gopan-server/ent/change"
 	"gopan-server/ent/filehash"
 	"gopan-server/ent/node"
+	"gopan-server/ent/nodepermission"
+	"gopan-server/ent/share"
 	"gopan-server/ent/user"
+	"gopan-server/internal/apierr"
+	"gopan-server/internal/changes"
 	"gopan-server/internal/database"
+	"gopan-server/internal/drive"
+	"gopan-server/internal/events"
+	"gopan-server/internal/permission"
 	"gopan-server/internal/storage"
 	"io"
 	"net/http"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -22,6 +31,151 @@ import (
 	"github.com/minio/minio-go/v7"
 )
 
+// CreateFolderRequest represents a request to create a folder
+type CreateFolderRequest struct {
+	Name     string `json:"name" binding:"required"`
+	ParentID string `json:"parent_id"` // Empty for the top level
+}
+
+// RenameFileRequest represents a request to rename a file or folder
+type RenameFileRequest struct {
+	Name string `json:"name" binding:"required"`
+}
+
+// MoveFilesRequest represents a request to move files and folders
+type MoveFilesRequest struct {
+	IDs      []string `json:"ids" binding:"required"`
+	ParentID string   `json:"parent_id"` // Empty for the top level
+}
+
+// CopyFilesRequest represents a request to copy files and folders
+type CopyFilesRequest struct {
+	IDs      []string `json:"ids" binding:"required"`
+	ParentID string   `json:"parent_id"` // Empty for the top level
+}
+
+// QuickUploadRequest represents a request to upload a file the server already has by its hash
+type QuickUploadRequest struct {
+	Hash     string `json:"hash" binding:"required"` // SHA-256 of the content
+	Name     string `json:"name" binding:"required"`
+	Size     int64  `json:"size" binding:"required"`
+	MimeType string `json:"mime_type"`
+	ParentID string `json:"parent_id"` // Empty for the top level
+}
+
+// RestoreFileRequest represents a request to restore a file from the trash
+type RestoreFileRequest struct {
+	ID string `json:"id" binding:"required"`
+}
+
+// FileResponse represents a file or folder
+type FileResponse struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	Type      int       `json:"type"` // 0: folder, 1: file
+	Size      int64     `json:"size"`
+	MimeType  string    `json:"mime_type"`
+	ParentID  *int      `json:"parent_id"` // Null at the top level
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// FileListResponse represents a page of files and folders
+type FileListResponse struct {
+	Files    []FileResponse `json:"files"`
+	Total    int            `json:"total"`
+	Page     int            `json:"page"`
+	PageSize int            `json:"page_size"`
+}
+
+// SearchFilesResponse represents the files and folders found by a search
+type SearchFilesResponse struct {
+	Files []FileResponse `json:"files"`
+}
+
+// UploadResponse represents an uploaded file
+type UploadResponse struct {
+	ID          int       `json:"id"`
+	Name        string    `json:"name"`
+	Size        int64     `json:"size"`
+	MimeType    string    `json:"mime_type"`
+	CreatedAt   time.Time `json:"created_at"`
+	QuickUpload bool      `json:"quick_upload,omitempty"` // Whether the content was already on the server
+}
+
+// FolderResponse represents a created folder
+type FolderResponse struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	Type      int       `json:"type"`
+	ParentID  *int      `json:"parent_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// FolderTreeItem represents a folder and the folders in it
+type FolderTreeItem struct {
+	ID       int              `json:"id"`
+	Name     string           `json:"name"`
+	Children []FolderTreeItem `json:"children"`
+}
+
+// FolderTreeResponse represents all folders of a user
+type FolderTreeResponse struct {
+	Tree []FolderTreeItem `json:"tree"`
+}
+
+// RenameFileResponse represents a renamed file or folder
+type RenameFileResponse struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// NodeLocation represents where a file or folder was moved or copied to
+type NodeLocation struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	ParentID *int   `json:"parent_id"`
+}
+
+// MoveFilesResponse represents the files and folders moved
+type MoveFilesResponse struct {
+	Moved []NodeLocation `json:"moved"`
+}
+
+// CopyFilesResponse represents the copies made
+type CopyFilesResponse struct {
+	Copied []NodeLocation `json:"copied"`
+}
+
+// TrashFileResponse represents a file or folder in the trash
+type TrashFileResponse struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	Type      int       `json:"type"`
+	Size      int64     `json:"size"`
+	DeletedAt time.Time `json:"deleted_at"`
+}
+
+// TrashListResponse represents the trash of a user
+type TrashListResponse struct {
+	Files []TrashFileResponse `json:"files"`
+}
+
+// formatFile formats a file or folder loaded with its parent
+func formatFile(n *ent.Node) FileResponse {
+	return FileResponse{
+		ID:        n.ID,
+		Name:      n.Name,
+		Type:      n.Type,
+		Size:      n.Size,
+		MimeType:  n.MimeType,
+		ParentID:  getParentID(n),
+		CreatedAt: n.CreatedAt,
+		UpdatedAt: n.UpdatedAt,
+	}
+}
+
 type FileHandler struct {
 	cfg *config.Config
 }
@@ -44,25 +198,31 @@ func (h *FileHandler) GetFiles(c *gin.Context) {
 	// Parse user ID
 	uid, err := parseUserID(userID)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		apierr.Abort(c, apierr.Unauthenticated)
 		return
 	}
 
-	// Build query
-	query := queryNodesByOwner(database.Client, uid).
-		Where(node.IsDeletedEQ(false))
-
 	// Filter by parent
+	// Folders shared with the user are listed with their owner's nodes
+	var query *ent.NodeQuery
 	if parentID == "" || parentID == "root" {
-		query = queryNodesWithoutParent(query)
+		if !rootAllowed(c) {
+			return
+		}
+		query = queryNodesWithoutParent(queryNodesByOwner(database.Client, uid))
 	} else {
 		pid, err := parseNodeID(parentID)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid parent ID"})
+			apierr.AbortInvalid(c, apierr.FieldError{Field: "parent_id", Rule: "number"})
+			return
+		}
+		parent, ok := getAccessibleNode(c, uid, pid, permission.RoleRead)
+		if !ok {
 			return
 		}
-		query = queryNodesByParent(query, pid)
+		query = queryNodesByParent(queryNodesByOwner(database.Client, getOwnerID(parent)), pid)
 	}
+	query = query.Where(node.IsDeletedEQ(false))
 
 	// Apply sorting
 	// Note: Windows-style folder-first sorting is handled on the client side
@@ -93,7 +253,7 @@ func (h *FileHandler) GetFiles(c *gin.Context) {
 	// Get total count
 	total, err := query.Clone().Count(ctx)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to count files"})
+		apierr.AbortInternal(c, "Failed to count files", err)
 		return
 	}
 
@@ -101,31 +261,21 @@ func (h *FileHandler) GetFiles(c *gin.Context) {
 	offset := (page - 1) * pageSize
 	nodes, err := query.Offset(offset).Limit(pageSize).WithParent().All(ctx)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get files"})
+		apierr.AbortInternal(c, "Failed to get files", err)
 		return
 	}
 
 	// Format response
-	files := make([]gin.H, len(nodes))
+	files := make([]FileResponse, len(nodes))
 	for i, n := range nodes {
-		parentID := getParentID(n)
-		files[i] = gin.H{
-			"id":         n.ID,
-			"name":       n.Name,
-			"type":       n.Type,
-			"size":       n.Size,
-			"mime_type":  n.MimeType,
-			"parent_id":  parentID,
-			"created_at": n.CreatedAt,
-			"updated_at": n.UpdatedAt,
-		}
+		files[i] = formatFile(n)
 	}
 
-	c.JSON(http.StatusOK, gin.H{
-		"files": files,
-		"total": total,
-		"page":  page,
-		"page_size": pageSize,
+	c.JSON(http.StatusOK, FileListResponse{
+		Files:    files,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
 	})
 }
 
@@ -137,7 +287,7 @@ func (h *FileHandler) UploadFile(c *gin.Context) {
 	// Get file from form
 	file, err := c.FormFile("file")
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "No file provided"})
+		apierr.AbortInvalid(c, apierr.FieldError{Field: "file", Rule: "required"})
 		return
 	}
 
@@ -146,24 +296,43 @@ func (h *FileHandler) UploadFile(c *gin.Context) {
 	// Parse user ID
 	uid, err := parseUserID(userID)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		apierr.Abort(c, apierr.Unauthenticated)
+		return
+	}
+
+	// Parse parent ID
+	// Files uploaded into a shared folder belong to the folder owner
+	ownerID := uid
+	var parentIDInt *int
+	if parentID != "" && parentID != "root" {
+		pid, err := parseNodeID(parentID)
+		if err == nil {
+			parent, ok := getAccessibleNode(c, uid, pid, permission.RoleWrite)
+			if !ok {
+				return
+			}
+			parentIDInt = &pid
+			ownerID = getOwnerID(parent)
+		}
+	}
+
+	if parentIDInt == nil && !rootAllowed(c) {
 		return
 	}
 
-	// Get user to check capacity
-	user, err := database.Client.User.Get(ctx, uid)
+	// Get owner to check capacity
+	user, err := database.Client.User.Get(ctx, ownerID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get user info"})
+		apierr.AbortInternal(c, "Failed to get user info", err)
 		return
 	}
 
 	// Check if user has enough capacity (only for non-instant upload)
 	// For instant upload, we'll check after determining if it's a new file
 	if user.TotalUsed+file.Size > user.TotalQuota {
-		c.JSON(http.StatusForbidden, gin.H{
-			"error": "Insufficient storage capacity",
-			"used":  user.TotalUsed,
-			"max":   user.TotalQuota,
+		apierr.AbortWithDetails(c, apierr.QuotaExceeded, apierr.Details{
+			"used":   user.TotalUsed,
+			"max":    user.TotalQuota,
 			"needed": file.Size,
 		})
 		return
@@ -172,7 +341,7 @@ func (h *FileHandler) UploadFile(c *gin.Context) {
 	// Open uploaded file
 	src, err := file.Open()
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to open file"})
+		apierr.AbortInternal(c, "Failed to open file", err)
 		return
 	}
 	defer src.Close()
@@ -183,7 +352,7 @@ func (h *FileHandler) UploadFile(c *gin.Context) {
 		hasher := sha256.New()
 		_, err = io.Copy(hasher, src)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to calculate hash"})
+			apierr.AbortInternal(c, "Failed to calculate hash", err)
 			return
 		}
 		fileHash = hex.EncodeToString(hasher.Sum(nil))
@@ -209,7 +378,7 @@ func (h *FileHandler) UploadFile(c *gin.Context) {
 	
 	if minioObject == "" {
 		// Upload to MinIO
-		objectName := fmt.Sprintf("%d/%s/%s", uid, uuid.New().String(), file.Filename)
+		objectName := fmt.Sprintf("%d/%s/%s", ownerID, uuid.New().String(), file.Filename)
 		minioObject = objectName
 
 		// Reset reader
@@ -218,7 +387,7 @@ func (h *FileHandler) UploadFile(c *gin.Context) {
 			ContentType: file.Header.Get("Content-Type"),
 		})
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to upload to storage"})
+			apierr.AbortInternal(c, "Failed to upload to storage", err)
 			return
 		}
 
@@ -237,15 +406,6 @@ func (h *FileHandler) UploadFile(c *gin.Context) {
 		}
 	}
 
-	// Parse parent ID
-	var parentIDInt *int
-	if parentID != "" && parentID != "root" {
-		pid, err := parseNodeID(parentID)
-		if err == nil {
-			parentIDInt = &pid
-		}
-	}
-
 	// Create node record
 	mimeType := file.Header.Get("Content-Type")
 	if mimeType == "" {
@@ -263,31 +423,34 @@ func (h *FileHandler) UploadFile(c *gin.Context) {
 			return nil
 		}()).
 		SetMinioObject(minioObject).
-		SetOwnerID(uid).
+		SetOwnerID(ownerID).
 		SetNillableParentID(parentIDInt).
 		Save(ctx)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create file record"})
+		apierr.AbortInternal(c, "Failed to create file record", err)
 		return
 	}
+	changes.Record(ctx, change.KindCreate, node)
 
-	// Update user's used storage (only for new files, not instant uploads)
+	// Update owner's used storage (only for new files, not instant uploads)
 	if isNewFile {
-		_, err = database.Client.User.UpdateOneID(uid).
+		owner, err := database.Client.User.UpdateOneID(ownerID).
 			AddTotalUsed(file.Size).
 			Save(ctx)
 		if err != nil {
 			// Log error but don't fail the upload
 			_ = err
+		} else {
+			events.CheckQuota(ctx, owner)
 		}
 	}
 
-	c.JSON(http.StatusOK, gin.H{
-		"id":         node.ID,
-		"name":       node.Name,
-		"size":       node.Size,
-		"mime_type":  node.MimeType,
-		"created_at": node.CreatedAt,
+	c.JSON(http.StatusOK, UploadResponse{
+		ID:        node.ID,
+		Name:      node.Name,
+		Size:      node.Size,
+		MimeType:  node.MimeType,
+		CreatedAt: node.CreatedAt,
 	})
 }
 
@@ -295,13 +458,10 @@ func (h *FileHandler) UploadFile(c *gin.Context) {
 func (h *FileHandler) CreateFolder(c *gin.Context) {
 	userID := c.GetString("userID")
 
-	var req struct {
-		Name     string `json:"name" binding:"required"`
-		ParentID string `json:"parent_id"`
-	}
+	var req CreateFolderRequest
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		apierr.AbortBind(c, err)
 		return
 	}
 
@@ -310,21 +470,32 @@ func (h *FileHandler) CreateFolder(c *gin.Context) {
 	// Parse user ID
 	uid, err := parseUserID(userID)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		apierr.Abort(c, apierr.Unauthenticated)
 		return
 	}
 
 	// Parse parent ID
+	// Folders created inside a shared folder belong to the folder owner
+	ownerID := uid
 	var parentIDInt *int
 	if req.ParentID != "" && req.ParentID != "root" {
 		pid, err := parseNodeID(req.ParentID)
 		if err == nil {
+			parent, ok := getAccessibleNode(c, uid, pid, permission.RoleWrite)
+			if !ok {
+				return
+			}
 			parentIDInt = &pid
+			ownerID = getOwnerID(parent)
 		}
 	}
 
+	if parentIDInt == nil && !rootAllowed(c) {
+		return
+	}
+
 	// Check if folder with same name already exists
-	query := queryNodesByOwner(database.Client, uid).
+	query := queryNodesByOwner(database.Client, ownerID).
 		Where(node.NameEQ(req.Name)).
 		Where(node.TypeEQ(0)).
 		Where(node.IsDeletedEQ(false))
@@ -336,11 +507,11 @@ func (h *FileHandler) CreateFolder(c *gin.Context) {
 
 	exists, err := query.Exist(ctx)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check folder existence"})
+		apierr.AbortInternal(c, "Failed to check folder existence", err)
 		return
 	}
 	if exists {
-		c.JSON(http.StatusConflict, gin.H{"error": "Folder already exists"})
+		apierr.Abort(c, apierr.NameConflict)
 		return
 	}
 
@@ -348,21 +519,21 @@ func (h *FileHandler) CreateFolder(c *gin.Context) {
 	folder, err := database.Client.Node.Create().
 		SetName(req.Name).
 		SetType(0). // Folder
-		SetOwnerID(uid).
+		SetOwnerID(ownerID).
 		SetNillableParentID(parentIDInt).
 		Save(ctx)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create folder"})
+		apierr.AbortInternal(c, "Failed to create folder", err)
 		return
 	}
+	changes.Record(ctx, change.KindCreate, folder)
 
-	parentID := getParentID(folder)
-	c.JSON(http.StatusOK, gin.H{
-		"id":         folder.ID,
-		"name":       folder.Name,
-		"type":       folder.Type,
-		"parent_id":  parentID,
-		"created_at": folder.CreatedAt,
+	c.JSON(http.StatusOK, FolderResponse{
+		ID:        folder.ID,
+		Name:      folder.Name,
+		Type:      folder.Type,
+		ParentID:  getParentID(folder),
+		CreatedAt: folder.CreatedAt,
 	})
 }
 
@@ -375,7 +546,7 @@ func (h *FileHandler) GetFileTree(c *gin.Context) {
 	// Parse user ID
 	uid, err := parseUserID(userID)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		apierr.Abort(c, apierr.Unauthenticated)
 		return
 	}
 
@@ -386,28 +557,28 @@ func (h *FileHandler) GetFileTree(c *gin.Context) {
 		WithParent().
 		All(ctx)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get folders"})
+		apierr.AbortInternal(c, "Failed to get folders", err)
 		return
 	}
 
 	// Build tree structure
 	tree := buildTree(folders, nil)
 
-	c.JSON(http.StatusOK, gin.H{"tree": tree})
+	c.JSON(http.StatusOK, FolderTreeResponse{Tree: tree})
 }
 
 // buildTree builds a tree structure from nodes
-func buildTree(nodes []*ent.Node, parentID *int) []gin.H {
-	var result []gin.H
+func buildTree(nodes []*ent.Node, parentID *int) []FolderTreeItem {
+	var result []FolderTreeItem
 	for _, n := range nodes {
 		nodeParentID := getParentID(n)
 
 		if (parentID == nil && nodeParentID == nil) || (parentID != nil && nodeParentID != nil && *parentID == *nodeParentID) {
 			children := buildTree(nodes, &n.ID)
-			item := gin.H{
-				"id":       n.ID,
-				"name":     n.Name,
-				"children": children,
+			item := FolderTreeItem{
+				ID:       n.ID,
+				Name:     n.Name,
+				Children: children,
 			}
 			result = append(result, item)
 		}
@@ -420,43 +591,26 @@ func (h *FileHandler) GetFile(c *gin.Context) {
 	userID := c.GetString("userID")
 	id := c.Param("id")
 
-	ctx := c.Request.Context()
-
 	// Parse IDs
 	uid, err := parseUserID(userID)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		apierr.Abort(c, apierr.Unauthenticated)
 		return
 	}
 
 	nodeID, err := parseNodeID(id)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid file ID"})
+		apierr.AbortInvalid(c, apierr.FieldError{Field: "id", Rule: "number"})
 		return
 	}
 
 	// Get file
-	n, err := database.Client.Node.Query().
-		Where(node.IDEQ(nodeID)).
-		Where(node.HasOwnerWith(user.IDEQ(uid))).
-		WithParent().
-		Only(ctx)
-	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "File not found"})
+	n, ok := getAccessibleNode(c, uid, nodeID, permission.RoleRead)
+	if !ok {
 		return
 	}
 
-	parentID := getParentID(n)
-	c.JSON(http.StatusOK, gin.H{
-		"id":         n.ID,
-		"name":       n.Name,
-		"type":       n.Type,
-		"size":       n.Size,
-		"mime_type":  n.MimeType,
-		"parent_id":  parentID,
-		"created_at": n.CreatedAt,
-		"updated_at": n.UpdatedAt,
-	})
+	c.JSON(http.StatusOK, formatFile(n))
 }
 
 // DownloadFile handles GET /api/files/:id/download - Download file
@@ -469,31 +623,30 @@ func (h *FileHandler) DownloadFile(c *gin.Context) {
 	// Parse IDs
 	uid, err := parseUserID(userID)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		apierr.Abort(c, apierr.Unauthenticated)
 		return
 	}
 
 	nodeID, err := parseNodeID(id)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid file ID"})
+		apierr.AbortInvalid(c, apierr.FieldError{Field: "id", Rule: "number"})
 		return
 	}
 
 	// Get file
-	n, err := database.Client.Node.Query().
-		Where(node.IDEQ(nodeID)).
-		Where(node.HasOwnerWith(user.IDEQ(uid))).
-		Where(node.TypeEQ(1)). // Only files
-		Only(ctx)
-	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "File not found"})
+	n, ok := getAccessibleNode(c, uid, nodeID, permission.RoleRead)
+	if !ok {
+		return
+	}
+	if n.Type != 1 { // Only files
+		apierr.Abort(c, apierr.FileNotFound)
 		return
 	}
 
 	// Get object from MinIO
 	object, err := storage.GetClient().GetObject(ctx, h.cfg.MinIO.BucketName, n.MinioObject, minio.GetObjectOptions{})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get file from storage"})
+		apierr.AbortInternal(c, "Failed to get file from storage", err)
 		return
 	}
 	defer object.Close()
@@ -517,31 +670,30 @@ func (h *FileHandler) ProxyFile(c *gin.Context) {
 	// Parse IDs
 	uid, err := parseUserID(userID)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		apierr.Abort(c, apierr.Unauthenticated)
 		return
 	}
 
 	nodeID, err := parseNodeID(id)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid file ID"})
+		apierr.AbortInvalid(c, apierr.FieldError{Field: "id", Rule: "number"})
 		return
 	}
 
 	// Get file
-	n, err := database.Client.Node.Query().
-		Where(node.IDEQ(nodeID)).
-		Where(node.HasOwnerWith(user.IDEQ(uid))).
-		Where(node.TypeEQ(1)). // Only files
-		Only(ctx)
-	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "File not found"})
+	n, ok := getAccessibleNode(c, uid, nodeID, permission.RoleRead)
+	if !ok {
+		return
+	}
+	if n.Type != 1 { // Only files
+		apierr.Abort(c, apierr.FileNotFound)
 		return
 	}
 
 	// Get object from MinIO
 	object, err := storage.GetClient().GetObject(ctx, h.cfg.MinIO.BucketName, n.MinioObject, minio.GetObjectOptions{})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get file from storage"})
+		apierr.AbortInternal(c, "Failed to get file from storage", err)
 		return
 	}
 	defer object.Close()
@@ -560,12 +712,10 @@ func (h *FileHandler) RenameFile(c *gin.Context) {
 	userID := c.GetString("userID")
 	id := c.Param("id")
 
-	var req struct {
-		Name string `json:"name" binding:"required"`
-	}
+	var req RenameFileRequest
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		apierr.AbortBind(c, err)
 		return
 	}
 
@@ -574,31 +724,26 @@ func (h *FileHandler) RenameFile(c *gin.Context) {
 	// Parse IDs
 	uid, err := parseUserID(userID)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		apierr.Abort(c, apierr.Unauthenticated)
 		return
 	}
 
 	nodeID, err := parseNodeID(id)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid file ID"})
+		apierr.AbortInvalid(c, apierr.FieldError{Field: "id", Rule: "number"})
 		return
 	}
 
 	// Get file
-	n, err := database.Client.Node.Query().
-		Where(node.IDEQ(nodeID)).
-		Where(node.HasOwnerWith(user.IDEQ(uid))).
-		WithParent().
-		Only(ctx)
-	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "File not found"})
+	n, ok := getAccessibleNode(c, uid, nodeID, permission.RoleWrite)
+	if !ok {
 		return
 	}
 
 	// Check if name already exists in same directory
 	parentIDInt := getParentID(n)
 
-	query := queryNodesByOwner(database.Client, uid).
+	query := queryNodesByOwner(database.Client, getOwnerID(n)).
 		Where(node.NameEQ(req.Name)).
 		Where(node.IDNEQ(nodeID)).
 		Where(node.IsDeletedEQ(false))
@@ -610,25 +755,26 @@ func (h *FileHandler) RenameFile(c *gin.Context) {
 
 	exists, err := query.Exist(ctx)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check name"})
+		apierr.AbortInternal(c, "Failed to check name", err)
 		return
 	}
 	if exists {
-		c.JSON(http.StatusConflict, gin.H{"error": "Name already exists"})
+		apierr.Abort(c, apierr.NameConflict)
 		return
 	}
 
 	// Update name
 	updated, err := n.Update().SetName(req.Name).Save(ctx)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to rename"})
+		apierr.AbortInternal(c, "Failed to rename", err)
 		return
 	}
+	changes.Record(ctx, change.KindRename, updated)
 
-	c.JSON(http.StatusOK, gin.H{
-		"id":         updated.ID,
-		"name":       updated.Name,
-		"updated_at": updated.UpdatedAt,
+	c.JSON(http.StatusOK, RenameFileResponse{
+		ID:        updated.ID,
+		Name:      updated.Name,
+		UpdatedAt: updated.UpdatedAt,
 	})
 }
 
@@ -636,13 +782,10 @@ func (h *FileHandler) RenameFile(c *gin.Context) {
 func (h *FileHandler) MoveFiles(c *gin.Context) {
 	userID := c.GetString("userID")
 
-	var req struct {
-		IDs      []string `json:"ids" binding:"required"`
-		ParentID string   `json:"parent_id"`
-	}
+	var req MoveFilesRequest
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		apierr.AbortBind(c, err)
 		return
 	}
 
@@ -651,66 +794,79 @@ func (h *FileHandler) MoveFiles(c *gin.Context) {
 	// Parse user ID
 	uid, err := parseUserID(userID)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		apierr.Abort(c, apierr.Unauthenticated)
 		return
 	}
 
 	// Parse parent ID
+	// Nodes can only be moved within the same owner's tree
+	targetOwnerID := uid
 	var parentIDInt *int
 	if req.ParentID != "" && req.ParentID != "root" {
 		pid, err := parseNodeID(req.ParentID)
 		if err == nil {
+			parent, ok := getAccessibleNode(c, uid, pid, permission.RoleWrite)
+			if !ok {
+				return
+			}
 			parentIDInt = &pid
+			targetOwnerID = getOwnerID(parent)
 		}
 	}
 
+	if parentIDInt == nil && !rootAllowed(c) {
+		return
+	}
+
 	// Parse file IDs
 	var nodeIDs []int
 	for _, id := range req.IDs {
 		nid, err := parseNodeID(id)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("Invalid file ID: %s", id)})
+			apierr.AbortInvalid(c, apierr.FieldError{Field: "ids", Rule: "number"})
 			return
 		}
 		nodeIDs = append(nodeIDs, nid)
 	}
 
 	// Move files
-	var moved []gin.H
+	var moved []NodeLocation
 	for _, nodeID := range nodeIDs {
-		n, err := database.Client.Node.Query().
-			Where(node.IDEQ(nodeID)).
-			Where(node.HasOwnerWith(user.IDEQ(uid))).
-			Only(ctx)
-		if err != nil {
+		n, _, err := permission.GetNode(ctx, database.Client, uid, nodeID, permission.RoleWrite)
+		if err != nil || getOwnerID(n) != targetOwnerID {
 			continue
 		}
 
+		// A folder cannot become its own descendant
+		if n.Type == drive.TypeFolder && parentIDInt != nil {
+			subtreeIDs, err := drive.SubtreeIDs(ctx, n.ID)
+			if err != nil || slices.Contains(subtreeIDs, *parentIDInt) {
+				continue
+			}
+		}
+
 		updated, err := n.Update().SetNillableParentID(parentIDInt).Save(ctx)
 		if err == nil {
-			parentID := getParentID(updated)
-			moved = append(moved, gin.H{
-				"id":        updated.ID,
-				"name":      updated.Name,
-				"parent_id": parentID,
+			changes.Record(ctx, change.KindMove, updated)
+			moved = append(moved, NodeLocation{
+				ID:       updated.ID,
+				Name:     updated.Name,
+				ParentID: getParentID(updated),
 			})
 		}
 	}
 
-	c.JSON(http.StatusOK, gin.H{"moved": moved})
+	c.JSON(http.StatusOK, MoveFilesResponse{Moved: moved})
 }
 
 // CopyFiles handles PUT /api/files/copy - Copy files/folders
 func (h *FileHandler) CopyFiles(c *gin.Context) {
 	userID := c.GetString("userID")
 
-	var req struct {
-		IDs      []string `json:"ids" binding:"required"`
-		ParentID string   `json:"parent_id"`
-	}
+	var req CopyFilesRequest
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		apierr.AbortBind(c, err)
 		return
 	}
 
@@ -719,37 +875,45 @@ func (h *FileHandler) CopyFiles(c *gin.Context) {
 	// Parse user ID
 	uid, err := parseUserID(userID)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		apierr.Abort(c, apierr.Unauthenticated)
 		return
 	}
 
 	// Parse parent ID
+	// Copies placed in a shared folder belong to the folder owner
+	targetOwnerID := uid
 	var parentIDInt *int
 	if req.ParentID != "" && req.ParentID != "root" {
 		pid, err := parseNodeID(req.ParentID)
 		if err == nil {
+			parent, ok := getAccessibleNode(c, uid, pid, permission.RoleWrite)
+			if !ok {
+				return
+			}
 			parentIDInt = &pid
+			targetOwnerID = getOwnerID(parent)
 		}
 	}
 
+	if parentIDInt == nil && !rootAllowed(c) {
+		return
+	}
+
 	// Parse file IDs
 	var nodeIDs []int
 	for _, id := range req.IDs {
 		nid, err := parseNodeID(id)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("Invalid file ID: %s", id)})
+			apierr.AbortInvalid(c, apierr.FieldError{Field: "ids", Rule: "number"})
 			return
 		}
 		nodeIDs = append(nodeIDs, nid)
 	}
 
 	// Copy files (simplified - only copy file records, not MinIO objects)
-	var copied []gin.H
+	var copied []NodeLocation
 	for _, nodeID := range nodeIDs {
-		n, err := database.Client.Node.Query().
-			Where(node.IDEQ(nodeID)).
-			Where(node.HasOwnerWith(user.IDEQ(uid))).
-			Only(ctx)
+		n, _, err := permission.GetNode(ctx, database.Client, uid, nodeID, permission.RoleRead)
 		if err != nil {
 			continue
 		}
@@ -770,7 +934,7 @@ func (h *FileHandler) CopyFiles(c *gin.Context) {
 		// Check for name conflicts and append (1), (2), etc.
 		counter := 1
 		for {
-			query := queryNodesByOwner(database.Client, uid).
+			query := queryNodesByOwner(database.Client, targetOwnerID).
 				Where(node.NameEQ(newName)).
 				Where(node.IsDeletedEQ(false))
 			
@@ -803,10 +967,11 @@ func (h *FileHandler) CopyFiles(c *gin.Context) {
 			SetMimeType(n.MimeType).
 			SetFileHash(n.FileHash).
 			SetMinioObject(n.MinioObject).
-			SetOwnerID(uid).
+			SetOwnerID(targetOwnerID).
 			SetNillableParentID(parentIDInt).
 			Save(ctx)
 		if err == nil {
+			changes.Record(ctx, change.KindCreate, newNode)
 			// Update reference count if it's a file
 			if n.Type == 1 && n.FileHash != "" {
 				fileHashRecord, err := database.Client.FileHash.Query().
@@ -816,16 +981,15 @@ func (h *FileHandler) CopyFiles(c *gin.Context) {
 					fileHashRecord.Update().AddReferenceCount(1).Save(ctx)
 				}
 			}
-			parentID := getParentID(newNode)
-			copied = append(copied, gin.H{
-				"id":        newNode.ID,
-				"name":      newNode.Name,
-				"parent_id": parentID,
+			copied = append(copied, NodeLocation{
+				ID:       newNode.ID,
+				Name:     newNode.Name,
+				ParentID: getParentID(newNode),
 			})
 		}
 	}
 
-	c.JSON(http.StatusOK, gin.H{"copied": copied})
+	c.JSON(http.StatusOK, CopyFilesResponse{Copied: copied})
 }
 
 // DeleteFile handles DELETE /api/files/:id - Delete file (move to trash)
@@ -838,54 +1002,39 @@ func (h *FileHandler) DeleteFile(c *gin.Context) {
 	// Parse IDs
 	uid, err := parseUserID(userID)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		apierr.Abort(c, apierr.Unauthenticated)
 		return
 	}
 
 	nodeID, err := parseNodeID(id)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid file ID"})
+		apierr.AbortInvalid(c, apierr.FieldError{Field: "id", Rule: "number"})
 		return
 	}
 
 	// Get file
-	n, err := database.Client.Node.Query().
-		Where(node.IDEQ(nodeID)).
-		Where(node.HasOwnerWith(user.IDEQ(uid))).
-		Only(ctx)
-	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "File not found"})
+	n, ok := getAccessibleNode(c, uid, nodeID, permission.RoleWrite)
+	if !ok {
 		return
 	}
 
-	// Mark as deleted
-	now := time.Now()
-	_, err = n.Update().
-		SetIsDeleted(true).
-		SetNillableDeletedAt(&now).
-		Save(ctx)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete"})
+	// Mark as deleted and suspend shares of the node and everything below it
+	if err := drive.Trash(ctx, n); err != nil {
+		apierr.AbortInternal(c, "Failed to delete", err)
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"message": "File deleted"})
+	c.JSON(http.StatusOK, MessageResponse{Message: "File deleted"})
 }
 
 // QuickUpload handles POST /api/files/quick-upload - Quick upload using hash
 func (h *FileHandler) QuickUpload(c *gin.Context) {
 	userID := c.GetString("userID")
 
-	var req struct {
-		Hash     string `json:"hash" binding:"required"`
-		Name     string `json:"name" binding:"required"`
-		Size     int64  `json:"size" binding:"required"`
-		MimeType string `json:"mime_type"`
-		ParentID string `json:"parent_id"`
-	}
+	var req QuickUploadRequest
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		apierr.AbortBind(c, err)
 		return
 	}
 
@@ -894,24 +1043,43 @@ func (h *FileHandler) QuickUpload(c *gin.Context) {
 	// Parse user ID
 	uid, err := parseUserID(userID)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		apierr.Abort(c, apierr.Unauthenticated)
 		return
 	}
 
-	// Check if user has enough capacity
-	user, err := database.Client.User.Get(ctx, uid)
+	// Parse parent ID
+	// Files uploaded into a shared folder belong to the folder owner
+	ownerID := uid
+	var parentIDInt *int
+	if req.ParentID != "" && req.ParentID != "root" {
+		pid, err := parseNodeID(req.ParentID)
+		if err == nil {
+			parent, ok := getAccessibleNode(c, uid, pid, permission.RoleWrite)
+			if !ok {
+				return
+			}
+			parentIDInt = &pid
+			ownerID = getOwnerID(parent)
+		}
+	}
+
+	if parentIDInt == nil && !rootAllowed(c) {
+		return
+	}
+
+	// Check if owner has enough capacity
+	user, err := database.Client.User.Get(ctx, ownerID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get user info"})
+		apierr.AbortInternal(c, "Failed to get user info", err)
 		return
 	}
 
 	// Quick upload doesn't increase storage if file already exists
 	// But we should still check capacity for safety
 	if user.TotalUsed+req.Size > user.TotalQuota {
-		c.JSON(http.StatusForbidden, gin.H{
-			"error": "Insufficient storage capacity",
-			"used":  user.TotalUsed,
-			"max":   user.TotalQuota,
+		apierr.AbortWithDetails(c, apierr.QuotaExceeded, apierr.Details{
+			"used":   user.TotalUsed,
+			"max":    user.TotalQuota,
 			"needed": req.Size,
 		})
 		return
@@ -922,25 +1090,16 @@ func (h *FileHandler) QuickUpload(c *gin.Context) {
 		Where(filehash.HashEQ(req.Hash)).
 		Only(ctx)
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "File hash not found"})
+		apierr.Abort(c, apierr.HashNotFound)
 		return
 	}
 
 	// Verify size matches
 	if fileHashRecord.Size != req.Size {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "File size mismatch"})
+		apierr.Abort(c, apierr.SizeMismatch)
 		return
 	}
 
-	// Parse parent ID
-	var parentIDInt *int
-	if req.ParentID != "" && req.ParentID != "root" {
-		pid, err := parseNodeID(req.ParentID)
-		if err == nil {
-			parentIDInt = &pid
-		}
-	}
-
 	// Create node record
 	mimeType := req.MimeType
 	if mimeType == "" {
@@ -953,24 +1112,25 @@ func (h *FileHandler) QuickUpload(c *gin.Context) {
 		SetMimeType(mimeType).
 		SetFileHash(req.Hash).
 		SetMinioObject(fileHashRecord.MinioObject).
-		SetOwnerID(uid).
+		SetOwnerID(ownerID).
 		SetNillableParentID(parentIDInt).
 		Save(ctx)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create file record"})
+		apierr.AbortInternal(c, "Failed to create file record", err)
 		return
 	}
+	changes.Record(ctx, change.KindCreate, node)
 
 	// Update reference count
 	fileHashRecord.Update().AddReferenceCount(1).Save(ctx)
 
-	c.JSON(http.StatusOK, gin.H{
-		"id":         node.ID,
-		"name":       node.Name,
-		"size":       node.Size,
-		"mime_type":  node.MimeType,
-		"created_at": node.CreatedAt,
-		"quick_upload": true,
+	c.JSON(http.StatusOK, UploadResponse{
+		ID:          node.ID,
+		Name:        node.Name,
+		Size:        node.Size,
+		MimeType:    node.MimeType,
+		CreatedAt:   node.CreatedAt,
+		QuickUpload: true,
 	})
 }
 
@@ -985,7 +1145,7 @@ func (h *FileHandler) SearchFiles(c *gin.Context) {
 	// Parse user ID
 	uid, err := parseUserID(userID)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		apierr.Abort(c, apierr.Unauthenticated)
 		return
 	}
 
@@ -1010,27 +1170,17 @@ func (h *FileHandler) SearchFiles(c *gin.Context) {
 	// Get results
 	nodes, err := dbQuery.WithParent().All(ctx)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to search files"})
+		apierr.AbortInternal(c, "Failed to search files", err)
 		return
 	}
 
 	// Format response
-	files := make([]gin.H, len(nodes))
+	files := make([]FileResponse, len(nodes))
 	for i, n := range nodes {
-		parentID := getParentID(n)
-		files[i] = gin.H{
-			"id":         n.ID,
-			"name":       n.Name,
-			"type":       n.Type,
-			"size":       n.Size,
-			"mime_type":  n.MimeType,
-			"parent_id":  parentID,
-			"created_at": n.CreatedAt,
-			"updated_at": n.UpdatedAt,
-		}
+		files[i] = formatFile(n)
 	}
 
-	c.JSON(http.StatusOK, gin.H{"files": files})
+	c.JSON(http.StatusOK, SearchFilesResponse{Files: files})
 }
 
 // GetTrash handles GET /api/files/trash - Get trash files
@@ -1042,7 +1192,7 @@ func (h *FileHandler) GetTrash(c *gin.Context) {
 	// Parse user ID
 	uid, err := parseUserID(userID)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		apierr.Abort(c, apierr.Unauthenticated)
 		return
 	}
 
@@ -1052,35 +1202,33 @@ func (h *FileHandler) GetTrash(c *gin.Context) {
 		Order(ent.Desc(node.FieldDeletedAt)).
 		All(ctx)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get trash"})
+		apierr.AbortInternal(c, "Failed to get trash", err)
 		return
 	}
 
 	// Format response
-	files := make([]gin.H, len(nodes))
+	files := make([]TrashFileResponse, len(nodes))
 	for i, n := range nodes {
-		files[i] = gin.H{
-			"id":         n.ID,
-			"name":       n.Name,
-			"type":       n.Type,
-			"size":       n.Size,
-			"deleted_at": n.DeletedAt,
+		files[i] = TrashFileResponse{
+			ID:        n.ID,
+			Name:      n.Name,
+			Type:      n.Type,
+			Size:      n.Size,
+			DeletedAt: n.DeletedAt,
 		}
 	}
 
-	c.JSON(http.StatusOK, gin.H{"files": files})
+	c.JSON(http.StatusOK, TrashListResponse{Files: files})
 }
 
 // RestoreFile handles POST /api/files/restore - Restore file from trash
 func (h *FileHandler) RestoreFile(c *gin.Context) {
 	userID := c.GetString("userID")
 
-	var req struct {
-		ID string `json:"id" binding:"required"`
-	}
+	var req RestoreFileRequest
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		apierr.AbortBind(c, err)
 		return
 	}
 
@@ -1089,13 +1237,13 @@ func (h *FileHandler) RestoreFile(c *gin.Context) {
 	// Parse IDs
 	uid, err := parseUserID(userID)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		apierr.Abort(c, apierr.Unauthenticated)
 		return
 	}
 
 	nodeID, err := parseNodeID(req.ID)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid file ID"})
+		apierr.AbortInvalid(c, apierr.FieldError{Field: "id", Rule: "number"})
 		return
 	}
 
@@ -1106,32 +1254,46 @@ func (h *FileHandler) RestoreFile(c *gin.Context) {
 		Where(node.IsDeletedEQ(true)).
 		Only(ctx)
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "File not found in trash"})
+		apierr.Abort(c, apierr.FileNotFound)
 		return
 	}
 
 	// Restore
-	_, err = n.Update().
+	restored, err := n.Update().
 		SetIsDeleted(false).
 		SetNillableDeletedAt(nil).
 		Save(ctx)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to restore"})
+		apierr.AbortInternal(c, "Failed to restore", err)
 		return
 	}
+	changes.Record(ctx, change.KindRestore, restored)
+
+	// Reactivate shares suspended when the node was trashed
+	// Shares that expired meanwhile are disabled again by the sweeper
+	subtreeIDs, err := drive.SubtreeIDs(ctx, n.ID)
+	if err == nil {
+		database.Client.Share.Update().
+			Where(share.HasNodeWith(node.IDIn(subtreeIDs...))).
+			Where(share.StatusEQ(share.StatusSuspended)).
+			SetStatus(share.StatusActive).
+			Save(ctx)
+	}
 
 	// Add back to user's used storage (if it's a file)
 	if n.Type == 1 {
-		_, err = database.Client.User.UpdateOneID(uid).
+		u, err := database.Client.User.UpdateOneID(uid).
 			AddTotalUsed(n.Size).
 			Save(ctx)
 		if err != nil {
 			// Log error but don't fail the restore
 			_ = err
+		} else {
+			events.CheckQuota(ctx, u)
 		}
 	}
 
-	c.JSON(http.StatusOK, gin.H{"message": "File restored"})
+	c.JSON(http.StatusOK, MessageResponse{Message: "File restored"})
 }
 
 // PermanentlyDelete handles DELETE /api/files/trash/:id - Permanently delete file
@@ -1144,13 +1306,13 @@ func (h *FileHandler) PermanentlyDelete(c *gin.Context) {
 	// Parse IDs
 	uid, err := parseUserID(userID)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		apierr.Abort(c, apierr.Unauthenticated)
 		return
 	}
 
 	nodeID, err := parseNodeID(id)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid file ID"})
+		apierr.AbortInvalid(c, apierr.FieldError{Field: "id", Rule: "number"})
 		return
 	}
 
@@ -1161,7 +1323,7 @@ func (h *FileHandler) PermanentlyDelete(c *gin.Context) {
 		Where(node.IsDeletedEQ(true)).
 		Only(ctx)
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "File not found"})
+		apierr.Abort(c, apierr.FileNotFound)
 		return
 	}
 
@@ -1193,13 +1355,37 @@ func (h *FileHandler) PermanentlyDelete(c *gin.Context) {
 		}
 	}
 
+	// Remove shares of the node and everything below it
+	subtreeIDs, err := drive.SubtreeIDs(ctx, n.ID)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to delete shares", err)
+		return
+	}
+	_, err = database.Client.Share.Delete().
+		Where(share.HasNodeWith(node.IDIn(subtreeIDs...))).
+		Exec(ctx)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to delete shares", err)
+		return
+	}
+
+	// Remove permissions granted on the node and everything below it
+	_, err = database.Client.NodePermission.Delete().
+		Where(nodepermission.HasNodeWith(node.IDIn(subtreeIDs...))).
+		Exec(ctx)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to delete permissions", err)
+		return
+	}
+
 	// Delete node
 	err = database.Client.Node.DeleteOne(n).Exec(ctx)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete"})
+		apierr.AbortInternal(c, "Failed to delete", err)
 		return
 	}
+	changes.RecordPurge(ctx, uid, n)
 
-	c.JSON(http.StatusOK, gin.H{"message": "File permanently deleted"})
+	c.JSON(http.StatusOK, MessageResponse{Message: "File permanently deleted"})
 }
 
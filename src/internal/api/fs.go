@@ -0,0 +1,343 @@
+package api
+
+import (
+	"errors"
+	"gopan-server/config"
+	"gopan-server/ent"
+	"gopan-server/ent/accesstoken"
+	"gopan-server/ent/user"
+	"gopan-server/internal/apierr"
+	"gopan-server/internal/drive"
+	"gopan-server/internal/permission"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// FSHandler handles the path based file API. Paths are relative to the
+// top level of the user's files, or to the folder of a folder restricted
+// token, and each element is percent-encoded like any URL path.
+type FSHandler struct {
+	cfg *config.Config
+}
+
+func NewFSHandler(cfg *config.Config) *FSHandler {
+	return &FSHandler{cfg: cfg}
+}
+
+// MovePathRequest represents a request to move a file or folder by path
+type MovePathRequest struct {
+	To string `json:"to" binding:"required"`
+}
+
+// FSNodeResponse represents a file or folder found by path
+type FSNodeResponse struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"` // Empty for the root folder
+	Path      string    `json:"path"`
+	Type      int       `json:"type"` // 0: folder, 1: file
+	Size      int64     `json:"size"`
+	MimeType  string    `json:"mime_type"`
+	FileHash  string    `json:"file_hash"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// FSListResponse represents the contents of a folder found by path
+type FSListResponse struct {
+	Path  string           `json:"path"`
+	Files []FSNodeResponse `json:"files"`
+}
+
+// fsTree returns the file tree of the current user and the requested path.
+// Shared-with-me files are not part of it.
+func (h *FSHandler) fsTree(c *gin.Context) (*drive.FS, string, bool) {
+	uid, err := parseUserID(c.GetString("userID"))
+	if err != nil {
+		apierr.Abort(c, apierr.Unauthenticated)
+		return nil, "", false
+	}
+
+	var rootID *int
+	if folderID, scoped := permission.FolderScope(c.Request.Context()); scoped {
+		rootID = &folderID
+	}
+	readOnly := c.GetString("role") == string(user.RoleReadonly)
+	return drive.New(h.cfg, uid, rootID, readOnly), cleanFSPath(c.Param("path")), true
+}
+
+// cleanFSPath returns p as an absolute path without trailing slash
+func cleanFSPath(p string) string {
+	return path.Clean("/" + p)
+}
+
+// escapeFSPath percent-encodes each element of a path
+func escapeFSPath(p string) string {
+	elems := strings.Split(p, "/")
+	for i, e := range elems {
+		elems[i] = url.PathEscape(e)
+	}
+	return strings.Join(elems, "/")
+}
+
+// formatFSNode formats a node found at path p
+func formatFSNode(n *ent.Node, p string) FSNodeResponse {
+	name := n.Name
+	if p == "/" {
+		name = ""
+	}
+	return FSNodeResponse{
+		ID:        n.ID,
+		Name:      name,
+		Path:      p,
+		Type:      n.Type,
+		Size:      n.Size,
+		MimeType:  n.MimeType,
+		FileHash:  n.FileHash,
+		CreatedAt: n.CreatedAt,
+		UpdatedAt: n.UpdatedAt,
+	}
+}
+
+// fsError writes the response for a drive error
+func fsError(c *gin.Context, err error, action string) {
+	switch {
+	case errors.Is(err, drive.ErrNotFound):
+		apierr.Abort(c, apierr.FileNotFound)
+	case errors.Is(err, drive.ErrNotFolder):
+		apierr.Abort(c, apierr.NotAFolder)
+	case errors.Is(err, drive.ErrExist):
+		apierr.Abort(c, apierr.NameConflict)
+	case errors.Is(err, drive.ErrIsFolder):
+		apierr.Abort(c, apierr.IsAFolder)
+	case errors.Is(err, drive.ErrInvalidName):
+		apierr.Abort(c, apierr.InvalidName)
+	case errors.Is(err, drive.ErrInvalidMove):
+		apierr.Abort(c, apierr.InvalidMove)
+	case errors.Is(err, drive.ErrRoot):
+		apierr.Abort(c, apierr.NotAllowedOnRoot)
+	case errors.Is(err, drive.ErrReadOnly):
+		apierr.Abort(c, apierr.ReadOnly)
+	case errors.Is(err, drive.ErrQuotaExceeded):
+		apierr.Abort(c, apierr.QuotaExceeded)
+	default:
+		apierr.AbortInternal(c, "Failed to "+action, err)
+	}
+}
+
+// Stat handles GET /api/fs/stat/*path - Get file or folder info by path
+func (h *FSHandler) Stat(c *gin.Context) {
+	fs, p, ok := h.fsTree(c)
+	if !ok {
+		return
+	}
+
+	n, err := fs.Stat(c.Request.Context(), p)
+	if err != nil {
+		fsError(c, err, "get file")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatFSNode(n, p))
+}
+
+// List handles GET /api/fs/list/*path - List folder by path
+func (h *FSHandler) List(c *gin.Context) {
+	fs, p, ok := h.fsTree(c)
+	if !ok {
+		return
+	}
+
+	nodes, err := fs.List(c.Request.Context(), p)
+	if errors.Is(err, drive.ErrNotFolder) {
+		apierr.Abort(c, apierr.NotAFolder)
+		return
+	}
+	if err != nil {
+		fsError(c, err, "get files")
+		return
+	}
+
+	files := make([]FSNodeResponse, len(nodes))
+	for i, n := range nodes {
+		files[i] = formatFSNode(n, path.Join(p, n.Name))
+	}
+
+	c.JSON(http.StatusOK, FSListResponse{
+		Path:  p,
+		Files: files,
+	})
+}
+
+// Download handles GET /api/fs/download/*path - Download file by path
+// Range and conditional requests are supported.
+func (h *FSHandler) Download(c *gin.Context) {
+	fs, p, ok := h.fsTree(c)
+	if !ok {
+		return
+	}
+	clearDeadlines(c)
+
+	ctx := c.Request.Context()
+	n, err := fs.Stat(ctx, p)
+	if err != nil {
+		fsError(c, err, "get file")
+		return
+	}
+	r, err := fs.Open(ctx, n)
+	if err != nil {
+		fsError(c, err, "get file")
+		return
+	}
+	defer r.Close()
+
+	mimeType := n.MimeType
+	if mimeType == "" {
+		mimeType = "application/octet-stream"
+	}
+	c.Header("Content-Type", mimeType)
+	c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": n.Name}))
+	if n.FileHash != "" {
+		c.Header("ETag", `"`+n.FileHash+`"`)
+	}
+	http.ServeContent(c.Writer, c.Request, n.Name, n.UpdatedAt, r)
+}
+
+// Upload handles PUT /api/fs/upload/*path - Upload file by path
+// The request body is the file content. Missing parent folders are created
+// and an existing file is replaced, unless overwrite=false is given.
+func (h *FSHandler) Upload(c *gin.Context) {
+	fs, p, ok := h.fsTree(c)
+	if !ok {
+		return
+	}
+	clearDeadlines(c)
+
+	// Upload tokens may add files but never replace them
+	overwrite := c.DefaultQuery("overwrite", "true") != "false" &&
+		c.GetString("tokenScope") != string(accesstoken.ScopeUpload)
+
+	ctx := c.Request.Context()
+	existing, err := fs.Stat(ctx, p)
+	if err != nil && !errors.Is(err, drive.ErrNotFound) {
+		fsError(c, err, "upload file")
+		return
+	}
+	if err == nil {
+		if existing.Type == drive.TypeFolder {
+			fsError(c, drive.ErrIsFolder, "upload file")
+			return
+		}
+		if !overwrite {
+			fsError(c, drive.ErrExist, "upload file")
+			return
+		}
+	}
+
+	if _, err := fs.MkdirAll(ctx, path.Dir(p)); err != nil {
+		fsError(c, err, "create folder")
+		return
+	}
+	up, err := fs.Create(ctx, p, c.Request.ContentLength)
+	if err != nil {
+		fsError(c, err, "upload file")
+		return
+	}
+	if _, err := io.Copy(up, c.Request.Body); err != nil {
+		up.Abort()
+		if errors.Is(err, drive.ErrQuotaExceeded) {
+			fsError(c, err, "upload file")
+			return
+		}
+		apierr.Abort(c, apierr.InvalidRequest)
+		return
+	}
+	n, err := up.Commit()
+	if errors.Is(err, io.ErrUnexpectedEOF) {
+		apierr.Abort(c, apierr.SizeMismatch)
+		return
+	}
+	if err != nil {
+		fsError(c, err, "upload file")
+		return
+	}
+
+	status := http.StatusOK
+	if existing == nil {
+		status = http.StatusCreated
+		c.Header("Location", "/api/fs/stat"+escapeFSPath(p))
+	}
+	c.JSON(status, formatFSNode(n, p))
+}
+
+// Mkdir handles POST /api/fs/mkdir/*path - Create folder and missing parents by path
+// Creating a folder that exists already succeeds, like mkdir -p.
+func (h *FSHandler) Mkdir(c *gin.Context) {
+	fs, p, ok := h.fsTree(c)
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+	if _, err := fs.MkdirAll(ctx, p); err != nil {
+		fsError(c, err, "create folder")
+		return
+	}
+	n, err := fs.Stat(ctx, p)
+	if err != nil {
+		fsError(c, err, "create folder")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatFSNode(n, p))
+}
+
+// Move handles POST /api/fs/move/*path - Move or rename file or folder by path
+// The destination must not exist, and its parent folder must.
+func (h *FSHandler) Move(c *gin.Context) {
+	fs, p, ok := h.fsTree(c)
+	if !ok {
+		return
+	}
+
+	var req MovePathRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apierr.AbortBind(c, err)
+		return
+	}
+	to := cleanFSPath(req.To)
+
+	ctx := c.Request.Context()
+	if err := fs.Move(ctx, p, to); err != nil {
+		fsError(c, err, "move file")
+		return
+	}
+	n, err := fs.Stat(ctx, to)
+	if err != nil {
+		fsError(c, err, "move file")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatFSNode(n, to))
+}
+
+// Delete handles DELETE /api/fs/delete/*path - Move file or folder to trash by path
+func (h *FSHandler) Delete(c *gin.Context) {
+	fs, p, ok := h.fsTree(c)
+	if !ok {
+		return
+	}
+
+	if err := fs.Remove(c.Request.Context(), p); err != nil {
+		fsError(c, err, "delete")
+		return
+	}
+
+	c.JSON(http.StatusOK, MessageResponse{Message: "File deleted"})
+}
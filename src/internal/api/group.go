@@ -0,0 +1,295 @@
+package api
+
+import (
+	"gopan-server/config"
+	"gopan-server/ent"
+	"gopan-server/ent/group"
+	"gopan-server/ent/nodepermission"
+	"gopan-server/ent/user"
+	"gopan-server/internal/apierr"
+	"gopan-server/internal/database"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GroupHandler handles user groups used for internal sharing
+type GroupHandler struct {
+	cfg *config.Config
+}
+
+func NewGroupHandler(cfg *config.Config) *GroupHandler {
+	return &GroupHandler{cfg: cfg}
+}
+
+// CreateGroupRequest represents a request to create a group
+type CreateGroupRequest struct {
+	Name        string `json:"name" binding:"required,max=100"`
+	Description string `json:"description"`
+}
+
+// AddGroupMemberRequest represents a request to add a member to a group
+type AddGroupMemberRequest struct {
+	Username string `json:"username" binding:"required"`
+}
+
+// GroupResponse represents a group
+type GroupResponse struct {
+	ID          int       `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Members     []UserRef `json:"members"`
+	Owner       *UserRef  `json:"owner,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// GroupListResponse represents the groups of a user
+type GroupListResponse struct {
+	Groups []GroupResponse `json:"groups"`
+}
+
+// formatGroup formats a group with its owner and members edges loaded
+func formatGroup(g *ent.Group) GroupResponse {
+	members := make([]UserRef, len(g.Edges.Members))
+	for i, m := range g.Edges.Members {
+		members[i] = UserRef{
+			ID:       m.ID,
+			Username: m.Username,
+		}
+	}
+	item := GroupResponse{
+		ID:          g.ID,
+		Name:        g.Name,
+		Description: g.Description,
+		Members:     members,
+		CreatedAt:   g.CreatedAt,
+	}
+	if owner := g.Edges.Owner; owner != nil {
+		item.Owner = &UserRef{
+			ID:       owner.ID,
+			Username: owner.Username,
+		}
+	}
+	return item
+}
+
+// getOwnedGroup loads a group owned by the user, writing an error response on failure
+func getOwnedGroup(c *gin.Context, uid int) (*ent.Group, bool) {
+	groupID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		apierr.AbortInvalid(c, apierr.FieldError{Field: "id", Rule: "number"})
+		return nil, false
+	}
+
+	g, err := database.Client.Group.Query().
+		Where(group.IDEQ(groupID)).
+		Where(group.HasOwnerWith(user.IDEQ(uid))).
+		Only(c.Request.Context())
+	if err != nil {
+		apierr.Abort(c, apierr.GroupNotFound)
+		return nil, false
+	}
+	return g, true
+}
+
+// GetGroups handles GET /api/groups - List groups I own or belong to
+func (h *GroupHandler) GetGroups(c *gin.Context) {
+	userID := c.GetString("userID")
+
+	ctx := c.Request.Context()
+
+	// Parse user ID
+	uid, err := parseUserID(userID)
+	if err != nil {
+		apierr.Abort(c, apierr.Unauthenticated)
+		return
+	}
+
+	groups, err := database.Client.Group.Query().
+		Where(group.Or(
+			group.HasOwnerWith(user.IDEQ(uid)),
+			group.HasMembersWith(user.IDEQ(uid)),
+		)).
+		WithOwner().
+		WithMembers().
+		Order(ent.Asc(group.FieldName)).
+		All(ctx)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to get groups", err)
+		return
+	}
+
+	result := make([]GroupResponse, len(groups))
+	for i, g := range groups {
+		result[i] = formatGroup(g)
+	}
+
+	c.JSON(http.StatusOK, GroupListResponse{Groups: result})
+}
+
+// CreateGroup handles POST /api/groups - Create group
+func (h *GroupHandler) CreateGroup(c *gin.Context) {
+	userID := c.GetString("userID")
+
+	var req CreateGroupRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apierr.AbortBind(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	// Parse user ID
+	uid, err := parseUserID(userID)
+	if err != nil {
+		apierr.Abort(c, apierr.Unauthenticated)
+		return
+	}
+
+	// Check if group with same name already exists
+	exists, err := database.Client.Group.Query().
+		Where(group.NameEQ(req.Name)).
+		Where(group.HasOwnerWith(user.IDEQ(uid))).
+		Exist(ctx)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to check group name", err)
+		return
+	}
+	if exists {
+		apierr.Abort(c, apierr.NameConflict)
+		return
+	}
+
+	g, err := database.Client.Group.Create().
+		SetName(req.Name).
+		SetDescription(req.Description).
+		SetOwnerID(uid).
+		Save(ctx)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to create group", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, formatGroup(g))
+}
+
+// DeleteGroup handles DELETE /api/groups/:id - Delete group and the access granted to it
+func (h *GroupHandler) DeleteGroup(c *gin.Context) {
+	userID := c.GetString("userID")
+
+	ctx := c.Request.Context()
+
+	// Parse user ID
+	uid, err := parseUserID(userID)
+	if err != nil {
+		apierr.Abort(c, apierr.Unauthenticated)
+		return
+	}
+
+	g, ok := getOwnedGroup(c, uid)
+	if !ok {
+		return
+	}
+
+	_, err = database.Client.NodePermission.Delete().
+		Where(nodepermission.HasGroupWith(group.IDEQ(g.ID))).
+		Exec(ctx)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to delete group permissions", err)
+		return
+	}
+
+	err = database.Client.Group.DeleteOne(g).Exec(ctx)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to delete group", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MessageResponse{Message: "Group deleted"})
+}
+
+// AddGroupMember handles POST /api/groups/:id/members - Add member to group
+func (h *GroupHandler) AddGroupMember(c *gin.Context) {
+	userID := c.GetString("userID")
+
+	var req AddGroupMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apierr.AbortBind(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	// Parse user ID
+	uid, err := parseUserID(userID)
+	if err != nil {
+		apierr.Abort(c, apierr.Unauthenticated)
+		return
+	}
+
+	g, ok := getOwnedGroup(c, uid)
+	if !ok {
+		return
+	}
+
+	member, err := database.Client.User.Query().
+		Where(user.UsernameEQ(req.Username)).
+		Only(ctx)
+	if err != nil {
+		apierr.Abort(c, apierr.UserNotFound)
+		return
+	}
+
+	isMember, err := g.QueryMembers().Where(user.IDEQ(member.ID)).Exist(ctx)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to check membership", err)
+		return
+	}
+	if isMember || member.ID == uid {
+		apierr.Abort(c, apierr.AlreadyMember)
+		return
+	}
+
+	_, err = g.Update().AddMemberIDs(member.ID).Save(ctx)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to add member", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MessageResponse{Message: "Member added"})
+}
+
+// RemoveGroupMember handles DELETE /api/groups/:id/members/:user_id - Remove member from group
+func (h *GroupHandler) RemoveGroupMember(c *gin.Context) {
+	userID := c.GetString("userID")
+
+	ctx := c.Request.Context()
+
+	// Parse user ID
+	uid, err := parseUserID(userID)
+	if err != nil {
+		apierr.Abort(c, apierr.Unauthenticated)
+		return
+	}
+
+	memberID, err := strconv.Atoi(c.Param("user_id"))
+	if err != nil {
+		apierr.AbortInvalid(c, apierr.FieldError{Field: "user_id", Rule: "number"})
+		return
+	}
+
+	g, ok := getOwnedGroup(c, uid)
+	if !ok {
+		return
+	}
+
+	_, err = g.Update().RemoveMemberIDs(memberID).Save(ctx)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to remove member", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MessageResponse{Message: "Member removed"})
+}
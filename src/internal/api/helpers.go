@@ -1,12 +1,40 @@
 package api
 
 import (
+	"errors"
+	"fmt"
+	"gopan-server/config"
 	"gopan-server/ent"
 	"gopan-server/ent/node"
 	"gopan-server/ent/user"
+	"gopan-server/internal/apierr"
+	"gopan-server/internal/database"
+	"gopan-server/internal/permission"
+	"net/http"
+	"net/url"
 	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
 )
 
+// MessageResponse represents the outcome of a request that returns no data
+type MessageResponse struct {
+	Message string `json:"message"`
+}
+
+// UserRef represents another user in a response
+type UserRef struct {
+	ID       int    `json:"id"`
+	Username string `json:"username"`
+}
+
+// GroupRef represents a group in a response
+type GroupRef struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
 // parseUserID converts string userID to int
 func parseUserID(userID string) (int, error) {
 	return strconv.Atoi(userID)
@@ -40,3 +68,72 @@ func getParentID(n *ent.Node) *int {
 	}
 	return nil
 }
+
+// getAccessibleNode loads a node the user owns or has been granted at least
+// the wanted role on. On failure it writes the error response and returns false.
+func getAccessibleNode(c *gin.Context, uid, nodeID int, want string) (*ent.Node, bool) {
+	n, _, err := permission.GetNode(c.Request.Context(), database.Client, uid, nodeID, want)
+	if err != nil {
+		switch {
+		case errors.Is(err, permission.ErrNotFound):
+			apierr.Abort(c, apierr.FileNotFound)
+		case errors.Is(err, permission.ErrForbidden):
+			apierr.Abort(c, apierr.PermissionDenied)
+		default:
+			apierr.AbortInternal(c, "Failed to check permission", err)
+		}
+		return nil, false
+	}
+	return n, true
+}
+
+// getBaseURL returns the external base URL of the server. Without
+// server.base_url it is derived from the request and its reverse proxy
+// headers, which a client can forge: only use it in responses to that client.
+func getBaseURL(cfg *config.Config, c *gin.Context) string {
+	if cfg.Server.BaseURL != "" {
+		return cfg.Server.BaseURL
+	}
+	scheme := "https"
+	if c.GetHeader("X-Forwarded-Proto") != "" {
+		scheme = c.GetHeader("X-Forwarded-Proto")
+	} else if c.Request.TLS == nil {
+		scheme = "http"
+	}
+	host := c.GetHeader("X-Forwarded-Host")
+	if host == "" {
+		host = c.Request.Host
+	}
+	return fmt.Sprintf("%s://%s", scheme, host)
+}
+
+// clearDeadlines lifts the server read and write timeouts from a request
+// transferring a file, which may take longer than any fixed timeout
+func clearDeadlines(c *gin.Context) {
+	rc := http.NewResponseController(c.Writer)
+	rc.SetReadDeadline(time.Time{})
+	rc.SetWriteDeadline(time.Time{})
+}
+
+// shareLink returns the public link for a share code or slug
+func shareLink(cfg *config.Config, c *gin.Context, code string) string {
+	return fmt.Sprintf("%s/s/%s", getBaseURL(cfg, c), url.PathEscape(code))
+}
+
+// getOwnerID returns the owner ID from a node, or 0 if the owner edge is not loaded
+func getOwnerID(n *ent.Node) int {
+	if n.Edges.Owner != nil {
+		return n.Edges.Owner.ID
+	}
+	return 0
+}
+
+// rootAllowed reports whether the request may use the root folder, writing an
+// error response if not. Requests restricted to a folder cannot.
+func rootAllowed(c *gin.Context) bool {
+	if _, scoped := permission.FolderScope(c.Request.Context()); scoped {
+		apierr.Abort(c, apierr.TokenScopeDenied)
+		return false
+	}
+	return true
+}
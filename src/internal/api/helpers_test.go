@@ -0,0 +1,38 @@
+package api
+
+import (
+	"gopan-server/config"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestMain(m *testing.M) {
+	gin.SetMode(gin.TestMode)
+	os.Exit(m.Run())
+}
+
+// testConfig parses a configuration file for tests
+func testConfig(t *testing.T, data string) *config.Config {
+	t.Helper()
+	cfg, err := config.Parse([]byte(data))
+	if err != nil {
+		t.Fatalf("parse config: %v", err)
+	}
+	return cfg
+}
+
+// newTestServer serves the API of cfg until the test ends. configure may
+// complete cfg with the server URL before the router is built.
+func newTestServer(t *testing.T, cfg *config.Config, configure func(serverURL string)) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(nil)
+	t.Cleanup(srv.Close)
+	if configure != nil {
+		configure(srv.URL)
+	}
+	srv.Config.Handler = SetupRouter(cfg)
+	return srv
+}
@@ -0,0 +1,324 @@
+package api
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"gopan-server/config"
+	"gopan-server/ent"
+	"gopan-server/ent/useridentity"
+	"gopan-server/internal/account"
+	"gopan-server/internal/apierr"
+	"gopan-server/internal/auth"
+	"gopan-server/internal/database"
+	"gopan-server/internal/logger"
+	"gopan-server/internal/session"
+	"gopan-server/internal/sso"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/oauth2"
+)
+
+// oidcCookiePath limits the login state cookie to the callback
+const oidcCookiePath = "/api/auth/oidc"
+
+// OIDCConfigResponse represents the single sign-on settings for the login page
+type OIDCConfigResponse struct {
+	Enabled    bool   `json:"enabled"`
+	ButtonText string `json:"button_text"`
+}
+
+// IdentityResponse represents an identity provider account linked to a user
+type IdentityResponse struct {
+	ID          int        `json:"id"`
+	Provider    string     `json:"provider"`
+	Issuer      string     `json:"issuer"`
+	Subject     string     `json:"subject"`
+	Email       string     `json:"email"`
+	CreatedAt   time.Time  `json:"created_at"`
+	LastLoginAt *time.Time `json:"last_login_at"`
+}
+
+// IdentityListResponse represents the linked identities of a user
+type IdentityListResponse struct {
+	Identities  []IdentityResponse `json:"identities"`
+	OIDCEnabled bool               `json:"oidc_enabled"`
+}
+
+// URLResponse represents a URL the browser must navigate to
+type URLResponse struct {
+	URL string `json:"url"`
+}
+
+// OIDCHandler handles OpenID Connect single sign-on
+type OIDCHandler struct {
+	cfg      *config.Config
+	provider *sso.Provider
+}
+
+func NewOIDCHandler(cfg *config.Config) *OIDCHandler {
+	return &OIDCHandler{cfg: cfg, provider: sso.NewProvider(&cfg.OIDC)}
+}
+
+// startFlow creates the state of a login attempt, stores it in the state
+// cookie and returns the authorization URL
+func (h *OIDCHandler) startFlow(c *gin.Context, linkUserID int) (string, error) {
+	state, err := auth.GenerateOpaqueToken()
+	if err != nil {
+		return "", err
+	}
+	nonce, err := auth.GenerateOpaqueToken()
+	if err != nil {
+		return "", err
+	}
+	verifier := oauth2.GenerateVerifier()
+
+	authURL, err := h.provider.AuthCodeURL(c.Request.Context(), state, nonce, verifier)
+	if err != nil {
+		return "", err
+	}
+
+	cookie, err := sso.SignState(&sso.State{
+		State:      state,
+		Nonce:      nonce,
+		Verifier:   verifier,
+		LinkUserID: linkUserID,
+	}, h.cfg.JWT.Secret)
+	if err != nil {
+		return "", err
+	}
+	h.setStateCookie(c, cookie, int(sso.StateTTL.Seconds()))
+	return authURL, nil
+}
+
+// setStateCookie writes the state cookie, a negative maxAge deletes it.
+// SameSite=Lax lets the cookie through the top-level redirect back from the provider.
+func (h *OIDCHandler) setStateCookie(c *gin.Context, value string, maxAge int) {
+	secure := c.Request.TLS != nil || c.GetHeader("X-Forwarded-Proto") == "https"
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(sso.StateCookie, value, maxAge, oidcCookiePath, "", secure, true)
+}
+
+// redirectResult sends the browser back to the web UI with the outcome in the URL fragment,
+// which is never sent to servers or leaked through the Referer header
+func redirectResult(c *gin.Context, page string, values url.Values) {
+	c.Header("Cache-Control", "no-store")
+	c.Redirect(http.StatusFound, page+"#"+values.Encode())
+}
+
+// GetOIDCConfig handles GET /api/auth/oidc/config - Get single sign-on settings for the login page
+func (h *OIDCHandler) GetOIDCConfig(c *gin.Context) {
+	c.JSON(http.StatusOK, OIDCConfigResponse{
+		Enabled:    h.provider.Enabled(),
+		ButtonText: h.cfg.OIDC.ButtonText,
+	})
+}
+
+// Login handles GET /api/auth/oidc/login - Redirect to the identity provider
+func (h *OIDCHandler) Login(c *gin.Context) {
+	if !h.provider.Enabled() {
+		apierr.AbortWithDetails(c, apierr.FeatureDisabled, apierr.Details{"feature": "oidc"})
+		return
+	}
+
+	authURL, err := h.startFlow(c, 0)
+	if err != nil {
+		logger.Error.Printf("Failed to start OIDC login: %v", err)
+		redirectResult(c, "/index.html", url.Values{"sso_error": {"unavailable"}})
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.Redirect(http.StatusFound, authURL)
+}
+
+// Callback handles GET /api/auth/oidc/callback - Complete login or identity linking
+func (h *OIDCHandler) Callback(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	cookie, _ := c.Cookie(sso.StateCookie)
+	h.setStateCookie(c, "", -1)
+
+	state, err := sso.ParseState(cookie, h.cfg.JWT.Secret)
+	if err != nil || c.Query("state") != state.State {
+		redirectResult(c, "/index.html", url.Values{"sso_error": {"invalid_state"}})
+		return
+	}
+
+	page := "/index.html"
+	if state.LinkUserID != 0 {
+		page = "/dashboard.html"
+	}
+
+	if errCode := c.Query("error"); errCode != "" {
+		redirectResult(c, page, url.Values{"sso_error": {errCode}})
+		return
+	}
+
+	id, err := h.provider.Exchange(ctx, c.Query("code"), state.Verifier, state.Nonce)
+	if err != nil {
+		logger.Error.Printf("OIDC code exchange failed: %v", err)
+		redirectResult(c, page, url.Values{"sso_error": {"exchange_failed"}})
+		return
+	}
+
+	// Link the identity to the user who started the flow
+	if state.LinkUserID != 0 {
+		_, err := account.LinkIdentity(ctx, state.LinkUserID, id)
+		switch {
+		case errors.Is(err, account.ErrIdentityInUse):
+			redirectResult(c, page, url.Values{"sso_error": {"identity_in_use"}})
+		case err != nil:
+			logger.Error.Printf("Failed to link OIDC identity: %v", err)
+			redirectResult(c, page, url.Values{"sso_error": {"link_failed"}})
+		default:
+			redirectResult(c, page, url.Values{"sso_linked": {"1"}})
+		}
+		return
+	}
+
+	u, err := account.ResolveIdentity(ctx, h.cfg, id)
+	switch {
+	case errors.Is(err, account.ErrIdentityNotLinked):
+		redirectResult(c, page, url.Values{"sso_error": {"not_linked"}})
+		return
+	case errors.Is(err, account.ErrUserDisabled):
+		redirectResult(c, page, url.Values{"sso_error": {"disabled"}})
+		return
+	case err != nil:
+		logger.Error.Printf("Failed to resolve OIDC identity: %v", err)
+		redirectResult(c, page, url.Values{"sso_error": {"login_failed"}})
+		return
+	}
+
+	// Update last login time
+	if err := u.Update().SetLastLoginAt(time.Now()).Exec(ctx); err != nil {
+		// Log error but don't fail the login
+		_ = err
+	}
+
+	s, refreshToken, err := session.Create(ctx, u.ID, c.ClientIP(), c.Request.UserAgent(), h.cfg.JWT.GetRefreshExpiration())
+	if err != nil {
+		redirectResult(c, page, url.Values{"sso_error": {"login_failed"}})
+		return
+	}
+	resp, err := loginResponse(h.cfg, u, s, refreshToken)
+	if err != nil {
+		redirectResult(c, page, url.Values{"sso_error": {"login_failed"}})
+		return
+	}
+	data, err := json.Marshal(resp)
+	if err != nil {
+		redirectResult(c, page, url.Values{"sso_error": {"login_failed"}})
+		return
+	}
+
+	redirectResult(c, page, url.Values{"sso": {base64.RawURLEncoding.EncodeToString(data)}})
+}
+
+// GetIdentities handles GET /api/user/identities - List my linked identities
+func (h *OIDCHandler) GetIdentities(c *gin.Context) {
+	userID := c.GetString("userID")
+
+	ctx := c.Request.Context()
+
+	// Parse user ID
+	uid, err := parseUserID(userID)
+	if err != nil {
+		apierr.Abort(c, apierr.Unauthenticated)
+		return
+	}
+
+	identities, err := database.Client.UserIdentity.Query().
+		Where(useridentity.UserIDEQ(uid)).
+		Order(ent.Asc(useridentity.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to get identities", err)
+		return
+	}
+
+	result := make([]IdentityResponse, len(identities))
+	for i, ident := range identities {
+		result[i] = IdentityResponse{
+			ID:          ident.ID,
+			Provider:    ident.Provider,
+			Issuer:      ident.Issuer,
+			Subject:     ident.Subject,
+			Email:       ident.Email,
+			CreatedAt:   ident.CreatedAt,
+			LastLoginAt: ident.LastLoginAt,
+		}
+	}
+
+	c.JSON(http.StatusOK, IdentityListResponse{
+		Identities:  result,
+		OIDCEnabled: h.provider.Enabled(),
+	})
+}
+
+// LinkIdentity handles POST /api/user/identities/oidc - Start linking an identity to my account
+// The browser must navigate to the returned URL, the callback then links the identity.
+func (h *OIDCHandler) LinkIdentity(c *gin.Context) {
+	userID := c.GetString("userID")
+
+	// Parse user ID
+	uid, err := parseUserID(userID)
+	if err != nil {
+		apierr.Abort(c, apierr.Unauthenticated)
+		return
+	}
+
+	if !h.provider.Enabled() {
+		apierr.AbortWithDetails(c, apierr.FeatureDisabled, apierr.Details{"feature": "oidc"})
+		return
+	}
+
+	authURL, err := h.startFlow(c, uid)
+	if err != nil {
+		logger.Error.Printf("Failed to start OIDC linking: %v", err)
+		apierr.Abort(c, apierr.IdentityProviderUnavailable)
+		return
+	}
+
+	c.JSON(http.StatusOK, URLResponse{URL: authURL})
+}
+
+// UnlinkIdentity handles DELETE /api/user/identities/:id - Unlink an identity from my account
+func (h *OIDCHandler) UnlinkIdentity(c *gin.Context) {
+	userID := c.GetString("userID")
+
+	ctx := c.Request.Context()
+
+	// Parse user ID
+	uid, err := parseUserID(userID)
+	if err != nil {
+		apierr.Abort(c, apierr.Unauthenticated)
+		return
+	}
+
+	identityID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		apierr.AbortInvalid(c, apierr.FieldError{Field: "id", Rule: "number"})
+		return
+	}
+
+	deleted, err := database.Client.UserIdentity.Delete().
+		Where(useridentity.IDEQ(identityID)).
+		Where(useridentity.UserIDEQ(uid)).
+		Exec(ctx)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to unlink identity", err)
+		return
+	}
+	if deleted == 0 {
+		apierr.Abort(c, apierr.IdentityNotFound)
+		return
+	}
+
+	c.JSON(http.StatusOK, MessageResponse{Message: "Identity unlinked"})
+}
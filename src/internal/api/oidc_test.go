@@ -0,0 +1,139 @@
+package api
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"gopan-server/internal/dbtest"
+	"gopan-server/internal/sso/ssotest"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+// ssoBrowser follows the OIDC flow like a browser, but stops at redirects
+type ssoBrowser struct {
+	t      *testing.T
+	client *http.Client
+}
+
+func newSSOBrowser(t *testing.T) *ssoBrowser {
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &ssoBrowser{t: t, client: &http.Client{
+		Jar: jar,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}}
+}
+
+// redirect requests rawURL and returns where it redirects to
+func (b *ssoBrowser) redirect(rawURL string) *url.URL {
+	b.t.Helper()
+	resp, err := b.client.Get(rawURL)
+	if err != nil {
+		b.t.Fatalf("GET %s: %v", rawURL, err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusFound {
+		b.t.Fatalf("GET %s: HTTP %d, want a redirect", rawURL, resp.StatusCode)
+	}
+	location, err := url.Parse(resp.Header.Get("Location"))
+	if err != nil {
+		b.t.Fatal(err)
+	}
+	return location
+}
+
+// result returns the outcome the callback put in the fragment of the web UI URL
+func result(t *testing.T, location *url.URL) url.Values {
+	t.Helper()
+	values, err := url.ParseQuery(location.Fragment)
+	if err != nil {
+		t.Fatalf("parse fragment of %s: %v", location, err)
+	}
+	return values
+}
+
+func TestOIDCLogin(t *testing.T) {
+	dbtest.Open(t)
+	idp := ssotest.NewServer(t)
+	cfg := testConfig(t, `{
+		"jwt": {"secret": "test"},
+		"oidc": {"enabled": true, "auto_create": true, "client_id": "`+ssotest.ClientID+`", "client_secret": "`+ssotest.ClientSecret+`"}
+	}`)
+	cfg.OIDC.Issuer = idp.URL
+	srv := newTestServer(t, cfg, func(serverURL string) {
+		cfg.OIDC.RedirectURL = serverURL + "/api/auth/oidc/callback"
+	})
+	claims := map[string]any{
+		"sub":                "alice-id",
+		"preferred_username": "alice",
+		"email":              "alice@example.com",
+		"email_verified":     true,
+	}
+
+	b := newSSOBrowser(t)
+	authURL := b.redirect(srv.URL + "/api/auth/oidc/login")
+	if !strings.HasPrefix(authURL.String(), idp.URL+"/authorize") {
+		t.Fatalf("login redirected to %s, want the identity provider", authURL)
+	}
+	q := authURL.Query()
+	if q.Get("state") == "" || q.Get("nonce") == "" || q.Get("code_challenge_method") != "S256" {
+		t.Fatalf("authorization request lacks state, nonce or PKCE: %s", authURL)
+	}
+
+	callback := idp.Authorize(t, authURL.String(), claims)
+	values := result(t, b.redirect(callback.String()))
+	if values.Get("sso_error") != "" {
+		t.Fatalf("login failed: %s", values.Get("sso_error"))
+	}
+	data, err := base64.RawURLEncoding.DecodeString(values.Get("sso"))
+	if err != nil {
+		t.Fatalf("decode login response: %v", err)
+	}
+	var resp LoginResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		t.Fatalf("parse login response: %v", err)
+	}
+	if resp.Token == "" || resp.RefreshToken == "" || resp.User.Username != "alice" {
+		t.Errorf("login response = %+v", resp)
+	}
+
+	// The state cookie is cleared, so the callback can't be replayed
+	if values := result(t, b.redirect(callback.String())); values.Get("sso_error") != "invalid_state" {
+		t.Errorf("replayed callback: %v, want invalid_state", values)
+	}
+}
+
+func TestOIDCCallbackRejectsForeignState(t *testing.T) {
+	dbtest.Open(t)
+	idp := ssotest.NewServer(t)
+	cfg := testConfig(t, `{
+		"jwt": {"secret": "test"},
+		"oidc": {"enabled": true, "auto_create": true, "client_id": "`+ssotest.ClientID+`", "client_secret": "`+ssotest.ClientSecret+`"}
+	}`)
+	cfg.OIDC.Issuer = idp.URL
+	srv := newTestServer(t, cfg, func(serverURL string) {
+		cfg.OIDC.RedirectURL = serverURL + "/api/auth/oidc/callback"
+	})
+
+	// A callback started in another browser carries a state this one's cookie doesn't match
+	victim, attacker := newSSOBrowser(t), newSSOBrowser(t)
+	victim.redirect(srv.URL + "/api/auth/oidc/login")
+	authURL := attacker.redirect(srv.URL + "/api/auth/oidc/login")
+	callback := idp.Authorize(t, authURL.String(), map[string]any{"sub": "mallory-id"})
+
+	if values := result(t, victim.redirect(callback.String())); values.Get("sso_error") != "invalid_state" {
+		t.Errorf("foreign callback: %v, want invalid_state", values)
+	}
+
+	// Without a state cookie at all
+	if values := result(t, newSSOBrowser(t).redirect(callback.String())); values.Get("sso_error") != "invalid_state" {
+		t.Errorf("callback without cookie: %v, want invalid_state", values)
+	}
+}
@@ -0,0 +1,559 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"gopan-server/config"
+	"gopan-server/internal/apierr"
+	"gopan-server/internal/preview"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// apiParam is a query parameter of a route
+type apiParam struct {
+	Name        string
+	Type        string // string, integer or boolean
+	Description string
+}
+
+// oneOf documents a response that is one of several types
+type oneOf []any
+
+// apiRoute documents a route of the API. Request and response bodies are
+// described by the DTOs the handlers bind and write.
+type apiRoute struct {
+	Method   string // HTTP method, or "ANY" for routes registered with Any
+	Path     string // As registered with gin
+	Summary  string
+	Tag      string
+	Public   bool // Whether the route works without authentication
+	Query    []apiParam
+	Request  any    // JSON request body, nil for none
+	Consumes string // Content type of a request body that is not JSON
+	Response any    // JSON response, nil when Produces is set
+	Produces string // Content type of a response that is not JSON
+
+	// Enabled reports whether the route is registered, nil when it always is
+	Enabled func(cfg *config.Config) bool
+}
+
+// anyMethods are the methods gin registers for Any
+var anyMethods = []string{
+	http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodHead,
+	http.MethodOptions, http.MethodDelete, http.MethodConnect, http.MethodTrace,
+}
+
+var (
+	pageParams = []apiParam{
+		{"page", "integer", "Page number, from 1 (default: 1)"},
+		{"page_size", "integer", "Items per page (default: 50)"},
+	}
+	sharePasswordParam = apiParam{"password", "string", "Password or extraction code of a protected share"}
+	s3Enabled          = func(cfg *config.Config) bool { return cfg.S3.Enabled }
+	sftpEnabled        = func(cfg *config.Config) bool { return cfg.SFTP.Enabled }
+)
+
+// apiRoutes documents every route under /api. SetupRouter panics when the
+// routes it registers and this table differ, so they cannot drift apart.
+var apiRoutes = []apiRoute{
+	// Auth
+	{Method: "GET", Path: "/api/auth/registration", Summary: "Get registration settings", Tag: "Auth", Public: true, Response: RegistrationConfigResponse{}},
+	{Method: "POST", Path: "/api/auth/register", Summary: "Register and log in", Tag: "Auth", Public: true, Request: RegisterRequest{}, Response: LoginResponse{}},
+	{Method: "POST", Path: "/api/auth/login", Summary: "Log in, or get a challenge when two-factor authentication is on", Tag: "Auth", Public: true, Request: LoginRequest{}, Response: oneOf{LoginResponse{}, TwoFactorChallengeResponse{}}},
+	{Method: "POST", Path: "/api/auth/login/2fa", Summary: "Complete a two-step login with a TOTP or recovery code", Tag: "Auth", Public: true, Request: LoginTwoFactorRequest{}, Response: LoginResponse{}},
+	{Method: "POST", Path: "/api/auth/refresh", Summary: "Exchange a refresh token for new tokens", Tag: "Auth", Public: true, Request: RefreshRequest{}, Response: LoginResponse{}},
+	{Method: "POST", Path: "/api/auth/password/forgot", Summary: "Email a password reset link", Tag: "Auth", Public: true, Request: ForgotPasswordRequest{}, Response: MessageResponse{}},
+	{Method: "POST", Path: "/api/auth/password/reset", Summary: "Set a new password with a reset token", Tag: "Auth", Public: true, Request: ResetPasswordRequest{}, Response: MessageResponse{}},
+	{Method: "POST", Path: "/api/auth/email/verify", Summary: "Confirm an email with a verification token", Tag: "Auth", Public: true, Request: VerifyEmailRequest{}, Response: VerifyEmailResponse{}},
+	{Method: "POST", Path: "/api/auth/logout", Summary: "Log out the current session", Tag: "Auth", Response: MessageResponse{}},
+	{Method: "GET", Path: "/api/auth/me", Summary: "Get the current user", Tag: "Auth", Response: MeResponse{}},
+	{Method: "GET", Path: "/api/auth/oidc/config", Summary: "Get single sign-on settings", Tag: "Auth", Public: true, Response: OIDCConfigResponse{}},
+	{Method: "GET", Path: "/api/auth/oidc/login", Summary: "Redirect to the identity provider", Tag: "Auth", Public: true, Produces: "text/html"},
+	{Method: "GET", Path: "/api/auth/oidc/callback", Summary: "Finish single sign-on and redirect to the web UI", Tag: "Auth", Public: true, Produces: "text/html", Query: []apiParam{
+		{"code", "string", "Authorization code"},
+		{"state", "string", "Login state"},
+		{"error", "string", "Error reported by the identity provider"},
+	}},
+
+	// Files
+	{Method: "GET", Path: "/api/files", Summary: "List a folder", Tag: "Files", Response: FileListResponse{}, Query: append([]apiParam{
+		{"parent_id", "string", "Folder ID, empty or \"root\" for the top level"},
+		{"sort_by", "string", "name or size (default: name)"},
+		{"order", "string", "asc or desc (default: asc)"},
+	}, pageParams...)},
+	{Method: "POST", Path: "/api/files/upload", Summary: "Upload a file as multipart form data with fields file and parent_id", Tag: "Files", Consumes: "multipart/form-data", Response: UploadResponse{}},
+	{Method: "POST", Path: "/api/files/folder", Summary: "Create a folder", Tag: "Files", Request: CreateFolderRequest{}, Response: FolderResponse{}},
+	{Method: "GET", Path: "/api/files/tree", Summary: "Get the folder tree", Tag: "Files", Response: FolderTreeResponse{}},
+	{Method: "GET", Path: "/api/files/:id", Summary: "Get a file or folder", Tag: "Files", Response: FileResponse{}},
+	{Method: "GET", Path: "/api/files/:id/download", Summary: "Download a file", Tag: "Files", Produces: "application/octet-stream"},
+	{Method: "GET", Path: "/api/files/:id/proxy", Summary: "Get a file inline, for previews", Tag: "Files", Produces: "application/octet-stream"},
+	{Method: "PUT", Path: "/api/files/:id", Summary: "Rename a file or folder", Tag: "Files", Request: RenameFileRequest{}, Response: RenameFileResponse{}},
+	{Method: "PUT", Path: "/api/files/move", Summary: "Move files and folders", Tag: "Files", Request: MoveFilesRequest{}, Response: MoveFilesResponse{}},
+	{Method: "PUT", Path: "/api/files/copy", Summary: "Copy files and folders", Tag: "Files", Request: CopyFilesRequest{}, Response: CopyFilesResponse{}},
+	{Method: "DELETE", Path: "/api/files/:id", Summary: "Move a file or folder to the trash", Tag: "Files", Response: MessageResponse{}},
+	{Method: "POST", Path: "/api/files/quick-upload", Summary: "Upload a file the server already has by its hash", Tag: "Files", Request: QuickUploadRequest{}, Response: UploadResponse{}},
+	{Method: "GET", Path: "/api/files/search", Summary: "Search files and folders by name", Tag: "Files", Response: SearchFilesResponse{}, Query: []apiParam{
+		{"q", "string", "Part of the name"},
+		{"type", "string", "Restrict to a kind of file"},
+	}},
+	{Method: "GET", Path: "/api/files/trash", Summary: "List the trash", Tag: "Files", Response: TrashListResponse{}},
+	{Method: "POST", Path: "/api/files/restore", Summary: "Restore a file or folder from the trash", Tag: "Files", Request: RestoreFileRequest{}, Response: MessageResponse{}},
+	{Method: "DELETE", Path: "/api/files/trash/:id", Summary: "Delete a file or folder in the trash for good", Tag: "Files", Response: MessageResponse{}},
+	{Method: "GET", Path: "/api/files/shared-with-me", Summary: "List files and folders other users shared with me", Tag: "Permissions", Response: SharedWithMeListResponse{}},
+	{Method: "GET", Path: "/api/files/:id/permissions", Summary: "List users and groups a file or folder is shared with", Tag: "Permissions", Response: PermissionListResponse{}},
+	{Method: "POST", Path: "/api/files/:id/permissions", Summary: "Share a file or folder with a user or group", Tag: "Permissions", Request: GrantPermissionRequest{}, Response: PermissionResponse{}},
+
+	// Path based files
+	{Method: "GET", Path: "/api/fs/stat/*path", Summary: "Get a file or folder by path", Tag: "FS", Response: FSNodeResponse{}},
+	{Method: "GET", Path: "/api/fs/list/*path", Summary: "List a folder by path", Tag: "FS", Response: FSListResponse{}},
+	{Method: "GET", Path: "/api/fs/download/*path", Summary: "Download a file by path, with range and conditional requests", Tag: "FS", Produces: "application/octet-stream"},
+	{Method: "HEAD", Path: "/api/fs/download/*path", Summary: "Get the headers of a file download by path", Tag: "FS", Produces: "application/octet-stream"},
+	{Method: "PUT", Path: "/api/fs/upload/*path", Summary: "Upload the request body as a file by path, 201 when new", Tag: "FS", Consumes: "application/octet-stream", Response: FSNodeResponse{}, Query: []apiParam{
+		{"overwrite", "boolean", "Whether an existing file is replaced (default: true)"},
+	}},
+	{Method: "POST", Path: "/api/fs/mkdir/*path", Summary: "Create a folder and its parents by path", Tag: "FS", Response: FSNodeResponse{}},
+	{Method: "POST", Path: "/api/fs/move/*path", Summary: "Move or rename a file or folder by path", Tag: "FS", Request: MovePathRequest{}, Response: FSNodeResponse{}},
+	{Method: "DELETE", Path: "/api/fs/delete/*path", Summary: "Move a file or folder to the trash by path", Tag: "FS", Response: MessageResponse{}},
+
+	// Changes and events
+	{Method: "GET", Path: "/api/changes", Summary: "List changes of my files since a cursor, 410 when the cursor expired", Tag: "Changes", Response: ChangePageResponse{}, Query: []apiParam{
+		{"cursor", "string", "Cursor of the previous page, empty to get the latest cursor"},
+		{"limit", "integer", "Changes per page, up to 1000 (default: 500)"},
+		{"timeout", "integer", "Seconds to wait for changes when there are none yet, up to 60"},
+	}},
+	{Method: "GET", Path: "/api/events", Summary: "Stream real-time events", Tag: "Changes", Produces: "text/event-stream"},
+	{Method: "GET", Path: "/api/events/ws", Summary: "Stream real-time events over WebSocket", Tag: "Changes", Produces: "application/json"},
+
+	// Permissions and groups
+	{Method: "PUT", Path: "/api/permissions/:id", Summary: "Change a granted role", Tag: "Permissions", Request: UpdatePermissionRequest{}, Response: PermissionResponse{}},
+	{Method: "DELETE", Path: "/api/permissions/:id", Summary: "Revoke a permission", Tag: "Permissions", Response: MessageResponse{}},
+	{Method: "GET", Path: "/api/groups", Summary: "List groups I own or belong to", Tag: "Groups", Response: GroupListResponse{}},
+	{Method: "POST", Path: "/api/groups", Summary: "Create a group", Tag: "Groups", Request: CreateGroupRequest{}, Response: GroupResponse{}},
+	{Method: "DELETE", Path: "/api/groups/:id", Summary: "Delete a group and the access granted to it", Tag: "Groups", Response: MessageResponse{}},
+	{Method: "POST", Path: "/api/groups/:id/members", Summary: "Add a member to a group", Tag: "Groups", Request: AddGroupMemberRequest{}, Response: MessageResponse{}},
+	{Method: "DELETE", Path: "/api/groups/:id/members/:user_id", Summary: "Remove a member from a group", Tag: "Groups", Response: MessageResponse{}},
+
+	// Shares
+	{Method: "POST", Path: "/api/shares", Summary: "Share a file or folder by link", Tag: "Shares", Request: CreateShareRequest{}, Response: CreateShareResponse{}},
+	{Method: "PUT", Path: "/api/shares/:id", Summary: "Edit expiry, password, limits or slug of my share", Tag: "Shares", Request: UpdateShareRequest{}, Response: UpdateShareResponse{}},
+	{Method: "DELETE", Path: "/api/shares/:id", Summary: "Delete my share", Tag: "Shares", Response: MessageResponse{}},
+	{Method: "GET", Path: "/api/shares", Summary: "List my shares", Tag: "Shares", Response: ShareListResponse{}},
+	{Method: "GET", Path: "/api/shares/stats/:id", Summary: "Get access aggregates of my share", Tag: "Shares", Response: ShareStatsResponse{}},
+	{Method: "GET", Path: "/api/shares/stats/:id/accesses", Summary: "Get the access log of my share", Tag: "Shares", Response: ShareAccessListResponse{}, Query: append([]apiParam{
+		{"action", "string", "Only accesses of this action"},
+	}, pageParams...)},
+	{Method: "GET", Path: "/api/shares/:code", Summary: "Get a share by code or slug", Tag: "Shares", Public: true, Response: ShareInfoResponse{}, Query: []apiParam{sharePasswordParam}},
+	{Method: "GET", Path: "/api/shares/:code/download", Summary: "Download a shared file", Tag: "Shares", Public: true, Produces: "application/octet-stream", Query: []apiParam{
+		sharePasswordParam,
+		{"file_id", "integer", "File inside a shared folder"},
+	}},
+	{Method: "GET", Path: "/api/shares/:code/folder/:id", Summary: "List a folder of a share", Tag: "Shares", Public: true, Response: ShareFolderResponse{}, Query: []apiParam{sharePasswordParam}},
+	{Method: "GET", Path: "/api/shares/:code/preview/:id", Summary: "Preview a shared file", Tag: "Shares", Public: true, Response: preview.Response{}, Query: []apiParam{sharePasswordParam}},
+	{Method: "GET", Path: "/api/shares/:code/qrcode", Summary: "Get a QR code of the share link", Tag: "Shares", Public: true, Produces: "image/png", Query: []apiParam{sharePasswordParam}},
+	{Method: "GET", Path: "/api/shares/:code/thumbnail", Summary: "Get the link preview image of a shared image", Tag: "Shares", Public: true, Produces: "image/*"},
+
+	// User
+	{Method: "GET", Path: "/api/user/capacity", Summary: "Get my storage capacity", Tag: "User", Response: GetCapacityResponse{}},
+	{Method: "POST", Path: "/api/user/recalculate", Summary: "Recalculate my used storage", Tag: "User", Response: RecalculateCapacityResponse{}},
+	{Method: "PUT", Path: "/api/user/password", Summary: "Change my password", Tag: "User", Request: ChangePasswordRequest{}, Response: ChangePasswordResponse{}},
+	{Method: "POST", Path: "/api/user/email/verification", Summary: "Resend my verification email", Tag: "User", Response: MessageResponse{}},
+	{Method: "GET", Path: "/api/user/sessions", Summary: "List my active sessions", Tag: "User", Response: SessionListResponse{}},
+	{Method: "DELETE", Path: "/api/user/sessions", Summary: "Log out all other devices", Tag: "User", Response: RevokeSessionsResponse{}},
+	{Method: "DELETE", Path: "/api/user/sessions/:id", Summary: "Log out a device", Tag: "User", Response: MessageResponse{}},
+	{Method: "GET", Path: "/api/user/tokens", Summary: "List my access tokens", Tag: "User", Response: TokenListResponse{}},
+	{Method: "POST", Path: "/api/user/tokens", Summary: "Create an access token", Tag: "User", Request: CreateTokenRequest{}, Response: CreateTokenResponse{}},
+	{Method: "DELETE", Path: "/api/user/tokens/:id", Summary: "Revoke an access token", Tag: "User", Response: MessageResponse{}},
+	{Method: "GET", Path: "/api/user/2fa", Summary: "Get my two-factor authentication status", Tag: "User", Response: TwoFactorStatusResponse{}},
+	{Method: "POST", Path: "/api/user/2fa/setup", Summary: "Start two-factor enrollment with a new secret", Tag: "User", Response: TwoFactorSetupResponse{}},
+	{Method: "POST", Path: "/api/user/2fa/enable", Summary: "Confirm two-factor enrollment with a code", Tag: "User", Request: TwoFactorCodeRequest{}, Response: RecoveryCodesResponse{}},
+	{Method: "POST", Path: "/api/user/2fa/disable", Summary: "Turn off two-factor authentication", Tag: "User", Request: DisableTwoFactorRequest{}, Response: MessageResponse{}},
+	{Method: "POST", Path: "/api/user/2fa/recovery-codes", Summary: "Replace my recovery codes", Tag: "User", Request: TwoFactorCodeRequest{}, Response: RecoveryCodesResponse{}},
+	{Method: "GET", Path: "/api/user/identities", Summary: "List my linked identities", Tag: "User", Response: IdentityListResponse{}},
+	{Method: "POST", Path: "/api/user/identities/oidc", Summary: "Start linking an identity, the browser must navigate to the URL", Tag: "User", Response: URLResponse{}},
+	{Method: "DELETE", Path: "/api/user/identities/:id", Summary: "Unlink an identity", Tag: "User", Response: MessageResponse{}},
+	{Method: "GET", Path: "/api/user/s3-keys", Summary: "List my S3 access keys", Tag: "User", Response: S3KeyListResponse{}, Enabled: s3Enabled},
+	{Method: "POST", Path: "/api/user/s3-keys", Summary: "Create an S3 access key", Tag: "User", Request: CreateS3KeyRequest{}, Response: CreateS3KeyResponse{}, Enabled: s3Enabled},
+	{Method: "DELETE", Path: "/api/user/s3-keys/:id", Summary: "Revoke an S3 access key", Tag: "User", Response: MessageResponse{}, Enabled: s3Enabled},
+	{Method: "GET", Path: "/api/user/ssh-keys", Summary: "List my SSH public keys", Tag: "User", Response: SSHKeyListResponse{}, Enabled: sftpEnabled},
+	{Method: "POST", Path: "/api/user/ssh-keys", Summary: "Add an SSH public key", Tag: "User", Request: AddSSHKeyRequest{}, Response: SSHKeyResponse{}, Enabled: sftpEnabled},
+	{Method: "DELETE", Path: "/api/user/ssh-keys/:id", Summary: "Remove an SSH public key", Tag: "User", Response: MessageResponse{}, Enabled: sftpEnabled},
+
+	// Admin
+	{Method: "GET", Path: "/api/admin/users", Summary: "List users", Tag: "Admin", Response: AdminUserListResponse{}, Query: append([]apiParam{
+		{"q", "string", "Part of the username or email"},
+		{"role", "string", "Only users with this role"},
+		{"disabled", "boolean", "Only disabled or enabled users"},
+	}, pageParams...)},
+	{Method: "POST", Path: "/api/admin/users", Summary: "Create a user", Tag: "Admin", Request: AdminCreateUserRequest{}, Response: AdminUserResponse{}},
+	{Method: "GET", Path: "/api/admin/users/:id", Summary: "Get a user", Tag: "Admin", Response: AdminUserResponse{}},
+	{Method: "PUT", Path: "/api/admin/users/:id", Summary: "Update a user", Tag: "Admin", Request: AdminUpdateUserRequest{}, Response: AdminUserResponse{}},
+	{Method: "DELETE", Path: "/api/admin/users/:id", Summary: "Delete a user and their files", Tag: "Admin", Response: MessageResponse{}},
+	{Method: "GET", Path: "/api/admin/users/:id/usage", Summary: "Get the storage and activity of a user", Tag: "Admin", Response: UserUsageResponse{}},
+	{Method: "POST", Path: "/api/admin/users/:id/disable", Summary: "Disable a user", Tag: "Admin", Response: AdminUserResponse{}},
+	{Method: "POST", Path: "/api/admin/users/:id/enable", Summary: "Enable a user", Tag: "Admin", Response: AdminUserResponse{}},
+	{Method: "POST", Path: "/api/admin/users/:id/password", Summary: "Reset the password of a user", Tag: "Admin", Request: AdminResetPasswordRequest{}, Response: MessageResponse{}},
+	{Method: "PUT", Path: "/api/admin/users/:id/capacity", Summary: "Set the storage quota of a user", Tag: "Admin", Request: UpdateCapacityRequest{}, Response: MessageResponse{}},
+	{Method: "DELETE", Path: "/api/admin/users/:id/2fa", Summary: "Turn off two-factor authentication of a user", Tag: "Admin", Response: MessageResponse{}},
+	{Method: "GET", Path: "/api/admin/users/:id/lockout", Summary: "Get the failed attempt state of a user", Tag: "Admin", Response: LockoutResponse{}},
+	{Method: "DELETE", Path: "/api/admin/users/:id/lockout", Summary: "Clear failed attempts and lockout of a user", Tag: "Admin", Response: MessageResponse{}},
+	{Method: "GET", Path: "/api/admin/auth-failures", Summary: "List failed login and share password attempts", Tag: "Admin", Response: AuthFailureListResponse{}, Query: append([]apiParam{
+		{"kind", "string", "login, two_factor or share"},
+		{"subject", "string", "Username or share code"},
+		{"ip", "string", "Client IP"},
+	}, pageParams...)},
+	{Method: "GET", Path: "/api/admin/invites", Summary: "List invite codes", Tag: "Admin", Response: InviteListResponse{}},
+	{Method: "POST", Path: "/api/admin/invites", Summary: "Create an invite code", Tag: "Admin", Request: CreateInviteRequest{}, Response: InviteResponse{}},
+	{Method: "DELETE", Path: "/api/admin/invites/:id", Summary: "Revoke an invite code", Tag: "Admin", Response: MessageResponse{}},
+
+	// Preview
+	{Method: "GET", Path: "/api/preview/:id", Summary: "Get how to preview a file", Tag: "Preview", Response: preview.Response{}},
+	{Method: "ANY", Path: "/api/preview/kkfileview/:id", Summary: "Proxy the kkFileView preview of a file", Tag: "Preview", Produces: "text/html"},
+	{Method: "ANY", Path: "/api/preview/kkfileview/:id/*path", Summary: "Proxy the resources of a kkFileView preview", Tag: "Preview", Produces: "*/*"},
+
+	{Method: "GET", Path: "/api/openapi.json", Summary: "Get this OpenAPI specification", Tag: "Meta", Public: true, Produces: "application/json"},
+}
+
+// OpenAPIHandler serves the OpenAPI specification of the routes enabled in
+// the configuration
+type OpenAPIHandler struct {
+	spec []byte
+}
+
+func NewOpenAPIHandler(cfg *config.Config) *OpenAPIHandler {
+	spec, err := json.Marshal(buildOpenAPI(cfg))
+	if err != nil {
+		panic(fmt.Sprintf("api: failed to encode the OpenAPI specification: %v", err))
+	}
+	return &OpenAPIHandler{spec: spec}
+}
+
+// GetSpec handles GET /api/openapi.json - Get the OpenAPI specification
+func (h *OpenAPIHandler) GetSpec(c *gin.Context) {
+	c.Data(http.StatusOK, "application/json; charset=utf-8", h.spec)
+}
+
+// enabledRoutes returns the documented routes registered with cfg
+func enabledRoutes(cfg *config.Config) []apiRoute {
+	var routes []apiRoute
+	for _, r := range apiRoutes {
+		if r.Enabled == nil || r.Enabled(cfg) {
+			routes = append(routes, r)
+		}
+	}
+	return routes
+}
+
+// checkRoutes returns an error listing the differences between the routes
+// registered under /api and the documented ones
+func checkRoutes(registered gin.RoutesInfo, cfg *config.Config) error {
+	want := map[string]bool{}
+	for _, r := range enabledRoutes(cfg) {
+		methods := []string{r.Method}
+		if r.Method == "ANY" {
+			methods = anyMethods
+		}
+		for _, m := range methods {
+			want[m+" "+r.Path] = true
+		}
+	}
+
+	var problems []string
+	for _, r := range registered {
+		if !strings.HasPrefix(r.Path, "/api/") {
+			continue
+		}
+		key := r.Method + " " + r.Path
+		if !want[key] {
+			problems = append(problems, "not documented: "+key)
+		}
+		delete(want, key)
+	}
+	for key := range want {
+		problems = append(problems, "documented but not registered: "+key)
+	}
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return errors.New("routes and OpenAPI specification differ:\n\t" + strings.Join(problems, "\n\t"))
+	}
+	return nil
+}
+
+// buildOpenAPI returns the OpenAPI 3 specification of the enabled routes
+func buildOpenAPI(cfg *config.Config) map[string]any {
+	g := &schemaGenerator{schemas: map[string]any{}}
+	errorResponse := map[string]any{
+		"description": "Error",
+		"content":     jsonContent(g.schema(reflect.TypeOf(apierr.Response{}))),
+	}
+
+	paths := map[string]map[string]any{}
+	for _, r := range enabledRoutes(cfg) {
+		p, params := openAPIPath(r.Path)
+		for _, q := range r.Query {
+			params = append(params, map[string]any{
+				"name":        q.Name,
+				"in":          "query",
+				"description": q.Description,
+				"schema":      map[string]any{"type": q.Type},
+			})
+		}
+
+		op := map[string]any{
+			"summary": r.Summary,
+			"tags":    []string{r.Tag},
+			"responses": map[string]any{
+				"200":     g.response(r),
+				"default": errorResponse,
+			},
+		}
+		if len(params) > 0 {
+			op["parameters"] = params
+		}
+		if r.Public {
+			op["security"] = []any{}
+		}
+		switch {
+		case r.Request != nil:
+			op["requestBody"] = map[string]any{
+				"required": true,
+				"content":  jsonContent(g.schema(reflect.TypeOf(r.Request))),
+			}
+		case r.Consumes != "":
+			op["requestBody"] = map[string]any{
+				"required": true,
+				"content":  map[string]any{r.Consumes: map[string]any{}},
+			}
+		}
+
+		if paths[p] == nil {
+			paths[p] = map[string]any{}
+		}
+		methods := []string{r.Method}
+		if r.Method == "ANY" {
+			methods = []string{http.MethodGet, http.MethodPost}
+		}
+		for _, m := range methods {
+			paths[p][strings.ToLower(m)] = op
+		}
+	}
+
+	return map[string]any{
+		"openapi": "3.0.3",
+		"info": map[string]any{
+			"title":       "GoPan API",
+			"version":     "1.0",
+			"description": "Requests are authenticated with an access token from login, or a personal access token, as a bearer token. Errors have a stable code, and a message in the language chosen by Accept-Language (en or zh-CN).",
+		},
+		"servers": []any{map[string]any{"url": "/"}},
+		"paths":   paths,
+		"components": map[string]any{
+			"schemas": g.schemas,
+			"securitySchemes": map[string]any{
+				"bearerAuth": map[string]any{"type": "http", "scheme": "bearer"},
+			},
+		},
+		"security": []any{map[string]any{"bearerAuth": []string{}}},
+	}
+}
+
+// openAPIPath converts a gin path to an OpenAPI path and its path parameters
+func openAPIPath(p string) (string, []any) {
+	var params []any
+	elems := strings.Split(p, "/")
+	for i, e := range elems {
+		if e == "" || (e[0] != ':' && e[0] != '*') {
+			continue
+		}
+		name := e[1:]
+		typ := "string"
+		if name == "id" || strings.HasSuffix(name, "_id") {
+			typ = "integer"
+		}
+		param := map[string]any{
+			"name":     name,
+			"in":       "path",
+			"required": true,
+			"schema":   map[string]any{"type": typ},
+		}
+		if e[0] == '*' {
+			param["description"] = "Slash separated path, each element percent-encoded"
+		}
+		params = append(params, param)
+		elems[i] = "{" + name + "}"
+	}
+	return strings.Join(elems, "/"), params
+}
+
+// jsonContent returns the content of a JSON body with the schema
+func jsonContent(schema map[string]any) map[string]any {
+	return map[string]any{"application/json": map[string]any{"schema": schema}}
+}
+
+// schemaGenerator builds JSON schemas of DTOs by reflection, collecting
+// named structs as reusable components
+type schemaGenerator struct {
+	schemas map[string]any
+}
+
+// response returns the success response of a route
+func (g *schemaGenerator) response(r apiRoute) map[string]any {
+	switch resp := r.Response.(type) {
+	case nil:
+		return map[string]any{
+			"description": "OK",
+			"content":     map[string]any{r.Produces: map[string]any{}},
+		}
+	case oneOf:
+		var schemas []any
+		for _, t := range resp {
+			schemas = append(schemas, g.schema(reflect.TypeOf(t)))
+		}
+		return map[string]any{
+			"description": "OK",
+			"content":     jsonContent(map[string]any{"oneOf": schemas}),
+		}
+	default:
+		return map[string]any{
+			"description": "OK",
+			"content":     jsonContent(g.schema(reflect.TypeOf(resp))),
+		}
+	}
+}
+
+// schema returns the schema of values of type t as encoded by encoding/json
+func (g *schemaGenerator) schema(t reflect.Type) map[string]any {
+	if t.Kind() == reflect.Pointer {
+		s := g.schema(t.Elem())
+		if _, ok := s["$ref"]; ok {
+			return map[string]any{"allOf": []any{s}, "nullable": true}
+		}
+		s["nullable"] = true
+		return s
+	}
+	switch t {
+	case reflect.TypeOf(time.Time{}):
+		return map[string]any{"type": "string", "format": "date-time"}
+	case reflect.TypeOf(apierr.Code("")):
+		return map[string]any{"type": "string", "enum": apierr.Codes()}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return map[string]any{"type": "integer", "format": "int32"}
+	case reflect.Int64, reflect.Uint64:
+		return map[string]any{"type": "integer", "format": "int64"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		return map[string]any{"type": "array", "items": g.schema(t.Elem())}
+	case reflect.Map:
+		return map[string]any{"type": "object", "additionalProperties": g.schema(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return g.object(t)
+		}
+		name := schemaName(t)
+		if _, ok := g.schemas[name]; !ok {
+			g.schemas[name] = nil // Guards against recursion, as in FolderTreeItem
+			g.schemas[name] = g.object(t)
+		}
+		return map[string]any{"$ref": "#/components/schemas/" + name}
+	}
+	return map[string]any{}
+}
+
+// object returns the schema of a struct, with the fields of embedded
+// structs inlined. Validation rules are taken from binding tags.
+func (g *schemaGenerator) object(t reflect.Type) map[string]any {
+	props := map[string]any{}
+	var required []string
+	g.fields(t, props, &required)
+	s := map[string]any{"type": "object", "properties": props}
+	if len(required) > 0 {
+		s["required"] = required
+	}
+	return s
+}
+
+// fields adds the properties of the fields of struct t
+func (g *schemaGenerator) fields(t reflect.Type, props map[string]any, required *[]string) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if f.Anonymous && name == "" {
+			g.fields(f.Type, props, required)
+			continue
+		}
+		if !f.IsExported() || name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+
+		s := g.schema(f.Type)
+		for _, rule := range strings.Split(f.Tag.Get("binding"), ",") {
+			key, value, _ := strings.Cut(rule, "=")
+			switch key {
+			case "required":
+				*required = append(*required, name)
+			case "oneof":
+				s["enum"] = strings.Fields(value)
+			case "email":
+				s["format"] = "email"
+			case "min", "max":
+				s[boundKeyword(s["type"], key)] = json.Number(value)
+			}
+		}
+		props[name] = s
+	}
+}
+
+// boundKeyword returns the schema keyword of a min or max binding rule,
+// which bounds the length of strings and the value of numbers
+func boundKeyword(typ any, rule string) string {
+	switch {
+	case typ == "string" && rule == "min":
+		return "minLength"
+	case typ == "string":
+		return "maxLength"
+	case typ == "array" && rule == "min":
+		return "minItems"
+	case typ == "array":
+		return "maxItems"
+	case rule == "min":
+		return "minimum"
+	}
+	return "maximum"
+}
+
+// schemaNames are the component names of types of other packages that would
+// read badly with the package prefix
+var schemaNames = map[reflect.Type]string{
+	reflect.TypeOf(apierr.Response{}): "ErrorResponse",
+	reflect.TypeOf(apierr.Error{}):    "Error",
+}
+
+// schemaName returns the component name of a named struct. Types of other
+// packages are prefixed with the package name, such as PreviewResponse.
+func schemaName(t reflect.Type) string {
+	if name, ok := schemaNames[t]; ok {
+		return name
+	}
+	if t.PkgPath() == reflect.TypeOf(apiRoute{}).PkgPath() {
+		return t.Name()
+	}
+	pkg := t.PkgPath()[strings.LastIndex(t.PkgPath(), "/")+1:]
+	return strings.ToUpper(pkg[:1]) + pkg[1:] + t.Name()
+}
@@ -0,0 +1,73 @@
+package api
+
+import (
+	"encoding/json"
+	"gopan-server/internal/dbtest"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRoutesMatchSpecification(t *testing.T) {
+	tests := []struct {
+		name    string
+		enabled bool
+	}{
+		{"optional features off", false},
+		{"optional features on", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dbtest.Open(t)
+			cfg := testConfig(t, `{"jwt": {"secret": "test"}}`)
+			cfg.S3.Enabled = tt.enabled
+			cfg.SFTP.Enabled = tt.enabled
+			cfg.WebDAV.Enabled = tt.enabled
+			router := SetupRouter(cfg)
+
+			if err := checkRoutes(router.Routes(), cfg); err != nil {
+				t.Fatal(err)
+			}
+
+			// The served specification documents the registered routes. Routes
+			// registered for any method are documented with GET and POST only.
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/openapi.json", nil))
+			if w.Code != http.StatusOK {
+				t.Fatalf("GET /api/openapi.json: HTTP %d", w.Code)
+			}
+			var spec struct {
+				Paths map[string]map[string]any `json:"paths"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &spec); err != nil {
+				t.Fatalf("parse specification: %v", err)
+			}
+			registered := map[string]bool{}
+			for _, r := range router.Routes() {
+				if !strings.HasPrefix(r.Path, "/api/") {
+					continue
+				}
+				path, _ := openAPIPath(r.Path)
+				registered[r.Method+" "+path] = true
+				if spec.Paths[path] == nil {
+					t.Errorf("%s is not in the served specification", r.Path)
+				}
+			}
+			for path, operations := range spec.Paths {
+				for method := range operations {
+					if !registered[strings.ToUpper(method)+" "+path] {
+						t.Errorf("%s %s is in the served specification but not registered", method, path)
+					}
+				}
+			}
+
+			if _, ok := spec.Paths["/api/user/s3-keys"]; ok != tt.enabled {
+				t.Errorf("S3 key routes documented = %v, want %v", ok, tt.enabled)
+			}
+			if _, ok := spec.Paths["/api/user/ssh-keys"]; ok != tt.enabled {
+				t.Errorf("SSH key routes documented = %v, want %v", ok, tt.enabled)
+			}
+		})
+	}
+}
@@ -0,0 +1,203 @@
+package api
+
+import (
+	"errors"
+	"gopan-server/ent/authfailure"
+	"gopan-server/ent/user"
+	"gopan-server/internal/account"
+	"gopan-server/internal/apierr"
+	"gopan-server/internal/auth"
+	"gopan-server/internal/logger"
+	"gopan-server/internal/mail"
+	"gopan-server/internal/session"
+	"gopan-server/internal/throttle"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ChangePasswordRequest represents a password change of the current user
+type ChangePasswordRequest struct {
+	OldPassword string `json:"old_password" binding:"required"`
+	NewPassword string `json:"new_password" binding:"required,min=6"`
+}
+
+// ForgotPasswordRequest represents a request for a password reset email
+type ForgotPasswordRequest struct {
+	Login string `json:"login" binding:"required"` // Username or verified email
+}
+
+// ResetPasswordRequest represents setting a new password with a reset token
+type ResetPasswordRequest struct {
+	Token       string `json:"token" binding:"required"`
+	NewPassword string `json:"new_password" binding:"required,min=6"`
+}
+
+// VerifyEmailRequest represents confirming an email with a verification token
+type VerifyEmailRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
+// ChangePasswordResponse represents the result of changing my password
+type ChangePasswordResponse struct {
+	Message         string `json:"message"`
+	RevokedSessions int    `json:"revoked_sessions"` // Other devices logged out
+}
+
+// VerifyEmailResponse represents the result of confirming an email
+type VerifyEmailResponse struct {
+	Message string `json:"message"`
+	Email   string `json:"email"`
+}
+
+// ChangePassword handles PUT /api/user/password - Change my password
+// All other sessions are signed out.
+func (h *AuthHandler) ChangePassword(c *gin.Context) {
+	var req ChangePasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apierr.AbortBind(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	u, ok := getCurrentUser(c)
+	if !ok {
+		return
+	}
+	if u.AuthSource != user.AuthSourceLocal {
+		apierr.Abort(c, apierr.PasswordManagedExternally)
+		return
+	}
+
+	// A stolen session must not be usable to guess the old password
+	limits := h.throttle.LoginLimits(u.Username, c.ClientIP())
+	attempt := newAttempt(c, authfailure.KindLogin, u.Username)
+	attempt.UserID = &u.ID
+	if !checkThrottle(c, h.throttle, limits, attempt) {
+		return
+	}
+	if !auth.CheckPassword(req.OldPassword, u.PasswordHash) {
+		failAttempt(c, h.throttle, limits, attempt, authfailure.ReasonInvalidCredentials)
+		apierr.Abort(c, apierr.WrongPassword)
+		return
+	}
+
+	if err := account.SetPassword(ctx, u, req.NewPassword); err != nil {
+		apierr.AbortInternal(c, "Failed to change password", err)
+		return
+	}
+
+	revoked, err := session.RevokeAll(ctx, u.ID, c.GetInt("sessionID"))
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to revoke sessions", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, ChangePasswordResponse{
+		Message:         "Password changed",
+		RevokedSessions: revoked,
+	})
+}
+
+// ForgotPassword handles POST /api/auth/password/forgot - Email a password reset link
+// The response is the same whether or not the account exists.
+func (h *AuthHandler) ForgotPassword(c *gin.Context) {
+	var req ForgotPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apierr.AbortBind(c, err)
+		return
+	}
+
+	err := account.SendPasswordReset(c.Request.Context(), h.cfg, req.Login)
+	if errors.Is(err, mail.ErrDisabled) {
+		apierr.AbortWithDetails(c, apierr.FeatureDisabled, apierr.Details{"feature": "email"})
+		return
+	}
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to send password reset", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MessageResponse{Message: "If the account has a verified email, a reset link has been sent"})
+}
+
+// ResetPassword handles POST /api/auth/password/reset - Set a new password with a reset token
+// All sessions of the user are signed out and a lockout is lifted.
+func (h *AuthHandler) ResetPassword(c *gin.Context) {
+	var req ResetPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apierr.AbortBind(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	u, err := account.ResetPassword(ctx, req.Token, req.NewPassword)
+	if errors.Is(err, account.ErrInvalidUserToken) || errors.Is(err, account.ErrExternalPassword) {
+		apierr.Abort(c, apierr.ResetLinkInvalid)
+		return
+	}
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to reset password", err)
+		return
+	}
+
+	if err := h.throttle.Reset(ctx, throttle.UserKey(u.Username)); err != nil {
+		logger.Error.Printf("Failed to reset throttle: %v", err)
+	}
+
+	c.JSON(http.StatusOK, MessageResponse{Message: "Password reset, please log in"})
+}
+
+// VerifyEmail handles POST /api/auth/email/verify - Confirm an email with a verification token
+func (h *AuthHandler) VerifyEmail(c *gin.Context) {
+	var req VerifyEmailRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apierr.AbortBind(c, err)
+		return
+	}
+
+	u, err := account.VerifyEmail(c.Request.Context(), req.Token)
+	if errors.Is(err, account.ErrInvalidUserToken) {
+		apierr.Abort(c, apierr.VerificationLinkInvalid)
+		return
+	}
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to verify email", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, VerifyEmailResponse{
+		Message: "Email verified",
+		Email:   u.Email,
+	})
+}
+
+// SendEmailVerification handles POST /api/user/email/verification - Resend my verification email
+func (h *AuthHandler) SendEmailVerification(c *gin.Context) {
+	u, ok := getCurrentUser(c)
+	if !ok {
+		return
+	}
+	if u.Email == "" {
+		apierr.Abort(c, apierr.EmailNotSet)
+		return
+	}
+	if u.EmailVerified {
+		apierr.Abort(c, apierr.EmailAlreadyVerified)
+		return
+	}
+
+	err := account.SendEmailVerification(c.Request.Context(), h.cfg, u)
+	switch {
+	case errors.Is(err, mail.ErrDisabled):
+		apierr.AbortWithDetails(c, apierr.FeatureDisabled, apierr.Details{"feature": "email"})
+	case errors.Is(err, account.ErrTokenRecentlySent):
+		apierr.Abort(c, apierr.EmailRecentlySent)
+	case err != nil:
+		apierr.AbortInternal(c, "Failed to send verification email", err)
+	default:
+		c.JSON(http.StatusOK, MessageResponse{Message: "Verification email sent"})
+	}
+}
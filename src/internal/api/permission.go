@@ -0,0 +1,430 @@
+package api
+
+import (
+	"gopan-server/config"
+	"gopan-server/ent"
+	"gopan-server/ent/group"
+	"gopan-server/ent/node"
+	"gopan-server/ent/nodepermission"
+	"gopan-server/ent/user"
+	"gopan-server/internal/apierr"
+	"gopan-server/internal/database"
+	"gopan-server/internal/permission"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// PermissionHandler handles sharing nodes with other GoPan users and groups
+type PermissionHandler struct {
+	cfg *config.Config
+}
+
+func NewPermissionHandler(cfg *config.Config) *PermissionHandler {
+	return &PermissionHandler{cfg: cfg}
+}
+
+// GrantPermissionRequest represents a request to share a node with a user or group
+type GrantPermissionRequest struct {
+	Username string `json:"username"`
+	GroupID  int    `json:"group_id"`
+	Role     string `json:"role" binding:"required"` // read or write
+}
+
+// UpdatePermissionRequest represents a request to change a granted role
+type UpdatePermissionRequest struct {
+	Role string `json:"role" binding:"required"`
+}
+
+// PermissionResponse represents access to a node granted to a user or group
+type PermissionResponse struct {
+	ID        int                 `json:"id"`
+	Role      nodepermission.Role `json:"role"`
+	User      *UserRef            `json:"user,omitempty"`  // Set when granted to a user
+	Group     *GroupRef           `json:"group,omitempty"` // Set when granted to a group
+	CreatedAt time.Time           `json:"created_at"`
+	UpdatedAt time.Time           `json:"updated_at"`
+}
+
+// PermissionListResponse represents the access granted to a node
+type PermissionListResponse struct {
+	Permissions []PermissionResponse `json:"permissions"`
+}
+
+// SharedWithMeResponse represents a node another user shared with me
+type SharedWithMeResponse struct {
+	ID        int                 `json:"id"`
+	Name      string              `json:"name"`
+	Type      int                 `json:"type"` // 0: folder, 1: file
+	Size      int64               `json:"size"`
+	MimeType  string              `json:"mime_type"`
+	Role      nodepermission.Role `json:"role"` // Strongest role granted
+	Owner     *UserRef            `json:"owner,omitempty"`
+	Group     *GroupRef           `json:"group,omitempty"` // Set when granted through a group
+	SharedAt  time.Time           `json:"shared_at"`
+	UpdatedAt time.Time           `json:"updated_at"`
+}
+
+// SharedWithMeListResponse represents the nodes shared with me
+type SharedWithMeListResponse struct {
+	Files []SharedWithMeResponse `json:"files"`
+}
+
+// formatPermission formats a permission with its grantee edges loaded
+func formatPermission(p *ent.NodePermission) PermissionResponse {
+	item := PermissionResponse{
+		ID:        p.ID,
+		Role:      p.Role,
+		CreatedAt: p.CreatedAt,
+		UpdatedAt: p.UpdatedAt,
+	}
+	if u := p.Edges.User; u != nil {
+		item.User = &UserRef{
+			ID:       u.ID,
+			Username: u.Username,
+		}
+	}
+	if g := p.Edges.Group; g != nil {
+		item.Group = &GroupRef{
+			ID:   g.ID,
+			Name: g.Name,
+		}
+	}
+	return item
+}
+
+// getOwnedNode loads a node owned by the user, writing an error response on failure
+func getOwnedNode(c *gin.Context, uid, nodeID int) (*ent.Node, bool) {
+	n, err := database.Client.Node.Query().
+		Where(node.IDEQ(nodeID)).
+		Where(node.HasOwnerWith(user.IDEQ(uid))).
+		Where(node.IsDeletedEQ(false)).
+		Only(c.Request.Context())
+	if err != nil {
+		apierr.Abort(c, apierr.FileNotFound)
+		return nil, false
+	}
+	return n, true
+}
+
+// GetPermissions handles GET /api/files/:id/permissions - List users and groups a node is shared with
+func (h *PermissionHandler) GetPermissions(c *gin.Context) {
+	userID := c.GetString("userID")
+	id := c.Param("id")
+
+	ctx := c.Request.Context()
+
+	// Parse IDs
+	uid, err := parseUserID(userID)
+	if err != nil {
+		apierr.Abort(c, apierr.Unauthenticated)
+		return
+	}
+
+	nodeID, err := parseNodeID(id)
+	if err != nil {
+		apierr.AbortInvalid(c, apierr.FieldError{Field: "id", Rule: "number"})
+		return
+	}
+
+	// Only the owner can see who a node is shared with
+	if _, ok := getOwnedNode(c, uid, nodeID); !ok {
+		return
+	}
+
+	perms, err := database.Client.NodePermission.Query().
+		Where(nodepermission.HasNodeWith(node.IDEQ(nodeID))).
+		WithUser().
+		WithGroup().
+		Order(ent.Asc(nodepermission.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to get permissions", err)
+		return
+	}
+
+	result := make([]PermissionResponse, len(perms))
+	for i, p := range perms {
+		result[i] = formatPermission(p)
+	}
+
+	c.JSON(http.StatusOK, PermissionListResponse{Permissions: result})
+}
+
+// GrantPermission handles POST /api/files/:id/permissions - Share a node with a user or group
+func (h *PermissionHandler) GrantPermission(c *gin.Context) {
+	userID := c.GetString("userID")
+	id := c.Param("id")
+
+	var req GrantPermissionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apierr.AbortBind(c, err)
+		return
+	}
+
+	if !permission.IsValidRole(req.Role) {
+		apierr.AbortInvalid(c, apierr.FieldError{Field: "role", Rule: "oneof", Param: "read write"})
+		return
+	}
+	if (req.Username == "") == (req.GroupID == 0) {
+		apierr.AbortInvalid(c, apierr.FieldError{Field: "username", Rule: "exactly_one_of", Param: "username group_id"})
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	// Parse IDs
+	uid, err := parseUserID(userID)
+	if err != nil {
+		apierr.Abort(c, apierr.Unauthenticated)
+		return
+	}
+
+	nodeID, err := parseNodeID(id)
+	if err != nil {
+		apierr.AbortInvalid(c, apierr.FieldError{Field: "id", Rule: "number"})
+		return
+	}
+
+	if _, ok := getOwnedNode(c, uid, nodeID); !ok {
+		return
+	}
+
+	// Resolve grantee and look for an existing grant to update instead
+	existing := database.Client.NodePermission.Query().
+		Where(nodepermission.HasNodeWith(node.IDEQ(nodeID)))
+	create := database.Client.NodePermission.Create().
+		SetNodeID(nodeID).
+		SetGrantedByID(uid).
+		SetRole(nodepermission.Role(req.Role))
+
+	if req.Username != "" {
+		grantee, err := database.Client.User.Query().
+			Where(user.UsernameEQ(req.Username)).
+			Only(ctx)
+		if err != nil {
+			apierr.Abort(c, apierr.UserNotFound)
+			return
+		}
+		if grantee.ID == uid {
+			apierr.Abort(c, apierr.NotAllowedOnSelf)
+			return
+		}
+		existing = existing.Where(nodepermission.HasUserWith(user.IDEQ(grantee.ID)))
+		create = create.SetUserID(grantee.ID)
+	} else {
+		// Groups must be visible to the owner (owned or joined)
+		exists, err := database.Client.Group.Query().
+			Where(group.IDEQ(req.GroupID)).
+			Where(group.Or(
+				group.HasOwnerWith(user.IDEQ(uid)),
+				group.HasMembersWith(user.IDEQ(uid)),
+			)).
+			Exist(ctx)
+		if err != nil || !exists {
+			apierr.Abort(c, apierr.GroupNotFound)
+			return
+		}
+		existing = existing.Where(nodepermission.HasGroupWith(group.IDEQ(req.GroupID)))
+		create = create.SetGroupID(req.GroupID)
+	}
+
+	p, err := existing.Only(ctx)
+	switch {
+	case err == nil:
+		p, err = p.Update().SetRole(nodepermission.Role(req.Role)).Save(ctx)
+	case ent.IsNotFound(err):
+		p, err = create.Save(ctx)
+	}
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to grant permission", err)
+		return
+	}
+
+	p, err = database.Client.NodePermission.Query().
+		Where(nodepermission.IDEQ(p.ID)).
+		WithUser().
+		WithGroup().
+		Only(ctx)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to get permission", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, formatPermission(p))
+}
+
+// UpdatePermission handles PUT /api/permissions/:id - Change the role of a grant
+func (h *PermissionHandler) UpdatePermission(c *gin.Context) {
+	userID := c.GetString("userID")
+	id := c.Param("id")
+
+	var req UpdatePermissionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apierr.AbortBind(c, err)
+		return
+	}
+
+	if !permission.IsValidRole(req.Role) {
+		apierr.AbortInvalid(c, apierr.FieldError{Field: "role", Rule: "oneof", Param: "read write"})
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	// Parse IDs
+	uid, err := parseUserID(userID)
+	if err != nil {
+		apierr.Abort(c, apierr.Unauthenticated)
+		return
+	}
+
+	permID, err := strconv.Atoi(id)
+	if err != nil {
+		apierr.AbortInvalid(c, apierr.FieldError{Field: "id", Rule: "number"})
+		return
+	}
+
+	// Only the node owner can change roles
+	p, err := database.Client.NodePermission.Query().
+		Where(nodepermission.IDEQ(permID)).
+		Where(nodepermission.HasNodeWith(node.HasOwnerWith(user.IDEQ(uid)))).
+		Only(ctx)
+	if err != nil {
+		apierr.Abort(c, apierr.PermissionNotFound)
+		return
+	}
+
+	_, err = p.Update().SetRole(nodepermission.Role(req.Role)).Save(ctx)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to update permission", err)
+		return
+	}
+
+	p, err = database.Client.NodePermission.Query().
+		Where(nodepermission.IDEQ(permID)).
+		WithUser().
+		WithGroup().
+		Only(ctx)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to get permission", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, formatPermission(p))
+}
+
+// RevokePermission handles DELETE /api/permissions/:id - Revoke access
+// The node owner can revoke any grant; a grantee user can remove their own access.
+func (h *PermissionHandler) RevokePermission(c *gin.Context) {
+	userID := c.GetString("userID")
+	id := c.Param("id")
+
+	ctx := c.Request.Context()
+
+	// Parse IDs
+	uid, err := parseUserID(userID)
+	if err != nil {
+		apierr.Abort(c, apierr.Unauthenticated)
+		return
+	}
+
+	permID, err := strconv.Atoi(id)
+	if err != nil {
+		apierr.AbortInvalid(c, apierr.FieldError{Field: "id", Rule: "number"})
+		return
+	}
+
+	p, err := database.Client.NodePermission.Query().
+		Where(nodepermission.IDEQ(permID)).
+		Where(nodepermission.Or(
+			nodepermission.HasNodeWith(node.HasOwnerWith(user.IDEQ(uid))),
+			nodepermission.HasUserWith(user.IDEQ(uid)),
+		)).
+		Only(ctx)
+	if err != nil {
+		apierr.Abort(c, apierr.PermissionNotFound)
+		return
+	}
+
+	err = database.Client.NodePermission.DeleteOne(p).Exec(ctx)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to revoke permission", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MessageResponse{Message: "Permission revoked"})
+}
+
+// GetSharedWithMe handles GET /api/files/shared-with-me - List nodes other users shared with me
+func (h *PermissionHandler) GetSharedWithMe(c *gin.Context) {
+	userID := c.GetString("userID")
+
+	ctx := c.Request.Context()
+
+	// Parse user ID
+	uid, err := parseUserID(userID)
+	if err != nil {
+		apierr.Abort(c, apierr.Unauthenticated)
+		return
+	}
+
+	perms, err := database.Client.NodePermission.Query().
+		Where(nodepermission.HasNodeWith(
+			node.IsDeletedEQ(false),
+			node.Not(node.HasOwnerWith(user.IDEQ(uid))),
+		)).
+		Where(permission.Grantee(uid)).
+		WithNode(func(q *ent.NodeQuery) {
+			q.WithOwner()
+		}).
+		WithGroup().
+		Order(ent.Desc(nodepermission.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to get shared files", err)
+		return
+	}
+
+	// A node may be granted both directly and through groups; keep the strongest role
+	index := make(map[int]int)
+	files := []SharedWithMeResponse{}
+	for _, p := range perms {
+		n := p.Edges.Node
+		if i, ok := index[n.ID]; ok {
+			if !permission.Allows(string(files[i].Role), string(p.Role)) {
+				files[i].Role = p.Role
+			}
+			continue
+		}
+
+		item := SharedWithMeResponse{
+			ID:        n.ID,
+			Name:      n.Name,
+			Type:      n.Type,
+			Size:      n.Size,
+			MimeType:  n.MimeType,
+			Role:      p.Role,
+			SharedAt:  p.CreatedAt,
+			UpdatedAt: n.UpdatedAt,
+		}
+		if owner := n.Edges.Owner; owner != nil {
+			item.Owner = &UserRef{
+				ID:       owner.ID,
+				Username: owner.Username,
+			}
+		}
+		if g := p.Edges.Group; g != nil {
+			item.Group = &GroupRef{
+				ID:   g.ID,
+				Name: g.Name,
+			}
+		}
+		index[n.ID] = len(files)
+		files = append(files, item)
+	}
+
+	c.JSON(http.StatusOK, SharedWithMeListResponse{Files: files})
+}
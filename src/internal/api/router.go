@@ -2,8 +2,10 @@ package api
 
 import (
 	"gopan-server/config"
+	"gopan-server/internal/logger"
 	"gopan-server/internal/middleware"
 	"gopan-server/internal/preview"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 )
@@ -11,15 +13,18 @@ import (
 // SetupRouter sets up all API routes
 func SetupRouter(cfg *config.Config) *gin.Engine {
 	router := gin.Default()
+	router.Use(middleware.RequestIDMiddleware())
 
 	// CORS middleware
 	router.Use(func(c *gin.Context) {
 		c.Writer.Header().Set("Access-Control-Allow-Origin", "*")
 		c.Writer.Header().Set("Access-Control-Allow-Credentials", "true")
-		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, accept, origin, Cache-Control, X-Requested-With")
+		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Content-Length, Accept-Encoding, Accept-Language, X-CSRF-Token, Authorization, accept, origin, Cache-Control, X-Requested-With, X-Request-ID")
 		c.Writer.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS, GET, PUT, DELETE")
+		c.Writer.Header().Set("Access-Control-Expose-Headers", "X-Request-ID")
 
-		if c.Request.Method == "OPTIONS" {
+		// WebDAV clients send OPTIONS to discover the supported methods
+		if c.Request.Method == "OPTIONS" && !isWebDAVPath(cfg, c.Request.URL.Path) {
 			c.AbortWithStatus(204)
 			return
 		}
@@ -30,9 +35,31 @@ func SetupRouter(cfg *config.Config) *gin.Engine {
 	// Initialize handlers
 	authHandler := NewAuthHandler(cfg)
 	fileHandler := NewFileHandler(cfg)
+	fsHandler := NewFSHandler(cfg)
+	changeHandler := NewChangeHandler(cfg)
+	eventHandler := NewEventHandler(cfg)
 	shareHandler := NewShareHandler(cfg)
 	previewHandler := preview.NewPreviewHandler(cfg)
 	capacityHandler := NewCapacityHandler(cfg)
+	permissionHandler := NewPermissionHandler(cfg)
+	groupHandler := NewGroupHandler(cfg)
+	sessionHandler := NewSessionHandler(cfg)
+	adminHandler := NewAdminHandler(cfg)
+	tokenHandler := NewTokenHandler(cfg)
+	twoFactorHandler := NewTwoFactorHandler(cfg)
+	oidcHandler := NewOIDCHandler(cfg)
+	s3KeyHandler := NewS3KeyHandler(cfg)
+	sshKeyHandler := NewSSHKeyHandler(cfg)
+	openAPIHandler := NewOpenAPIHandler(cfg)
+
+	// WebDAV routes (basic auth, see ServeWebDAV)
+	if cfg.WebDAV.Enabled {
+		webdavHandler := NewWebDAVHandler(cfg)
+		for _, method := range webdavMethods {
+			router.Handle(method, cfg.WebDAV.Prefix, webdavHandler.ServeWebDAV)
+			router.Handle(method, cfg.WebDAV.Prefix+"/*path", webdavHandler.ServeWebDAV)
+		}
+	}
 
 	// Public routes
 	api := router.Group("/api")
@@ -40,10 +67,19 @@ func SetupRouter(cfg *config.Config) *gin.Engine {
 		// Auth routes
 		auth := api.Group("/auth")
 		{
+			auth.GET("/registration", authHandler.GetRegistrationConfig)
 			auth.POST("/register", authHandler.Register)
 			auth.POST("/login", authHandler.Login)
-			auth.POST("/logout", authHandler.Logout)
+			auth.POST("/login/2fa", authHandler.LoginTwoFactor)
+			auth.POST("/refresh", authHandler.Refresh)
+			auth.POST("/password/forgot", authHandler.ForgotPassword)
+			auth.POST("/password/reset", authHandler.ResetPassword)
+			auth.POST("/email/verify", authHandler.VerifyEmail)
+			auth.POST("/logout", middleware.AuthMiddleware(&cfg.JWT), middleware.SessionOnlyMiddleware(), authHandler.Logout)
 			auth.GET("/me", middleware.AuthMiddleware(&cfg.JWT), authHandler.Me)
+			auth.GET("/oidc/config", oidcHandler.GetOIDCConfig)
+			auth.GET("/oidc/login", oidcHandler.Login)
+			auth.GET("/oidc/callback", oidcHandler.Callback)
 		}
 
 		// Protected routes
@@ -51,7 +87,7 @@ func SetupRouter(cfg *config.Config) *gin.Engine {
 		protected.Use(middleware.AuthMiddleware(&cfg.JWT))
 		{
 			// File routes
-			files := protected.Group("/files")
+			files := protected.Group("/files", middleware.WriteAccessMiddleware())
 			{
 				files.GET("", fileHandler.GetFiles)
 				files.POST("/upload", fileHandler.UploadFile)
@@ -69,27 +105,117 @@ func SetupRouter(cfg *config.Config) *gin.Engine {
 				files.GET("/trash", fileHandler.GetTrash)
 				files.POST("/restore", fileHandler.RestoreFile)
 				files.DELETE("/trash/:id", fileHandler.PermanentlyDelete)
+				files.GET("/shared-with-me", permissionHandler.GetSharedWithMe)
+				files.GET("/:id/permissions", permissionHandler.GetPermissions)
+				files.POST("/:id/permissions", permissionHandler.GrantPermission)
+			}
+
+			// Path based file routes
+			fs := protected.Group("/fs", middleware.WriteAccessMiddleware())
+			{
+				fs.GET("/stat/*path", fsHandler.Stat)
+				fs.GET("/list/*path", fsHandler.List)
+				fs.GET("/download/*path", fsHandler.Download)
+				fs.HEAD("/download/*path", fsHandler.Download)
+				fs.PUT("/upload/*path", fsHandler.Upload)
+				fs.POST("/mkdir/*path", fsHandler.Mkdir)
+				fs.POST("/move/*path", fsHandler.Move)
+				fs.DELETE("/delete/*path", fsHandler.Delete)
+			}
+
+			// Change feed for sync clients
+			protected.GET("/changes", changeHandler.GetChanges)
+
+			// Real-time events
+			protected.GET("/events", eventHandler.Stream)
+			protected.GET("/events/ws", eventHandler.WebSocket)
+
+			// Internal sharing routes
+			permissions := protected.Group("/permissions", middleware.WriteAccessMiddleware())
+			{
+				permissions.PUT("/:id", permissionHandler.UpdatePermission)
+				permissions.DELETE("/:id", permissionHandler.RevokePermission)
+			}
+
+			// Group routes
+			groups := protected.Group("/groups", middleware.WriteAccessMiddleware())
+			{
+				groups.GET("", groupHandler.GetGroups)
+				groups.POST("", groupHandler.CreateGroup)
+				groups.DELETE("/:id", groupHandler.DeleteGroup)
+				groups.POST("/:id/members", groupHandler.AddGroupMember)
+				groups.DELETE("/:id/members/:user_id", groupHandler.RemoveGroupMember)
 			}
 
 			// Share routes
-			shares := protected.Group("/shares")
+			shares := protected.Group("/shares", middleware.WriteAccessMiddleware())
 			{
 				shares.POST("", shareHandler.CreateShare)
+				shares.PUT("/:id", shareHandler.UpdateShare)
 				shares.DELETE("/:id", shareHandler.DeleteShare)
 				shares.GET("", shareHandler.GetMyShares)
+				shares.GET("/stats/:id", shareHandler.GetShareStats)
+				shares.GET("/stats/:id/accesses", shareHandler.GetShareAccesses)
 			}
 
-			// User routes (capacity management)
+			// User routes (capacity, sessions, access tokens and linked identities)
 			userRoutes := protected.Group("/user")
 			{
 				userRoutes.GET("/capacity", capacityHandler.GetCapacity)
 				userRoutes.POST("/recalculate", capacityHandler.RecalculateUsedCapacity)
+
+				// Credentials can only be managed from a login session
+				credentials := userRoutes.Group("", middleware.SessionOnlyMiddleware())
+				{
+					credentials.PUT("/password", authHandler.ChangePassword)
+					credentials.POST("/email/verification", authHandler.SendEmailVerification)
+					credentials.GET("/sessions", sessionHandler.GetSessions)
+					credentials.DELETE("/sessions", sessionHandler.RevokeOtherSessions)
+					credentials.DELETE("/sessions/:id", sessionHandler.RevokeSession)
+					credentials.GET("/tokens", tokenHandler.GetTokens)
+					credentials.POST("/tokens", tokenHandler.CreateToken)
+					credentials.DELETE("/tokens/:id", tokenHandler.DeleteToken)
+					credentials.GET("/2fa", twoFactorHandler.GetTwoFactor)
+					credentials.POST("/2fa/setup", twoFactorHandler.SetupTwoFactor)
+					credentials.POST("/2fa/enable", twoFactorHandler.EnableTwoFactor)
+					credentials.POST("/2fa/disable", twoFactorHandler.DisableTwoFactor)
+					credentials.POST("/2fa/recovery-codes", twoFactorHandler.RegenerateRecoveryCodes)
+					credentials.GET("/identities", oidcHandler.GetIdentities)
+					credentials.POST("/identities/oidc", oidcHandler.LinkIdentity)
+					credentials.DELETE("/identities/:id", oidcHandler.UnlinkIdentity)
+					if cfg.S3.Enabled {
+						credentials.GET("/s3-keys", s3KeyHandler.GetS3Keys)
+						credentials.POST("/s3-keys", s3KeyHandler.CreateS3Key)
+						credentials.DELETE("/s3-keys/:id", s3KeyHandler.DeleteS3Key)
+					}
+					if cfg.SFTP.Enabled {
+						credentials.GET("/ssh-keys", sshKeyHandler.GetSSHKeys)
+						credentials.POST("/ssh-keys", sshKeyHandler.AddSSHKey)
+						credentials.DELETE("/ssh-keys/:id", sshKeyHandler.DeleteSSHKey)
+					}
+				}
 			}
 
-			// Admin routes (capacity management)
-			admin := protected.Group("/admin")
+			// Admin routes (user management)
+			admin := protected.Group("/admin", middleware.AdminMiddleware())
 			{
+				admin.GET("/users", adminHandler.ListUsers)
+				admin.POST("/users", adminHandler.CreateUser)
+				admin.GET("/users/:id", adminHandler.GetUser)
+				admin.PUT("/users/:id", adminHandler.UpdateUser)
+				admin.DELETE("/users/:id", adminHandler.DeleteUser)
+				admin.GET("/users/:id/usage", adminHandler.GetUserUsage)
+				admin.POST("/users/:id/disable", adminHandler.DisableUser)
+				admin.POST("/users/:id/enable", adminHandler.EnableUser)
+				admin.POST("/users/:id/password", adminHandler.ResetPassword)
 				admin.PUT("/users/:id/capacity", capacityHandler.UpdateCapacity)
+				admin.DELETE("/users/:id/2fa", adminHandler.ResetTwoFactor)
+				admin.GET("/users/:id/lockout", adminHandler.GetLockout)
+				admin.DELETE("/users/:id/lockout", adminHandler.Unlock)
+				admin.GET("/auth-failures", adminHandler.GetAuthFailures)
+				admin.GET("/invites", adminHandler.ListInvites)
+				admin.POST("/invites", adminHandler.CreateInvite)
+				admin.DELETE("/invites/:id", adminHandler.DeleteInvite)
 			}
 
 			// Preview routes
@@ -106,8 +232,22 @@ func SetupRouter(cfg *config.Config) *gin.Engine {
 		api.GET("/shares/:code/download", shareHandler.DownloadShare)
 		api.GET("/shares/:code/folder/:id", shareHandler.GetShareFolder)
 		api.GET("/shares/:code/preview/:id", shareHandler.PreviewShareFile)
+		api.GET("/shares/:code/qrcode", shareHandler.GetShareQRCode)
+		api.GET("/shares/:code/thumbnail", shareHandler.GetShareThumbnail)
+
+		// API specification
+		api.GET("/openapi.json", openAPIHandler.GetSpec)
+	}
+
+	// Every API route must be documented in the specification
+	if err := checkRoutes(router.Routes(), cfg); err != nil {
+		logger.Error.Printf("%v", err)
 	}
 
 	return router
 }
 
+// isWebDAVPath reports whether a request path is served by the WebDAV endpoint
+func isWebDAVPath(cfg *config.Config, p string) bool {
+	return cfg.WebDAV.Enabled && (p == cfg.WebDAV.Prefix || strings.HasPrefix(p, cfg.WebDAV.Prefix+"/"))
+}
@@ -0,0 +1,180 @@
+package api
+
+import (
+	"gopan-server/config"
+	"gopan-server/ent"
+	"gopan-server/ent/s3key"
+	"gopan-server/internal/apierr"
+	"gopan-server/internal/database"
+	"gopan-server/internal/s3"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// S3KeyHandler handles the S3 access keys of the current user
+type S3KeyHandler struct {
+	cfg *config.Config
+}
+
+func NewS3KeyHandler(cfg *config.Config) *S3KeyHandler {
+	return &S3KeyHandler{cfg: cfg}
+}
+
+// CreateS3KeyRequest represents a request to create an S3 access key
+type CreateS3KeyRequest struct {
+	Name     string `json:"name" binding:"required,max=100"`
+	ReadOnly bool   `json:"read_only"`
+}
+
+// S3KeyResponse represents an S3 access key
+type S3KeyResponse struct {
+	ID          int        `json:"id"`
+	Name        string     `json:"name"`
+	AccessKeyID string     `json:"access_key_id"`
+	ReadOnly    bool       `json:"read_only"`
+	LastUsedAt  *time.Time `json:"last_used_at"`
+	LastUsedIP  string     `json:"last_used_ip"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// S3KeyListResponse represents the S3 access keys of a user and where to use them
+type S3KeyListResponse struct {
+	Keys   []S3KeyResponse `json:"keys"`
+	Port   int             `json:"port"`
+	Region string          `json:"region"`
+}
+
+// CreateS3KeyResponse represents a created S3 access key with its secret
+type CreateS3KeyResponse struct {
+	S3KeyResponse
+	SecretAccessKey string `json:"secret_access_key"`
+}
+
+// formatS3Key formats an S3 access key, never including the secret
+func formatS3Key(k *ent.S3Key) S3KeyResponse {
+	return S3KeyResponse{
+		ID:          k.ID,
+		Name:        k.Name,
+		AccessKeyID: k.AccessKey,
+		ReadOnly:    k.ReadOnly,
+		LastUsedAt:  k.LastUsedAt,
+		LastUsedIP:  k.LastUsedIP,
+		CreatedAt:   k.CreatedAt,
+	}
+}
+
+// GetS3Keys handles GET /api/user/s3-keys - List my S3 access keys
+func (h *S3KeyHandler) GetS3Keys(c *gin.Context) {
+	userID := c.GetString("userID")
+
+	ctx := c.Request.Context()
+
+	// Parse user ID
+	uid, err := parseUserID(userID)
+	if err != nil {
+		apierr.Abort(c, apierr.Unauthenticated)
+		return
+	}
+
+	keys, err := database.Client.S3Key.Query().
+		Where(s3key.UserIDEQ(uid)).
+		Order(ent.Desc(s3key.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to get S3 keys", err)
+		return
+	}
+
+	result := make([]S3KeyResponse, len(keys))
+	for i, k := range keys {
+		result[i] = formatS3Key(k)
+	}
+
+	c.JSON(http.StatusOK, S3KeyListResponse{
+		Keys:   result,
+		Port:   h.cfg.S3.Port,
+		Region: h.cfg.S3.Region,
+	})
+}
+
+// CreateS3Key handles POST /api/user/s3-keys - Create S3 access key
+// The secret access key is only returned in this response.
+func (h *S3KeyHandler) CreateS3Key(c *gin.Context) {
+	userID := c.GetString("userID")
+
+	var req CreateS3KeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apierr.AbortBind(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	// Parse user ID
+	uid, err := parseUserID(userID)
+	if err != nil {
+		apierr.Abort(c, apierr.Unauthenticated)
+		return
+	}
+
+	accessKey, secret, err := s3.GenerateKey()
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to generate S3 key", err)
+		return
+	}
+
+	k, err := database.Client.S3Key.Create().
+		SetUserID(uid).
+		SetName(req.Name).
+		SetAccessKey(accessKey).
+		SetSecretKey(secret).
+		SetReadOnly(req.ReadOnly).
+		Save(ctx)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to create S3 key", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, CreateS3KeyResponse{
+		S3KeyResponse:   formatS3Key(k),
+		SecretAccessKey: secret,
+	})
+}
+
+// DeleteS3Key handles DELETE /api/user/s3-keys/:id - Revoke S3 access key
+func (h *S3KeyHandler) DeleteS3Key(c *gin.Context) {
+	userID := c.GetString("userID")
+
+	ctx := c.Request.Context()
+
+	// Parse user ID
+	uid, err := parseUserID(userID)
+	if err != nil {
+		apierr.Abort(c, apierr.Unauthenticated)
+		return
+	}
+
+	keyID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		apierr.AbortInvalid(c, apierr.FieldError{Field: "id", Rule: "number"})
+		return
+	}
+
+	deleted, err := database.Client.S3Key.Delete().
+		Where(s3key.IDEQ(keyID)).
+		Where(s3key.UserIDEQ(uid)).
+		Exec(ctx)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to revoke S3 key", err)
+		return
+	}
+	if deleted == 0 {
+		apierr.Abort(c, apierr.S3KeyNotFound)
+		return
+	}
+
+	c.JSON(http.StatusOK, MessageResponse{Message: "S3 key revoked"})
+}
@@ -0,0 +1,137 @@
+package api
+
+import (
+	"gopan-server/config"
+	"gopan-server/ent"
+	"gopan-server/ent/session"
+	"gopan-server/internal/apierr"
+	"gopan-server/internal/database"
+	usersession "gopan-server/internal/session"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SessionResponse represents a login session (device)
+type SessionResponse struct {
+	ID         int       `json:"id"`
+	IP         string    `json:"ip"`
+	UserAgent  string    `json:"user_agent"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	Current    bool      `json:"current"` // Whether this is the session of the request
+}
+
+// SessionListResponse represents the active sessions of a user
+type SessionListResponse struct {
+	Sessions []SessionResponse `json:"sessions"`
+}
+
+// RevokeSessionsResponse represents the result of logging out other devices
+type RevokeSessionsResponse struct {
+	Message string `json:"message"`
+	Count   int    `json:"count"` // Sessions revoked
+}
+
+// SessionHandler handles the login sessions (devices) of the current user
+type SessionHandler struct {
+	cfg *config.Config
+}
+
+func NewSessionHandler(cfg *config.Config) *SessionHandler {
+	return &SessionHandler{cfg: cfg}
+}
+
+// GetSessions handles GET /api/user/sessions - List my active sessions
+func (h *SessionHandler) GetSessions(c *gin.Context) {
+	userID := c.GetString("userID")
+
+	ctx := c.Request.Context()
+
+	// Parse user ID
+	uid, err := parseUserID(userID)
+	if err != nil {
+		apierr.Abort(c, apierr.Unauthenticated)
+		return
+	}
+
+	sessions, err := database.Client.Session.Query().
+		Where(session.UserIDEQ(uid)).
+		Where(session.RevokedAtIsNil()).
+		Where(session.ExpiresAtGT(time.Now())).
+		Order(ent.Desc(session.FieldLastUsedAt)).
+		All(ctx)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to get sessions", err)
+		return
+	}
+
+	current := c.GetInt("sessionID")
+	result := make([]SessionResponse, len(sessions))
+	for i, s := range sessions {
+		result[i] = SessionResponse{
+			ID:         s.ID,
+			IP:         s.IP,
+			UserAgent:  s.UserAgent,
+			CreatedAt:  s.CreatedAt,
+			LastUsedAt: s.LastUsedAt,
+			ExpiresAt:  s.ExpiresAt,
+			Current:    s.ID == current,
+		}
+	}
+
+	c.JSON(http.StatusOK, SessionListResponse{Sessions: result})
+}
+
+// RevokeSession handles DELETE /api/user/sessions/:id - Log out a device
+func (h *SessionHandler) RevokeSession(c *gin.Context) {
+	userID := c.GetString("userID")
+
+	// Parse user ID
+	uid, err := parseUserID(userID)
+	if err != nil {
+		apierr.Abort(c, apierr.Unauthenticated)
+		return
+	}
+
+	sessionID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		apierr.AbortInvalid(c, apierr.FieldError{Field: "id", Rule: "number"})
+		return
+	}
+
+	revoked, err := usersession.Revoke(c.Request.Context(), sessionID, uid)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to revoke session", err)
+		return
+	}
+	if !revoked {
+		apierr.Abort(c, apierr.SessionNotFound)
+		return
+	}
+
+	c.JSON(http.StatusOK, MessageResponse{Message: "Session revoked"})
+}
+
+// RevokeOtherSessions handles DELETE /api/user/sessions - Log out all other devices
+func (h *SessionHandler) RevokeOtherSessions(c *gin.Context) {
+	userID := c.GetString("userID")
+
+	// Parse user ID
+	uid, err := parseUserID(userID)
+	if err != nil {
+		apierr.Abort(c, apierr.Unauthenticated)
+		return
+	}
+
+	count, err := usersession.RevokeAll(c.Request.Context(), uid, c.GetInt("sessionID"))
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to revoke sessions", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, RevokeSessionsResponse{Message: "Sessions revoked", Count: count})
+}
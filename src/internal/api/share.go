@@ -1,16 +1,23 @@
 package api
 
 import (
-	"crypto/rand"
-	"encoding/hex"
+	"context"
+	"crypto/subtle"
 	"fmt"
 	"gopan-server/config"
 	"gopan-server/ent"
+	"gopan-server/ent/authfailure"
 	"gopan-server/ent/node"
+	"gopan-server/ent/predicate"
 	"gopan-server/ent/share"
+	"gopan-server/ent/shareaccess"
 	"gopan-server/ent/user"
+	"gopan-server/internal/apierr"
 	"gopan-server/internal/database"
+	"gopan-server/internal/events"
+	"gopan-server/internal/preview"
 	"gopan-server/internal/storage"
+	"gopan-server/internal/throttle"
 	"io"
 	"net/http"
 	"net/url"
@@ -19,41 +26,307 @@ import (
 	"strings"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/gin-gonic/gin"
 	"github.com/minio/minio-go/v7"
 )
 
+// CreateShareRequest represents a request to share a file or folder by link
+type CreateShareRequest struct {
+	NodeID              string     `json:"node_id" binding:"required"`
+	ShareType           int        `json:"share_type"` // 0: permanent, 1: temporary
+	ExpiresAt           *time.Time `json:"expires_at"`
+	Password            string     `json:"password"`
+	MaxAccessCount      int        `json:"max_access_count"`
+	MaxDownloadCount    int        `json:"max_download_count"`
+	Slug                string     `json:"slug"`                  // Optional custom link name
+	GenerateExtractCode bool       `json:"generate_extract_code"` // Generate an extraction code when no password is given
+}
+
+// CreateShareResponse represents a created share
+type CreateShareResponse struct {
+	ID               int        `json:"id"`
+	Code             string     `json:"code"`
+	Slug             *string    `json:"slug"`
+	ShareType        int        `json:"share_type"`
+	ExpiresAt        *time.Time `json:"expires_at"` // Null when the share does not expire
+	HasPassword      bool       `json:"has_password"`
+	ExtractCode      string     `json:"extract_code"`
+	MaxAccessCount   *int       `json:"max_access_count"` // Null for unlimited
+	MaxDownloadCount *int       `json:"max_download_count"`
+	CreatedAt        time.Time  `json:"created_at"`
+}
+
+// ShareInfoResponse represents a share as seen by its visitors
+type ShareInfoResponse struct {
+	Code             string            `json:"code"`
+	ShareType        int               `json:"share_type"`
+	ExpiresAt        *time.Time        `json:"expires_at"`
+	AccessCount      int               `json:"access_count"`
+	MaxAccessCount   *int              `json:"max_access_count"`
+	DownloadCount    int               `json:"download_count"`
+	MaxDownloadCount *int              `json:"max_download_count"`
+	Node             ShareNodeResponse `json:"node"`
+}
+
+// ShareNodeResponse represents the file or folder of a share
+type ShareNodeResponse struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	Type     int    `json:"type"` // 0: folder, 1: file
+	Size     int64  `json:"size,omitempty"`
+	MimeType string `json:"mime_type,omitempty"`
+}
+
+// UpdateShareResponse represents an edited share
+type UpdateShareResponse struct {
+	ID               int          `json:"id"`
+	Code             string       `json:"code"`
+	Slug             *string      `json:"slug"`
+	ShareType        int          `json:"share_type"`
+	Status           share.Status `json:"status"`
+	ExpiresAt        *time.Time   `json:"expires_at"`
+	HasPassword      bool         `json:"has_password"`
+	ExtractCode      string       `json:"extract_code"`
+	AccessCount      int          `json:"access_count"`
+	MaxAccessCount   *int         `json:"max_access_count"`
+	DownloadCount    int          `json:"download_count"`
+	MaxDownloadCount *int         `json:"max_download_count"`
+	UpdatedAt        time.Time    `json:"updated_at"`
+}
+
+// ShareResponse represents one of my shares
+type ShareResponse struct {
+	ID               int               `json:"id"`
+	Code             string            `json:"code"`
+	Slug             *string           `json:"slug"`
+	ShareType        int               `json:"share_type"`
+	Status           share.Status      `json:"status"`
+	ExpiresAt        *time.Time        `json:"expires_at"`
+	AccessCount      int               `json:"access_count"`
+	MaxAccessCount   *int              `json:"max_access_count"`
+	DownloadCount    int               `json:"download_count"`
+	MaxDownloadCount *int              `json:"max_download_count"`
+	HasPassword      bool              `json:"has_password"`
+	ExtractCode      string            `json:"extract_code"`
+	CreatedAt        time.Time         `json:"created_at"`
+	Node             ShareNodeResponse `json:"node"`
+}
+
+// ShareListResponse represents my shares
+type ShareListResponse struct {
+	Shares []ShareResponse `json:"shares"`
+}
+
+// ShareFolderItem represents a file or folder in a shared folder
+type ShareFolderItem struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	Type      int       `json:"type"`
+	Size      int64     `json:"size"`
+	MimeType  string    `json:"mime_type"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ShareFolderResponse represents the contents of a shared folder
+type ShareFolderResponse struct {
+	Files []ShareFolderItem `json:"files"`
+}
+
 type ShareHandler struct {
-	cfg *config.Config
+	cfg      *config.Config
+	throttle *throttle.Throttler
 }
 
 func NewShareHandler(cfg *config.Config) *ShareHandler {
-	return &ShareHandler{cfg: cfg}
+	return &ShareHandler{cfg: cfg, throttle: throttle.New(&cfg.Security)}
+}
+
+// getActiveShare loads a share by code and checks expiry, password and the
+// limit that applies to the action. On failure it writes the error response
+// and returns false.
+func (h *ShareHandler) getActiveShare(c *gin.Context, code string, action shareaccess.Action) (*ent.Share, bool) {
+	password := c.Query("password")
+	attempt := newAttempt(c, authfailure.KindShare, code)
+
+	// Get share by code or slug
+	s, err := database.Client.Share.Query().
+		Where(shareCodeOrSlug(code)).
+		WithNode().
+		Only(c.Request.Context())
+	if ent.IsNotFound(err) {
+		// Lookups of unknown codes are throttled per client IP, links that
+		// exist keep working for others behind the same address
+		lookupLimits := h.throttle.ShareLookupLimits(c.ClientIP())
+		if !checkThrottle(c, h.throttle, lookupLimits, attempt) {
+			return nil, false
+		}
+		failAttempt(c, h.throttle, lookupLimits, attempt, authfailure.ReasonInvalidCode)
+		apierr.Abort(c, apierr.ShareNotFound)
+		return nil, false
+	}
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to get share", err)
+		return nil, false
+	}
+
+	// Shares of trashed nodes look like they don't exist
+	if s.Status == share.StatusSuspended || s.Edges.Node == nil || s.Edges.Node.IsDeleted {
+		apierr.Abort(c, apierr.ShareNotFound)
+		return nil, false
+	}
+
+	// Check if expired
+	if s.Status == share.StatusExpired || (!s.ExpiresAt.IsZero() && s.ExpiresAt.Before(time.Now())) {
+		apierr.Abort(c, apierr.ShareExpired)
+		return nil, false
+	}
+	if s.Status == share.StatusExhausted {
+		apierr.Abort(c, apierr.ShareAccessLimitReached)
+		return nil, false
+	}
+
+	// Check password if required; wrong passwords are throttled per share and per client IP
+	if s.Password != "" {
+		if password == "" {
+			apierr.Abort(c, apierr.SharePasswordRequired)
+			return nil, false
+		}
+
+		limits := h.throttle.ShareLimits(s.ID, c.ClientIP())
+		if !checkThrottle(c, h.throttle, limits, attempt) {
+			return nil, false
+		}
+		if subtle.ConstantTimeCompare([]byte(password), []byte(s.Password)) != 1 {
+			failAttempt(c, h.throttle, limits, attempt, authfailure.ReasonWrongPassword)
+			apierr.Abort(c, apierr.SharePasswordInvalid)
+			return nil, false
+		}
+	}
+
+	// Views and downloads are limited separately; folder listings happen
+	// inside an already opened share and are not limited. The counters are
+	// only bumped by reserveShareAccess, this check just fails early.
+	switch action {
+	case shareaccess.ActionView:
+		if s.MaxAccessCount > 0 && s.AccessCount >= s.MaxAccessCount {
+			apierr.Abort(c, apierr.ShareAccessLimitReached)
+			return nil, false
+		}
+	case shareaccess.ActionDownload:
+		if s.MaxDownloadCount > 0 && s.DownloadCount >= s.MaxDownloadCount {
+			apierr.Abort(c, apierr.ShareDownloadLimitReached)
+			return nil, false
+		}
+	}
+
+	return s, true
+}
+
+// reserveShareAccess bumps the counter of a view or download unless its
+// limit is reached. The update only matches shares below the limit, so
+// concurrent requests can't go past it. On failure it writes the error
+// response and returns false.
+func reserveShareAccess(c *gin.Context, s *ent.Share, action shareaccess.Action) bool {
+	update := database.Client.Share.Update().Where(share.IDEQ(s.ID))
+	var limitReached apierr.Code
+	switch action {
+	case shareaccess.ActionView:
+		update.Where(share.Or(
+			share.MaxAccessCountIsNil(),
+			share.MaxAccessCountEQ(0),
+			columnsLT(share.FieldAccessCount, share.FieldMaxAccessCount),
+		)).AddAccessCount(1)
+		limitReached = apierr.ShareAccessLimitReached
+	case shareaccess.ActionDownload:
+		update.Where(share.Or(
+			share.MaxDownloadCountIsNil(),
+			share.MaxDownloadCountEQ(0),
+			columnsLT(share.FieldDownloadCount, share.FieldMaxDownloadCount),
+		)).AddDownloadCount(1)
+		limitReached = apierr.ShareDownloadLimitReached
+	default:
+		return true
+	}
+
+	n, err := update.Save(c.Request.Context())
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to update share", err)
+		return false
+	}
+	if n == 0 {
+		apierr.Abort(c, limitReached)
+		return false
+	}
+	return true
+}
+
+// columnsLT compares two columns of the share table
+func columnsLT(col1, col2 string) predicate.Share {
+	return predicate.Share(func(s *sql.Selector) {
+		s.Where(sql.ColumnsLT(s.C(col1), s.C(col2)))
+	})
 }
 
-// generateShareCode generates a unique share code
-func generateShareCode() (string, error) {
-	bytes := make([]byte, 16)
-	if _, err := rand.Read(bytes); err != nil {
-		return "", err
+// recordShareAccess logs an access event for a share, whose counter
+// reserveShareAccess bumped. Failures are ignored so they never break the
+// access.
+func recordShareAccess(c *gin.Context, s *ent.Share, action shareaccess.Action, nodeID int, bytes int64) {
+	ctx := c.Request.Context()
+
+	userAgent := c.Request.UserAgent()
+	if len(userAgent) > 512 {
+		userAgent = userAgent[:512]
+	}
+
+	database.Client.ShareAccess.Create().
+		SetShareID(s.ID).
+		SetNodeID(nodeID).
+		SetAction(action).
+		SetIP(c.ClientIP()).
+		SetUserAgent(userAgent).
+		SetBytes(bytes).
+		Save(ctx)
+
+	if ownerID, err := s.QueryOwner().OnlyID(ctx); err == nil {
+		events.Publish(ctx, events.TypeShare, gin.H{
+			"share_id": s.ID,
+			"code":     s.Code,
+			"action":   action,
+			"node_id":  nodeID,
+			"ip":       c.ClientIP(),
+		}, ownerID)
 	}
-	return hex.EncodeToString(bytes), nil
+}
+
+// isInSharedTree checks if a node is the shared node itself or one of its
+// descendants, without passing through folders in trash
+func isInSharedTree(ctx context.Context, sharedNodeID, nodeID int) bool {
+	currentID := nodeID
+	for depth := 0; depth < 256; depth++ {
+		if currentID == sharedNodeID {
+			return true
+		}
+		parentID, err := database.Client.Node.Query().
+			Where(node.HasChildrenWith(node.IDEQ(currentID))).
+			Where(node.IsDeletedEQ(false)).
+			OnlyID(ctx)
+		if err != nil {
+			return false
+		}
+		currentID = parentID
+	}
+	return false
 }
 
 // CreateShare handles POST /api/shares - Create share
 func (h *ShareHandler) CreateShare(c *gin.Context) {
 	userID := c.GetString("userID")
 
-	var req struct {
-		NodeID      string    `json:"node_id" binding:"required"`
-		ShareType   int       `json:"share_type"` // 0: permanent, 1: temporary
-		ExpiresAt   *time.Time `json:"expires_at"`
-		Password    string    `json:"password"`
-		MaxAccessCount int    `json:"max_access_count"`
-	}
+	var req CreateShareRequest
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		apierr.AbortBind(c, err)
 		return
 	}
 
@@ -62,14 +335,14 @@ func (h *ShareHandler) CreateShare(c *gin.Context) {
 	// Parse user ID
 	uid, err := strconv.Atoi(userID)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		apierr.Abort(c, apierr.Unauthenticated)
 		return
 	}
 
 	// Parse node ID
 	nodeID, err := strconv.Atoi(req.NodeID)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid node ID"})
+		apierr.AbortInvalid(c, apierr.FieldError{Field: "node_id", Rule: "number"})
 		return
 	}
 
@@ -80,14 +353,31 @@ func (h *ShareHandler) CreateShare(c *gin.Context) {
 		Where(node.IsDeletedEQ(false)).
 		Only(ctx)
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "File not found"})
+		apierr.Abort(c, apierr.FileNotFound)
 		return
 	}
 
+	// Validate custom slug
+	var slug *string
+	if req.Slug != "" {
+		normalized := normalizeSlug(req.Slug)
+		if err := validateSlug(ctx, normalized, 0); err != nil {
+			if err == errSlugTaken || err == errReservedSlug {
+				apierr.Abort(c, apierr.SlugTaken)
+			} else if err == errInvalidSlug {
+				apierr.Abort(c, apierr.InvalidSlug)
+			} else {
+				apierr.AbortInternal(c, "Failed to check slug", err)
+			}
+			return
+		}
+		slug = &normalized
+	}
+
 	// Generate share code
-	code, err := generateShareCode()
+	code, err := h.generateShareCode(ctx)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate share code"})
+		apierr.AbortInternal(c, "Failed to generate share code", err)
 		return
 	}
 
@@ -103,10 +393,17 @@ func (h *ShareHandler) CreateShare(c *gin.Context) {
 		}
 	}
 
-	// Set password if provided
+	// Set password if provided, or generate an extraction code
 	var password *string
 	if req.Password != "" {
 		password = &req.Password
+	} else if req.GenerateExtractCode {
+		extractCode, err := h.generateExtractCode()
+		if err != nil {
+			apierr.AbortInternal(c, "Failed to generate extraction code", err)
+			return
+		}
+		password = &extractCode
 	}
 
 	// Set max access count
@@ -115,18 +412,26 @@ func (h *ShareHandler) CreateShare(c *gin.Context) {
 		maxAccessCount = &req.MaxAccessCount
 	}
 
+	// Set max download count
+	var maxDownloadCount *int
+	if req.MaxDownloadCount > 0 {
+		maxDownloadCount = &req.MaxDownloadCount
+	}
+
 	// Create share
 	s, err := database.Client.Share.Create().
 		SetCode(code).
+		SetNillableSlug(slug).
 		SetShareType(req.ShareType).
 		SetNillableExpiresAt(expiresAt).
 		SetNillablePassword(password).
 		SetNillableMaxAccessCount(maxAccessCount).
+		SetNillableMaxDownloadCount(maxDownloadCount).
 		SetOwnerID(uid).
 		SetNodeID(nodeID).
 		Save(ctx)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create share"})
+		apierr.AbortInternal(c, "Failed to create share", err)
 		return
 	}
 
@@ -138,60 +443,42 @@ func (h *ShareHandler) CreateShare(c *gin.Context) {
 	if s.MaxAccessCount > 0 {
 		respMaxAccessCount = &s.MaxAccessCount
 	}
+	var respMaxDownloadCount *int
+	if s.MaxDownloadCount > 0 {
+		respMaxDownloadCount = &s.MaxDownloadCount
+	}
 
-	c.JSON(http.StatusOK, gin.H{
-		"id":             s.ID,
-		"code":           s.Code,
-		"share_type":     s.ShareType,
-		"expires_at":     respExpiresAt,
-		"has_password":   s.Password != "",
-		"max_access_count": respMaxAccessCount,
-		"created_at":     s.CreatedAt,
+	c.JSON(http.StatusOK, CreateShareResponse{
+		ID:               s.ID,
+		Code:             s.Code,
+		Slug:             s.Slug,
+		ShareType:        s.ShareType,
+		ExpiresAt:        respExpiresAt,
+		HasPassword:      s.Password != "",
+		ExtractCode:      s.Password,
+		MaxAccessCount:   respMaxAccessCount,
+		MaxDownloadCount: respMaxDownloadCount,
+		CreatedAt:        s.CreatedAt,
 	})
 }
 
 // GetShare handles GET /api/shares/:code - Get share info
 func (h *ShareHandler) GetShare(c *gin.Context) {
 	code := c.Param("code")
-	password := c.Query("password")
-
-	ctx := c.Request.Context()
 
-	// Get share
-	s, err := database.Client.Share.Query().
-		Where(share.CodeEQ(code)).
-		WithNode().
-		Only(ctx)
-	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Share not found"})
+	s, ok := h.getActiveShare(c, code, shareaccess.ActionView)
+	if !ok {
 		return
 	}
 
-	// Check if expired
-	if !s.ExpiresAt.IsZero() && s.ExpiresAt.Before(time.Now()) {
-		c.JSON(http.StatusGone, gin.H{"error": "Share has expired"})
+	// Record the view
+	if !reserveShareAccess(c, s, shareaccess.ActionView) {
 		return
 	}
-
-	// Check password if required
-	if s.Password != "" {
-		if password == "" || password != s.Password {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Password required"})
-			return
-		}
-	}
-
-	// Check max access count
-	if s.MaxAccessCount > 0 && s.AccessCount >= s.MaxAccessCount {
-		c.JSON(http.StatusForbidden, gin.H{"error": "Share access limit reached"})
-		return
-	}
-
-	// Increment access count
-	s.Update().AddAccessCount(1).Save(ctx)
+	node := s.Edges.Node
+	recordShareAccess(c, s, shareaccess.ActionView, node.ID, 0)
 
 	// Get node info
-	node := s.Edges.Node
 	var respExpiresAt *time.Time
 	if !s.ExpiresAt.IsZero() {
 		respExpiresAt = &s.ExpiresAt
@@ -200,78 +487,78 @@ func (h *ShareHandler) GetShare(c *gin.Context) {
 	if s.MaxAccessCount > 0 {
 		respMaxAccessCount = &s.MaxAccessCount
 	}
+	var respMaxDownloadCount *int
+	if s.MaxDownloadCount > 0 {
+		respMaxDownloadCount = &s.MaxDownloadCount
+	}
 
-	c.JSON(http.StatusOK, gin.H{
-		"code":           s.Code,
-		"share_type":     s.ShareType,
-		"expires_at":     respExpiresAt,
-		"access_count":   s.AccessCount,
-		"max_access_count": respMaxAccessCount,
-		"node": gin.H{
-			"id":        node.ID,
-			"name":      node.Name,
-			"type":      node.Type,
-			"size":      node.Size,
-			"mime_type": node.MimeType,
+	c.JSON(http.StatusOK, ShareInfoResponse{
+		Code:             s.Code,
+		ShareType:        s.ShareType,
+		ExpiresAt:        respExpiresAt,
+		AccessCount:      s.AccessCount,
+		MaxAccessCount:   respMaxAccessCount,
+		DownloadCount:    s.DownloadCount,
+		MaxDownloadCount: respMaxDownloadCount,
+		Node: ShareNodeResponse{
+			ID:       node.ID,
+			Name:     node.Name,
+			Type:     node.Type,
+			Size:     node.Size,
+			MimeType: node.MimeType,
 		},
 	})
 }
 
 // DownloadShare handles GET /api/shares/:code/download - Download via share code
+// An optional file_id query parameter downloads a file inside a shared folder.
 func (h *ShareHandler) DownloadShare(c *gin.Context) {
 	code := c.Param("code")
-	password := c.Query("password")
 
 	ctx := c.Request.Context()
 
-	// Get share
-	share, err := database.Client.Share.Query().
-		Where(share.CodeEQ(code)).
-		WithNode().
-		Only(ctx)
-	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Share not found"})
-		return
-	}
-
-	// Check if expired
-	if !share.ExpiresAt.IsZero() && share.ExpiresAt.Before(time.Now()) {
-		c.JSON(http.StatusGone, gin.H{"error": "Share has expired"})
+	s, ok := h.getActiveShare(c, code, shareaccess.ActionDownload)
+	if !ok {
 		return
 	}
 
-	// Check password if required
-	if share.Password != "" {
-		if password == "" || password != share.Password {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Password required"})
+	node := s.Edges.Node
+	if fileIDStr := c.Query("file_id"); fileIDStr != "" {
+		fileID, err := strconv.Atoi(fileIDStr)
+		if err != nil {
+			apierr.AbortInvalid(c, apierr.FieldError{Field: "file_id", Rule: "number"})
+			return
+		}
+		if !isInSharedTree(ctx, node.ID, fileID) {
+			apierr.Abort(c, apierr.NotInShare)
+			return
+		}
+		node, err = database.Client.Node.Get(ctx, fileID)
+		if err != nil || node.IsDeleted {
+			apierr.Abort(c, apierr.FileNotFound)
 			return
 		}
 	}
 
-	// Check max access count
-	if share.MaxAccessCount > 0 && share.AccessCount >= share.MaxAccessCount {
-		c.JSON(http.StatusForbidden, gin.H{"error": "Share access limit reached"})
-		return
-	}
-
-	node := share.Edges.Node
-
 	// Only files can be downloaded directly
 	if node.Type != 1 {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Only files can be downloaded"})
+		apierr.Abort(c, apierr.IsAFolder)
 		return
 	}
 
 	// Get object from MinIO
 	object, err := storage.GetClient().GetObject(ctx, h.cfg.MinIO.BucketName, node.MinioObject, minio.GetObjectOptions{})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get file from storage"})
+		apierr.AbortInternal(c, "Failed to get file from storage", err)
 		return
 	}
 	defer object.Close()
 
-	// Increment access count
-	share.Update().AddAccessCount(1).Save(ctx)
+	// Record the download
+	if !reserveShareAccess(c, s, shareaccess.ActionDownload) {
+		return
+	}
+	recordShareAccess(c, s, shareaccess.ActionDownload, node.ID, node.Size)
 
 	// Set headers
 	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s", node.Name))
@@ -292,13 +579,13 @@ func (h *ShareHandler) DeleteShare(c *gin.Context) {
 	// Parse IDs
 	uid, err := strconv.Atoi(userID)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		apierr.Abort(c, apierr.Unauthenticated)
 		return
 	}
 
 	shareID, err := strconv.Atoi(id)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid share ID"})
+		apierr.AbortInvalid(c, apierr.FieldError{Field: "id", Rule: "number"})
 		return
 	}
 
@@ -308,18 +595,172 @@ func (h *ShareHandler) DeleteShare(c *gin.Context) {
 		Where(share.HasOwnerWith(user.IDEQ(uid))).
 		Only(ctx)
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Share not found"})
+		apierr.Abort(c, apierr.ShareNotFound)
 		return
 	}
 
 	// Delete share
 	err = database.Client.Share.DeleteOne(share).Exec(ctx)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete share"})
+		apierr.AbortInternal(c, "Failed to delete share", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MessageResponse{Message: "Share deleted"})
+}
+
+// UpdateShareRequest represents a request to edit a share, omitted fields are left unchanged
+type UpdateShareRequest struct {
+	ShareType        *int       `json:"share_type"` // 0: permanent, 1: temporary
+	ExpiresAt        *time.Time `json:"expires_at"`
+	Password         *string    `json:"password"`           // Empty string removes the password
+	MaxAccessCount   *int       `json:"max_access_count"`   // 0 removes the limit
+	MaxDownloadCount *int       `json:"max_download_count"` // 0 removes the limit
+	Slug             *string    `json:"slug"`               // Empty string removes the slug
+}
+
+// shareStatus computes the status a share should have based on its expiry
+// and limits. A share is exhausted once neither views nor downloads are left;
+// reaching one limit only refuses that action. Suspended shares stay
+// suspended until their node is restored.
+func shareStatus(s *ent.Share) share.Status {
+	switch {
+	case s.Status == share.StatusSuspended:
+		return share.StatusSuspended
+	case !s.ExpiresAt.IsZero() && s.ExpiresAt.Before(time.Now()):
+		return share.StatusExpired
+	case s.MaxAccessCount > 0 && s.AccessCount >= s.MaxAccessCount &&
+		s.MaxDownloadCount > 0 && s.DownloadCount >= s.MaxDownloadCount:
+		return share.StatusExhausted
+	default:
+		return share.StatusActive
+	}
+}
+
+// UpdateShare handles PUT /api/shares/:id - Edit expiry, password, limits or slug of my share
+func (h *ShareHandler) UpdateShare(c *gin.Context) {
+	var req UpdateShareRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apierr.AbortBind(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	s, ok := getOwnedShare(c)
+	if !ok {
+		return
+	}
+
+	update := s.Update()
+
+	// Expiration
+	if req.ShareType != nil && *req.ShareType == 0 {
+		update.SetShareType(0).ClearExpiresAt()
+	} else if req.ShareType != nil || req.ExpiresAt != nil {
+		update.SetShareType(1)
+		if req.ExpiresAt != nil {
+			update.SetExpiresAt(*req.ExpiresAt)
+		} else if s.ExpiresAt.IsZero() {
+			// Default: 7 days
+			update.SetExpiresAt(time.Now().Add(7 * 24 * time.Hour))
+		}
+	}
+
+	// Password
+	if req.Password != nil {
+		if *req.Password == "" {
+			update.ClearPassword()
+		} else {
+			update.SetPassword(*req.Password)
+		}
+	}
+
+	// Limits
+	if req.MaxAccessCount != nil {
+		if *req.MaxAccessCount > 0 {
+			update.SetMaxAccessCount(*req.MaxAccessCount)
+		} else {
+			update.ClearMaxAccessCount()
+		}
+	}
+	if req.MaxDownloadCount != nil {
+		if *req.MaxDownloadCount > 0 {
+			update.SetMaxDownloadCount(*req.MaxDownloadCount)
+		} else {
+			update.ClearMaxDownloadCount()
+		}
+	}
+
+	// Slug
+	if req.Slug != nil {
+		if *req.Slug == "" {
+			update.ClearSlug()
+		} else {
+			normalized := normalizeSlug(*req.Slug)
+			if err := validateSlug(ctx, normalized, s.ID); err != nil {
+				if err == errSlugTaken || err == errReservedSlug {
+					apierr.Abort(c, apierr.SlugTaken)
+				} else if err == errInvalidSlug {
+					apierr.Abort(c, apierr.InvalidSlug)
+				} else {
+					apierr.AbortInternal(c, "Failed to check slug", err)
+				}
+				return
+			}
+			update.SetSlug(normalized)
+		}
+	}
+
+	updated, err := update.Save(ctx)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to update share", err)
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"message": "Share deleted"})
+	// Re-enable or disable the share according to the new settings
+	if status := shareStatus(updated); status != updated.Status {
+		statusUpdate := updated.Update().SetStatus(status)
+		if status == share.StatusActive {
+			statusUpdate.ClearDisabledAt()
+		} else if status != share.StatusSuspended {
+			statusUpdate.SetDisabledAt(time.Now())
+		}
+		updated, err = statusUpdate.Save(ctx)
+		if err != nil {
+			apierr.AbortInternal(c, "Failed to update share status", err)
+			return
+		}
+	}
+
+	var respExpiresAt *time.Time
+	if !updated.ExpiresAt.IsZero() {
+		respExpiresAt = &updated.ExpiresAt
+	}
+	var respMaxAccessCount *int
+	if updated.MaxAccessCount > 0 {
+		respMaxAccessCount = &updated.MaxAccessCount
+	}
+	var respMaxDownloadCount *int
+	if updated.MaxDownloadCount > 0 {
+		respMaxDownloadCount = &updated.MaxDownloadCount
+	}
+
+	c.JSON(http.StatusOK, UpdateShareResponse{
+		ID:               updated.ID,
+		Code:             updated.Code,
+		Slug:             updated.Slug,
+		ShareType:        updated.ShareType,
+		Status:           updated.Status,
+		ExpiresAt:        respExpiresAt,
+		HasPassword:      updated.Password != "",
+		ExtractCode:      updated.Password,
+		AccessCount:      updated.AccessCount,
+		MaxAccessCount:   respMaxAccessCount,
+		DownloadCount:    updated.DownloadCount,
+		MaxDownloadCount: respMaxDownloadCount,
+		UpdatedAt:        updated.UpdatedAt,
+	})
 }
 
 // GetMyShares handles GET /api/shares - Get my shares
@@ -331,7 +772,7 @@ func (h *ShareHandler) GetMyShares(c *gin.Context) {
 	// Parse user ID
 	uid, err := strconv.Atoi(userID)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		apierr.Abort(c, apierr.Unauthenticated)
 		return
 	}
 
@@ -342,12 +783,12 @@ func (h *ShareHandler) GetMyShares(c *gin.Context) {
 		Order(ent.Desc(share.FieldCreatedAt)).
 		All(ctx)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get shares"})
+		apierr.AbortInternal(c, "Failed to get shares", err)
 		return
 	}
 
 	// Format response
-	result := make([]gin.H, len(shares))
+	result := make([]ShareResponse, len(shares))
 	for i, s := range shares {
 		node := s.Edges.Node
 		var expiresAt *time.Time
@@ -358,101 +799,57 @@ func (h *ShareHandler) GetMyShares(c *gin.Context) {
 		if s.MaxAccessCount > 0 {
 			maxAccessCount = &s.MaxAccessCount
 		}
-		result[i] = gin.H{
-			"id":             s.ID,
-			"code":           s.Code,
-			"share_type":     s.ShareType,
-			"expires_at":     expiresAt,
-			"access_count":   s.AccessCount,
-			"max_access_count": maxAccessCount,
-			"has_password":   s.Password != "",
-			"created_at":     s.CreatedAt,
-			"node": gin.H{
-				"id":   node.ID,
-				"name": node.Name,
-				"type": node.Type,
+		var maxDownloadCount *int
+		if s.MaxDownloadCount > 0 {
+			maxDownloadCount = &s.MaxDownloadCount
+		}
+		result[i] = ShareResponse{
+			ID:               s.ID,
+			Code:             s.Code,
+			Slug:             s.Slug,
+			ShareType:        s.ShareType,
+			Status:           s.Status,
+			ExpiresAt:        expiresAt,
+			AccessCount:      s.AccessCount,
+			MaxAccessCount:   maxAccessCount,
+			DownloadCount:    s.DownloadCount,
+			MaxDownloadCount: maxDownloadCount,
+			HasPassword:      s.Password != "",
+			ExtractCode:      s.Password,
+			CreatedAt:        s.CreatedAt,
+			Node: ShareNodeResponse{
+				ID:   node.ID,
+				Name: node.Name,
+				Type: node.Type,
 			},
 		}
 	}
 
-	c.JSON(http.StatusOK, gin.H{"shares": result})
+	c.JSON(http.StatusOK, ShareListResponse{Shares: result})
 }
 
 // GetShareFolder handles GET /api/shares/:code/folder/:id - Get folder contents via share
 func (h *ShareHandler) GetShareFolder(c *gin.Context) {
 	code := c.Param("code")
 	folderIDStr := c.Param("id")
-	password := c.Query("password")
 
 	ctx := c.Request.Context()
 
 	// Parse folder ID
 	folderID, err := strconv.Atoi(folderIDStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid folder ID"})
+		apierr.AbortInvalid(c, apierr.FieldError{Field: "id", Rule: "number"})
 		return
 	}
 
-	// Get share
-	share, err := database.Client.Share.Query().
-		Where(share.CodeEQ(code)).
-		WithNode().
-		Only(ctx)
-	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Share not found"})
+	s, ok := h.getActiveShare(c, code, shareaccess.ActionList)
+	if !ok {
 		return
 	}
 
-	// Check if expired
-	if !share.ExpiresAt.IsZero() && share.ExpiresAt.Before(time.Now()) {
-		c.JSON(http.StatusGone, gin.H{"error": "Share has expired"})
-		return
-	}
-
-	// Check password if required
-	if share.Password != "" {
-		if password == "" || password != share.Password {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Password required"})
-			return
-		}
-	}
-
-	// Check max access count
-	if share.MaxAccessCount > 0 && share.AccessCount >= share.MaxAccessCount {
-		c.JSON(http.StatusForbidden, gin.H{"error": "Share access limit reached"})
-		return
-	}
-
-	// Verify folder is within shared node's subtree
-	sharedNode := share.Edges.Node
-	
-	// Check if folder is the shared node itself or a descendant
-	isValidFolder := false
-	if sharedNode.ID == folderID {
-		isValidFolder = true
-	} else {
-		// Check if folder is a descendant of shared node
-		currentNode, err := database.Client.Node.Query().
-			Where(node.IDEQ(folderID)).
-			WithParent().
-			Only(ctx)
-		if err == nil {
-			// Traverse up to check if it's under shared node
-			for currentNode != nil {
-				if currentNode.ID == sharedNode.ID {
-					isValidFolder = true
-					break
-				}
-				if currentNode.Edges.Parent == nil {
-					break
-				}
-				currentNode = currentNode.Edges.Parent
-			}
-		}
-	}
-
-	if !isValidFolder {
-		c.JSON(http.StatusForbidden, gin.H{"error": "Folder not accessible via this share"})
+	// Verify folder is the shared node itself or a descendant
+	if !isInSharedTree(ctx, s.Edges.Node.ID, folderID) {
+		apierr.Abort(c, apierr.NotInShare)
 		return
 	}
 
@@ -462,71 +859,46 @@ func (h *ShareHandler) GetShareFolder(c *gin.Context) {
 		Where(node.IsDeletedEQ(false)).
 		All(ctx)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get folder contents"})
+		apierr.AbortInternal(c, "Failed to get folder contents", err)
 		return
 	}
 
-	// Increment access count
-	share.Update().AddAccessCount(1).Save(ctx)
+	// Record the listing
+	recordShareAccess(c, s, shareaccess.ActionList, folderID, 0)
 
 	// Format response
-	files := make([]gin.H, len(nodes))
+	files := make([]ShareFolderItem, len(nodes))
 	for i, n := range nodes {
-		files[i] = gin.H{
-			"id":        n.ID,
-			"name":      n.Name,
-			"type":      n.Type,
-			"size":      n.Size,
-			"mime_type": n.MimeType,
-			"updated_at": n.UpdatedAt,
+		files[i] = ShareFolderItem{
+			ID:        n.ID,
+			Name:      n.Name,
+			Type:      n.Type,
+			Size:      n.Size,
+			MimeType:  n.MimeType,
+			UpdatedAt: n.UpdatedAt,
 		}
 	}
 
-	c.JSON(http.StatusOK, gin.H{"files": files})
+	c.JSON(http.StatusOK, ShareFolderResponse{Files: files})
 }
 
 // PreviewShareFile handles GET /api/shares/:code/preview/:id - Preview file via share
 func (h *ShareHandler) PreviewShareFile(c *gin.Context) {
 	code := c.Param("code")
 	fileIDStr := c.Param("id")
-	password := c.Query("password")
 
 	ctx := c.Request.Context()
 
 	// Parse file ID
 	fileID, err := strconv.Atoi(fileIDStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid file ID"})
+		apierr.AbortInvalid(c, apierr.FieldError{Field: "id", Rule: "number"})
 		return
 	}
 
-	// Get share
-	share, err := database.Client.Share.Query().
-		Where(share.CodeEQ(code)).
-		WithNode().
-		Only(ctx)
-	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Share not found"})
-		return
-	}
-
-	// Check if expired
-	if !share.ExpiresAt.IsZero() && share.ExpiresAt.Before(time.Now()) {
-		c.JSON(http.StatusGone, gin.H{"error": "Share has expired"})
-		return
-	}
-
-	// Check password if required
-	if share.Password != "" {
-		if password == "" || password != share.Password {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Password required"})
-			return
-		}
-	}
-
-	// Check max access count
-	if share.MaxAccessCount > 0 && share.AccessCount >= share.MaxAccessCount {
-		c.JSON(http.StatusForbidden, gin.H{"error": "Share access limit reached"})
+	// Every preview hands out the whole file, so it counts as a download
+	s, ok := h.getActiveShare(c, code, shareaccess.ActionDownload)
+	if !ok {
 		return
 	}
 
@@ -537,51 +909,23 @@ func (h *ShareHandler) PreviewShareFile(c *gin.Context) {
 		Where(node.IsDeletedEQ(false)).
 		Only(ctx)
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "File not found"})
-		return
-	}
-
-	// Verify file is within shared node's subtree
-	sharedNode := share.Edges.Node
-	isValidFile := false
-	if sharedNode.ID == fileID {
-		isValidFile = true
-	} else {
-		// Check if file is a descendant of shared node
-		currentNode, err := database.Client.Node.Query().
-			Where(node.IDEQ(fileID)).
-			WithParent().
-			Only(ctx)
-		if err == nil {
-			// Traverse up to check if it's under shared node
-			for currentNode != nil {
-				if currentNode.ID == sharedNode.ID {
-					isValidFile = true
-					break
-				}
-				if currentNode.Edges.Parent == nil {
-					break
-				}
-				currentNode = currentNode.Edges.Parent
-			}
-		}
+		apierr.Abort(c, apierr.FileNotFound)
+		return
 	}
 
-	if !isValidFile {
-		c.JSON(http.StatusForbidden, gin.H{"error": "File not accessible via this share"})
+	// Verify file is the shared node itself or a descendant
+	if !isInSharedTree(ctx, s.Edges.Node.ID, fileID) {
+		apierr.Abort(c, apierr.NotInShare)
 		return
 	}
 
 	// Generate presigned URL
 	presignedURL, err := storage.GetClient().PresignedGetObject(ctx, h.cfg.MinIO.BucketName, file.MinioObject, 1*time.Hour, nil)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate preview URL"})
+		apierr.AbortInternal(c, "Failed to generate preview URL", err)
 		return
 	}
 
-	// Increment access count
-	share.Update().AddAccessCount(1).Save(ctx)
-
 	// Use preview handler logic (similar to preview.go)
 	ext := strings.ToLower(filepath.Ext(file.Name))
 	mimeType := file.MimeType
@@ -593,35 +937,47 @@ func (h *ShareHandler) PreviewShareFile(c *gin.Context) {
 	if isTextFile(mimeType) || ext == ".txt" || ext == ".md" {
 		object, err := storage.GetClient().GetObject(ctx, h.cfg.MinIO.BucketName, file.MinioObject, minio.GetObjectOptions{})
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get file"})
+			apierr.AbortInternal(c, "Failed to get file", err)
 			return
 		}
 		defer object.Close()
 
 		content, err := io.ReadAll(object)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to read file"})
+			apierr.AbortInternal(c, "Failed to read file", err)
 			return
 		}
 
-		c.JSON(http.StatusOK, gin.H{
-			"type":      "text",
-			"content":   string(content),
-			"mime_type": mimeType,
-			"file_name": file.Name,
+		if !reserveShareAccess(c, s, shareaccess.ActionDownload) {
+			return
+		}
+		recordShareAccess(c, s, shareaccess.ActionPreview, file.ID, int64(len(content)))
+
+		text := string(content)
+		c.JSON(http.StatusOK, preview.Response{
+			Type:     "text",
+			Content:  &text,
+			MimeType: mimeType,
+			FileName: file.Name,
 		})
 		return
 	}
 
+	// External viewers fetch the whole file through the presigned URL
+	if !reserveShareAccess(c, s, shareaccess.ActionDownload) {
+		return
+	}
+	recordShareAccess(c, s, shareaccess.ActionPreview, file.ID, file.Size)
+
 	// For Office documents
 	if isOfficeDocument(ext) {
 		encodedURL := url.QueryEscape(presignedURL.String())
 		previewURL := fmt.Sprintf("https://view.officeapps.live.com/op/embed.aspx?src=%s", encodedURL)
-		c.JSON(http.StatusOK, gin.H{
-			"type":      "office",
-			"url":       previewURL,
-			"mime_type": mimeType,
-			"file_name": file.Name,
+		c.JSON(http.StatusOK, preview.Response{
+			Type:     "office",
+			URL:      previewURL,
+			MimeType: mimeType,
+			FileName: file.Name,
 		})
 		return
 	}
@@ -630,11 +986,11 @@ func (h *ShareHandler) PreviewShareFile(c *gin.Context) {
 	if ext == ".pdf" {
 		encodedURL := url.QueryEscape(presignedURL.String())
 		previewURL := fmt.Sprintf("/pdfjs/web/viewer.html?file=%s", encodedURL)
-		c.JSON(http.StatusOK, gin.H{
-			"type":      "pdf",
-			"url":       previewURL,
-			"mime_type": mimeType,
-			"file_name": file.Name,
+		c.JSON(http.StatusOK, preview.Response{
+			Type:     "pdf",
+			URL:      previewURL,
+			MimeType: mimeType,
+			FileName: file.Name,
 		})
 		return
 	}
@@ -643,21 +999,21 @@ func (h *ShareHandler) PreviewShareFile(c *gin.Context) {
 	if h.cfg.Preview.KKFileView.Enabled && h.cfg.Preview.KKFileView.BaseURL != "" {
 		encodedURL := url.QueryEscape(presignedURL.String())
 		kkFileViewURL := fmt.Sprintf("%s/onlinePreview?url=%s&fullfilename=%s", h.cfg.Preview.KKFileView.BaseURL, encodedURL, url.QueryEscape(file.Name))
-		c.JSON(http.StatusOK, gin.H{
-			"type":      "kkfileview",
-			"url":       kkFileViewURL,
-			"mime_type": mimeType,
-			"file_name": file.Name,
+		c.JSON(http.StatusOK, preview.Response{
+			Type:     "kkfileview",
+			URL:      kkFileViewURL,
+			MimeType: mimeType,
+			FileName: file.Name,
 		})
 		return
 	}
 
 	// Fallback to direct URL
-	c.JSON(http.StatusOK, gin.H{
-		"type":      "url",
-		"url":       presignedURL.String(),
-		"mime_type": mimeType,
-		"file_name": file.Name,
+	c.JSON(http.StatusOK, preview.Response{
+		Type:     "url",
+		URL:      presignedURL.String(),
+		MimeType: mimeType,
+		FileName: file.Name,
 	})
 }
 
@@ -0,0 +1,142 @@
+package api
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"gopan-server/ent/predicate"
+	"gopan-server/ent/share"
+	"gopan-server/ent/shareaccess"
+	"gopan-server/internal/apierr"
+	"gopan-server/internal/database"
+	"math/big"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/skip2/go-qrcode"
+)
+
+var (
+	errInvalidSlug  = errors.New("slug must be 3-64 characters of letters, digits, '-' or '_' and start with a letter or digit")
+	errReservedSlug = errors.New("slug is reserved")
+	errSlugTaken    = errors.New("slug is already taken")
+)
+
+// slugPattern matches valid owner-chosen share slugs
+var slugPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{2,63}$`)
+
+// reservedSlugs can't be used as share slugs because they clash with routes
+// or could be mistaken for official pages
+var reservedSlugs = map[string]bool{
+	"admin": true, "api": true, "app": true, "dashboard": true, "download": true,
+	"folder": true, "gopan": true, "help": true, "index": true, "login": true,
+	"logout": true, "new": true, "preview": true, "qrcode": true, "register": true,
+	"root": true, "s": true, "settings": true, "share": true, "shares": true,
+	"static": true, "stats": true, "system": true, "user": true, "users": true,
+}
+
+// randomString generates a random string of length characters from alphabet
+func randomString(alphabet string, length int) (string, error) {
+	chars := []rune(alphabet)
+	max := big.NewInt(int64(len(chars)))
+	var sb strings.Builder
+	for i := 0; i < length; i++ {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		sb.WriteRune(chars[n.Int64()])
+	}
+	return sb.String(), nil
+}
+
+// generateShareCode generates a unique short share code
+func (h *ShareHandler) generateShareCode(ctx context.Context) (string, error) {
+	for attempt := 0; attempt < 5; attempt++ {
+		code, err := randomString(h.cfg.Share.CodeAlphabet, h.cfg.Share.CodeLength)
+		if err != nil {
+			return "", err
+		}
+		exists, err := database.Client.Share.Query().
+			Where(shareCodeOrSlug(code)).
+			Exist(ctx)
+		if err != nil {
+			return "", err
+		}
+		if !exists {
+			return code, nil
+		}
+	}
+	return "", fmt.Errorf("failed to generate a unique share code")
+}
+
+// generateExtractCode generates an extraction code ("提取码") for a share
+func (h *ShareHandler) generateExtractCode() (string, error) {
+	return randomString(h.cfg.Share.CodeAlphabet, h.cfg.Share.ExtractCodeLength)
+}
+
+// normalizeSlug lowercases and trims an owner-chosen slug
+func normalizeSlug(slug string) string {
+	return strings.ToLower(strings.TrimSpace(slug))
+}
+
+// validateSlug checks that a normalized slug is well-formed, not reserved and
+// not used by another share (as slug or code). excludeID skips the share
+// being edited, 0 for new shares.
+func validateSlug(ctx context.Context, slug string, excludeID int) error {
+	if !slugPattern.MatchString(slug) {
+		return errInvalidSlug
+	}
+	if reservedSlugs[slug] {
+		return errReservedSlug
+	}
+	exists, err := database.Client.Share.Query().
+		Where(shareCodeOrSlug(slug)).
+		Where(share.IDNEQ(excludeID)).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return errSlugTaken
+	}
+	return nil
+}
+
+// shareCodeOrSlug matches a share by its generated code or its custom slug
+func shareCodeOrSlug(code string) predicate.Share {
+	return share.Or(
+		share.CodeEQ(code),
+		share.SlugEQ(normalizeSlug(code)),
+	)
+}
+
+// GetShareQRCode handles GET /api/shares/:code/qrcode - Render share link as a QR code PNG
+func (h *ShareHandler) GetShareQRCode(c *gin.Context) {
+	code := c.Param("code")
+
+	// Rendering the link is not a view, so no limit applies
+	s, ok := h.getActiveShare(c, code, shareaccess.ActionList)
+	if !ok {
+		return
+	}
+
+	// Prefer the slug so the encoded link matches what the owner chose
+	linkCode := s.Code
+	if s.Slug != nil {
+		linkCode = *s.Slug
+	}
+	link := shareLink(h.cfg, c, linkCode)
+
+	png, err := qrcode.Encode(link, qrcode.Medium, 256)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to generate QR code", err)
+		return
+	}
+
+	c.Header("Cache-Control", "private, max-age=300")
+	c.Data(http.StatusOK, "image/png", png)
+}
@@ -0,0 +1,203 @@
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"gopan-server/config"
+	"gopan-server/ent"
+	"gopan-server/ent/share"
+	"gopan-server/ent/shareaccess"
+	"gopan-server/internal/apierr"
+	"gopan-server/internal/database"
+	"gopan-server/internal/storage"
+	"html/template"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/minio/minio-go/v7"
+)
+
+// maxThumbnailSize is the largest image served as a link preview thumbnail
+const maxThumbnailSize = 10 * 1024 * 1024
+
+// thumbnailTypes are the image types served as link preview thumbnails.
+// Only raster formats are allowed: SVG can carry script that would run on
+// the origin of the app.
+var thumbnailTypes = map[string]bool{
+	"image/png":  true,
+	"image/jpeg": true,
+	"image/gif":  true,
+	"image/webp": true,
+}
+
+// hasThumbnail reports whether the shared node can be served as a
+// thumbnail. The thumbnail is the whole image, so shares with a download
+// limit have none: link previews would use it up or get around it.
+func hasThumbnail(s *ent.Share) bool {
+	n := s.Edges.Node
+	return n.Type == 1 && thumbnailTypes[n.MimeType] && n.Size <= maxThumbnailSize && s.MaxDownloadCount == 0
+}
+
+// shareMetaTemplate renders the OpenGraph and Twitter card tags of a share page
+var shareMetaTemplate = template.Must(template.New("share-meta").Parse(`<title>{{.Title}} - GoPan</title>
+    <meta name="description" content="{{.Description}}">
+    <meta property="og:type" content="website">
+    <meta property="og:site_name" content="GoPan">
+    <meta property="og:title" content="{{.Title}}">
+    <meta property="og:description" content="{{.Description}}">
+    {{- if .URL}}
+    <meta property="og:url" content="{{.URL}}">
+    {{- end}}
+    {{- if .Image}}
+    <meta property="og:image" content="{{.Image}}">
+    <meta name="twitter:image" content="{{.Image}}">
+    {{- end}}
+    <meta name="twitter:card" content="{{if .Image}}summary_large_image{{else}}summary{{end}}">
+    <meta name="twitter:title" content="{{.Title}}">
+    <meta name="twitter:description" content="{{.Description}}">
+    <meta name="robots" content="noindex">`))
+
+// titlePattern matches the static title of share.html
+var titlePattern = regexp.MustCompile(`(?s)<title>.*?</title>`)
+
+// shareMeta holds the link preview metadata of a share
+type shareMeta struct {
+	Title       string
+	Description string
+	URL         string
+	Image       string
+}
+
+// SharePageHandler renders the public share landing page
+type SharePageHandler struct {
+	cfg  *config.Config
+	page string
+}
+
+// NewSharePageHandler creates a handler rendering page (the share.html SPA)
+// with link preview metadata
+func NewSharePageHandler(cfg *config.Config, page []byte) *SharePageHandler {
+	return &SharePageHandler{cfg: cfg, page: string(page)}
+}
+
+// formatSize formats a byte count for humans
+func formatSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(size)/float64(div), "KMGTPE"[exp])
+}
+
+// RenderSharePage handles GET /s/:code - Share landing page with OpenGraph metadata
+// The page is the share SPA with metadata injected, so chat apps can show a
+// preview card. Names of password protected shares are never included.
+func (h *SharePageHandler) RenderSharePage(c *gin.Context) {
+	code := c.Param("code")
+
+	ctx := c.Request.Context()
+
+	// Absolute links come from the configuration only: link preview
+	// crawlers and caches share the page, so it must not depend on the
+	// Host header. Without server.base_url the page has none.
+	status := http.StatusOK
+	meta := shareMeta{}
+	baseURL := h.cfg.Server.BaseURL
+	if baseURL != "" {
+		meta.URL = fmt.Sprintf("%s/s/%s", baseURL, url.PathEscape(code))
+	}
+
+	s, err := database.Client.Share.Query().
+		Where(shareCodeOrSlug(code)).
+		WithNode().
+		Only(ctx)
+	switch {
+	case err != nil || s.Status == share.StatusSuspended || s.Edges.Node == nil || s.Edges.Node.IsDeleted:
+		status = http.StatusNotFound
+		meta.Title = "分享不存在"
+		meta.Description = "该分享链接不存在或已被取消"
+	case s.Status == share.StatusExpired || (!s.ExpiresAt.IsZero() && s.ExpiresAt.Before(time.Now())):
+		status = http.StatusGone
+		meta.Title = "分享已过期"
+		meta.Description = "该分享链接已过期"
+	case s.Status == share.StatusExhausted:
+		meta.Title = "分享已失效"
+		meta.Description = "该分享链接的访问和下载次数已用完"
+	case s.Password != "":
+		meta.Title = "GoPan 文件分享"
+		meta.Description = "此分享已加密，请输入提取码查看"
+	default:
+		n := s.Edges.Node
+		meta.Title = n.Name
+
+		var parts []string
+		if n.Type == 0 {
+			parts = append(parts, "文件夹")
+		} else {
+			parts = append(parts, formatSize(n.Size))
+		}
+		if !s.ExpiresAt.IsZero() {
+			parts = append(parts, "有效期至 "+s.ExpiresAt.Format("2006-01-02 15:04"))
+		} else {
+			parts = append(parts, "永久有效")
+		}
+		meta.Description = strings.Join(parts, " · ")
+
+		if baseURL != "" && hasThumbnail(s) {
+			meta.Image = fmt.Sprintf("%s/api/shares/%s/thumbnail", baseURL, url.PathEscape(code))
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := shareMetaTemplate.Execute(&buf, meta); err != nil {
+		apierr.AbortInternal(c, "Failed to render share page", err)
+		return
+	}
+
+	page := titlePattern.ReplaceAllLiteralString(h.page, buf.String())
+
+	c.Header("Cache-Control", "no-cache")
+	c.Data(status, "text/html; charset=utf-8", []byte(page))
+}
+
+// GetShareThumbnail handles GET /api/shares/:code/thumbnail - Link preview image of a shared image file
+func (h *ShareHandler) GetShareThumbnail(c *gin.Context) {
+	code := c.Param("code")
+
+	ctx := c.Request.Context()
+
+	s, ok := h.getActiveShare(c, code, shareaccess.ActionPreview)
+	if !ok {
+		return
+	}
+
+	n := s.Edges.Node
+	if !hasThumbnail(s) {
+		apierr.Abort(c, apierr.NotFound)
+		return
+	}
+
+	object, err := storage.GetClient().GetObject(ctx, h.cfg.MinIO.BucketName, n.MinioObject, minio.GetObjectOptions{})
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to get file from storage", err)
+		return
+	}
+	defer object.Close()
+
+	recordShareAccess(c, s, shareaccess.ActionPreview, n.ID, n.Size)
+
+	// Not public: shared caches would keep serving it after the share ends
+	c.Header("Cache-Control", "private, max-age=3600")
+	c.Header("X-Content-Type-Options", "nosniff")
+	c.Header("Content-Security-Policy", "default-src 'none'; sandbox")
+	c.DataFromReader(http.StatusOK, n.Size, n.MimeType, object, nil)
+}
@@ -0,0 +1,268 @@
+package api
+
+import (
+	"gopan-server/ent"
+	"gopan-server/ent/node"
+	"gopan-server/ent/share"
+	"gopan-server/ent/shareaccess"
+	"gopan-server/ent/user"
+	"gopan-server/internal/apierr"
+	"gopan-server/internal/database"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ShareStatsResponse represents the access aggregates of a share
+type ShareStatsResponse struct {
+	ShareID        int                  `json:"share_id"`
+	Code           string               `json:"code"`
+	AccessCount    int                  `json:"access_count"`
+	DownloadCount  int                  `json:"download_count"`
+	UniqueVisitors int                  `json:"unique_visitors"` // By client IP
+	BytesServed    int64                `json:"bytes_served"`
+	Actions        map[string]int       `json:"actions"` // Events per action
+	Files          []ShareFileDownloads `json:"files"`
+	LastAccessAt   *time.Time           `json:"last_access_at"`
+}
+
+// ShareFileDownloads represents the downloads of a file of a share
+type ShareFileDownloads struct {
+	NodeID    *int   `json:"node_id"` // Null when the file was deleted
+	Name      string `json:"name,omitempty"`
+	Downloads int    `json:"downloads"`
+	Bytes     int64  `json:"bytes"`
+}
+
+// ShareAccessResponse represents an access to a share
+type ShareAccessResponse struct {
+	ID        int                `json:"id"`
+	Action    shareaccess.Action `json:"action"`
+	IP        string             `json:"ip"`
+	UserAgent string             `json:"user_agent"`
+	Bytes     int64              `json:"bytes"`
+	NodeID    *int               `json:"node_id"`
+	NodeName  string             `json:"node_name,omitempty"`
+	CreatedAt time.Time          `json:"created_at"`
+}
+
+// ShareAccessListResponse represents a page of the access log of a share
+type ShareAccessListResponse struct {
+	Accesses []ShareAccessResponse `json:"accesses"`
+	Total    int                   `json:"total"`
+	Page     int                   `json:"page"`
+	PageSize int                   `json:"page_size"`
+}
+
+// getOwnedShare loads a share owned by the current user from the :id param,
+// writing an error response on failure
+func getOwnedShare(c *gin.Context) (*ent.Share, bool) {
+	uid, err := parseUserID(c.GetString("userID"))
+	if err != nil {
+		apierr.Abort(c, apierr.Unauthenticated)
+		return nil, false
+	}
+
+	shareID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		apierr.AbortInvalid(c, apierr.FieldError{Field: "id", Rule: "number"})
+		return nil, false
+	}
+
+	s, err := database.Client.Share.Query().
+		Where(share.IDEQ(shareID)).
+		Where(share.HasOwnerWith(user.IDEQ(uid))).
+		WithNode().
+		Only(c.Request.Context())
+	if err != nil {
+		apierr.Abort(c, apierr.ShareNotFound)
+		return nil, false
+	}
+	return s, true
+}
+
+// GetShareStats handles GET /api/shares/stats/:id - Get access aggregates for my share
+func (h *ShareHandler) GetShareStats(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	s, ok := getOwnedShare(c)
+	if !ok {
+		return
+	}
+
+	accesses := database.Client.ShareAccess.Query().
+		Where(shareaccess.ShareIDEQ(s.ID))
+
+	// Events and bytes per action
+	var byAction []struct {
+		Action shareaccess.Action `json:"action"`
+		Count  int                `json:"count"`
+		Bytes  int64              `json:"bytes"`
+	}
+	err := accesses.Clone().
+		GroupBy(shareaccess.FieldAction).
+		Aggregate(ent.Count(), ent.As(ent.Sum(shareaccess.FieldBytes), "bytes")).
+		Scan(ctx, &byAction)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to aggregate share accesses", err)
+		return
+	}
+
+	actions := map[string]int{}
+	var bytesServed int64
+	for _, a := range byAction {
+		actions[string(a.Action)] = a.Count
+		bytesServed += a.Bytes
+	}
+
+	// Unique visitors are counted by client IP
+	visitors, err := accesses.Clone().
+		Unique(true).
+		Select(shareaccess.FieldIP).
+		Strings(ctx)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to count visitors", err)
+		return
+	}
+
+	// Downloads per file
+	var byNode []struct {
+		NodeID *int  `json:"node_id"`
+		Count  int   `json:"count"`
+		Bytes  int64 `json:"bytes"`
+	}
+	err = accesses.Clone().
+		Where(shareaccess.ActionEQ(shareaccess.ActionDownload)).
+		GroupBy(shareaccess.FieldNodeID).
+		Aggregate(ent.Count(), ent.As(ent.Sum(shareaccess.FieldBytes), "bytes")).
+		Scan(ctx, &byNode)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to aggregate downloads", err)
+		return
+	}
+
+	var nodeIDs []int
+	for _, d := range byNode {
+		if d.NodeID != nil {
+			nodeIDs = append(nodeIDs, *d.NodeID)
+		}
+	}
+	names := make(map[int]string)
+	if len(nodeIDs) > 0 {
+		nodes, err := database.Client.Node.Query().
+			Where(node.IDIn(nodeIDs...)).
+			All(ctx)
+		if err != nil {
+			apierr.AbortInternal(c, "Failed to get files", err)
+			return
+		}
+		for _, n := range nodes {
+			names[n.ID] = n.Name
+		}
+	}
+
+	files := make([]ShareFileDownloads, len(byNode))
+	for i, d := range byNode {
+		item := ShareFileDownloads{
+			NodeID:    d.NodeID,
+			Downloads: d.Count,
+			Bytes:     d.Bytes,
+		}
+		if d.NodeID != nil {
+			item.Name = names[*d.NodeID]
+		}
+		files[i] = item
+	}
+
+	// Last access time
+	var lastAccessAt *time.Time
+	last, err := accesses.Clone().
+		Order(ent.Desc(shareaccess.FieldCreatedAt)).
+		First(ctx)
+	if err == nil {
+		lastAccessAt = &last.CreatedAt
+	}
+
+	c.JSON(http.StatusOK, ShareStatsResponse{
+		ShareID:        s.ID,
+		Code:           s.Code,
+		AccessCount:    s.AccessCount,
+		DownloadCount:  s.DownloadCount,
+		UniqueVisitors: len(visitors),
+		BytesServed:    bytesServed,
+		Actions:        actions,
+		Files:          files,
+		LastAccessAt:   lastAccessAt,
+	})
+}
+
+// GetShareAccesses handles GET /api/shares/stats/:id/accesses - Get access log for my share
+func (h *ShareHandler) GetShareAccesses(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "50"))
+	action := c.Query("action")
+
+	ctx := c.Request.Context()
+
+	s, ok := getOwnedShare(c)
+	if !ok {
+		return
+	}
+
+	query := database.Client.ShareAccess.Query().
+		Where(shareaccess.ShareIDEQ(s.ID))
+	if action != "" {
+		if err := shareaccess.ActionValidator(shareaccess.Action(action)); err != nil {
+			apierr.AbortInvalid(c, apierr.FieldError{Field: "action", Rule: "oneof", Param: "view list preview download"})
+			return
+		}
+		query = query.Where(shareaccess.ActionEQ(shareaccess.Action(action)))
+	}
+
+	// Get total count
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to count accesses", err)
+		return
+	}
+
+	// Apply pagination
+	offset := (page - 1) * pageSize
+	events, err := query.
+		Order(ent.Desc(shareaccess.FieldCreatedAt)).
+		Offset(offset).
+		Limit(pageSize).
+		WithNode().
+		All(ctx)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to get accesses", err)
+		return
+	}
+
+	// Format response
+	result := make([]ShareAccessResponse, len(events))
+	for i, e := range events {
+		item := ShareAccessResponse{
+			ID:        e.ID,
+			Action:    e.Action,
+			IP:        e.IP,
+			UserAgent: e.UserAgent,
+			Bytes:     e.Bytes,
+			NodeID:    e.NodeID,
+			CreatedAt: e.CreatedAt,
+		}
+		if n := e.Edges.Node; n != nil {
+			item.NodeName = n.Name
+		}
+		result[i] = item
+	}
+
+	c.JSON(http.StatusOK, ShareAccessListResponse{
+		Accesses: result,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	})
+}
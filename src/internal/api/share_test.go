@@ -0,0 +1,230 @@
+package api
+
+import (
+	"context"
+	"gopan-server/ent"
+	"gopan-server/ent/share"
+	"gopan-server/internal/dbtest"
+	"gopan-server/internal/jobs"
+	"gopan-server/internal/storage"
+	"gopan-server/internal/storage/storagetest"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/minio/minio-go/v7"
+)
+
+// shareFixture serves the API with a shared text file and returns the
+// server URL and the share
+func shareFixture(t *testing.T, maxViews, maxDownloads int) (string, *ent.Client, *ent.Share) {
+	t.Helper()
+	client := dbtest.Open(t)
+	_, minioCfg := storagetest.Open(t)
+	cfg := testConfig(t, `{"jwt": {"secret": "test"}}`)
+	cfg.MinIO = minioCfg
+	srv := newTestServer(t, cfg, nil)
+	ctx := context.Background()
+
+	const content = "shared notes"
+	_, err := storage.GetClient().PutObject(ctx, minioCfg.BucketName, "notes", strings.NewReader(content), int64(len(content)), minio.PutObjectOptions{})
+	if err != nil {
+		t.Fatalf("store object: %v", err)
+	}
+	owner := client.User.Create().SetUsername("alice").SetPasswordHash("x").SetTotalQuota(1 << 30).SaveX(ctx)
+	file := client.Node.Create().
+		SetName("notes.txt").
+		SetType(1).
+		SetSize(int64(len(content))).
+		SetMimeType("text/plain").
+		SetMinioObject("notes").
+		SetOwner(owner).
+		SaveX(ctx)
+	s := client.Share.Create().
+		SetCode("abcdefgh").
+		SetOwner(owner).
+		SetNode(file).
+		SetMaxAccessCount(maxViews).
+		SetMaxDownloadCount(maxDownloads).
+		SaveX(ctx)
+	return srv.URL, client, s
+}
+
+// get requests path and returns the status code, draining the body
+func get(t *testing.T, url string) int {
+	t.Helper()
+	resp, err := http.Get(url)
+	if err != nil {
+		t.Fatalf("GET %s: %v", url, err)
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+	return resp.StatusCode
+}
+
+func TestSharePreviewCountsAsDownload(t *testing.T) {
+	url, client, s := shareFixture(t, 0, 1)
+	preview := url + "/api/shares/abcdefgh/preview/" + strconv.Itoa(s.QueryNode().OnlyIDX(context.Background()))
+
+	if code := get(t, preview); code != http.StatusOK {
+		t.Fatalf("first preview: HTTP %d", code)
+	}
+	if code := get(t, preview); code != http.StatusForbidden {
+		t.Errorf("preview past the download limit: HTTP %d, want 403", code)
+	}
+	if code := get(t, url+"/api/shares/abcdefgh/download"); code != http.StatusForbidden {
+		t.Errorf("download past the download limit: HTTP %d, want 403", code)
+	}
+	if got := client.Share.GetX(context.Background(), s.ID).DownloadCount; got != 1 {
+		t.Errorf("download count = %d, want 1", got)
+	}
+}
+
+func TestShareLimitsHoldUnderConcurrency(t *testing.T) {
+	tests := []struct {
+		name      string
+		path      string
+		views     int
+		downloads int
+		count     func(s *ent.Share) int
+	}{
+		{"views", "/api/shares/abcdefgh", 3, 0, func(s *ent.Share) int { return s.AccessCount }},
+		{"downloads", "/api/shares/abcdefgh/download", 0, 3, func(s *ent.Share) int { return s.DownloadCount }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			url, client, s := shareFixture(t, tt.views, tt.downloads)
+
+			var mu sync.Mutex
+			var wg sync.WaitGroup
+			statuses := map[int]int{}
+			for range 20 {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					code := get(t, url+tt.path)
+					mu.Lock()
+					statuses[code]++
+					mu.Unlock()
+				}()
+			}
+			wg.Wait()
+
+			if statuses[http.StatusOK] != 3 || statuses[http.StatusForbidden] != 17 {
+				t.Errorf("responses = %v, want 3 OK and 17 forbidden", statuses)
+			}
+			if got := tt.count(client.Share.GetX(context.Background(), s.ID)); got != 3 {
+				t.Errorf("counter = %d, want 3", got)
+			}
+		})
+	}
+}
+
+func TestShareSweepKeepsRemainingAction(t *testing.T) {
+	tests := []struct {
+		name      string
+		views     int
+		downloads int
+		want      share.Status
+		viewCode  int
+	}{
+		{"download limit only", 0, 1, share.StatusActive, http.StatusOK},
+		{"view limit left", 2, 1, share.StatusActive, http.StatusOK},
+		{"both limits", 1, 1, share.StatusExhausted, http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			url, client, s := shareFixture(t, tt.views, tt.downloads)
+			ctx := context.Background()
+
+			if tt.views == 1 {
+				if code := get(t, url+"/api/shares/abcdefgh"); code != http.StatusOK {
+					t.Fatalf("view: HTTP %d", code)
+				}
+			}
+			if code := get(t, url+"/api/shares/abcdefgh/download"); code != http.StatusOK {
+				t.Fatalf("download: HTTP %d", code)
+			}
+			if err := jobs.SweepShares(ctx, 0); err != nil {
+				t.Fatalf("SweepShares: %v", err)
+			}
+
+			if got := client.Share.GetX(ctx, s.ID).Status; got != tt.want {
+				t.Errorf("status after the sweep = %s, want %s", got, tt.want)
+			}
+			if code := get(t, url+"/api/shares/abcdefgh"); code != tt.viewCode {
+				t.Errorf("view after the sweep: HTTP %d, want %d", code, tt.viewCode)
+			}
+			if code := get(t, url+"/api/shares/abcdefgh/download"); code != http.StatusForbidden {
+				t.Errorf("download after the sweep: HTTP %d, want 403", code)
+			}
+		})
+	}
+}
+
+func TestShareLookupThrottle(t *testing.T) {
+	url, _, _ := shareFixture(t, 0, 0)
+
+	var code int
+	for i := range 20 {
+		code = get(t, url+"/api/shares/unknown"+strconv.Itoa(i))
+	}
+	if code != http.StatusTooManyRequests {
+		t.Fatalf("lookup of an unknown code after many misses: HTTP %d, want 429", code)
+	}
+
+	// Existing links and logins from the same address are not blocked
+	if code := get(t, url+"/api/shares/abcdefgh"); code != http.StatusOK {
+		t.Errorf("lookup of an existing code: HTTP %d, want 200", code)
+	}
+	resp, err := http.Post(url+"/api/auth/login", "application/json", strings.NewReader(`{"username": "alice", "password": "wrong"}`))
+	if err != nil {
+		t.Fatalf("login: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("login from the same address: HTTP %d, want 401", resp.StatusCode)
+	}
+}
+
+func TestSharePageIgnoresHostHeader(t *testing.T) {
+	tests := []struct {
+		name    string
+		baseURL string
+		want    string
+	}{
+		{"configured", "https://pan.example.com", `<meta property="og:url" content="https://pan.example.com/s/abcdefgh">`},
+		{"not configured", "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shareFixture(t, 0, 0)
+			cfg := testConfig(t, `{"jwt": {"secret": "test"}}`)
+			cfg.Server.BaseURL = tt.baseURL
+			router := gin.New()
+			router.GET("/s/:code", NewSharePageHandler(cfg, []byte("<title>GoPan</title>")).RenderSharePage)
+
+			req := httptest.NewRequest(http.MethodGet, "/s/abcdefgh", nil)
+			req.Host = "evil.example"
+			req.Header.Set("X-Forwarded-Host", "evil.example")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			body := w.Body.String()
+			if w.Code != http.StatusOK || strings.Contains(body, "evil.example") {
+				t.Fatalf("HTTP %d, page %s", w.Code, body)
+			}
+			if tt.want != "" && !strings.Contains(body, tt.want) {
+				t.Errorf("page %s does not contain %s", body, tt.want)
+			}
+			if tt.want == "" && strings.Contains(body, "og:url") {
+				t.Errorf("page %s has og:url without a configured base URL", body)
+			}
+		})
+	}
+}
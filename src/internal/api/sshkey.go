@@ -0,0 +1,195 @@
+package api
+
+import (
+	"gopan-server/config"
+	"gopan-server/ent"
+	"gopan-server/ent/sshkey"
+	"gopan-server/internal/apierr"
+	"gopan-server/internal/database"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/crypto/ssh"
+)
+
+// SSHKeyHandler handles the SSH public keys of the current user
+type SSHKeyHandler struct {
+	cfg *config.Config
+}
+
+func NewSSHKeyHandler(cfg *config.Config) *SSHKeyHandler {
+	return &SSHKeyHandler{cfg: cfg}
+}
+
+// AddSSHKeyRequest represents a request to add an SSH public key
+type AddSSHKeyRequest struct {
+	Name      string `json:"name" binding:"max=100"`
+	PublicKey string `json:"public_key" binding:"required"`
+}
+
+// SSHKeyResponse represents an SSH public key
+type SSHKeyResponse struct {
+	ID          int        `json:"id"`
+	Name        string     `json:"name"`
+	Type        string     `json:"type"` // Key algorithm, such as ssh-ed25519
+	Fingerprint string     `json:"fingerprint"`
+	LastUsedAt  *time.Time `json:"last_used_at"`
+	LastUsedIP  string     `json:"last_used_ip"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// SSHKeyListResponse represents the SSH public keys of a user and where to use them
+type SSHKeyListResponse struct {
+	Keys []SSHKeyResponse `json:"keys"`
+	Port int              `json:"port"`
+}
+
+// formatSSHKey formats an SSH public key
+func formatSSHKey(k *ent.SSHKey) SSHKeyResponse {
+	keyType, _, _ := strings.Cut(k.PublicKey, " ")
+	return SSHKeyResponse{
+		ID:          k.ID,
+		Name:        k.Name,
+		Type:        keyType,
+		Fingerprint: k.Fingerprint,
+		LastUsedAt:  k.LastUsedAt,
+		LastUsedIP:  k.LastUsedIP,
+		CreatedAt:   k.CreatedAt,
+	}
+}
+
+// GetSSHKeys handles GET /api/user/ssh-keys - List my SSH public keys
+func (h *SSHKeyHandler) GetSSHKeys(c *gin.Context) {
+	userID := c.GetString("userID")
+
+	ctx := c.Request.Context()
+
+	// Parse user ID
+	uid, err := parseUserID(userID)
+	if err != nil {
+		apierr.Abort(c, apierr.Unauthenticated)
+		return
+	}
+
+	keys, err := database.Client.SSHKey.Query().
+		Where(sshkey.UserIDEQ(uid)).
+		Order(ent.Desc(sshkey.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to get SSH keys", err)
+		return
+	}
+
+	result := make([]SSHKeyResponse, len(keys))
+	for i, k := range keys {
+		result[i] = formatSSHKey(k)
+	}
+
+	c.JSON(http.StatusOK, SSHKeyListResponse{
+		Keys: result,
+		Port: h.cfg.SFTP.Port,
+	})
+}
+
+// AddSSHKey handles POST /api/user/ssh-keys - Add SSH public key
+// The key is given in authorized_keys format. Its comment is the default name.
+func (h *SSHKeyHandler) AddSSHKey(c *gin.Context) {
+	userID := c.GetString("userID")
+
+	var req AddSSHKeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apierr.AbortBind(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	// Parse user ID
+	uid, err := parseUserID(userID)
+	if err != nil {
+		apierr.Abort(c, apierr.Unauthenticated)
+		return
+	}
+
+	key, comment, _, rest, err := ssh.ParseAuthorizedKey([]byte(req.PublicKey))
+	if err != nil || strings.TrimSpace(string(rest)) != "" {
+		apierr.AbortInvalid(c, apierr.FieldError{Field: "public_key", Rule: "authorized_key"})
+		return
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		name = comment
+	}
+	if name == "" {
+		name = key.Type()
+	}
+	if len(name) > 100 {
+		name = name[:100]
+	}
+
+	fingerprint := ssh.FingerprintSHA256(key)
+	exists, err := database.Client.SSHKey.Query().
+		Where(sshkey.UserIDEQ(uid)).
+		Where(sshkey.FingerprintEQ(fingerprint)).
+		Exist(ctx)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to add SSH key", err)
+		return
+	}
+	if exists {
+		apierr.Abort(c, apierr.SSHKeyExists)
+		return
+	}
+
+	k, err := database.Client.SSHKey.Create().
+		SetUserID(uid).
+		SetName(name).
+		SetPublicKey(strings.TrimSpace(string(ssh.MarshalAuthorizedKey(key)))).
+		SetFingerprint(fingerprint).
+		Save(ctx)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to add SSH key", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, formatSSHKey(k))
+}
+
+// DeleteSSHKey handles DELETE /api/user/ssh-keys/:id - Remove SSH public key
+func (h *SSHKeyHandler) DeleteSSHKey(c *gin.Context) {
+	userID := c.GetString("userID")
+
+	ctx := c.Request.Context()
+
+	// Parse user ID
+	uid, err := parseUserID(userID)
+	if err != nil {
+		apierr.Abort(c, apierr.Unauthenticated)
+		return
+	}
+
+	keyID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		apierr.AbortInvalid(c, apierr.FieldError{Field: "id", Rule: "number"})
+		return
+	}
+
+	deleted, err := database.Client.SSHKey.Delete().
+		Where(sshkey.IDEQ(keyID)).
+		Where(sshkey.UserIDEQ(uid)).
+		Exec(ctx)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to remove SSH key", err)
+		return
+	}
+	if deleted == 0 {
+		apierr.Abort(c, apierr.SSHKeyNotFound)
+		return
+	}
+
+	c.JSON(http.StatusOK, MessageResponse{Message: "SSH key removed"})
+}
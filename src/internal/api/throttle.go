@@ -0,0 +1,61 @@
+package api
+
+import (
+	"gopan-server/ent/authfailure"
+	"gopan-server/internal/apierr"
+	"gopan-server/internal/logger"
+	"gopan-server/internal/throttle"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// newAttempt describes an attempt of the current request for auditing
+func newAttempt(c *gin.Context, kind authfailure.Kind, subject string) throttle.Attempt {
+	return throttle.Attempt{
+		Kind:      kind,
+		Subject:   subject,
+		IP:        c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+	}
+}
+
+// checkThrottle rejects the attempt with 429 when one of limits is blocked,
+// auditing the rejected attempt. It returns false when the attempt must stop.
+func checkThrottle(c *gin.Context, t *throttle.Throttler, limits []throttle.Limit, attempt throttle.Attempt) bool {
+	ctx := c.Request.Context()
+
+	block, err := t.Check(ctx, limits)
+	if err != nil {
+		// Don't lock everyone out when the throttle state is unavailable
+		logger.Error.Printf("Failed to check throttle: %v", err)
+		return true
+	}
+	if block == nil {
+		return true
+	}
+
+	attempt.Reason = authfailure.ReasonThrottled
+	code := apierr.TooManyAttempts
+	if block.Locked {
+		attempt.Reason = authfailure.ReasonLocked
+		code = apierr.AccountLocked
+	}
+	throttle.Audit(ctx, attempt)
+
+	c.Header("Retry-After", strconv.Itoa(block.RetryAfter()))
+	apierr.AbortWithDetails(c, code, apierr.Details{"retry_after": block.RetryAfter()})
+	return false
+}
+
+// failAttempt counts a failed attempt against limits and audits it
+func failAttempt(c *gin.Context, t *throttle.Throttler, limits []throttle.Limit, attempt throttle.Attempt, reason authfailure.Reason) {
+	ctx := c.Request.Context()
+
+	attempt.Reason = reason
+	throttle.Audit(ctx, attempt)
+
+	if err := t.Fail(ctx, limits); err != nil {
+		logger.Error.Printf("Failed to record failed attempt: %v", err)
+	}
+}
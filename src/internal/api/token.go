@@ -0,0 +1,218 @@
+package api
+
+import (
+	"gopan-server/config"
+	"gopan-server/ent"
+	"gopan-server/ent/accesstoken"
+	"gopan-server/internal/apierr"
+	"gopan-server/internal/auth"
+	"gopan-server/internal/database"
+	"gopan-server/internal/pat"
+	"gopan-server/internal/permission"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TokenHandler handles personal access tokens of the current user
+type TokenHandler struct {
+	cfg *config.Config
+}
+
+func NewTokenHandler(cfg *config.Config) *TokenHandler {
+	return &TokenHandler{cfg: cfg}
+}
+
+// CreateTokenRequest represents a request to create a personal access token
+type CreateTokenRequest struct {
+	Name      string     `json:"name" binding:"required,max=100"`
+	Scope     string     `json:"scope" binding:"omitempty,oneof=full read upload"`
+	FolderID  *int       `json:"folder_id"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+// TokenResponse represents a personal access token
+type TokenResponse struct {
+	ID          int               `json:"id"`
+	Name        string            `json:"name"`
+	TokenPrefix string            `json:"token_prefix"` // Start of the token, to tell tokens apart
+	Scope       accesstoken.Scope `json:"scope"`
+	FolderID    *int              `json:"folder_id"` // Folder the token is restricted to
+	FolderName  string            `json:"folder_name,omitempty"`
+	ExpiresAt   *time.Time        `json:"expires_at"`
+	LastUsedAt  *time.Time        `json:"last_used_at"`
+	LastUsedIP  string            `json:"last_used_ip"`
+	CreatedAt   time.Time         `json:"created_at"`
+}
+
+// TokenListResponse represents the access tokens of a user
+type TokenListResponse struct {
+	Tokens []TokenResponse `json:"tokens"`
+}
+
+// CreateTokenResponse represents a created access token with the token itself
+type CreateTokenResponse struct {
+	TokenResponse
+	Token string `json:"token"`
+}
+
+// formatToken formats an access token with its folder edge loaded, never including the secret
+func formatToken(t *ent.AccessToken) TokenResponse {
+	item := TokenResponse{
+		ID:          t.ID,
+		Name:        t.Name,
+		TokenPrefix: t.TokenPrefix,
+		Scope:       t.Scope,
+		FolderID:    t.FolderID,
+		ExpiresAt:   t.ExpiresAt,
+		LastUsedAt:  t.LastUsedAt,
+		LastUsedIP:  t.LastUsedIP,
+		CreatedAt:   t.CreatedAt,
+	}
+	if f := t.Edges.Folder; f != nil {
+		item.FolderName = f.Name
+	}
+	return item
+}
+
+// GetTokens handles GET /api/user/tokens - List my access tokens
+func (h *TokenHandler) GetTokens(c *gin.Context) {
+	userID := c.GetString("userID")
+
+	ctx := c.Request.Context()
+
+	// Parse user ID
+	uid, err := parseUserID(userID)
+	if err != nil {
+		apierr.Abort(c, apierr.Unauthenticated)
+		return
+	}
+
+	tokens, err := database.Client.AccessToken.Query().
+		Where(accesstoken.UserIDEQ(uid)).
+		WithFolder().
+		Order(ent.Desc(accesstoken.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to get tokens", err)
+		return
+	}
+
+	result := make([]TokenResponse, len(tokens))
+	for i, t := range tokens {
+		result[i] = formatToken(t)
+	}
+
+	c.JSON(http.StatusOK, TokenListResponse{Tokens: result})
+}
+
+// CreateToken handles POST /api/user/tokens - Create access token
+// The token itself is only returned in this response.
+func (h *TokenHandler) CreateToken(c *gin.Context) {
+	userID := c.GetString("userID")
+
+	var req CreateTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apierr.AbortBind(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	// Parse user ID
+	uid, err := parseUserID(userID)
+	if err != nil {
+		apierr.Abort(c, apierr.Unauthenticated)
+		return
+	}
+
+	if req.ExpiresAt != nil && req.ExpiresAt.Before(time.Now()) {
+		apierr.AbortInvalid(c, apierr.FieldError{Field: "expires_at", Rule: "future"})
+		return
+	}
+
+	// A folder restricted token must point at a folder the user can access
+	if req.FolderID != nil {
+		folder, ok := getAccessibleNode(c, uid, *req.FolderID, permission.RoleRead)
+		if !ok {
+			return
+		}
+		if folder.Type != 0 {
+			apierr.Abort(c, apierr.NotAFolder)
+			return
+		}
+	}
+
+	token, err := pat.Generate()
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to generate token", err)
+		return
+	}
+
+	create := database.Client.AccessToken.Create().
+		SetUserID(uid).
+		SetName(req.Name).
+		SetTokenHash(auth.HashToken(token)).
+		SetTokenPrefix(token[:len(pat.Prefix)+6]).
+		SetNillableFolderID(req.FolderID).
+		SetNillableExpiresAt(req.ExpiresAt)
+	if req.Scope != "" {
+		create.SetScope(accesstoken.Scope(req.Scope))
+	}
+	t, err := create.Save(ctx)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to create token", err)
+		return
+	}
+
+	t, err = database.Client.AccessToken.Query().
+		Where(accesstoken.IDEQ(t.ID)).
+		WithFolder().
+		Only(ctx)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to get token", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, CreateTokenResponse{
+		TokenResponse: formatToken(t),
+		Token:         token,
+	})
+}
+
+// DeleteToken handles DELETE /api/user/tokens/:id - Revoke access token
+func (h *TokenHandler) DeleteToken(c *gin.Context) {
+	userID := c.GetString("userID")
+
+	ctx := c.Request.Context()
+
+	// Parse user ID
+	uid, err := parseUserID(userID)
+	if err != nil {
+		apierr.Abort(c, apierr.Unauthenticated)
+		return
+	}
+
+	tokenID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		apierr.AbortInvalid(c, apierr.FieldError{Field: "id", Rule: "number"})
+		return
+	}
+
+	deleted, err := database.Client.AccessToken.Delete().
+		Where(accesstoken.IDEQ(tokenID)).
+		Where(accesstoken.UserIDEQ(uid)).
+		Exec(ctx)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to revoke token", err)
+		return
+	}
+	if deleted == 0 {
+		apierr.Abort(c, apierr.TokenNotFound)
+		return
+	}
+
+	c.JSON(http.StatusOK, MessageResponse{Message: "Token revoked"})
+}
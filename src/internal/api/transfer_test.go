@@ -0,0 +1,142 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"gopan-server/client"
+	"gopan-server/config"
+	"gopan-server/internal/dbtest"
+	"gopan-server/internal/storage/storagetest"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// serverTimeout stands in for the read and write timeouts of the server
+const serverTimeout = 200 * time.Millisecond
+
+// transferSize is large enough to fill the socket buffers, so the server
+// keeps writing for as long as the client keeps reading
+const transferSize = 32 << 20
+
+// newTimeoutServer serves the API like main does, with short timeouts, and
+// returns the server URL and a client signed in as alice
+func newTimeoutServer(t *testing.T, configure func(cfg *config.Config)) (string, *client.Client) {
+	t.Helper()
+	dbtest.Open(t)
+	_, minioCfg := storagetest.Open(t)
+	cfg := testConfig(t, `{"jwt": {"secret": "test"}}`)
+	cfg.MinIO = minioCfg
+	if configure != nil {
+		configure(cfg)
+	}
+
+	srv := httptest.NewUnstartedServer(SetupRouter(cfg))
+	srv.Config.ReadTimeout = serverTimeout
+	srv.Config.WriteTimeout = serverTimeout
+	srv.Start()
+	t.Cleanup(srv.Close)
+
+	c := client.New(srv.URL)
+	_, err := c.Register(context.Background(), client.RegisterRequest{Username: "alice", Password: "alice-password"})
+	if err != nil {
+		t.Fatalf("register: %v", err)
+	}
+	return srv.URL, c
+}
+
+// slowReader delivers data in 16 chunks with a pause before each, taking
+// several server timeouts in total
+type slowReader struct {
+	data []byte
+	left int // Bytes left in the current chunk
+}
+
+func (r *slowReader) Read(p []byte) (int, error) {
+	if len(r.data) == 0 {
+		return 0, io.EOF
+	}
+	if r.left == 0 {
+		time.Sleep(serverTimeout / 4)
+		r.left = transferSize / 16
+	}
+	n := copy(p[:min(len(p), r.left)], r.data)
+	r.data = r.data[n:]
+	r.left -= n
+	return n, nil
+}
+
+// readSlowly reads body in chunks with a pause before each and returns what
+// was read until the body ended or broke off
+func readSlowly(t *testing.T, body io.Reader) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	chunk := make([]byte, transferSize/16)
+	for {
+		time.Sleep(serverTimeout / 4)
+		n, err := io.ReadFull(body, chunk)
+		buf.Write(chunk[:n])
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return buf.Bytes()
+		}
+		if err != nil {
+			t.Fatalf("read: %v", err)
+		}
+	}
+}
+
+func TestWebDAVOutlastsServerTimeouts(t *testing.T) {
+	url, _ := newTimeoutServer(t, func(cfg *config.Config) {
+		cfg.WebDAV.Enabled = true
+	})
+	data := bytes.Repeat([]byte("0123456789abcdef"), transferSize/16)
+
+	req, err := http.NewRequest(http.MethodPut, url+"/dav/big.bin", &slowReader{data: data})
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.ContentLength = int64(len(data))
+	req.SetBasicAuth("alice", "alice-password")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("slow PUT: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("slow PUT: HTTP %d", resp.StatusCode)
+	}
+
+	req, err = http.NewRequest(http.MethodGet, url+"/dav/big.bin", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.SetBasicAuth("alice", "alice-password")
+	resp, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("GET: %v", err)
+	}
+	defer resp.Body.Close()
+	if got := readSlowly(t, resp.Body); !bytes.Equal(got, data) {
+		t.Errorf("slow GET read %d of %d bytes", len(got), len(data))
+	}
+}
+
+func TestFSTransfersOutlastServerTimeouts(t *testing.T) {
+	_, c := newTimeoutServer(t, nil)
+	ctx := context.Background()
+	data := bytes.Repeat([]byte("0123456789abcdef"), transferSize/16)
+
+	if _, err := c.Upload(ctx, "/big.bin", &slowReader{data: data}, int64(len(data)), true); err != nil {
+		t.Fatalf("slow Upload: %v", err)
+	}
+	body, err := c.Download(ctx, "/big.bin", 0)
+	if err != nil {
+		t.Fatalf("Download: %v", err)
+	}
+	defer body.Close()
+	if got := readSlowly(t, body); !bytes.Equal(got, data) {
+		t.Errorf("slow Download read %d of %d bytes", len(got), len(data))
+	}
+}
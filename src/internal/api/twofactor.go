@@ -0,0 +1,269 @@
+package api
+
+import (
+	"encoding/base64"
+	"gopan-server/config"
+	"gopan-server/ent"
+	"gopan-server/internal/account"
+	"gopan-server/internal/apierr"
+	"gopan-server/internal/auth"
+	"gopan-server/internal/database"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/skip2/go-qrcode"
+)
+
+// totpIssuer is the account issuer shown in authenticator apps
+const totpIssuer = "GoPan"
+
+// TwoFactorHandler handles TOTP two-factor authentication of the current user
+type TwoFactorHandler struct {
+	cfg *config.Config
+}
+
+func NewTwoFactorHandler(cfg *config.Config) *TwoFactorHandler {
+	return &TwoFactorHandler{cfg: cfg}
+}
+
+// TwoFactorCodeRequest represents a request confirmed with a TOTP or recovery code
+type TwoFactorCodeRequest struct {
+	Code string `json:"code" binding:"required"`
+}
+
+// DisableTwoFactorRequest represents a request to turn off two-factor authentication
+type DisableTwoFactorRequest struct {
+	Password string `json:"password" binding:"required"`
+	Code     string `json:"code" binding:"required"`
+}
+
+// TwoFactorStatusResponse represents the two-factor authentication status
+type TwoFactorStatusResponse struct {
+	Enabled                bool `json:"enabled"`
+	RecoveryCodesRemaining int  `json:"recovery_codes_remaining"`
+}
+
+// TwoFactorSetupResponse represents a new TOTP secret to enroll in an authenticator app
+type TwoFactorSetupResponse struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioning_uri"` // otpauth:// URI
+	QRCode          string `json:"qr_code"`          // PNG data URL of the URI
+}
+
+// RecoveryCodesResponse represents newly generated recovery codes
+type RecoveryCodesResponse struct {
+	Message       string   `json:"message,omitempty"`
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// getCurrentUser loads the authenticated user, writing an error response on failure
+func getCurrentUser(c *gin.Context) (*ent.User, bool) {
+	uid, err := parseUserID(c.GetString("userID"))
+	if err != nil {
+		apierr.Abort(c, apierr.Unauthenticated)
+		return nil, false
+	}
+
+	u, err := database.Client.User.Get(c.Request.Context(), uid)
+	if err != nil {
+		apierr.Abort(c, apierr.UserNotFound)
+		return nil, false
+	}
+	return u, true
+}
+
+// GetTwoFactor handles GET /api/user/2fa - Get two-factor authentication status
+func (h *TwoFactorHandler) GetTwoFactor(c *gin.Context) {
+	u, ok := getCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, TwoFactorStatusResponse{
+		Enabled:                u.TotpEnabled,
+		RecoveryCodesRemaining: len(u.TotpRecoveryCodes),
+	})
+}
+
+// SetupTwoFactor handles POST /api/user/2fa/setup - Start enrollment with a new secret
+// The secret only takes effect once confirmed through EnableTwoFactor.
+func (h *TwoFactorHandler) SetupTwoFactor(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	u, ok := getCurrentUser(c)
+	if !ok {
+		return
+	}
+	if u.TotpEnabled {
+		apierr.Abort(c, apierr.TwoFactorAlreadyEnabled)
+		return
+	}
+
+	secret, err := auth.GenerateTOTPSecret()
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to generate secret", err)
+		return
+	}
+
+	err = u.Update().
+		SetTotpSecret(secret).
+		SetTotpLastStep(0).
+		Exec(ctx)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to save secret", err)
+		return
+	}
+
+	uri := auth.TOTPURI(totpIssuer, u.Username, secret)
+	png, err := qrcode.Encode(uri, qrcode.Medium, 256)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to generate QR code", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, TwoFactorSetupResponse{
+		Secret:          secret,
+		ProvisioningURI: uri,
+		QRCode:          "data:image/png;base64," + base64.StdEncoding.EncodeToString(png),
+	})
+}
+
+// EnableTwoFactor handles POST /api/user/2fa/enable - Confirm enrollment with a code
+// The recovery codes are only returned in this response.
+func (h *TwoFactorHandler) EnableTwoFactor(c *gin.Context) {
+	var req TwoFactorCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apierr.AbortBind(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	u, ok := getCurrentUser(c)
+	if !ok {
+		return
+	}
+	if u.TotpEnabled {
+		apierr.Abort(c, apierr.TwoFactorAlreadyEnabled)
+		return
+	}
+	if u.TotpSecret == "" {
+		apierr.Abort(c, apierr.TwoFactorSetupRequired)
+		return
+	}
+
+	step, valid := auth.ValidateTOTP(u.TotpSecret, req.Code, time.Now())
+	if !valid {
+		apierr.Abort(c, apierr.InvalidVerificationCode)
+		return
+	}
+
+	codes, hashes, err := account.NewRecoveryCodes()
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to generate recovery codes", err)
+		return
+	}
+
+	err = u.Update().
+		SetTotpEnabled(true).
+		SetTotpLastStep(step).
+		SetTotpRecoveryCodes(hashes).
+		Exec(ctx)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to enable two-factor authentication", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, RecoveryCodesResponse{
+		Message:       "Two-factor authentication enabled",
+		RecoveryCodes: codes,
+	})
+}
+
+// DisableTwoFactor handles POST /api/user/2fa/disable - Turn off two-factor authentication
+func (h *TwoFactorHandler) DisableTwoFactor(c *gin.Context) {
+	var req DisableTwoFactorRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apierr.AbortBind(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	u, ok := getCurrentUser(c)
+	if !ok {
+		return
+	}
+	if !u.TotpEnabled {
+		apierr.Abort(c, apierr.TwoFactorNotEnabled)
+		return
+	}
+
+	if !account.VerifyPassword(h.cfg, u, req.Password) {
+		apierr.Abort(c, apierr.WrongPassword)
+		return
+	}
+	valid, err := account.VerifySecondFactor(ctx, u, req.Code)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to verify code", err)
+		return
+	}
+	if !valid {
+		apierr.Abort(c, apierr.InvalidVerificationCode)
+		return
+	}
+
+	if err := account.ResetTwoFactor(ctx, u.ID); err != nil {
+		apierr.AbortInternal(c, "Failed to disable two-factor authentication", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MessageResponse{Message: "Two-factor authentication disabled"})
+}
+
+// RegenerateRecoveryCodes handles POST /api/user/2fa/recovery-codes - Replace all recovery codes
+func (h *TwoFactorHandler) RegenerateRecoveryCodes(c *gin.Context) {
+	var req TwoFactorCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apierr.AbortBind(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	u, ok := getCurrentUser(c)
+	if !ok {
+		return
+	}
+	if !u.TotpEnabled {
+		apierr.Abort(c, apierr.TwoFactorNotEnabled)
+		return
+	}
+
+	valid, err := account.VerifySecondFactor(ctx, u, req.Code)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to verify code", err)
+		return
+	}
+	if !valid {
+		apierr.Abort(c, apierr.InvalidVerificationCode)
+		return
+	}
+
+	codes, hashes, err := account.NewRecoveryCodes()
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to generate recovery codes", err)
+		return
+	}
+
+	err = database.Client.User.UpdateOneID(u.ID).
+		SetTotpRecoveryCodes(hashes).
+		Exec(ctx)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to save recovery codes", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, RecoveryCodesResponse{RecoveryCodes: codes})
+}
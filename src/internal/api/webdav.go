@@ -0,0 +1,290 @@
+package api
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"gopan-server/config"
+	"gopan-server/ent"
+	"gopan-server/ent/accesstoken"
+	"gopan-server/ent/authfailure"
+	"gopan-server/ent/user"
+	"gopan-server/internal/account"
+	"gopan-server/internal/apierr"
+	"gopan-server/internal/database"
+	"gopan-server/internal/dav"
+	"gopan-server/internal/drive"
+	"gopan-server/internal/logger"
+	"gopan-server/internal/pat"
+	"gopan-server/internal/throttle"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/net/webdav"
+)
+
+// webdavMethods are the methods of WebDAV class 1 and 2
+var webdavMethods = []string{
+	http.MethodOptions, http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete,
+	"PROPFIND", "PROPPATCH", "MKCOL", "COPY", "MOVE", "LOCK", "UNLOCK",
+}
+
+// webdavReadMethods are the methods allowed with read-only access
+var webdavReadMethods = map[string]bool{
+	http.MethodOptions: true,
+	http.MethodGet:     true,
+	http.MethodHead:    true,
+	"PROPFIND":         true,
+}
+
+// webdavCredentialTTL is how long a verified password is remembered. WebDAV
+// clients send it with every request, and checking it is deliberately slow.
+const webdavCredentialTTL = time.Minute
+
+// webdavCredential is a remembered password check
+type webdavCredential struct {
+	userID       int
+	passwordHash string // Forgets the check when the password changes
+	expires      time.Time
+}
+
+type WebDAVHandler struct {
+	cfg      *config.Config
+	throttle *throttle.Throttler
+
+	mu          sync.Mutex
+	locks       map[string]webdav.LockSystem
+	credentials map[string]webdavCredential
+}
+
+func NewWebDAVHandler(cfg *config.Config) *WebDAVHandler {
+	return &WebDAVHandler{
+		cfg:         cfg,
+		throttle:    throttle.New(&cfg.Security),
+		locks:       make(map[string]webdav.LockSystem),
+		credentials: make(map[string]webdavCredential),
+	}
+}
+
+// ServeWebDAV handles the WebDAV endpoint - Mount my files as a network drive
+// Clients authenticate with basic auth, using the account password or a
+// personal access token as the password.
+func (h *WebDAVHandler) ServeWebDAV(c *gin.Context) {
+	fs, lockKey, ok := h.authenticate(c)
+	if !ok {
+		return
+	}
+	clearDeadlines(c)
+
+	if fs.ReadOnly() && !webdavReadMethods[c.Request.Method] {
+		apierr.Abort(c, apierr.ReadOnly)
+		return
+	}
+
+	ctx := c.Request.Context()
+	if c.Request.Method == http.MethodPut {
+		// Reject uploads that cannot fit before receiving them
+		if c.Request.ContentLength > 0 {
+			available, err := fs.Available(ctx)
+			if err != nil {
+				apierr.AbortInternal(c, "Failed to get user info", err)
+				return
+			}
+			if c.Request.ContentLength > available {
+				apierr.Abort(c, apierr.QuotaExceeded)
+				return
+			}
+		}
+		ctx = dav.WithContentLength(ctx, c.Request.ContentLength)
+	}
+
+	handler := &webdav.Handler{
+		Prefix:     h.cfg.WebDAV.Prefix,
+		FileSystem: dav.New(fs),
+		LockSystem: h.lockSystem(lockKey),
+		Logger: func(r *http.Request, err error) {
+			if isWebDAVServerError(err) {
+				logger.Error.Printf("WebDAV %s %s failed: %v", r.Method, r.URL.Path, err)
+			}
+		},
+	}
+	handler.ServeHTTP(c.Writer, c.Request.WithContext(ctx))
+}
+
+// authenticate checks the basic auth credentials of the request and returns
+// the file tree they give access to, with the key of its lock namespace.
+// On failure it writes the error response and returns false.
+func (h *WebDAVHandler) authenticate(c *gin.Context) (*drive.FS, string, bool) {
+	username, password, ok := c.Request.BasicAuth()
+	if !ok || username == "" {
+		h.unauthorized(c, apierr.Unauthenticated)
+		return nil, "", false
+	}
+
+	if strings.HasPrefix(password, pat.Prefix) {
+		return h.authenticateToken(c, username, password)
+	}
+	if h.cfg.WebDAV.DisablePassword {
+		h.unauthorized(c, apierr.AccessTokenRequired)
+		return nil, "", false
+	}
+
+	u, ok := h.authenticatePassword(c, username, password)
+	if !ok {
+		return nil, "", false
+	}
+	fs := drive.New(h.cfg, u.ID, nil, u.Role == user.RoleReadonly)
+	return fs, fmt.Sprintf("%d", u.ID), true
+}
+
+// authenticateToken authenticates with a personal access token of the user
+func (h *WebDAVHandler) authenticateToken(c *gin.Context, username, token string) (*drive.FS, string, bool) {
+	ctx := c.Request.Context()
+
+	t, err := pat.Lookup(ctx, token)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to check token", err)
+		return nil, "", false
+	}
+	if t == nil || t.Edges.User.Username != username {
+		h.unauthorized(c, apierr.InvalidToken)
+		return nil, "", false
+	}
+	if t.Scope == accesstoken.ScopeUpload {
+		apierr.Abort(c, apierr.TokenScopeDenied)
+		return nil, "", false
+	}
+	if err := pat.Touch(ctx, t, c.ClientIP()); err != nil {
+		apierr.AbortInternal(c, "Failed to update token", err)
+		return nil, "", false
+	}
+
+	// Folder restricted tokens see their folder as the root
+	u := t.Edges.User
+	readOnly := t.Scope == accesstoken.ScopeRead || u.Role == user.RoleReadonly
+	lockKey := fmt.Sprintf("%d", u.ID)
+	if t.FolderID != nil {
+		lockKey = fmt.Sprintf("%d/%d", u.ID, *t.FolderID)
+	}
+	return drive.New(h.cfg, u.ID, t.FolderID, readOnly), lockKey, true
+}
+
+// authenticatePassword authenticates with the account password, throttled
+// like the login form
+func (h *WebDAVHandler) authenticatePassword(c *gin.Context, username, password string) (*ent.User, bool) {
+	ctx := c.Request.Context()
+
+	sum := sha256.Sum256([]byte(username + "\x00" + password))
+	key := hex.EncodeToString(sum[:])
+	if u := h.rememberedUser(c, key); u != nil {
+		return u, true
+	}
+
+	limits := h.throttle.LoginLimits(username, c.ClientIP())
+	attempt := newAttempt(c, authfailure.KindLogin, username)
+	if !checkThrottle(c, h.throttle, limits, attempt) {
+		return nil, false
+	}
+
+	u, err := account.Authenticate(ctx, h.cfg, username, password)
+	if errors.Is(err, account.ErrInvalidCredentials) {
+		failAttempt(c, h.throttle, limits, attempt, authfailure.ReasonInvalidCredentials)
+		h.unauthorized(c, apierr.InvalidCredentials)
+		return nil, false
+	}
+	if errors.Is(err, account.ErrUserDisabled) || (err == nil && u.IsDisabled) {
+		apierr.Abort(c, apierr.AccountDisabled)
+		return nil, false
+	}
+	if err != nil {
+		logger.Error.Printf("Authentication of %s failed: %v", username, err)
+		apierr.Abort(c, apierr.AuthUnavailable)
+		return nil, false
+	}
+
+	// A password alone must not get around the second factor
+	if u.TotpEnabled {
+		h.unauthorized(c, apierr.AccessTokenRequired)
+		return nil, false
+	}
+
+	if err := h.throttle.Reset(ctx, throttle.UserKey(username)); err != nil {
+		logger.Error.Printf("Failed to reset throttle: %v", err)
+	}
+
+	h.mu.Lock()
+	h.credentials[key] = webdavCredential{
+		userID:       u.ID,
+		passwordHash: u.PasswordHash,
+		expires:      time.Now().Add(webdavCredentialTTL),
+	}
+	h.mu.Unlock()
+	return u, true
+}
+
+// rememberedUser returns the user of a recently verified password, as long
+// as the user is still enabled and has not changed the password since
+func (h *WebDAVHandler) rememberedUser(c *gin.Context, key string) *ent.User {
+	now := time.Now()
+	h.mu.Lock()
+	cred, ok := h.credentials[key]
+	for k, v := range h.credentials {
+		if now.After(v.expires) {
+			delete(h.credentials, k)
+		}
+	}
+	h.mu.Unlock()
+	if !ok || now.After(cred.expires) {
+		return nil
+	}
+
+	u, err := database.Client.User.Get(c.Request.Context(), cred.userID)
+	if err != nil || u.IsDisabled || u.TotpEnabled || u.PasswordHash != cred.passwordHash {
+		return nil
+	}
+	return u
+}
+
+// lockSystem returns the WebDAV locks of a file tree. Locks are kept in
+// memory, like sessions of the clients holding them.
+func (h *WebDAVHandler) lockSystem(key string) webdav.LockSystem {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	ls, ok := h.locks[key]
+	if !ok {
+		ls = webdav.NewMemLS()
+		h.locks[key] = ls
+	}
+	return ls
+}
+
+// isWebDAVServerError reports whether a failed WebDAV request is worth
+// logging, leaving out what clients routinely cause
+func isWebDAVServerError(err error) bool {
+	clientErrors := []error{
+		os.ErrNotExist, os.ErrExist, os.ErrPermission,
+		drive.ErrIsFolder, drive.ErrInvalidName, drive.ErrInvalidMove, drive.ErrQuotaExceeded,
+		webdav.ErrLocked, webdav.ErrConfirmationFailed, webdav.ErrNoSuchLock, webdav.ErrForbidden,
+	}
+	if err == nil {
+		return false
+	}
+	for _, e := range clientErrors {
+		if errors.Is(err, e) {
+			return false
+		}
+	}
+	return true
+}
+
+// unauthorized asks the client for basic auth credentials
+func (h *WebDAVHandler) unauthorized(c *gin.Context, code apierr.Code) {
+	c.Header("WWW-Authenticate", `Basic realm="GoPan", charset="UTF-8"`)
+	apierr.Abort(c, code)
+}
@@ -0,0 +1,114 @@
+package api
+
+import (
+	"context"
+	"gopan-server/client"
+	"gopan-server/ent/accesstoken"
+	"gopan-server/internal/auth"
+	"gopan-server/internal/dbtest"
+	"gopan-server/internal/pat"
+	"gopan-server/internal/storage/storagetest"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestWebDAVAuthentication(t *testing.T) {
+	db := dbtest.Open(t)
+	_, minioCfg := storagetest.Open(t)
+	cfg := testConfig(t, `{"jwt": {"secret": "test"}, "webdav": {"enabled": true}}`)
+	cfg.MinIO = minioCfg
+	url := newTestServer(t, cfg, nil).URL
+	ctx := context.Background()
+
+	c := client.New(url)
+	if _, err := c.Register(ctx, client.RegisterRequest{Username: "alice", Password: "alice-password"}); err != nil {
+		t.Fatalf("register: %v", err)
+	}
+	if _, err := c.Upload(ctx, "/docs/notes.txt", strings.NewReader("notes"), 5, true); err != nil {
+		t.Fatalf("Upload: %v", err)
+	}
+	if _, err := c.Upload(ctx, "/other.txt", strings.NewReader("other"), 5, true); err != nil {
+		t.Fatalf("Upload: %v", err)
+	}
+	alice := db.User.Query().OnlyX(ctx)
+	docs, err := c.Stat(ctx, "/docs")
+	if err != nil {
+		t.Fatalf("Stat: %v", err)
+	}
+
+	// newToken creates a personal access token of alice
+	newToken := func(scope accesstoken.Scope, folderID *int) string {
+		token, err := pat.Generate()
+		if err != nil {
+			t.Fatalf("Generate: %v", err)
+		}
+		db.AccessToken.Create().
+			SetUser(alice).
+			SetName(string(scope)).
+			SetTokenHash(auth.HashToken(token)).
+			SetTokenPrefix(token[:8]).
+			SetScope(scope).
+			SetNillableFolderID(folderID).
+			SaveX(ctx)
+		return token
+	}
+	full := newToken(accesstoken.ScopeFull, nil)
+	read := newToken(accesstoken.ScopeRead, nil)
+	upload := newToken(accesstoken.ScopeUpload, nil)
+	folder := newToken(accesstoken.ScopeFull, &docs.ID)
+
+	tests := []struct {
+		name               string
+		username, password string
+		method, path       string
+		status             int
+		want               string // Contained in the response body
+	}{
+		{"no credentials", "", "", "PROPFIND", "/dav/", http.StatusUnauthorized, ""},
+		{"wrong password", "alice", "wrong", "PROPFIND", "/dav/", http.StatusUnauthorized, ""},
+		{"password lists", "alice", "alice-password", "PROPFIND", "/dav/", http.StatusMultiStatus, "/dav/other.txt"},
+		{"password reads", "alice", "alice-password", "GET", "/dav/docs/notes.txt", http.StatusOK, "notes"},
+		{"password writes", "alice", "alice-password", "PUT", "/dav/new.txt", http.StatusCreated, ""},
+		{"token writes", "alice", full, "PUT", "/dav/token.txt", http.StatusCreated, ""},
+		{"token of another user", "bob", full, "PROPFIND", "/dav/", http.StatusUnauthorized, ""},
+		{"read token reads", "alice", read, "GET", "/dav/other.txt", http.StatusOK, "other"},
+		{"read token cannot write", "alice", read, "PUT", "/dav/read.txt", http.StatusForbidden, ""},
+		{"upload token", "alice", upload, "PUT", "/dav/upload.txt", http.StatusForbidden, ""},
+		{"folder token sees the folder as root", "alice", folder, "GET", "/dav/notes.txt", http.StatusOK, "notes"},
+		{"folder token cannot leave the folder", "alice", folder, "GET", "/dav/other.txt", http.StatusNotFound, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body io.Reader
+			if tt.method == "PUT" {
+				body = strings.NewReader("data")
+			}
+			req, err := http.NewRequest(tt.method, url+tt.path, body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.username != "" {
+				req.SetBasicAuth(tt.username, tt.password)
+			}
+			req.Header.Set("Depth", "1")
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("%s %s: %v", tt.method, tt.path, err)
+			}
+			defer resp.Body.Close()
+			data, _ := io.ReadAll(resp.Body)
+
+			if resp.StatusCode != tt.status {
+				t.Fatalf("HTTP %d, want %d: %s", resp.StatusCode, tt.status, data)
+			}
+			if !strings.Contains(string(data), tt.want) {
+				t.Errorf("response %s does not contain %s", data, tt.want)
+			}
+			if tt.status == http.StatusUnauthorized && resp.Header.Get("WWW-Authenticate") == "" {
+				t.Error("no WWW-Authenticate challenge")
+			}
+		})
+	}
+}
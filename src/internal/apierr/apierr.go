@@ -0,0 +1,114 @@
+// Package apierr writes the error responses of the API. Every error has a
+// stable code that clients can switch on, the HTTP status of the code, a
+// message in the language the client asked for and the ID of the request.
+package apierr
+
+import (
+	"encoding/json"
+	"errors"
+	"gopan-server/internal/logger"
+	"reflect"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+// Details holds extra information about an error, such as the fields that
+// failed validation
+type Details map[string]any
+
+// Error is an error of the API
+type Error struct {
+	Code      Code    `json:"code"`
+	Message   string  `json:"message"` // Localized, for display only
+	RequestID string  `json:"request_id,omitempty"`
+	Details   Details `json:"details,omitempty"`
+}
+
+// Response is the body of an error response
+type Response struct {
+	Error Error `json:"error"`
+}
+
+// FieldError describes a request field that failed validation
+type FieldError struct {
+	Field string `json:"field"`
+	Rule  string `json:"rule"`            // Validation rule, such as required, max or oneof
+	Param string `json:"param,omitempty"` // Parameter of the rule, such as the maximum
+}
+
+func init() {
+	// Report validation errors with the JSON names of fields
+	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		v.RegisterTagNameFunc(func(f reflect.StructField) string {
+			name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+			if name == "-" {
+				return ""
+			}
+			if name == "" {
+				return f.Name
+			}
+			return name
+		})
+	}
+}
+
+// New returns the error of code in the language of the request
+func New(c *gin.Context, code Code, details Details) Error {
+	return Error{
+		Code:      code,
+		Message:   code.Message(Language(c)),
+		RequestID: c.GetString("requestID"),
+		Details:   details,
+	}
+}
+
+// Abort writes the error response of code and stops the request
+func Abort(c *gin.Context, code Code) {
+	AbortWithDetails(c, code, nil)
+}
+
+// AbortWithDetails writes the error response of code with details and stops the request
+func AbortWithDetails(c *gin.Context, code Code, details Details) {
+	c.AbortWithStatusJSON(code.Status(), Response{Error: New(c, code, details)})
+}
+
+// AbortInternal logs an unexpected failure with the request ID and responds
+// with INTERNAL_ERROR, so that internals never reach clients
+func AbortInternal(c *gin.Context, message string, err error) {
+	if err != nil {
+		logger.Error.Printf("%s: %v (request %s)", message, err, c.GetString("requestID"))
+	} else {
+		logger.Error.Printf("%s (request %s)", message, c.GetString("requestID"))
+	}
+	Abort(c, InternalError)
+}
+
+// AbortInvalid responds with VALIDATION_FAILED for the fields
+func AbortInvalid(c *gin.Context, fields ...FieldError) {
+	AbortWithDetails(c, ValidationFailed, Details{"fields": fields})
+}
+
+// AbortBind responds to a request that failed binding. Validation failures
+// list the fields, anything else is a malformed request.
+func AbortBind(c *gin.Context, err error) {
+	var verrs validator.ValidationErrors
+	if errors.As(err, &verrs) {
+		fields := make([]FieldError, len(verrs))
+		for i, fe := range verrs {
+			fields[i] = FieldError{Field: fe.Field(), Rule: fe.Tag(), Param: fe.Param()}
+		}
+		AbortInvalid(c, fields...)
+		return
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		AbortInvalid(c, FieldError{Field: typeErr.Field, Rule: "type", Param: typeErr.Type.String()})
+		return
+	}
+
+	Abort(c, InvalidRequest)
+}
@@ -0,0 +1,227 @@
+package apierr
+
+import (
+	"net/http"
+	"sort"
+)
+
+// Code identifies an error. Codes are stable, clients can rely on them
+// while messages may change.
+type Code string
+
+// General errors
+const (
+	InvalidRequest   Code = "INVALID_REQUEST"
+	ValidationFailed Code = "VALIDATION_FAILED"
+	NotFound         Code = "NOT_FOUND"
+	RateLimited      Code = "RATE_LIMITED"
+	InternalError    Code = "INTERNAL_ERROR"
+	FeatureDisabled  Code = "FEATURE_DISABLED"
+)
+
+// Authentication errors
+const (
+	Unauthenticated             Code = "UNAUTHENTICATED"
+	InvalidToken                Code = "INVALID_TOKEN"
+	TokenExpired                Code = "TOKEN_EXPIRED"
+	SessionRevoked              Code = "SESSION_REVOKED"
+	InvalidCredentials          Code = "INVALID_CREDENTIALS"
+	AccessTokenRequired         Code = "ACCESS_TOKEN_REQUIRED"
+	LoginChallengeExpired       Code = "LOGIN_CHALLENGE_EXPIRED"
+	WrongPassword               Code = "WRONG_PASSWORD"
+	InvalidVerificationCode     Code = "INVALID_VERIFICATION_CODE"
+	AccountDisabled             Code = "ACCOUNT_DISABLED"
+	AccountLocked               Code = "ACCOUNT_LOCKED"
+	TooManyAttempts             Code = "TOO_MANY_ATTEMPTS"
+	AuthUnavailable             Code = "AUTH_UNAVAILABLE"
+	IdentityProviderUnavailable Code = "IDENTITY_PROVIDER_UNAVAILABLE"
+	PasswordManagedExternally   Code = "PASSWORD_MANAGED_EXTERNALLY"
+	TwoFactorAlreadyEnabled     Code = "TWO_FACTOR_ALREADY_ENABLED"
+	TwoFactorNotEnabled         Code = "TWO_FACTOR_NOT_ENABLED"
+	TwoFactorSetupRequired      Code = "TWO_FACTOR_SETUP_REQUIRED"
+)
+
+// Registration and email errors
+const (
+	RegistrationClosed      Code = "REGISTRATION_CLOSED"
+	InviteRequired          Code = "INVITE_REQUIRED"
+	InvalidInvite           Code = "INVALID_INVITE"
+	EmailDomainNotAllowed   Code = "EMAIL_DOMAIN_NOT_ALLOWED"
+	UsernameTaken           Code = "USERNAME_TAKEN"
+	EmailNotSet             Code = "EMAIL_NOT_SET"
+	EmailAlreadyVerified    Code = "EMAIL_ALREADY_VERIFIED"
+	EmailRecentlySent       Code = "EMAIL_RECENTLY_SENT"
+	ResetLinkInvalid        Code = "RESET_LINK_INVALID"
+	VerificationLinkInvalid Code = "VERIFICATION_LINK_INVALID"
+)
+
+// Access errors
+const (
+	PermissionDenied   Code = "PERMISSION_DENIED"
+	AdminRequired      Code = "ADMIN_REQUIRED"
+	ReadOnly           Code = "READ_ONLY"
+	TokenScopeDenied   Code = "TOKEN_SCOPE_DENIED"
+	SessionRequired    Code = "SESSION_REQUIRED"
+	NotAllowedOnSelf   Code = "NOT_ALLOWED_ON_SELF"
+	NotAllowedOnRoot   Code = "NOT_ALLOWED_ON_ROOT"
+	PreviewUnavailable Code = "PREVIEW_UNAVAILABLE"
+)
+
+// File errors
+const (
+	FileNotFound  Code = "FILE_NOT_FOUND"
+	HashNotFound  Code = "HASH_NOT_FOUND"
+	NameConflict  Code = "NAME_CONFLICT"
+	NotAFolder    Code = "NOT_A_FOLDER"
+	IsAFolder     Code = "IS_A_FOLDER"
+	InvalidName   Code = "INVALID_NAME"
+	InvalidMove   Code = "INVALID_MOVE"
+	QuotaExceeded Code = "QUOTA_EXCEEDED"
+	SizeMismatch  Code = "SIZE_MISMATCH"
+	CursorExpired Code = "CURSOR_EXPIRED"
+)
+
+// Share errors
+const (
+	ShareNotFound             Code = "SHARE_NOT_FOUND"
+	ShareExpired              Code = "SHARE_EXPIRED"
+	ShareAccessLimitReached   Code = "SHARE_ACCESS_LIMIT_REACHED"
+	ShareDownloadLimitReached Code = "SHARE_DOWNLOAD_LIMIT_REACHED"
+	SharePasswordRequired     Code = "SHARE_PASSWORD_REQUIRED"
+	SharePasswordInvalid      Code = "SHARE_PASSWORD_INVALID"
+	NotInShare                Code = "NOT_IN_SHARE"
+	SlugTaken                 Code = "SLUG_TAKEN"
+	InvalidSlug               Code = "INVALID_SLUG"
+)
+
+// Errors of other resources
+const (
+	UserNotFound       Code = "USER_NOT_FOUND"
+	GroupNotFound      Code = "GROUP_NOT_FOUND"
+	AlreadyMember      Code = "ALREADY_MEMBER"
+	PermissionNotFound Code = "PERMISSION_NOT_FOUND"
+	SessionNotFound    Code = "SESSION_NOT_FOUND"
+	TokenNotFound      Code = "TOKEN_NOT_FOUND"
+	SSHKeyNotFound     Code = "SSH_KEY_NOT_FOUND"
+	SSHKeyExists       Code = "SSH_KEY_EXISTS"
+	S3KeyNotFound      Code = "S3_KEY_NOT_FOUND"
+	IdentityNotFound   Code = "IDENTITY_NOT_FOUND"
+	InviteNotFound     Code = "INVITE_NOT_FOUND"
+)
+
+// definition is the HTTP status and the messages of a code
+type definition struct {
+	status int
+	en, zh string
+}
+
+var definitions = map[Code]definition{
+	InvalidRequest:   {http.StatusBadRequest, "Invalid request", "请求无效"},
+	ValidationFailed: {http.StatusBadRequest, "Invalid request parameters", "请求参数无效"},
+	NotFound:         {http.StatusNotFound, "Not found", "资源不存在"},
+	RateLimited:      {http.StatusTooManyRequests, "Too many requests, please try again later", "请求过于频繁，请稍后再试"},
+	InternalError:    {http.StatusInternalServerError, "Internal server error, please try again later", "服务器内部错误，请稍后再试"},
+	FeatureDisabled:  {http.StatusNotFound, "This feature is not enabled", "该功能未启用"},
+
+	Unauthenticated:             {http.StatusUnauthorized, "Authentication required", "请先登录"},
+	InvalidToken:                {http.StatusUnauthorized, "Invalid token", "令牌无效"},
+	TokenExpired:                {http.StatusUnauthorized, "Token expired", "令牌已过期"},
+	SessionRevoked:              {http.StatusUnauthorized, "Session has ended, please log in again", "会话已失效，请重新登录"},
+	InvalidCredentials:          {http.StatusUnauthorized, "Invalid username or password", "用户名或密码错误"},
+	AccessTokenRequired:         {http.StatusUnauthorized, "Use a personal access token as the password", "请使用个人访问令牌作为密码"},
+	LoginChallengeExpired:       {http.StatusUnauthorized, "Login challenge expired, please log in again", "登录验证已过期，请重新登录"},
+	WrongPassword:               {http.StatusForbidden, "Incorrect password", "密码错误"},
+	InvalidVerificationCode:     {http.StatusForbidden, "Invalid verification code", "验证码错误"},
+	AccountDisabled:             {http.StatusForbidden, "Account disabled", "账号已被禁用"},
+	AccountLocked:               {http.StatusTooManyRequests, "Account temporarily locked after too many failed attempts", "失败次数过多，账号已被暂时锁定"},
+	TooManyAttempts:             {http.StatusTooManyRequests, "Too many failed attempts, please try again later", "失败次数过多，请稍后再试"},
+	AuthUnavailable:             {http.StatusServiceUnavailable, "Authentication service unavailable", "认证服务不可用"},
+	IdentityProviderUnavailable: {http.StatusBadGateway, "Identity provider is unavailable", "身份提供方不可用"},
+	PasswordManagedExternally:   {http.StatusBadRequest, "Password is managed by the directory", "密码由目录服务管理，无法在此修改"},
+	TwoFactorAlreadyEnabled:     {http.StatusConflict, "Two-factor authentication is already enabled", "两步验证已开启"},
+	TwoFactorNotEnabled:         {http.StatusBadRequest, "Two-factor authentication is not enabled", "两步验证未开启"},
+	TwoFactorSetupRequired:      {http.StatusBadRequest, "Two-factor setup has not been started", "尚未开始设置两步验证"},
+
+	RegistrationClosed:      {http.StatusForbidden, "Registration is closed", "已关闭注册"},
+	InviteRequired:          {http.StatusForbidden, "An invite code is required", "需要邀请码"},
+	InvalidInvite:           {http.StatusForbidden, "Invalid or expired invite code", "邀请码无效或已过期"},
+	EmailDomainNotAllowed:   {http.StatusForbidden, "Email domain is not allowed to register", "该邮箱域名不允许注册"},
+	UsernameTaken:           {http.StatusConflict, "Username already exists", "用户名已存在"},
+	EmailNotSet:             {http.StatusBadRequest, "No email set", "未设置邮箱"},
+	EmailAlreadyVerified:    {http.StatusConflict, "Email is already verified", "邮箱已验证"},
+	EmailRecentlySent:       {http.StatusTooManyRequests, "A verification email was sent recently", "验证邮件刚刚已发送，请稍后再试"},
+	ResetLinkInvalid:        {http.StatusBadRequest, "Invalid or expired reset link", "重置链接无效或已过期"},
+	VerificationLinkInvalid: {http.StatusBadRequest, "Invalid or expired verification link", "验证链接无效或已过期"},
+
+	PermissionDenied:   {http.StatusForbidden, "Permission denied", "没有权限"},
+	AdminRequired:      {http.StatusForbidden, "Admin privileges required", "需要管理员权限"},
+	ReadOnly:           {http.StatusForbidden, "Read-only access", "只读权限，无法修改"},
+	TokenScopeDenied:   {http.StatusForbidden, "The access token does not allow this request", "访问令牌不允许此操作"},
+	SessionRequired:    {http.StatusForbidden, "Not available to access tokens", "访问令牌不能使用此功能，请登录后操作"},
+	NotAllowedOnSelf:   {http.StatusBadRequest, "Not allowed on your own account", "不能对自己执行此操作"},
+	NotAllowedOnRoot:   {http.StatusBadRequest, "Not allowed on the root folder", "不能对根目录执行此操作"},
+	PreviewUnavailable: {http.StatusBadGateway, "Preview service is unavailable", "预览服务不可用"},
+
+	FileNotFound:  {http.StatusNotFound, "File or folder not found", "文件或文件夹不存在"},
+	HashNotFound:  {http.StatusNotFound, "No file with this hash, upload the content", "没有相同内容的文件，请上传文件内容"},
+	NameConflict:  {http.StatusConflict, "Name already exists", "名称已存在"},
+	NotAFolder:    {http.StatusConflict, "Not a folder", "不是文件夹"},
+	IsAFolder:     {http.StatusConflict, "Not a file", "不是文件"},
+	InvalidName:   {http.StatusBadRequest, "Invalid file name", "文件名无效"},
+	InvalidMove:   {http.StatusBadRequest, "Cannot move a folder into itself", "不能将文件夹移动到自身或其子文件夹中"},
+	QuotaExceeded: {http.StatusInsufficientStorage, "Insufficient storage capacity", "存储空间不足"},
+	SizeMismatch:  {http.StatusBadRequest, "File size does not match", "文件大小不一致"},
+	CursorExpired: {http.StatusGone, "Cursor expired, list all files again", "游标已过期，请重新获取全部文件"},
+
+	ShareNotFound:             {http.StatusNotFound, "Share not found", "分享不存在"},
+	ShareExpired:              {http.StatusGone, "Share has expired", "分享已过期"},
+	ShareAccessLimitReached:   {http.StatusForbidden, "Share access limit reached", "分享访问次数已达上限"},
+	ShareDownloadLimitReached: {http.StatusForbidden, "Share download limit reached", "分享下载次数已达上限"},
+	SharePasswordRequired:     {http.StatusUnauthorized, "Password required", "请输入提取码"},
+	SharePasswordInvalid:      {http.StatusUnauthorized, "Wrong password", "提取码错误"},
+	NotInShare:                {http.StatusForbidden, "Not accessible via this share", "不在分享范围内"},
+	SlugTaken:                 {http.StatusConflict, "Slug is already taken", "链接名称已被使用"},
+	InvalidSlug:               {http.StatusBadRequest, "Slug must be 3-64 characters of letters, digits, '-' or '_' and start with a letter or digit", "链接名称须为 3-64 个字母、数字、“-”或“_”，并以字母或数字开头"},
+
+	UserNotFound:       {http.StatusNotFound, "User not found", "用户不存在"},
+	GroupNotFound:      {http.StatusNotFound, "Group not found", "群组不存在"},
+	AlreadyMember:      {http.StatusConflict, "User is already a member", "用户已是群组成员"},
+	PermissionNotFound: {http.StatusNotFound, "Permission not found", "权限不存在"},
+	SessionNotFound:    {http.StatusNotFound, "Session not found", "会话不存在"},
+	TokenNotFound:      {http.StatusNotFound, "Token not found", "令牌不存在"},
+	SSHKeyNotFound:     {http.StatusNotFound, "SSH key not found", "SSH 密钥不存在"},
+	SSHKeyExists:       {http.StatusConflict, "SSH key already added", "SSH 密钥已添加"},
+	S3KeyNotFound:      {http.StatusNotFound, "S3 key not found", "S3 密钥不存在"},
+	IdentityNotFound:   {http.StatusNotFound, "Identity not found", "关联身份不存在"},
+	InviteNotFound:     {http.StatusNotFound, "Invite not found", "邀请码不存在"},
+}
+
+// Status returns the HTTP status of the code
+func (code Code) Status() int {
+	if d, ok := definitions[code]; ok {
+		return d.status
+	}
+	return http.StatusInternalServerError
+}
+
+// Message returns the message of the code in lang
+func (code Code) Message(lang Lang) string {
+	d, ok := definitions[code]
+	if !ok {
+		d = definitions[InternalError]
+	}
+	if lang == Chinese {
+		return d.zh
+	}
+	return d.en
+}
+
+// Codes returns all codes, sorted
+func Codes() []string {
+	codes := make([]string, 0, len(definitions))
+	for code := range definitions {
+		codes = append(codes, string(code))
+	}
+	sort.Strings(codes)
+	return codes
+}
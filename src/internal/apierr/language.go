@@ -0,0 +1,26 @@
+package apierr
+
+import (
+	"github.com/gin-gonic/gin"
+	"golang.org/x/text/language"
+)
+
+// Lang is a language messages are available in
+type Lang int
+
+const (
+	English Lang = iota
+	Chinese      // Simplified Chinese
+)
+
+// languages are the tags of the languages, English first as the default
+var languages = []language.Tag{language.English, language.SimplifiedChinese}
+
+var matcher = language.NewMatcher(languages)
+
+// Language returns the language of messages for the request, chosen by its
+// Accept-Language header
+func Language(c *gin.Context) Lang {
+	_, i := language.MatchStrings(matcher, c.GetHeader("Accept-Language"))
+	return Lang(i)
+}
@@ -13,20 +13,44 @@ var (
 	ErrExpiredToken = errors.New("token expired")
 )
 
+// PurposeTwoFactor marks challenge tokens of a login waiting for its second factor
+const PurposeTwoFactor = "2fa"
+
 // Claims represents JWT claims
 type Claims struct {
-	UserID   string `json:"user_id"`
-	Username string `json:"username"`
+	UserID    string `json:"user_id"`
+	Username  string `json:"username"`
+	SessionID int    `json:"sid,omitempty"`
+	Purpose   string `json:"purpose,omitempty"` // Empty for access tokens
 	jwt.RegisteredClaims
 }
 
-// GenerateToken generates a JWT token for a user
-func GenerateToken(userID, username string, cfg *config.JWTConfig) (string, error) {
+// GenerateToken generates a short-lived JWT access token for a user session
+func GenerateToken(userID, username string, sessionID int, cfg *config.JWTConfig) (string, error) {
+	claims := &Claims{
+		UserID:    userID,
+		Username:  username,
+		SessionID: sessionID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(cfg.GetExpiration())),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			NotBefore: jwt.NewNumericDate(time.Now()),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString([]byte(cfg.Secret))
+}
+
+// GenerateChallengeToken generates a short-lived token proving that the
+// password step of a two-step login succeeded
+func GenerateChallengeToken(userID, username string, ttl time.Duration, cfg *config.JWTConfig) (string, error) {
 	claims := &Claims{
 		UserID:   userID,
 		Username: username,
+		Purpose:  PurposeTwoFactor,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(cfg.GetExpiration())),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(ttl)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 			NotBefore: jwt.NewNumericDate(time.Now()),
 		},
@@ -36,8 +60,18 @@ func GenerateToken(userID, username string, cfg *config.JWTConfig) (string, erro
 	return token.SignedString([]byte(cfg.Secret))
 }
 
-// ValidateToken validates a JWT token and returns the claims
+// ValidateToken validates a JWT access token and returns the claims
 func ValidateToken(tokenString string, cfg *config.JWTConfig) (*Claims, error) {
+	return parseToken(tokenString, "", cfg)
+}
+
+// ValidateChallengeToken validates a two-step login challenge token and returns the claims
+func ValidateChallengeToken(tokenString string, cfg *config.JWTConfig) (*Claims, error) {
+	return parseToken(tokenString, PurposeTwoFactor, cfg)
+}
+
+// parseToken validates a JWT issued for purpose and returns the claims
+func parseToken(tokenString, purpose string, cfg *config.JWTConfig) (*Claims, error) {
 	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, ErrInvalidToken
@@ -52,10 +86,9 @@ func ValidateToken(tokenString string, cfg *config.JWTConfig) (*Claims, error) {
 		return nil, ErrInvalidToken
 	}
 
-	if claims, ok := token.Claims.(*Claims); ok && token.Valid {
+	if claims, ok := token.Claims.(*Claims); ok && token.Valid && claims.Purpose == purpose {
 		return claims, nil
 	}
 
 	return nil, ErrInvalidToken
 }
-
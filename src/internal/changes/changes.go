@@ -0,0 +1,227 @@
+// Package changes keeps the journal of changes to the files of each user.
+// Sync clients read it from a cursor to learn what changed since they last
+// looked, instead of listing all files again.
+package changes
+
+import (
+	"context"
+	"errors"
+	"gopan-server/ent"
+	"gopan-server/ent/change"
+	"gopan-server/internal/database"
+	"gopan-server/internal/events"
+	"gopan-server/internal/logger"
+	"gopan-server/internal/permission"
+	"time"
+)
+
+// pollInterval is how often Wait looks at the journal again, in case a
+// change event got lost
+const pollInterval = 10 * time.Second
+
+// ErrCursorExpired is returned for a cursor whose following entries are
+// no longer kept, or which is ahead of the journal. The client has to list
+// all files again and start over from the latest cursor.
+var ErrCursorExpired = errors.New("cursor expired")
+
+// Page is a part of the journal
+type Page struct {
+	Changes []*ent.Change
+	Cursor  int64 // Where the next page starts
+	HasMore bool  // Whether more entries follow right away
+}
+
+// Record journals a change of n, which must hold its state after the
+// change, and notifies the owner and the users n is shared with. The
+// change is done already, so failures are logged rather than returned.
+func Record(ctx context.Context, kind change.Kind, n *ent.Node) {
+	// Journal the change even when the client went away meanwhile
+	ctx = context.WithoutCancel(ctx)
+
+	ownerID, err := n.QueryOwner().OnlyID(ctx)
+	if err != nil {
+		logger.Error.Printf("Failed to record %s of node %d: %v", kind, n.ID, err)
+		return
+	}
+	var parentID *int
+	pid, err := n.QueryParent().OnlyID(ctx)
+	if err == nil {
+		parentID = &pid
+	} else if !ent.IsNotFound(err) {
+		logger.Error.Printf("Failed to record %s of node %d: %v", kind, n.ID, err)
+		return
+	}
+	entry := record(ctx, ownerID, parentID, kind, n)
+	if entry == nil {
+		return
+	}
+
+	// Collaborators see the change in their shared-with-me files
+	audience, err := permission.Audience(ctx, database.Client, ownerID, n.ID)
+	if err != nil {
+		logger.Error.Printf("Failed to find users node %d is shared with: %v", n.ID, err)
+	}
+	events.Publish(ctx, events.TypeChange, Format(entry), append(audience, ownerID)...)
+}
+
+// RecordPurge journals the permanent deletion of n, which no longer exists,
+// and notifies the owner
+func RecordPurge(ctx context.Context, ownerID int, n *ent.Node) {
+	ctx = context.WithoutCancel(ctx)
+	if entry := record(ctx, ownerID, nil, change.KindPurge, n); entry != nil {
+		events.Publish(ctx, events.TypeChange, Format(entry), ownerID)
+	}
+}
+
+// record writes the journal entry, returning nil on failure
+func record(ctx context.Context, ownerID int, parentID *int, kind change.Kind, n *ent.Node) *ent.Change {
+	entry, err := write(ctx, ownerID, parentID, kind, n)
+	if err != nil {
+		logger.Error.Printf("Failed to record %s of node %d: %v", kind, n.ID, err)
+		return nil
+	}
+	return entry
+}
+
+// write appends an entry to the journal of the owner
+func write(ctx context.Context, ownerID int, parentID *int, kind change.Kind, n *ent.Node) (*ent.Change, error) {
+	tx, err := database.Client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Taking the next number locks the user until the entry is committed,
+	// so entries become visible in the order of their numbers
+	u, err := tx.User.UpdateOneID(ownerID).AddChangeSeq(1).Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	entry, err := tx.Change.Create().
+		SetUserID(ownerID).
+		SetSeq(u.ChangeSeq).
+		SetKind(kind).
+		SetNodeID(n.ID).
+		SetNillableParentID(parentID).
+		SetName(n.Name).
+		SetType(n.Type).
+		SetSize(n.Size).
+		SetFileHash(n.FileHash).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	return entry.Unwrap(), tx.Commit()
+}
+
+// Entry is a journal entry as shown to clients
+type Entry struct {
+	Seq       int64       `json:"seq"`
+	Kind      change.Kind `json:"kind"`
+	NodeID    int         `json:"node_id"`
+	ParentID  *int        `json:"parent_id"` // Null at the top level
+	Name      string      `json:"name"`
+	Type      int         `json:"type"` // 0 for folders, 1 for files
+	Size      int64       `json:"size"`
+	FileHash  string      `json:"file_hash"`
+	CreatedAt time.Time   `json:"created_at"`
+}
+
+// Format returns a journal entry as shown to clients
+func Format(entry *ent.Change) Entry {
+	return Entry{
+		Seq:       entry.Seq,
+		Kind:      entry.Kind,
+		NodeID:    entry.NodeID,
+		ParentID:  entry.ParentID,
+		Name:      entry.Name,
+		Type:      entry.Type,
+		Size:      entry.Size,
+		FileHash:  entry.FileHash,
+		CreatedAt: entry.CreatedAt,
+	}
+}
+
+// Latest returns the cursor at the end of the journal of a user
+func Latest(ctx context.Context, userID int) (int64, error) {
+	u, err := database.Client.User.Get(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	return u.ChangeSeq, nil
+}
+
+// List returns up to limit entries following cursor
+func List(ctx context.Context, userID int, cursor int64, limit int) (*Page, error) {
+	latest, err := Latest(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if cursor < 0 || cursor > latest {
+		return nil, ErrCursorExpired
+	}
+
+	entries, err := database.Client.Change.Query().
+		Where(change.UserIDEQ(userID)).
+		Where(change.SeqGT(cursor)).
+		Order(ent.Asc(change.FieldSeq)).
+		Limit(limit + 1).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Entries are numbered without gaps, so a missing next entry was purged
+	if cursor < latest && (len(entries) == 0 || entries[0].Seq != cursor+1) {
+		return nil, ErrCursorExpired
+	}
+
+	page := &Page{Cursor: cursor}
+	if len(entries) > limit {
+		entries, page.HasMore = entries[:limit], true
+	}
+	if len(entries) > 0 {
+		page.Cursor = entries[len(entries)-1].Seq
+	}
+	page.Changes = entries
+	return page, nil
+}
+
+// Wait blocks until the journal of a user has entries following cursor,
+// or ctx is done. It may return early, such as on shutdown, so callers
+// look at the journal again.
+func Wait(ctx context.Context, userID int, cursor int64) error {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		// Subscribe before looking, so no change slips in between
+		sub := events.Subscribe(userID)
+		latest, err := Latest(ctx, userID)
+		if err != nil || latest > cursor {
+			sub.Close()
+			return err
+		}
+
+		ended := false
+		select {
+		case <-ctx.Done():
+			err = ctx.Err()
+		case <-ticker.C:
+		case _, ok := <-sub.C:
+			ended = !ok
+		}
+		sub.Close()
+		if err != nil || ended {
+			return err
+		}
+	}
+}
+
+// Purge deletes journal entries recorded before cutoff
+func Purge(ctx context.Context, cutoff time.Time) (int, error) {
+	return database.Client.Change.Delete().
+		Where(change.CreatedAtLT(cutoff)).
+		Exec(ctx)
+}
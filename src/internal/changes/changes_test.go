@@ -0,0 +1,175 @@
+package changes_test
+
+import (
+	"context"
+	"errors"
+	"gopan-server/ent"
+	"gopan-server/ent/change"
+	"gopan-server/internal/changes"
+	"gopan-server/internal/dbtest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// newUser creates a user with a file to record changes of
+func newUser(t *testing.T, client *ent.Client, username string) (*ent.User, *ent.Node) {
+	t.Helper()
+	ctx := context.Background()
+	u := client.User.Create().SetUsername(username).SetPasswordHash("x").SetTotalQuota(1 << 30).SaveX(ctx)
+	n := client.Node.Create().SetName(username + ".txt").SetType(1).SetOwner(u).SaveX(ctx)
+	return u, n
+}
+
+// seqs returns the sequence numbers of entries
+func seqs(entries []*ent.Change) []int64 {
+	result := make([]int64, len(entries))
+	for i, e := range entries {
+		result[i] = e.Seq
+	}
+	return result
+}
+
+func TestSequencePerUser(t *testing.T) {
+	client := dbtest.Open(t)
+	alice, aliceFile := newUser(t, client, "alice")
+	bob, bobFile := newUser(t, client, "bob")
+	ctx := context.Background()
+
+	// Concurrent changes of one user are numbered without gaps or repeats
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			changes.Record(ctx, change.KindUpdate, aliceFile)
+		}()
+		go func() {
+			defer wg.Done()
+			changes.Record(ctx, change.KindUpdate, bobFile)
+		}()
+	}
+	wg.Wait()
+
+	for _, u := range []*ent.User{alice, bob} {
+		page, err := changes.List(ctx, u.ID, 0, 100)
+		if err != nil {
+			t.Fatalf("List of %s: %v", u.Username, err)
+		}
+		for i, seq := range seqs(page.Changes) {
+			if seq != int64(i+1) {
+				t.Fatalf("sequence of %s = %v, want 1 to 10", u.Username, seqs(page.Changes))
+			}
+		}
+		if len(page.Changes) != 10 || page.Cursor != 10 || page.HasMore {
+			t.Errorf("page of %s: %d entries, cursor %d, more %v", u.Username, len(page.Changes), page.Cursor, page.HasMore)
+		}
+		if latest, err := changes.Latest(ctx, u.ID); latest != 10 || err != nil {
+			t.Errorf("Latest of %s = %d, %v, want 10", u.Username, latest, err)
+		}
+	}
+}
+
+func TestListPages(t *testing.T) {
+	client := dbtest.Open(t)
+	u, n := newUser(t, client, "alice")
+	ctx := context.Background()
+	for _, kind := range []change.Kind{change.KindCreate, change.KindRename, change.KindMove} {
+		changes.Record(ctx, kind, n)
+	}
+
+	tests := []struct {
+		cursor  int64
+		limit   int
+		want    []int64
+		next    int64
+		hasMore bool
+	}{
+		{0, 2, []int64{1, 2}, 2, true},
+		{2, 2, []int64{3}, 3, false},
+		{3, 2, []int64{}, 3, false},
+	}
+	for _, tt := range tests {
+		page, err := changes.List(ctx, u.ID, tt.cursor, tt.limit)
+		if err != nil {
+			t.Fatalf("List from %d: %v", tt.cursor, err)
+		}
+		got := seqs(page.Changes)
+		if len(got) != len(tt.want) || (len(got) > 0 && got[0] != tt.want[0]) || page.Cursor != tt.next || page.HasMore != tt.hasMore {
+			t.Errorf("List from %d = %v, cursor %d, more %v, want %v, cursor %d, more %v",
+				tt.cursor, got, page.Cursor, page.HasMore, tt.want, tt.next, tt.hasMore)
+		}
+	}
+}
+
+func TestCursorExpiry(t *testing.T) {
+	client := dbtest.Open(t)
+	u, n := newUser(t, client, "alice")
+	ctx := context.Background()
+	changes.Record(ctx, change.KindCreate, n)
+	changes.Record(ctx, change.KindUpdate, n)
+
+	// Cursors ahead of the journal or negative are not valid
+	for _, cursor := range []int64{-1, 3} {
+		if _, err := changes.List(ctx, u.ID, cursor, 10); !errors.Is(err, changes.ErrCursorExpired) {
+			t.Errorf("List from %d: err = %v, want ErrCursorExpired", cursor, err)
+		}
+	}
+
+	// Once the entries after a cursor are purged it expires, but the
+	// latest cursor still works
+	if _, err := changes.Purge(ctx, time.Now().Add(time.Second)); err != nil {
+		t.Fatalf("Purge: %v", err)
+	}
+	if _, err := changes.List(ctx, u.ID, 0, 10); !errors.Is(err, changes.ErrCursorExpired) {
+		t.Errorf("List from a purged cursor: err = %v, want ErrCursorExpired", err)
+	}
+	page, err := changes.List(ctx, u.ID, 2, 10)
+	if err != nil || len(page.Changes) != 0 || page.Cursor != 2 {
+		t.Errorf("List from the latest cursor = %+v, %v", page, err)
+	}
+
+	// Numbering goes on after the purge
+	changes.Record(ctx, change.KindTrash, n)
+	page, err = changes.List(ctx, u.ID, 2, 10)
+	if err != nil || len(page.Changes) != 1 || page.Changes[0].Seq != 3 || page.Changes[0].Kind != change.KindTrash {
+		t.Errorf("List after the purge = %+v, %v", page, err)
+	}
+}
+
+func TestWait(t *testing.T) {
+	client := dbtest.Open(t)
+	u, n := newUser(t, client, "alice")
+	ctx := context.Background()
+	changes.Record(ctx, change.KindCreate, n)
+
+	// Returns right away for a cursor behind the journal
+	if err := changes.Wait(ctx, u.ID, 0); err != nil {
+		t.Errorf("Wait behind the journal: %v", err)
+	}
+
+	// Wakes up on the next change, well before it would poll again
+	done := make(chan error, 1)
+	start := time.Now()
+	go func() { done <- changes.Wait(ctx, u.ID, 1) }()
+	time.Sleep(50 * time.Millisecond)
+	changes.Record(ctx, change.KindUpdate, n)
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("Wait: %v", err)
+		}
+		if elapsed := time.Since(start); elapsed > 5*time.Second {
+			t.Errorf("Wait returned after %s", elapsed)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Wait did not return after a change")
+	}
+
+	// Ends with the context
+	waitCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	if err := changes.Wait(waitCtx, u.ID, 2); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Wait with an expiring context: err = %v, want DeadlineExceeded", err)
+	}
+}
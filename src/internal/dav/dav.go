@@ -0,0 +1,266 @@
+// Package dav serves a drive over WebDAV.
+package dav
+
+import (
+	"context"
+	"errors"
+	"gopan-server/ent"
+	"gopan-server/internal/drive"
+	"io"
+	"os"
+	"path"
+	"time"
+
+	"golang.org/x/net/webdav"
+)
+
+// contentLengthKey is the context key of the length of a PUT body
+type contentLengthKey struct{}
+
+// WithContentLength records the length of the request body in ctx, so
+// uploads can check the quota up front and detect truncated bodies
+func WithContentLength(ctx context.Context, n int64) context.Context {
+	return context.WithValue(ctx, contentLengthKey{}, n)
+}
+
+// contentLength returns the body length recorded in ctx, or -1
+func contentLength(ctx context.Context) int64 {
+	if n, ok := ctx.Value(contentLengthKey{}).(int64); ok {
+		return n
+	}
+	return -1
+}
+
+// FileSystem implements webdav.FileSystem on top of a drive
+type FileSystem struct {
+	fs *drive.FS
+}
+
+// New creates a WebDAV file system serving fs
+func New(fs *drive.FS) *FileSystem {
+	return &FileSystem{fs: fs}
+}
+
+// Mkdir implements webdav.FileSystem
+func (f *FileSystem) Mkdir(ctx context.Context, name string, perm os.FileMode) error {
+	_, err := f.fs.Mkdir(ctx, name)
+	return osError(err)
+}
+
+// OpenFile implements webdav.FileSystem. Files opened for writing are
+// always truncated, which is all the WebDAV handler asks for.
+func (f *FileSystem) OpenFile(ctx context.Context, name string, flag int, perm os.FileMode) (webdav.File, error) {
+	if flag&(os.O_WRONLY|os.O_RDWR) != 0 {
+		up, err := f.fs.Create(ctx, name, contentLength(ctx))
+		if err != nil {
+			return nil, osError(err)
+		}
+		return &uploadFile{upload: up, name: path.Base(name)}, nil
+	}
+
+	n, err := f.fs.Stat(ctx, name)
+	if err != nil {
+		return nil, osError(err)
+	}
+	if n.Type == drive.TypeFolder {
+		return &dirFile{fs: f.fs, ctx: ctx, name: name, node: n}, nil
+	}
+	r, err := f.fs.Open(ctx, n)
+	if err != nil {
+		return nil, osError(err)
+	}
+	return &readFile{Reader: r}, nil
+}
+
+// RemoveAll implements webdav.FileSystem by moving to the trash
+func (f *FileSystem) RemoveAll(ctx context.Context, name string) error {
+	return osError(f.fs.Remove(ctx, name))
+}
+
+// Rename implements webdav.FileSystem
+func (f *FileSystem) Rename(ctx context.Context, oldName, newName string) error {
+	return osError(f.fs.Move(ctx, oldName, newName))
+}
+
+// Stat implements webdav.FileSystem
+func (f *FileSystem) Stat(ctx context.Context, name string) (os.FileInfo, error) {
+	n, err := f.fs.Stat(ctx, name)
+	if err != nil {
+		return nil, osError(err)
+	}
+	return fileInfo{n}, nil
+}
+
+// osError translates drive errors to the os errors the WebDAV handler
+// maps to status codes
+func osError(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, drive.ErrNotFound), errors.Is(err, drive.ErrNotFolder):
+		return os.ErrNotExist
+	case errors.Is(err, drive.ErrExist):
+		return os.ErrExist
+	case errors.Is(err, drive.ErrReadOnly), errors.Is(err, drive.ErrRoot):
+		return os.ErrPermission
+	}
+	return err
+}
+
+// fileInfo implements os.FileInfo for a node
+type fileInfo struct {
+	node *ent.Node
+}
+
+func (fi fileInfo) Name() string       { return fi.node.Name }
+func (fi fileInfo) Size() int64        { return fi.node.Size }
+func (fi fileInfo) ModTime() time.Time { return fi.node.UpdatedAt }
+func (fi fileInfo) IsDir() bool        { return fi.node.Type == drive.TypeFolder }
+func (fi fileInfo) Sys() any           { return nil }
+
+func (fi fileInfo) Mode() os.FileMode {
+	if fi.IsDir() {
+		return os.ModeDir | 0755
+	}
+	return 0644
+}
+
+// ContentType implements webdav.ContentTyper, so listing a folder does not
+// read the content of every file
+func (fi fileInfo) ContentType(ctx context.Context) (string, error) {
+	if fi.node.MimeType == "" {
+		return "application/octet-stream", nil
+	}
+	return fi.node.MimeType, nil
+}
+
+// readFile is a file opened for reading
+type readFile struct {
+	*drive.Reader
+}
+
+func (f *readFile) Readdir(count int) ([]os.FileInfo, error) {
+	return nil, os.ErrInvalid
+}
+
+func (f *readFile) Stat() (os.FileInfo, error) {
+	return fileInfo{f.Node()}, nil
+}
+
+func (f *readFile) Write(p []byte) (int, error) {
+	return 0, os.ErrPermission
+}
+
+// dirFile is an opened folder
+type dirFile struct {
+	fs      *drive.FS
+	ctx     context.Context
+	name    string
+	node    *ent.Node
+	entries []os.FileInfo
+	listed  bool
+}
+
+func (f *dirFile) Close() error                   { return nil }
+func (f *dirFile) Read(p []byte) (int, error)     { return 0, os.ErrInvalid }
+func (f *dirFile) Seek(int64, int) (int64, error) { return 0, os.ErrInvalid }
+func (f *dirFile) Write(p []byte) (int, error)    { return 0, os.ErrInvalid }
+func (f *dirFile) Stat() (os.FileInfo, error)     { return fileInfo{f.node}, nil }
+
+// Readdir follows the semantics of os.File.Readdir
+func (f *dirFile) Readdir(count int) ([]os.FileInfo, error) {
+	if !f.listed {
+		nodes, err := f.fs.List(f.ctx, f.name)
+		if err != nil {
+			return nil, osError(err)
+		}
+		for _, n := range nodes {
+			f.entries = append(f.entries, fileInfo{n})
+		}
+		f.listed = true
+	}
+
+	if count <= 0 {
+		entries := f.entries
+		f.entries = nil
+		return entries, nil
+	}
+	if len(f.entries) == 0 {
+		return nil, io.EOF
+	}
+	count = min(count, len(f.entries))
+	entries := f.entries[:count]
+	f.entries = f.entries[count:]
+	return entries, nil
+}
+
+// uploadFile is a file opened for writing
+type uploadFile struct {
+	upload *drive.Upload
+	name   string
+	err    error // First failure, which discards the upload on Close
+}
+
+func (f *uploadFile) Read(p []byte) (int, error)     { return 0, os.ErrInvalid }
+func (f *uploadFile) Seek(int64, int) (int64, error) { return 0, os.ErrInvalid }
+
+func (f *uploadFile) Readdir(count int) ([]os.FileInfo, error) {
+	return nil, os.ErrInvalid
+}
+
+func (f *uploadFile) Write(p []byte) (int, error) {
+	n, err := f.upload.Write(p)
+	if err != nil && f.err == nil {
+		f.err = err
+	}
+	return n, err
+}
+
+// ReadFrom is used by io.Copy for PUT bodies and for COPY. Copies of stored
+// files share their content instead of uploading it again, and a broken
+// request body discards the upload rather than storing a truncated file.
+func (f *uploadFile) ReadFrom(r io.Reader) (int64, error) {
+	if src, ok := r.(*readFile); ok && src.Node().FileHash != "" {
+		if err := f.upload.CopyOf(src.Node()); err == nil {
+			return src.Node().Size, nil
+		}
+	}
+
+	buf := make([]byte, 256*1024)
+	var total int64
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			written, werr := f.Write(buf[:n])
+			total += int64(written)
+			if werr != nil {
+				return total, werr
+			}
+		}
+		if err == io.EOF {
+			return total, nil
+		}
+		if err != nil {
+			f.err = err
+			return total, err
+		}
+	}
+}
+
+func (f *uploadFile) Stat() (os.FileInfo, error) {
+	return fileInfo{&ent.Node{
+		Name:      f.name,
+		Type:      drive.TypeFile,
+		Size:      f.upload.Written(),
+		UpdatedAt: time.Now(),
+	}}, nil
+}
+
+func (f *uploadFile) Close() error {
+	if f.err != nil {
+		f.upload.Abort()
+		return f.err
+	}
+	_, err := f.upload.Commit()
+	return err
+}
@@ -0,0 +1,126 @@
+package dav_test
+
+import (
+	"context"
+	"gopan-server/config"
+	"gopan-server/internal/dav"
+	"gopan-server/internal/dbtest"
+	"gopan-server/internal/drive"
+	"gopan-server/internal/storage/storagetest"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/webdav"
+)
+
+// newServer serves the drive of a new user over WebDAV, as the API does
+// after authenticating the request
+func newServer(t *testing.T, readOnly bool) string {
+	t.Helper()
+	client := dbtest.Open(t)
+	_, minioCfg := storagetest.Open(t)
+	cfg, err := config.Parse([]byte(`{"jwt": {"secret": "test"}}`))
+	if err != nil {
+		t.Fatalf("parse config: %v", err)
+	}
+	cfg.MinIO = minioCfg
+	u := client.User.Create().SetUsername("alice").SetPasswordHash("x").SetTotalQuota(1 << 30).SaveX(context.Background())
+
+	// The folder exists even for read-only drives
+	if _, err := drive.New(cfg, u.ID, nil, false).Mkdir(context.Background(), "/docs"); err != nil {
+		t.Fatalf("Mkdir: %v", err)
+	}
+
+	handler := &webdav.Handler{
+		FileSystem: dav.New(drive.New(cfg, u.ID, nil, readOnly)),
+		LockSystem: webdav.NewMemLS(),
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler.ServeHTTP(w, r.WithContext(dav.WithContentLength(r.Context(), r.ContentLength)))
+	}))
+	t.Cleanup(srv.Close)
+	return srv.URL
+}
+
+// do sends a WebDAV request and returns the status code and body
+func do(t *testing.T, method, url, body string, headers ...string) (int, string) {
+	t.Helper()
+	var r io.Reader
+	if body != "" {
+		r = strings.NewReader(body)
+	}
+	req, err := http.NewRequest(method, url, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i+1 < len(headers); i += 2 {
+		req.Header.Set(headers[i], headers[i+1])
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("%s %s: %v", method, url, err)
+	}
+	defer resp.Body.Close()
+	data, _ := io.ReadAll(resp.Body)
+	return resp.StatusCode, string(data)
+}
+
+func TestReadWrite(t *testing.T) {
+	url := newServer(t, false)
+
+	steps := []struct {
+		method  string
+		path    string
+		body    string
+		headers []string
+		status  int
+		want    string // Contained in the response body
+	}{
+		{"MKCOL", "/docs/2024", "", nil, http.StatusCreated, ""},
+		{"PUT", "/docs/2024/notes.txt", "some notes", nil, http.StatusCreated, ""},
+		{"GET", "/docs/2024/notes.txt", "", nil, http.StatusOK, "some notes"},
+		{"GET", "/docs/2024/notes.txt", "", []string{"Range", "bytes=5-"}, http.StatusPartialContent, "notes"},
+		{"PROPFIND", "/docs/2024", "", []string{"Depth", "1"}, http.StatusMultiStatus, "/docs/2024/notes.txt"},
+		{"PUT", "/docs/2024/notes.txt", "new notes", nil, http.StatusCreated, ""},
+		{"GET", "/docs/2024/notes.txt", "", nil, http.StatusOK, "new notes"},
+		{"COPY", "/docs/2024/notes.txt", "", []string{"Destination", url + "/docs/copy.txt"}, http.StatusCreated, ""},
+		{"GET", "/docs/copy.txt", "", nil, http.StatusOK, "new notes"},
+		{"MOVE", "/docs/copy.txt", "", []string{"Destination", url + "/moved.txt"}, http.StatusCreated, ""},
+		{"GET", "/docs/copy.txt", "", nil, http.StatusNotFound, ""},
+		{"GET", "/moved.txt", "", nil, http.StatusOK, "new notes"},
+		{"DELETE", "/docs", "", nil, http.StatusNoContent, ""},
+		{"GET", "/docs/2024/notes.txt", "", nil, http.StatusNotFound, ""},
+		{"PROPFIND", "/", "", []string{"Depth", "1"}, http.StatusMultiStatus, "/moved.txt"},
+	}
+	for _, s := range steps {
+		status, body := do(t, s.method, url+s.path, s.body, s.headers...)
+		if status != s.status {
+			t.Fatalf("%s %s: HTTP %d, want %d: %s", s.method, s.path, status, s.status, body)
+		}
+		if !strings.Contains(body, s.want) {
+			t.Errorf("%s %s returned %q, want %q in it", s.method, s.path, body, s.want)
+		}
+	}
+}
+
+func TestReadOnly(t *testing.T) {
+	url := newServer(t, true)
+
+	if status, body := do(t, "PROPFIND", url+"/", "", "Depth", "1"); status != http.StatusMultiStatus || !strings.Contains(body, "/docs") {
+		t.Errorf("PROPFIND: HTTP %d: %s", status, body)
+	}
+	for _, method := range []string{"PUT", "MKCOL"} {
+		if status, _ := do(t, method, url+"/docs/new", "data"); status < 400 {
+			t.Errorf("%s on a read-only drive: HTTP %d", method, status)
+		}
+	}
+	if status, _ := do(t, "DELETE", url+"/docs", ""); status < 400 {
+		t.Errorf("DELETE on a read-only drive: HTTP %d", status)
+	}
+	if status, _ := do(t, "GET", url+"/docs/new", ""); status != http.StatusNotFound {
+		t.Errorf("GET after the rejected writes: HTTP %d, want 404", status)
+	}
+}
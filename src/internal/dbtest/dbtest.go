@@ -0,0 +1,42 @@
+// Package dbtest gives tests a throwaway database in place of PostgreSQL
+package dbtest
+
+import (
+	"context"
+	"gopan-server/ent"
+	"gopan-server/internal/database"
+	"gopan-server/internal/logger"
+	"path/filepath"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// Open creates an empty SQLite database with the current schema and makes it
+// the database of the server until the test ends
+func Open(t testing.TB) *ent.Client {
+	t.Helper()
+
+	if logger.Info == nil {
+		logger.Init()
+	}
+
+	dsn := "file:" + filepath.Join(t.TempDir(), "gopan.db") + "?_fk=1&_busy_timeout=5000"
+	client, err := ent.Open(dialect.SQLite, dsn)
+	if err != nil {
+		t.Fatalf("open database: %v", err)
+	}
+	if err := client.Schema.Create(context.Background()); err != nil {
+		client.Close()
+		t.Fatalf("create schema: %v", err)
+	}
+
+	previous := database.Client
+	database.Client = client
+	t.Cleanup(func() {
+		database.Client = previous
+		client.Close()
+	})
+	return client
+}
@@ -0,0 +1,259 @@
+package directory
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"gopan-server/config"
+	"strings"
+
+	"github.com/go-ldap/ldap/v3"
+)
+
+var (
+	ErrDisabled           = errors.New("LDAP authentication is not enabled")
+	ErrInvalidCredentials = errors.New("invalid directory credentials")
+	ErrUserNotFound       = errors.New("user not found in directory")
+)
+
+// rolePriority orders mapped roles, the highest privilege wins when several match
+var rolePriority = map[string]int{"readonly": 1, "user": 2, "admin": 3}
+
+// Entry is a directory user with the groups it belongs to
+type Entry struct {
+	DN       string
+	Username string
+	Email    string
+	Groups   []string
+}
+
+// Client authenticates and looks up users in an LDAP directory.
+// Every call opens its own connection, so a Client is safe for concurrent use.
+type Client struct {
+	cfg *config.LDAPConfig
+}
+
+// New creates a directory client for cfg
+func New(cfg *config.LDAPConfig) *Client {
+	return &Client{cfg: cfg}
+}
+
+// Enabled reports whether LDAP authentication is configured
+func (d *Client) Enabled() bool {
+	return d.cfg.Enabled && d.cfg.URL != "" && d.cfg.BaseDN != ""
+}
+
+// dial connects to the directory and binds as the service account
+func (d *Client) dial() (*ldap.Conn, error) {
+	if !d.Enabled() {
+		return nil, ErrDisabled
+	}
+
+	tlsConfig := &tls.Config{InsecureSkipVerify: d.cfg.InsecureSkipVerify}
+	conn, err := ldap.DialURL(d.cfg.URL, ldap.DialWithTLSConfig(tlsConfig))
+	if err != nil {
+		return nil, fmt.Errorf("connect %s: %w", d.cfg.URL, err)
+	}
+
+	if d.cfg.StartTLS {
+		if err := conn.StartTLS(tlsConfig); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("start TLS: %w", err)
+		}
+	}
+
+	if err := d.bindService(conn); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return conn, nil
+}
+
+// bindService binds as the service account, or anonymously when none is configured
+func (d *Client) bindService(conn *ldap.Conn) error {
+	var err error
+	if d.cfg.BindDN != "" {
+		err = conn.Bind(d.cfg.BindDN, d.cfg.BindPassword)
+	} else {
+		err = conn.UnauthenticatedBind("")
+	}
+	if err != nil {
+		return fmt.Errorf("service bind: %w", err)
+	}
+	return nil
+}
+
+// Authenticate verifies a username and password by binding as the user
+func (d *Client) Authenticate(username, password string) (*Entry, error) {
+	// An empty password would be an unauthenticated bind, which many servers accept
+	if username == "" || password == "" {
+		return nil, ErrInvalidCredentials
+	}
+
+	conn, err := d.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	entry, err := d.find(conn, username)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := conn.Bind(entry.DN, password); err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials) {
+			return nil, ErrInvalidCredentials
+		}
+		return nil, fmt.Errorf("user bind: %w", err)
+	}
+
+	// Group searches may need the service account's rights
+	if err := d.bindService(conn); err != nil {
+		return nil, err
+	}
+	if err := d.loadGroups(conn, entry); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// LookupAll looks up users by username over a single connection. Users
+// missing from the directory are absent from the result.
+func (d *Client) LookupAll(usernames []string) (map[string]*Entry, error) {
+	conn, err := d.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	entries := make(map[string]*Entry, len(usernames))
+	for _, username := range usernames {
+		entry, err := d.find(conn, username)
+		if errors.Is(err, ErrUserNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if err := d.loadGroups(conn, entry); err != nil {
+			return nil, err
+		}
+		entries[username] = entry
+	}
+	return entries, nil
+}
+
+// find searches the user entry of username
+func (d *Client) find(conn *ldap.Conn, username string) (*Entry, error) {
+	req := ldap.NewSearchRequest(
+		d.cfg.BaseDN,
+		ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 2, 0, false,
+		fmt.Sprintf(d.cfg.UserFilter, ldap.EscapeFilter(username)),
+		[]string{d.cfg.UsernameAttribute, d.cfg.EmailAttribute, d.cfg.GroupAttribute},
+		nil,
+	)
+	// A missing base DN is a configuration error, not a missing user, so
+	// sync never disables everyone because of it
+	res, err := conn.Search(req)
+	if err != nil {
+		return nil, fmt.Errorf("search user: %w", err)
+	}
+	// An ambiguous filter must not let one user log in as another
+	if len(res.Entries) != 1 {
+		return nil, ErrUserNotFound
+	}
+
+	e := res.Entries[0]
+	entry := &Entry{
+		DN:       e.DN,
+		Username: e.GetAttributeValue(d.cfg.UsernameAttribute),
+		Email:    e.GetAttributeValue(d.cfg.EmailAttribute),
+		Groups:   e.GetAttributeValues(d.cfg.GroupAttribute),
+	}
+	if entry.Username == "" {
+		entry.Username = username
+	}
+	return entry, nil
+}
+
+// loadGroups searches the groups of entry when groups are not read from a user attribute
+func (d *Client) loadGroups(conn *ldap.Conn, entry *Entry) error {
+	if d.cfg.GroupBaseDN == "" {
+		return nil
+	}
+
+	req := ldap.NewSearchRequest(
+		d.cfg.GroupBaseDN,
+		ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 0, 0, false,
+		fmt.Sprintf(d.cfg.GroupFilter, ldap.EscapeFilter(entry.DN)),
+		[]string{"dn"},
+		nil,
+	)
+	res, err := conn.Search(req)
+	if err != nil {
+		return fmt.Errorf("search groups: %w", err)
+	}
+
+	entry.Groups = make([]string, len(res.Entries))
+	for i, g := range res.Entries {
+		entry.Groups[i] = g.DN
+	}
+	return nil
+}
+
+// Allowed reports whether entry may use GoPan according to the required group
+func (d *Client) Allowed(entry *Entry) bool {
+	return d.cfg.RequiredGroup == "" || entry.memberOf(d.cfg.RequiredGroup)
+}
+
+// Role returns the role mapped from the groups of entry
+func (d *Client) Role(entry *Entry) string {
+	role := "user"
+	best := 0
+	for group, r := range d.cfg.RoleGroups {
+		if rolePriority[r] > best && entry.memberOf(group) {
+			role, best = r, rolePriority[r]
+		}
+	}
+	return role
+}
+
+// Quota returns the largest quota mapped from the groups of entry
+func (d *Client) Quota(entry *Entry) (int64, bool) {
+	var quota int64
+	found := false
+	for group, q := range d.cfg.QuotaGroups {
+		if (!found || q > quota) && entry.memberOf(group) {
+			quota, found = q, true
+		}
+	}
+	return quota, found
+}
+
+// memberOf reports whether the entry belongs to group, comparing DNs case-insensitively
+func (e *Entry) memberOf(group string) bool {
+	for _, g := range e.Groups {
+		if strings.EqualFold(normalizeDN(g), normalizeDN(group)) {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeDN removes insignificant spaces between DN components
+func normalizeDN(dn string) string {
+	parsed, err := ldap.ParseDN(dn)
+	if err != nil {
+		return dn
+	}
+	parts := make([]string, len(parsed.RDNs))
+	for i, rdn := range parsed.RDNs {
+		attrs := make([]string, len(rdn.Attributes))
+		for j, a := range rdn.Attributes {
+			attrs[j] = a.Type + "=" + a.Value
+		}
+		parts[i] = strings.Join(attrs, "+")
+	}
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,166 @@
+package directory_test
+
+import (
+	"errors"
+	"gopan-server/config"
+	"gopan-server/internal/directory"
+	"gopan-server/internal/directory/ldaptest"
+	"sort"
+	"testing"
+)
+
+const (
+	serviceDN  = "cn=gopan,dc=example,dc=com"
+	peopleDN   = "ou=people,dc=example,dc=com"
+	groupsDN   = "ou=groups,dc=example,dc=com"
+	aliceDN    = "uid=alice,ou=people,dc=example,dc=com"
+	bobDN      = "uid=bob,ou=people,dc=example,dc=com"
+	adminsDN   = "cn=admins,ou=groups,dc=example,dc=com"
+	staffDN    = "cn=staff,ou=groups,dc=example,dc=com"
+	bigQuotaDN = "cn=big-quota,ou=groups,dc=example,dc=com"
+)
+
+// newDirectory starts a directory where alice is an admin with a big quota
+// and bob is plain staff. Groups are listed both in the memberOf attribute
+// of users and as member attributes of group entries.
+func newDirectory(t *testing.T) (*ldaptest.Server, *config.LDAPConfig) {
+	t.Helper()
+	srv := ldaptest.NewServer(t)
+	srv.Add("dc=example,dc=com", "", nil)
+	srv.Add(serviceDN, "service-secret", nil)
+	srv.Add(peopleDN, "", nil)
+	srv.Add(groupsDN, "", nil)
+	srv.Add(aliceDN, "alice-secret", map[string][]string{
+		"uid":      {"alice"},
+		"mail":     {"alice@example.com"},
+		"memberOf": {adminsDN, staffDN, bigQuotaDN},
+	})
+	srv.Add(bobDN, "bob-secret", map[string][]string{
+		"uid":      {"bob"},
+		"memberOf": {staffDN},
+	})
+	srv.Add(adminsDN, "", map[string][]string{"member": {aliceDN}})
+	srv.Add(staffDN, "", map[string][]string{"member": {aliceDN, bobDN}})
+	srv.Add(bigQuotaDN, "", map[string][]string{"member": {aliceDN}})
+
+	cfg, err := config.Parse([]byte(`{
+		"jwt": {"secret": "test"},
+		"ldap": {
+			"enabled": true,
+			"bind_dn": "` + serviceDN + `",
+			"bind_password": "service-secret",
+			"base_dn": "` + peopleDN + `",
+			"role_groups": {"` + adminsDN + `": "admin"},
+			"quota_groups": {"` + staffDN + `": 1024, "` + bigQuotaDN + `": 4096}
+		}
+	}`))
+	if err != nil {
+		t.Fatalf("parse config: %v", err)
+	}
+	cfg.LDAP.URL = srv.URL
+	return srv, &cfg.LDAP
+}
+
+func TestAuthenticate(t *testing.T) {
+	_, cfg := newDirectory(t)
+	dir := directory.New(cfg)
+
+	entry, err := dir.Authenticate("alice", "alice-secret")
+	if err != nil {
+		t.Fatalf("Authenticate: %v", err)
+	}
+	if entry.DN != aliceDN || entry.Username != "alice" || entry.Email != "alice@example.com" || len(entry.Groups) != 3 {
+		t.Errorf("entry = %+v", entry)
+	}
+
+	tests := []struct {
+		name, username, password string
+		want                     error
+	}{
+		{"wrong password", "alice", "bob-secret", directory.ErrInvalidCredentials},
+		{"empty password", "alice", "", directory.ErrInvalidCredentials},
+		{"unknown user", "carol", "secret", directory.ErrUserNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := dir.Authenticate(tt.username, tt.password); !errors.Is(err, tt.want) {
+				t.Errorf("err = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestAuthenticateWrongServicePassword(t *testing.T) {
+	_, cfg := newDirectory(t)
+	cfg.BindPassword = "wrong"
+
+	_, err := directory.New(cfg).Authenticate("alice", "alice-secret")
+	if err == nil || errors.Is(err, directory.ErrInvalidCredentials) {
+		t.Fatalf("err = %v, want a service bind error rather than invalid user credentials", err)
+	}
+}
+
+func TestGroupSearch(t *testing.T) {
+	_, cfg := newDirectory(t)
+	cfg.GroupAttribute = "unused"
+	cfg.GroupBaseDN = groupsDN
+	dir := directory.New(cfg)
+
+	entry, err := dir.Authenticate("bob", "bob-secret")
+	if err != nil {
+		t.Fatalf("Authenticate: %v", err)
+	}
+	if len(entry.Groups) != 1 || entry.Groups[0] != staffDN {
+		t.Errorf("groups = %v, want [%s]", entry.Groups, staffDN)
+	}
+
+	entries, err := dir.LookupAll([]string{"alice", "bob", "carol"})
+	if err != nil {
+		t.Fatalf("LookupAll: %v", err)
+	}
+	if _, ok := entries["carol"]; ok || len(entries) != 2 {
+		t.Errorf("LookupAll returned %d entries, want alice and bob", len(entries))
+	}
+	groups := entries["alice"].Groups
+	sort.Strings(groups)
+	if len(groups) != 3 || groups[0] != adminsDN {
+		t.Errorf("groups of alice = %v", groups)
+	}
+}
+
+func TestRoleAndQuota(t *testing.T) {
+	_, cfg := newDirectory(t)
+	cfg.RoleGroups["cn=guests,ou=groups,dc=example,dc=com"] = "readonly"
+	cfg.RequiredGroup = "CN=Staff, OU=Groups, DC=Example, DC=Com"
+	dir := directory.New(cfg)
+
+	tests := []struct {
+		name      string
+		groups    []string
+		role      string
+		quota     int64 // -1 for none
+		isAllowed bool
+	}{
+		{"no groups", nil, "user", -1, false},
+		{"staff", []string{staffDN}, "user", 1024, true},
+		{"guest", []string{"cn=guests,ou=groups,dc=example,dc=com", staffDN}, "readonly", 1024, true},
+		{"admin wins over guest", []string{"cn=guests,ou=groups,dc=example,dc=com", adminsDN}, "admin", -1, false},
+		{"largest quota wins", []string{bigQuotaDN, staffDN}, "user", 4096, true},
+		{"DNs compared case-insensitively", []string{"CN=Admins,OU=Groups,DC=example,DC=com"}, "admin", -1, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry := &directory.Entry{Groups: tt.groups}
+			if role := dir.Role(entry); role != tt.role {
+				t.Errorf("role = %q, want %q", role, tt.role)
+			}
+			quota, ok := dir.Quota(entry)
+			if (tt.quota < 0 && ok) || (tt.quota >= 0 && (!ok || quota != tt.quota)) {
+				t.Errorf("quota = %d, %v, want %d", quota, ok, tt.quota)
+			}
+			if allowed := dir.Allowed(entry); allowed != tt.isAllowed {
+				t.Errorf("allowed = %v, want %v", allowed, tt.isAllowed)
+			}
+		})
+	}
+}
@@ -0,0 +1,295 @@
+// Package ldaptest runs an in-memory LDAP directory for tests. It speaks
+// enough LDAPv3 for GoPan: simple binds, searches with equality, presence,
+// and, or and not filters, and unbinds.
+package ldaptest
+
+import (
+	"net"
+	"strings"
+	"sync"
+	"testing"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+)
+
+// LDAP protocol operations and result codes used by the server
+const (
+	opBindRequest        = 0
+	opBindResponse       = 1
+	opUnbindRequest      = 2
+	opSearchRequest      = 3
+	opSearchResultEntry  = 4
+	opSearchResultDone   = 5
+	resultSuccess        = 0
+	resultNoSuchObject   = 32
+	resultInvalidCreds   = 49
+	resultUnwillingToAct = 53
+)
+
+// Entry is a directory entry. Attribute names are case-insensitive.
+type Entry struct {
+	DN         string
+	Password   string // Empty when the entry can't bind
+	Attributes map[string][]string
+}
+
+// Server is an in-memory directory listening on a local port
+type Server struct {
+	URL string
+
+	listener net.Listener
+	mu       sync.Mutex
+	entries  map[string]*Entry // By lowercase DN
+	conns    map[net.Conn]bool
+	wg       sync.WaitGroup
+}
+
+// NewServer starts an empty directory that is closed when the test ends
+func NewServer(t testing.TB) *Server {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	s := &Server{
+		URL:      "ldap://" + listener.Addr().String(),
+		listener: listener,
+		entries:  map[string]*Entry{},
+		conns:    map[net.Conn]bool{},
+	}
+	s.wg.Add(1)
+	go s.serve()
+	t.Cleanup(s.Close)
+	return s
+}
+
+// Add adds an entry, replacing the one with the same DN
+func (s *Server) Add(dn, password string, attrs map[string][]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries[strings.ToLower(dn)] = &Entry{DN: dn, Password: password, Attributes: attrs}
+}
+
+// Remove deletes the entry of dn
+func (s *Server) Remove(dn string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.entries, strings.ToLower(dn))
+}
+
+// Close stops the server and drops open connections, like a directory going down
+func (s *Server) Close() {
+	s.listener.Close()
+	s.mu.Lock()
+	for conn := range s.conns {
+		conn.Close()
+	}
+	s.mu.Unlock()
+	s.wg.Wait()
+}
+
+func (s *Server) serve() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.mu.Lock()
+		s.conns[conn] = true
+		s.mu.Unlock()
+
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.handle(conn)
+			s.mu.Lock()
+			delete(s.conns, conn)
+			s.mu.Unlock()
+			conn.Close()
+		}()
+	}
+}
+
+// handle answers the requests of one connection until it is unbound or closed
+func (s *Server) handle(conn net.Conn) {
+	for {
+		packet, err := ber.ReadPacket(conn)
+		if err != nil || len(packet.Children) < 2 {
+			return
+		}
+		id, _ := packet.Children[0].Value.(int64)
+		op := packet.Children[1]
+
+		var responses []*ber.Packet
+		switch op.Tag {
+		case opBindRequest:
+			responses = []*ber.Packet{result(opBindResponse, s.bind(op))}
+		case opSearchRequest:
+			responses = s.search(op)
+		case opUnbindRequest:
+			return
+		default:
+			responses = []*ber.Packet{result(opSearchResultDone, resultUnwillingToAct)}
+		}
+
+		for _, resp := range responses {
+			msg := ber.NewSequence("LDAP Message")
+			msg.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, id, "Message ID"))
+			msg.AppendChild(resp)
+			if _, err := conn.Write(msg.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// bind checks a simple bind. Anonymous binds are allowed.
+func (s *Server) bind(op *ber.Packet) int {
+	if len(op.Children) < 3 {
+		return resultUnwillingToAct
+	}
+	dn, _ := op.Children[1].Value.(string)
+	password := op.Children[2].Data.String()
+	if dn == "" && password == "" {
+		return resultSuccess
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e := s.entries[strings.ToLower(dn)]
+	if e == nil || e.Password == "" || e.Password != password {
+		return resultInvalidCreds
+	}
+	return resultSuccess
+}
+
+// search returns the entries below the base DN that match the filter
+func (s *Server) search(op *ber.Packet) []*ber.Packet {
+	if len(op.Children) < 8 {
+		return []*ber.Packet{result(opSearchResultDone, resultUnwillingToAct)}
+	}
+	base, _ := op.Children[0].Value.(string)
+	filter := op.Children[6]
+	var attrs []string
+	for _, a := range op.Children[7].Children {
+		if name, ok := a.Value.(string); ok {
+			attrs = append(attrs, name)
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	base = strings.ToLower(base)
+	baseExists := base == ""
+	var responses []*ber.Packet
+	for key, e := range s.entries {
+		if key == base {
+			baseExists = true
+		}
+		if key != base && !strings.HasSuffix(key, ","+base) {
+			continue
+		}
+		if matches(e, filter) {
+			responses = append(responses, entryPacket(e, attrs))
+		}
+	}
+	if !baseExists {
+		return []*ber.Packet{result(opSearchResultDone, resultNoSuchObject)}
+	}
+	return append(responses, result(opSearchResultDone, resultSuccess))
+}
+
+// matches evaluates a search filter against an entry
+func matches(e *Entry, filter *ber.Packet) bool {
+	switch filter.Tag {
+	case 0: // and
+		for _, f := range filter.Children {
+			if !matches(e, f) {
+				return false
+			}
+		}
+		return true
+	case 1: // or
+		for _, f := range filter.Children {
+			if matches(e, f) {
+				return true
+			}
+		}
+		return false
+	case 2: // not
+		return len(filter.Children) == 1 && !matches(e, filter.Children[0])
+	case 3: // equalityMatch
+		if len(filter.Children) != 2 {
+			return false
+		}
+		name, _ := filter.Children[0].Value.(string)
+		value, _ := filter.Children[1].Value.(string)
+		for _, v := range e.values(name) {
+			if strings.EqualFold(v, value) {
+				return true
+			}
+		}
+		return false
+	case 7: // present
+		return len(e.values(filter.Data.String())) > 0
+	}
+	return false
+}
+
+// values returns the values of an attribute, matching its name case-insensitively
+func (e *Entry) values(name string) []string {
+	for k, v := range e.Attributes {
+		if strings.EqualFold(k, name) {
+			return v
+		}
+	}
+	return nil
+}
+
+// entryPacket encodes a search result entry with the requested attributes
+func entryPacket(e *Entry, attrs []string) *ber.Packet {
+	p := ber.Encode(ber.ClassApplication, ber.TypeConstructed, opSearchResultEntry, nil, "Search Result Entry")
+	p.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, e.DN, "Object Name"))
+
+	list := ber.NewSequence("Attributes")
+	for name, values := range e.Attributes {
+		if !requested(name, attrs) {
+			continue
+		}
+		attr := ber.NewSequence("Attribute")
+		attr.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, name, "Type"))
+		set := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "Values")
+		for _, v := range values {
+			set.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, v, "Value"))
+		}
+		attr.AppendChild(set)
+		list.AppendChild(attr)
+	}
+	p.AppendChild(list)
+	return p
+}
+
+// requested reports whether an attribute was asked for; no list means all
+func requested(name string, attrs []string) bool {
+	if len(attrs) == 0 {
+		return true
+	}
+	for _, a := range attrs {
+		if a == "*" || strings.EqualFold(a, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// result encodes an LDAPResult of the operation op
+func result(op ber.Tag, code int) *ber.Packet {
+	p := ber.Encode(ber.ClassApplication, ber.TypeConstructed, op, nil, "Result")
+	p.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, code, "Result Code"))
+	p.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Matched DN"))
+	p.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Diagnostic Message"))
+	return p
+}
@@ -0,0 +1,372 @@
+// Package drive exposes the files of a user as a tree addressed by slash
+// separated paths, for protocol gateways such as WebDAV.
+package drive
+
+import (
+	"context"
+	"errors"
+	"gopan-server/config"
+	"gopan-server/ent"
+	"gopan-server/ent/change"
+	"gopan-server/ent/node"
+	"gopan-server/ent/share"
+	"gopan-server/ent/user"
+	"gopan-server/internal/changes"
+	"gopan-server/internal/database"
+	"path"
+	"strings"
+	"time"
+)
+
+// Node types
+const (
+	TypeFolder = 0
+	TypeFile   = 1
+)
+
+var (
+	ErrNotFound      = errors.New("no such file or folder")
+	ErrExist         = errors.New("file or folder already exists")
+	ErrNotFolder     = errors.New("not a folder")
+	ErrIsFolder      = errors.New("is a folder")
+	ErrInvalidName   = errors.New("invalid name")
+	ErrInvalidMove   = errors.New("cannot move a folder into itself")
+	ErrRoot          = errors.New("not allowed on the root folder")
+	ErrReadOnly      = errors.New("read-only access")
+	ErrQuotaExceeded = errors.New("insufficient storage capacity")
+)
+
+// FS is the file tree of one user
+type FS struct {
+	cfg      *config.Config
+	ownerID  int
+	rootID   *int // Folder served as "/", nil for the top level of the user's files
+	readOnly bool
+}
+
+// New creates the file tree of a user. A non-nil rootID restricts the tree
+// to that folder, as for folder restricted access tokens.
+func New(cfg *config.Config, ownerID int, rootID *int, readOnly bool) *FS {
+	return &FS{cfg: cfg, ownerID: ownerID, rootID: rootID, readOnly: readOnly}
+}
+
+// ReadOnly reports whether the tree rejects modifications
+func (fs *FS) ReadOnly() bool {
+	return fs.readOnly
+}
+
+// Stat returns the node at p. The top level of the user's files has no
+// node of its own and is returned as a folder node with ID 0.
+func (fs *FS) Stat(ctx context.Context, p string) (*ent.Node, error) {
+	n, err := fs.lookup(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+	if n == nil {
+		return &ent.Node{Name: "/", Type: TypeFolder}, nil
+	}
+	return n, nil
+}
+
+// List returns the children of the folder at p, ordered by name
+func (fs *FS) List(ctx context.Context, p string) ([]*ent.Node, error) {
+	dir, err := fs.lookup(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+	if dir != nil && dir.Type != TypeFolder {
+		return nil, ErrNotFolder
+	}
+	return fs.children(dir).
+		Order(ent.Asc(node.FieldName)).
+		All(ctx)
+}
+
+// Mkdir creates a folder at p
+func (fs *FS) Mkdir(ctx context.Context, p string) (*ent.Node, error) {
+	if fs.readOnly {
+		return nil, ErrReadOnly
+	}
+	parent, name, err := fs.lookupParent(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := fs.child(ctx, parent, name); err == nil {
+		return nil, ErrExist
+	} else if !errors.Is(err, ErrNotFound) {
+		return nil, err
+	}
+	return fs.createFolder(ctx, parent, name)
+}
+
+// MkdirAll returns the folder at p, creating it and any missing parents.
+// It returns nil for the top level of the user's files.
+func (fs *FS) MkdirAll(ctx context.Context, p string) (*ent.Node, error) {
+	cur, err := fs.lookup(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range split(p) {
+		next, err := fs.child(ctx, cur, name)
+		if errors.Is(err, ErrNotFound) {
+			if fs.readOnly {
+				return nil, ErrReadOnly
+			}
+			if !ValidName(name) {
+				return nil, ErrInvalidName
+			}
+			next, err = fs.createFolder(ctx, cur, name)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if next.Type != TypeFolder {
+			return nil, ErrNotFolder
+		}
+		cur = next
+	}
+	return cur, nil
+}
+
+// createFolder creates a folder in parent
+func (fs *FS) createFolder(ctx context.Context, parent *ent.Node, name string) (*ent.Node, error) {
+	n, err := database.Client.Node.Create().
+		SetName(name).
+		SetType(TypeFolder).
+		SetOwnerID(fs.ownerID).
+		SetNillableParentID(nodeID(parent)).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	changes.Record(ctx, change.KindCreate, n)
+	return n, nil
+}
+
+// Remove moves the file or folder at p to the trash
+func (fs *FS) Remove(ctx context.Context, p string) error {
+	if fs.readOnly {
+		return ErrReadOnly
+	}
+	n, err := fs.lookup(ctx, p)
+	if err != nil {
+		return err
+	}
+	if n == nil || (fs.rootID != nil && n.ID == *fs.rootID) {
+		return ErrRoot
+	}
+	return Trash(ctx, n)
+}
+
+// Move renames the file or folder at src to dst, which must not exist
+func (fs *FS) Move(ctx context.Context, src, dst string) error {
+	if fs.readOnly {
+		return ErrReadOnly
+	}
+	n, err := fs.lookup(ctx, src)
+	if err != nil {
+		return err
+	}
+	if n == nil || (fs.rootID != nil && n.ID == *fs.rootID) {
+		return ErrRoot
+	}
+	parent, name, err := fs.lookupParent(ctx, dst)
+	if err != nil {
+		return err
+	}
+	if existing, err := fs.child(ctx, parent, name); err == nil {
+		if existing.ID == n.ID {
+			return nil
+		}
+		return ErrExist
+	} else if !errors.Is(err, ErrNotFound) {
+		return err
+	}
+
+	// A folder cannot become its own descendant
+	if n.Type == TypeFolder && parent != nil {
+		ids, err := SubtreeIDs(ctx, n.ID)
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			if id == parent.ID {
+				return ErrInvalidMove
+			}
+		}
+	}
+
+	// Staying in the same folder is a rename, 0 standing for the top level
+	oldParentID, err := n.QueryParent().OnlyID(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return err
+	}
+	kind := change.KindRename
+	if parent == nil && oldParentID != 0 || parent != nil && parent.ID != oldParentID {
+		kind = change.KindMove
+	}
+
+	update := n.Update().SetName(name)
+	if parent == nil {
+		update.ClearParent()
+	} else {
+		update.SetParentID(parent.ID)
+	}
+	moved, err := update.Save(ctx)
+	if err != nil {
+		return err
+	}
+	changes.Record(ctx, kind, moved)
+	return nil
+}
+
+// Trash moves a node to the trash and suspends the shares of everything
+// below it, as deleting from the file list does
+func Trash(ctx context.Context, n *ent.Node) error {
+	now := time.Now()
+	trashed, err := n.Update().
+		SetIsDeleted(true).
+		SetDeletedAt(now).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	changes.Record(ctx, change.KindTrash, trashed)
+
+	subtreeIDs, err := SubtreeIDs(ctx, n.ID)
+	if err != nil {
+		return err
+	}
+	_, err = database.Client.Share.Update().
+		Where(share.HasNodeWith(node.IDIn(subtreeIDs...))).
+		Where(share.StatusEQ(share.StatusActive)).
+		SetStatus(share.StatusSuspended).
+		Save(ctx)
+	return err
+}
+
+// SubtreeIDs returns the IDs of a node and all of its descendants
+func SubtreeIDs(ctx context.Context, rootID int) ([]int, error) {
+	ids := []int{rootID}
+	frontier := []int{rootID}
+	for len(frontier) > 0 {
+		children, err := database.Client.Node.Query().
+			Where(node.HasParentWith(node.IDIn(frontier...))).
+			IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, children...)
+		frontier = children
+	}
+	return ids, nil
+}
+
+// Available returns how many more bytes the owner may store
+func (fs *FS) Available(ctx context.Context) (int64, error) {
+	u, err := database.Client.User.Get(ctx, fs.ownerID)
+	if err != nil {
+		return 0, err
+	}
+	return max(u.TotalQuota-u.TotalUsed, 0), nil
+}
+
+// lookup resolves p to its node, nil standing for the top level of the
+// user's files. Nodes in the trash and below trashed folders are not found.
+func (fs *FS) lookup(ctx context.Context, p string) (*ent.Node, error) {
+	var cur *ent.Node
+	if fs.rootID != nil {
+		root, err := database.Client.Node.Query().
+			Where(node.IDEQ(*fs.rootID)).
+			Where(node.HasOwnerWith(user.IDEQ(fs.ownerID))).
+			Where(node.TypeEQ(TypeFolder)).
+			Where(node.IsDeletedEQ(false)).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		if err != nil {
+			return nil, err
+		}
+		cur = root
+	}
+
+	for _, name := range split(p) {
+		if cur != nil && cur.Type != TypeFolder {
+			return nil, ErrNotFound
+		}
+		next, err := fs.child(ctx, cur, name)
+		if err != nil {
+			return nil, err
+		}
+		cur = next
+	}
+	return cur, nil
+}
+
+// lookupParent resolves the folder p would be created in, returning it with
+// the last element of p
+func (fs *FS) lookupParent(ctx context.Context, p string) (*ent.Node, string, error) {
+	elems := split(p)
+	if len(elems) == 0 {
+		return nil, "", ErrRoot
+	}
+	name := elems[len(elems)-1]
+	if !ValidName(name) {
+		return nil, "", ErrInvalidName
+	}
+	parent, err := fs.lookup(ctx, strings.Join(elems[:len(elems)-1], "/"))
+	if err != nil {
+		return nil, "", err
+	}
+	if parent != nil && parent.Type != TypeFolder {
+		return nil, "", ErrNotFolder
+	}
+	return parent, name, nil
+}
+
+// children returns a query for the live children of a folder
+func (fs *FS) children(parent *ent.Node) *ent.NodeQuery {
+	query := database.Client.Node.Query().
+		Where(node.HasOwnerWith(user.IDEQ(fs.ownerID))).
+		Where(node.IsDeletedEQ(false))
+	if parent == nil {
+		return query.Where(node.Not(node.HasParent()))
+	}
+	return query.Where(node.HasParentWith(node.IDEQ(parent.ID)))
+}
+
+// child returns the child of a folder with the given name. The file list
+// allows duplicate names, in which case the oldest node wins.
+func (fs *FS) child(ctx context.Context, parent *ent.Node, name string) (*ent.Node, error) {
+	n, err := fs.children(parent).
+		Where(node.NameEQ(name)).
+		Order(ent.Asc(node.FieldID)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrNotFound
+	}
+	return n, err
+}
+
+// ValidName reports whether name can be used for a file or folder
+func ValidName(name string) bool {
+	return name != "" && name != "." && name != ".." && !strings.ContainsAny(name, "/\\\x00")
+}
+
+// split returns the elements of a slash separated path
+func split(p string) []string {
+	p = strings.Trim(path.Clean("/"+p), "/")
+	if p == "" {
+		return nil
+	}
+	return strings.Split(p, "/")
+}
+
+// nodeID returns the ID of a folder, or nil for the top level
+func nodeID(n *ent.Node) *int {
+	if n == nil {
+		return nil
+	}
+	return &n.ID
+}
@@ -0,0 +1,87 @@
+package drive
+
+import (
+	"context"
+	"errors"
+	"gopan-server/ent"
+	"gopan-server/internal/storage"
+	"io"
+
+	"github.com/minio/minio-go/v7"
+)
+
+// Reader reads the content of a file. Seeking is free until the next read,
+// which requests the object from the new offset on.
+type Reader struct {
+	ctx    context.Context
+	bucket string
+	node   *ent.Node
+	offset int64
+	body   *minio.Object
+}
+
+// Open returns a reader for the content of a file node
+func (fs *FS) Open(ctx context.Context, n *ent.Node) (*Reader, error) {
+	if n.Type != TypeFile {
+		return nil, ErrIsFolder
+	}
+	return &Reader{ctx: ctx, bucket: fs.cfg.MinIO.BucketName, node: n}, nil
+}
+
+// Node returns the file node being read
+func (r *Reader) Node() *ent.Node {
+	return r.node
+}
+
+// Read implements io.Reader
+func (r *Reader) Read(p []byte) (int, error) {
+	if r.offset >= r.node.Size {
+		return 0, io.EOF
+	}
+	if r.body == nil {
+		opts := minio.GetObjectOptions{}
+		if r.offset > 0 {
+			if err := opts.SetRange(r.offset, 0); err != nil {
+				return 0, err
+			}
+		}
+		body, err := storage.GetClient().GetObject(r.ctx, r.bucket, r.node.MinioObject, opts)
+		if err != nil {
+			return 0, err
+		}
+		r.body = body
+	}
+
+	n, err := r.body.Read(p)
+	r.offset += int64(n)
+	return n, err
+}
+
+// Seek implements io.Seeker
+func (r *Reader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekCurrent:
+		offset += r.offset
+	case io.SeekEnd:
+		offset += r.node.Size
+	}
+	if offset < 0 {
+		return 0, errors.New("negative position")
+	}
+	if offset != r.offset && r.body != nil {
+		r.body.Close()
+		r.body = nil
+	}
+	r.offset = offset
+	return offset, nil
+}
+
+// Close implements io.Closer
+func (r *Reader) Close() error {
+	if r.body == nil {
+		return nil
+	}
+	err := r.body.Close()
+	r.body = nil
+	return err
+}
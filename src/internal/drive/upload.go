@@ -0,0 +1,315 @@
+package drive
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"gopan-server/ent"
+	"gopan-server/ent/change"
+	"gopan-server/ent/filehash"
+	"gopan-server/internal/changes"
+	"gopan-server/internal/database"
+	"gopan-server/internal/events"
+	"gopan-server/internal/logger"
+	"gopan-server/internal/storage"
+	"hash"
+	"io"
+	"mime"
+	"path"
+
+	"github.com/google/uuid"
+	"github.com/minio/minio-go/v7"
+)
+
+// uploadPartSize bounds the memory a streamed upload of unknown size buffers
+const uploadPartSize = 16 * 1024 * 1024
+
+// Upload streams the content of a new file, or of a replacement for an
+// existing one, to MinIO. The file appears in the tree on Commit.
+type Upload struct {
+	fs       *FS
+	ctx      context.Context
+	parent   *ent.Node
+	name     string
+	size     int64 // Expected size, -1 when unknown
+	limit    int64 // Bytes the owner may still store
+	written  int64
+	hasher   hash.Hash
+	object   string
+	pw       *io.PipeWriter
+	done     chan error
+	source   *ent.Node // File whose content is reused instead of uploading
+	finished bool
+}
+
+// Create starts writing the file at p, replacing an existing file on Commit.
+// size is the expected content length, or -1 when unknown.
+func (fs *FS) Create(ctx context.Context, p string, size int64) (*Upload, error) {
+	if fs.readOnly {
+		return nil, ErrReadOnly
+	}
+	parent, name, err := fs.lookupParent(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+	if existing, err := fs.child(ctx, parent, name); err == nil && existing.Type == TypeFolder {
+		return nil, ErrIsFolder
+	}
+
+	limit, err := fs.Available(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if size > limit {
+		return nil, ErrQuotaExceeded
+	}
+
+	return &Upload{
+		fs:     fs,
+		ctx:    ctx,
+		parent: parent,
+		name:   name,
+		size:   size,
+		limit:  limit,
+		hasher: sha256.New(),
+	}, nil
+}
+
+// Written returns the number of bytes written so far
+func (u *Upload) Written() int64 {
+	return u.written
+}
+
+// Write implements io.Writer
+func (u *Upload) Write(p []byte) (int, error) {
+	if u.source != nil || u.finished {
+		return 0, errors.New("upload is closed")
+	}
+	if u.written+int64(len(p)) > u.limit {
+		return 0, ErrQuotaExceeded
+	}
+	if u.pw == nil {
+		u.start()
+	}
+
+	n, err := u.pw.Write(p)
+	u.hasher.Write(p[:n])
+	u.written += int64(n)
+	return n, err
+}
+
+// CopyOf makes the upload reuse the content of another file, which is then
+// stored only once. It must be called before anything is written.
+func (u *Upload) CopyOf(src *ent.Node) error {
+	if u.pw != nil || u.finished {
+		return errors.New("upload already started")
+	}
+	if src.Type != TypeFile || src.FileHash == "" {
+		return errors.New("file content cannot be shared")
+	}
+	u.source = src
+	return nil
+}
+
+// start begins streaming to a new MinIO object
+func (u *Upload) start() {
+	pr, pw := io.Pipe()
+	u.pw = pw
+	u.done = make(chan error, 1)
+	u.object = fmt.Sprintf("%d/%s/%s", u.fs.ownerID, uuid.New().String(), u.name)
+	go func() {
+		_, err := storage.GetClient().PutObject(u.ctx, u.fs.cfg.MinIO.BucketName, u.object, pr, u.size, minio.PutObjectOptions{
+			ContentType: contentType(u.name),
+			PartSize:    uploadPartSize,
+		})
+		pr.CloseWithError(err)
+		u.done <- err
+	}()
+}
+
+// Abort discards the upload
+func (u *Upload) Abort() {
+	if u.finished {
+		return
+	}
+	u.finished = true
+	if u.pw != nil {
+		u.pw.CloseWithError(errors.New("upload aborted"))
+		if err := <-u.done; err == nil {
+			u.removeObject()
+		}
+	}
+}
+
+// Commit finishes the upload and creates or updates the file node. Content
+// that is already stored is deduplicated by its SHA-256 hash.
+func (u *Upload) Commit() (*ent.Node, error) {
+	if u.finished {
+		return nil, errors.New("upload is closed")
+	}
+	if u.source != nil {
+		u.finished = true
+		return u.commitCopy()
+	}
+	if u.size >= 0 && u.written != u.size {
+		u.Abort()
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	// Empty files are stored like any other
+	if u.pw == nil {
+		u.start()
+	}
+	u.pw.Close()
+	u.finished = true
+	if err := <-u.done; err != nil {
+		return nil, err
+	}
+
+	ctx := u.ctx
+	sum := hex.EncodeToString(u.hasher.Sum(nil))
+	object := u.object
+	newObject := true
+	existing, err := database.Client.FileHash.Query().
+		Where(filehash.HashEQ(sum)).
+		Only(ctx)
+	if err == nil {
+		// Same content is stored already
+		u.removeObject()
+		object = existing.MinioObject
+		newObject = false
+		err = existing.Update().AddReferenceCount(1).Exec(ctx)
+	} else if ent.IsNotFound(err) {
+		err = database.Client.FileHash.Create().
+			SetHash(sum).
+			SetMinioObject(object).
+			SetSize(u.written).
+			SetMimeType(contentType(u.name)).
+			Exec(ctx)
+	}
+	if err != nil {
+		if newObject {
+			u.removeObject()
+		}
+		return nil, err
+	}
+
+	n, err := u.save(sum, object, u.written, contentType(u.name))
+	if err != nil {
+		releaseHash(ctx, u.fs.ownerID, sum, u.written, u.fs.cfg.MinIO.BucketName)
+		return nil, err
+	}
+	if newObject {
+		owner, err := database.Client.User.UpdateOneID(u.fs.ownerID).
+			AddTotalUsed(u.written).
+			Save(ctx)
+		if err != nil {
+			logger.Error.Printf("Failed to update used storage of user %d: %v", u.fs.ownerID, err)
+		} else {
+			events.CheckQuota(ctx, owner)
+		}
+	}
+	return n, nil
+}
+
+// commitCopy creates the file with the content of the source file
+func (u *Upload) commitCopy() (*ent.Node, error) {
+	src := u.source
+	err := database.Client.FileHash.Update().
+		Where(filehash.HashEQ(src.FileHash)).
+		AddReferenceCount(1).
+		Exec(u.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := u.save(src.FileHash, src.MinioObject, src.Size, src.MimeType)
+	if err != nil {
+		releaseHash(u.ctx, u.fs.ownerID, src.FileHash, src.Size, u.fs.cfg.MinIO.BucketName)
+		return nil, err
+	}
+	return n, nil
+}
+
+// save creates the file node, or points an existing file at the new content
+// and releases the old content
+func (u *Upload) save(sum, object string, size int64, mimeType string) (*ent.Node, error) {
+	ctx := u.ctx
+	existing, err := u.fs.child(ctx, u.parent, u.name)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return nil, err
+	}
+
+	if existing == nil {
+		n, err := database.Client.Node.Create().
+			SetName(u.name).
+			SetType(TypeFile).
+			SetSize(size).
+			SetMimeType(mimeType).
+			SetFileHash(sum).
+			SetMinioObject(object).
+			SetOwnerID(u.fs.ownerID).
+			SetNillableParentID(nodeID(u.parent)).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		changes.Record(ctx, change.KindCreate, n)
+		return n, nil
+	}
+	if existing.Type != TypeFile {
+		return nil, ErrIsFolder
+	}
+
+	n, err := existing.Update().
+		SetSize(size).
+		SetMimeType(mimeType).
+		SetFileHash(sum).
+		SetMinioObject(object).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	changes.Record(ctx, change.KindUpdate, n)
+	if existing.FileHash != "" {
+		releaseHash(ctx, u.fs.ownerID, existing.FileHash, existing.Size, u.fs.cfg.MinIO.BucketName)
+	}
+	return n, nil
+}
+
+// removeObject deletes the object the upload streamed to
+func (u *Upload) removeObject() {
+	err := storage.GetClient().RemoveObject(context.Background(), u.fs.cfg.MinIO.BucketName, u.object, minio.RemoveObjectOptions{})
+	if err != nil {
+		logger.Error.Printf("Failed to remove object %s: %v", u.object, err)
+	}
+}
+
+// releaseHash drops a reference to stored content. Content nothing refers to
+// anymore is deleted and no longer counts against the quota of ownerID.
+func releaseHash(ctx context.Context, ownerID int, sum string, size int64, bucket string) {
+	record, err := database.Client.FileHash.Query().
+		Where(filehash.HashEQ(sum)).
+		Only(ctx)
+	if err != nil {
+		return
+	}
+	if record.ReferenceCount > 1 {
+		record.Update().AddReferenceCount(-1).Exec(ctx)
+		return
+	}
+
+	storage.GetClient().RemoveObject(ctx, bucket, record.MinioObject, minio.RemoveObjectOptions{})
+	database.Client.FileHash.DeleteOne(record).Exec(ctx)
+	database.Client.User.UpdateOneID(ownerID).AddTotalUsed(-size).Exec(ctx)
+}
+
+// contentType guesses the MIME type of a file from its name
+func contentType(name string) string {
+	if t := mime.TypeByExtension(path.Ext(name)); t != "" {
+		return t
+	}
+	return "application/octet-stream"
+}
@@ -0,0 +1,83 @@
+// Package events pushes notifications to the connected clients of a user,
+// such as changed files, share activity and quota warnings. A broker fans
+// them out, either within this process or through PostgreSQL to all
+// instances of the server.
+package events
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"gopan-server/config"
+	"gopan-server/internal/logger"
+	"time"
+)
+
+// Event types
+const (
+	TypeChange = "change" // A file or folder changed, data is the change journal entry
+	TypeShare  = "share"  // A share was accessed or disabled
+	TypeQuota  = "quota"  // Storage in use is close to or over the quota
+	TypeJob    = "job"    // Progress of a background job
+)
+
+// Event is a notification for a user
+type Event struct {
+	Type string          `json:"type"`
+	Time time.Time       `json:"time"`
+	Data json.RawMessage `json:"data"`
+}
+
+// Broker fans events out to the subscriptions of users. Events are not
+// stored, so clients that were not subscribed miss them.
+type Broker interface {
+	// Publish delivers an event to the subscriptions of the given users
+	Publish(ctx context.Context, userIDs []int, e Event) error
+	// Subscribe returns the events of a user until the subscription is closed
+	Subscribe(userID int) *Subscription
+	// Close ends all subscriptions
+	Close() error
+}
+
+var broker Broker = NewMemoryBroker()
+
+// Init sets up the configured broker
+func Init(cfg *config.Config, db *sql.DB) error {
+	if cfg.Events.Broker != "postgres" {
+		return nil
+	}
+	b, err := NewPostgresBroker(db, cfg.Database.DSN())
+	if err != nil {
+		return err
+	}
+	broker = b
+	return nil
+}
+
+// Close ends all subscriptions, so that event streams do not hold up a
+// shutdown
+func Close() error {
+	return broker.Close()
+}
+
+// Publish sends an event to users. Events only tell clients to look again,
+// so failures are logged rather than returned.
+func Publish(ctx context.Context, typ string, data any, userIDs ...int) {
+	if len(userIDs) == 0 {
+		return
+	}
+	raw, err := json.Marshal(data)
+	if err != nil {
+		logger.Error.Printf("Failed to encode %s event: %v", typ, err)
+		return
+	}
+	e := Event{Type: typ, Time: time.Now(), Data: raw}
+	if err := broker.Publish(context.WithoutCancel(ctx), userIDs, e); err != nil {
+		logger.Error.Printf("Failed to publish %s event: %v", typ, err)
+	}
+}
+
+// Subscribe returns the events of a user until the subscription is closed
+func Subscribe(userID int) *Subscription {
+	return broker.Subscribe(userID)
+}
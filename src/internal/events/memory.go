@@ -0,0 +1,127 @@
+package events
+
+import (
+	"context"
+	"sync"
+)
+
+// subscriptionBuffer is how many events a subscription holds for a client
+// that is slow to read them
+const subscriptionBuffer = 64
+
+// Subscription receives the events of a user
+type Subscription struct {
+	// C is closed when the subscription ends, also when the client fell
+	// behind or the broker closed. Clients then reconnect and catch up.
+	C <-chan Event
+
+	c      chan Event
+	userID int
+	hub    *hub
+}
+
+// Close ends the subscription
+func (s *Subscription) Close() {
+	s.hub.remove(s)
+}
+
+// hub delivers events to the subscriptions in this process
+type hub struct {
+	mu     sync.Mutex
+	subs   map[int]map[*Subscription]struct{}
+	closed bool
+}
+
+func newHub() *hub {
+	return &hub{subs: make(map[int]map[*Subscription]struct{})}
+}
+
+func (h *hub) subscribe(userID int) *Subscription {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	c := make(chan Event, subscriptionBuffer)
+	s := &Subscription{C: c, c: c, userID: userID, hub: h}
+	if h.closed {
+		close(c)
+		return s
+	}
+	if h.subs[userID] == nil {
+		h.subs[userID] = make(map[*Subscription]struct{})
+	}
+	h.subs[userID][s] = struct{}{}
+	return s
+}
+
+func (h *hub) remove(s *Subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.drop(s)
+}
+
+// drop ends a subscription unless it ended already. h.mu must be held.
+func (h *hub) drop(s *Subscription) {
+	subs := h.subs[s.userID]
+	if _, ok := subs[s]; !ok {
+		return
+	}
+	delete(subs, s)
+	if len(subs) == 0 {
+		delete(h.subs, s.userID)
+	}
+	close(s.c)
+}
+
+func (h *hub) deliver(userIDs []int, e Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, userID := range userIDs {
+		for s := range h.subs[userID] {
+			select {
+			case s.c <- e:
+			default:
+				// Rather than blocking everyone, make the client reconnect
+				h.drop(s)
+			}
+		}
+	}
+}
+
+// closeAll ends all subscriptions, and when final also future ones
+func (h *hub) closeAll(final bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, subs := range h.subs {
+		for s := range subs {
+			h.drop(s)
+		}
+	}
+	h.closed = h.closed || final
+}
+
+// MemoryBroker delivers events within this process, which is enough when
+// a single instance of the server is running
+type MemoryBroker struct {
+	hub *hub
+}
+
+func NewMemoryBroker() *MemoryBroker {
+	return &MemoryBroker{hub: newHub()}
+}
+
+func (b *MemoryBroker) Publish(ctx context.Context, userIDs []int, e Event) error {
+	b.hub.deliver(userIDs, e)
+	return nil
+}
+
+func (b *MemoryBroker) Subscribe(userID int) *Subscription {
+	return b.hub.subscribe(userID)
+}
+
+func (b *MemoryBroker) Close() error {
+	b.hub.closeAll(true)
+	return nil
+}
@@ -0,0 +1,124 @@
+package events_test
+
+import (
+	"context"
+	"gopan-server/internal/events"
+	"testing"
+)
+
+// receive returns the types of the events waiting on a subscription, and
+// whether it is still open
+func receive(s *events.Subscription) ([]string, bool) {
+	var types []string
+	for {
+		select {
+		case e, ok := <-s.C:
+			if !ok {
+				return types, false
+			}
+			types = append(types, e.Type)
+		default:
+			return types, true
+		}
+	}
+}
+
+func TestMemoryBrokerFanOut(t *testing.T) {
+	b := events.NewMemoryBroker()
+	defer b.Close()
+	ctx := context.Background()
+
+	phone, laptop, other := b.Subscribe(1), b.Subscribe(1), b.Subscribe(2)
+	b.Publish(ctx, []int{1}, events.Event{Type: events.TypeChange})
+	b.Publish(ctx, []int{1, 2}, events.Event{Type: events.TypeShare})
+	b.Publish(ctx, []int{3}, events.Event{Type: events.TypeQuota})
+
+	tests := []struct {
+		name string
+		sub  *events.Subscription
+		want string
+	}{
+		{"phone", phone, "change share"},
+		{"laptop", laptop, "change share"},
+		{"other user", other, "share"},
+	}
+	for _, tt := range tests {
+		types, open := receive(tt.sub)
+		if got := join(types); got != tt.want || !open {
+			t.Errorf("%s received %q, open %v, want %q", tt.name, got, open, tt.want)
+		}
+	}
+
+	// A closed subscription ends and no longer receives events
+	laptop.Close()
+	laptop.Close()
+	b.Publish(ctx, []int{1}, events.Event{Type: events.TypeJob})
+	if types, open := receive(laptop); len(types) != 0 || open {
+		t.Errorf("closed subscription received %v, open %v", types, open)
+	}
+	if types, open := receive(phone); join(types) != "job" || !open {
+		t.Errorf("phone received %v, open %v after the laptop left", types, open)
+	}
+}
+
+func TestMemoryBrokerDropsSlowSubscriptions(t *testing.T) {
+	b := events.NewMemoryBroker()
+	defer b.Close()
+	ctx := context.Background()
+
+	slow, reader := b.Subscribe(1), b.Subscribe(1)
+	for range 64 {
+		b.Publish(ctx, []int{1}, events.Event{Type: events.TypeChange})
+		receive(reader)
+	}
+	if types, open := receive(slow); len(types) != 64 || !open {
+		t.Fatalf("slow subscription holds %d events, open %v, want 64 and open", len(types), open)
+	}
+
+	// Falling behind by more than the buffer ends the subscription after
+	// the events it holds, and leaves the others alone
+	for range 65 {
+		b.Publish(ctx, []int{1}, events.Event{Type: events.TypeChange})
+	}
+	if types, open := receive(slow); len(types) != 64 || open {
+		t.Errorf("slow subscription received %d events, open %v, want 64 and closed", len(types), open)
+	}
+	if types, open := receive(reader); len(types) != 64 || open {
+		t.Errorf("second slow subscription received %d events, open %v, want 64 and closed", len(types), open)
+	}
+	fresh := b.Subscribe(1)
+	b.Publish(ctx, []int{1}, events.Event{Type: events.TypeChange})
+	if types, open := receive(fresh); len(types) != 1 || !open {
+		t.Errorf("new subscription received %d events, open %v", len(types), open)
+	}
+}
+
+func TestMemoryBrokerClose(t *testing.T) {
+	b := events.NewMemoryBroker()
+	subs := []*events.Subscription{b.Subscribe(1), b.Subscribe(1), b.Subscribe(2)}
+	b.Publish(context.Background(), []int{1, 2}, events.Event{Type: events.TypeChange})
+	b.Close()
+
+	// Events already delivered are still read before the end
+	for i, s := range subs {
+		if types, open := receive(s); join(types) != "change" || open {
+			t.Errorf("subscription %d received %v, open %v, want the event and closed", i, types, open)
+		}
+		s.Close()
+	}
+	if types, open := receive(b.Subscribe(1)); len(types) != 0 || open {
+		t.Errorf("subscription after Close received %v, open %v, want closed", types, open)
+	}
+}
+
+// join joins event types with spaces
+func join(types []string) string {
+	s := ""
+	for i, t := range types {
+		if i > 0 {
+			s += " "
+		}
+		s += t
+	}
+	return s
+}
@@ -0,0 +1,89 @@
+package events
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"gopan-server/internal/logger"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// notifyChannel is the PostgreSQL channel events are sent on
+const notifyChannel = "gopan_events"
+
+// maxPayload stays below the 8000 byte limit of NOTIFY payloads
+const maxPayload = 7900
+
+// notification is an event as sent through PostgreSQL
+type notification struct {
+	UserIDs []int `json:"user_ids"`
+	Event   Event `json:"event"`
+}
+
+// PostgresBroker sends events through PostgreSQL LISTEN/NOTIFY, so that
+// clients connected to any instance of the server receive them
+type PostgresBroker struct {
+	hub      *hub
+	db       *sql.DB
+	listener *pq.Listener
+}
+
+// NewPostgresBroker starts listening for events on the database
+func NewPostgresBroker(db *sql.DB, dsn string) (*PostgresBroker, error) {
+	listener := pq.NewListener(dsn, time.Second, time.Minute, func(ev pq.ListenerEventType, err error) {
+		if err != nil {
+			logger.Error.Printf("Event listener: %v", err)
+		}
+	})
+	if err := listener.Listen(notifyChannel); err != nil {
+		listener.Close()
+		return nil, err
+	}
+
+	b := &PostgresBroker{hub: newHub(), db: db, listener: listener}
+	go b.run()
+	return b, nil
+}
+
+// run delivers the events received from the database until the listener
+// is closed
+func (b *PostgresBroker) run() {
+	for n := range b.listener.Notify {
+		if n == nil {
+			// Events sent while the connection was lost are missed, so
+			// clients reconnect and catch up
+			b.hub.closeAll(false)
+			continue
+		}
+		var msg notification
+		if err := json.Unmarshal([]byte(n.Extra), &msg); err != nil {
+			logger.Error.Printf("Failed to decode event: %v", err)
+			continue
+		}
+		b.hub.deliver(msg.UserIDs, msg.Event)
+	}
+}
+
+func (b *PostgresBroker) Publish(ctx context.Context, userIDs []int, e Event) error {
+	payload, err := json.Marshal(notification{UserIDs: userIDs, Event: e})
+	if err != nil {
+		return err
+	}
+	if len(payload) > maxPayload {
+		return errors.New("event too large")
+	}
+	_, err = b.db.ExecContext(ctx, "SELECT pg_notify($1, $2)", notifyChannel, string(payload))
+	return err
+}
+
+func (b *PostgresBroker) Subscribe(userID int) *Subscription {
+	return b.hub.subscribe(userID)
+}
+
+func (b *PostgresBroker) Close() error {
+	b.hub.closeAll(true)
+	return b.listener.Close()
+}
@@ -0,0 +1,22 @@
+package events
+
+import (
+	"context"
+	"gopan-server/ent"
+)
+
+// quotaWarning is the share of the quota in use from which users are warned
+const quotaWarning = 0.9
+
+// CheckQuota warns u when the storage in use, as just updated, is close to
+// or over the quota
+func CheckQuota(ctx context.Context, u *ent.User) {
+	if u.TotalQuota <= 0 || float64(u.TotalUsed) < quotaWarning*float64(u.TotalQuota) {
+		return
+	}
+	Publish(ctx, TypeQuota, map[string]any{
+		"total_used":  u.TotalUsed,
+		"total_quota": u.TotalQuota,
+		"exceeded":    u.TotalUsed > u.TotalQuota,
+	}, u.ID)
+}
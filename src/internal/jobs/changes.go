@@ -0,0 +1,36 @@
+package jobs
+
+import (
+	"context"
+	"gopan-server/internal/changes"
+	"gopan-server/internal/logger"
+	"time"
+)
+
+// changeRetention is how long the change journal is kept. Sync clients that
+// stay away longer have to list all files again.
+const changeRetention = 30 * 24 * time.Hour
+
+// StartChangeSweeper periodically deletes old entries of the change journal
+// until ctx is done
+func StartChangeSweeper(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(time.Hour)
+		defer ticker.Stop()
+
+		for {
+			purged, err := changes.Purge(ctx, time.Now().Add(-changeRetention))
+			if err != nil && ctx.Err() == nil {
+				logger.Error.Printf("Change journal sweep failed: %v", err)
+			} else if purged > 0 {
+				logger.Info.Printf("Change journal sweep: %d purged", purged)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
@@ -0,0 +1,67 @@
+package jobs
+
+import (
+	"context"
+	"gopan-server/config"
+	"gopan-server/ent/user"
+	"gopan-server/internal/account"
+	"gopan-server/internal/database"
+	"gopan-server/internal/events"
+	"gopan-server/internal/logger"
+	"time"
+)
+
+// StartDirectorySync periodically synchronises directory users until ctx is done.
+// It does nothing when LDAP is disabled or the sync interval is 0.
+func StartDirectorySync(ctx context.Context, cfg *config.LDAPConfig) {
+	interval := cfg.GetSyncInterval()
+	if !cfg.Enabled || interval == 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			notifyAdmins(ctx, map[string]any{"job": "directory_sync", "state": "running"})
+			updated, disabled, err := account.SyncDirectory(ctx, cfg)
+			if err != nil && ctx.Err() == nil {
+				logger.Error.Printf("Directory sync failed: %v", err)
+				notifyAdmins(ctx, map[string]any{"job": "directory_sync", "state": "failed"})
+			} else if err == nil {
+				if updated > 0 || disabled > 0 {
+					logger.Info.Printf("Directory sync: %d updated, %d disabled", updated, disabled)
+				}
+				notifyAdmins(ctx, map[string]any{
+					"job":      "directory_sync",
+					"state":    "done",
+					"updated":  updated,
+					"disabled": disabled,
+				})
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	logger.Info.Printf("Directory sync started (interval %s)", interval)
+}
+
+// notifyAdmins sends the progress of a job to the enabled admins
+func notifyAdmins(ctx context.Context, data map[string]any) {
+	admins, err := database.Client.User.Query().
+		Where(user.RoleEQ(user.RoleAdmin), user.IsDisabledEQ(false)).
+		IDs(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			logger.Error.Printf("Failed to find admins: %v", err)
+		}
+		return
+	}
+	events.Publish(ctx, events.TypeJob, data, admins...)
+}
@@ -0,0 +1,40 @@
+package jobs
+
+import (
+	"context"
+	"gopan-server/config"
+	"gopan-server/internal/logger"
+	"gopan-server/internal/s3"
+	"time"
+)
+
+// s3UploadRetention is how long an unfinished S3 multipart upload is kept
+const s3UploadRetention = 7 * 24 * time.Hour
+
+// StartS3UploadSweeper periodically discards abandoned S3 multipart uploads
+// until ctx is done
+func StartS3UploadSweeper(ctx context.Context, cfg *config.Config) {
+	if !cfg.S3.Enabled {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(time.Hour)
+		defer ticker.Stop()
+
+		for {
+			purged, err := s3.PurgeUploads(ctx, cfg, time.Now().Add(-s3UploadRetention))
+			if err != nil && ctx.Err() == nil {
+				logger.Error.Printf("S3 upload sweep failed: %v", err)
+			} else if purged > 0 {
+				logger.Info.Printf("S3 upload sweep: %d purged", purged)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
@@ -0,0 +1,44 @@
+package jobs
+
+import (
+	"context"
+	"gopan-server/internal/account"
+	"gopan-server/internal/logger"
+	"gopan-server/internal/session"
+	"time"
+)
+
+// sessionRetention is how long revoked and expired sessions are kept, so a
+// reused refresh token is still recognised for a while
+const sessionRetention = 7 * 24 * time.Hour
+
+// StartSessionSweeper periodically deletes old revoked or expired sessions
+// and expired email tokens until ctx is done
+func StartSessionSweeper(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(time.Hour)
+		defer ticker.Stop()
+
+		for {
+			purged, err := session.Purge(ctx, time.Now().Add(-sessionRetention))
+			if err != nil && ctx.Err() == nil {
+				logger.Error.Printf("Session sweep failed: %v", err)
+			} else if purged > 0 {
+				logger.Info.Printf("Session sweep: %d purged", purged)
+			}
+
+			tokens, err := account.PurgeUserTokens(ctx, time.Now())
+			if err != nil && ctx.Err() == nil {
+				logger.Error.Printf("Email token sweep failed: %v", err)
+			} else if tokens > 0 {
+				logger.Info.Printf("Email token sweep: %d purged", tokens)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
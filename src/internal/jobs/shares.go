@@ -0,0 +1,132 @@
+package jobs
+
+import (
+	"context"
+	"gopan-server/config"
+	"gopan-server/ent/node"
+	"gopan-server/ent/predicate"
+	"gopan-server/ent/share"
+	"gopan-server/internal/database"
+	"gopan-server/internal/events"
+	"gopan-server/internal/logger"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// StartShareSweeper periodically disables expired or exhausted shares until ctx is done
+func StartShareSweeper(ctx context.Context, cfg *config.ShareConfig) {
+	interval := cfg.GetSweepInterval()
+	retention := cfg.GetExpiredRetention()
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			if err := SweepShares(ctx, retention); err != nil && ctx.Err() == nil {
+				logger.Error.Printf("Share sweep failed: %v", err)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	logger.Info.Printf("Share sweeper started (interval %s)", interval)
+}
+
+// SweepShares disables active shares that expired, reached both limits or
+// whose node is in trash, and deletes disabled shares older than retention
+// (0 keeps them). A share that reached only one limit stays active, the
+// other action is still allowed.
+func SweepShares(ctx context.Context, retention time.Duration) error {
+	now := time.Now()
+
+	expired, err := disableShares(ctx, share.StatusExpired, now,
+		share.ExpiresAtNotNil(), share.ExpiresAtLT(now),
+	)
+	if err != nil {
+		return err
+	}
+
+	exhausted, err := disableShares(ctx, share.StatusExhausted, now,
+		share.MaxAccessCountGT(0), columnsGTE(share.FieldAccessCount, share.FieldMaxAccessCount),
+		share.MaxDownloadCountGT(0), columnsGTE(share.FieldDownloadCount, share.FieldMaxDownloadCount),
+	)
+	if err != nil {
+		return err
+	}
+
+	// Catch shares of nodes trashed before suspension was in place
+	suspended, err := database.Client.Share.Update().
+		Where(share.StatusEQ(share.StatusActive)).
+		Where(share.HasNodeWith(node.IsDeletedEQ(true))).
+		SetStatus(share.StatusSuspended).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	purged := 0
+	if retention > 0 {
+		purged, err = database.Client.Share.Delete().
+			Where(share.StatusIn(share.StatusExpired, share.StatusExhausted)).
+			Where(share.DisabledAtLT(now.Add(-retention))).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	if expired+exhausted+suspended+purged > 0 {
+		logger.Info.Printf("Share sweep: %d expired, %d exhausted, %d suspended, %d purged", expired, exhausted, suspended, purged)
+	}
+	return nil
+}
+
+// disableShares gives the active shares matching preds the status and
+// notifies their owners
+func disableShares(ctx context.Context, status share.Status, now time.Time, preds ...predicate.Share) (int, error) {
+	shares, err := database.Client.Share.Query().
+		Where(share.StatusEQ(share.StatusActive)).
+		Where(preds...).
+		WithOwner().
+		All(ctx)
+	if err != nil || len(shares) == 0 {
+		return 0, err
+	}
+
+	ids := make([]int, len(shares))
+	for i, s := range shares {
+		ids[i] = s.ID
+	}
+	disabled, err := database.Client.Share.Update().
+		Where(share.IDIn(ids...)).
+		Where(share.StatusEQ(share.StatusActive)).
+		SetStatus(status).
+		SetDisabledAt(now).
+		Save(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, s := range shares {
+		events.Publish(ctx, events.TypeShare, map[string]any{
+			"share_id": s.ID,
+			"code":     s.Code,
+			"status":   status,
+		}, s.Edges.Owner.ID)
+	}
+	return disabled, nil
+}
+
+// columnsGTE compares two columns of the share table
+func columnsGTE(col1, col2 string) predicate.Share {
+	return predicate.Share(func(s *sql.Selector) {
+		s.Where(sql.ColumnsGTE(s.C(col1), s.C(col2)))
+	})
+}
@@ -0,0 +1,35 @@
+package jobs
+
+import (
+	"context"
+	"gopan-server/config"
+	"gopan-server/internal/logger"
+	"gopan-server/internal/throttle"
+	"time"
+)
+
+// StartThrottleSweeper periodically deletes forgotten failure counts and
+// expired audit entries of failed attempts until ctx is done
+func StartThrottleSweeper(ctx context.Context, cfg *config.SecurityConfig) {
+	t := throttle.New(cfg)
+
+	go func() {
+		ticker := time.NewTicker(time.Hour)
+		defer ticker.Stop()
+
+		for {
+			throttles, failures, err := t.Purge(ctx)
+			if err != nil && ctx.Err() == nil {
+				logger.Error.Printf("Throttle sweep failed: %v", err)
+			} else if throttles > 0 || failures > 0 {
+				logger.Info.Printf("Throttle sweep: %d counters and %d failed attempts purged", throttles, failures)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
@@ -0,0 +1,170 @@
+// Package mailtest runs a local SMTP server for tests that accepts every
+// message and hands it to the test instead of delivering it
+package mailtest
+
+import (
+	"bufio"
+	"io"
+	"mime"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Message is an email received by the sink
+type Message struct {
+	From    string
+	To      []string
+	Subject string // Decoded
+	Body    string
+	Header  mail.Header
+}
+
+// Sink is a local SMTP server without TLS or authentication
+type Sink struct {
+	Host string
+	Port int
+
+	listener net.Listener
+	messages chan Message
+	wg       sync.WaitGroup
+}
+
+// NewSink starts an SMTP sink that is closed when the test ends
+func NewSink(t testing.TB) *Sink {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	addr := listener.Addr().(*net.TCPAddr)
+	s := &Sink{
+		Host:     addr.IP.String(),
+		Port:     addr.Port,
+		listener: listener,
+		messages: make(chan Message, 16),
+	}
+	s.wg.Add(1)
+	go s.serve()
+	t.Cleanup(func() {
+		listener.Close()
+		s.wg.Wait()
+	})
+	return s
+}
+
+// Wait returns the next message, failing the test when none arrives in time
+func (s *Sink) Wait(t testing.TB) Message {
+	t.Helper()
+	select {
+	case msg := <-s.messages:
+		return msg
+	case <-time.After(5 * time.Second):
+		t.Fatal("no email was delivered")
+		return Message{}
+	}
+}
+
+// Empty reports whether no message arrives within a short while
+func (s *Sink) Empty() bool {
+	select {
+	case <-s.messages:
+		return false
+	case <-time.After(200 * time.Millisecond):
+		return true
+	}
+}
+
+func (s *Sink) serve() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			defer conn.Close()
+			conn.SetDeadline(time.Now().Add(10 * time.Second))
+			s.handle(textproto.NewConn(conn))
+		}()
+	}
+}
+
+// handle runs one SMTP session
+func (s *Sink) handle(conn *textproto.Conn) {
+	var msg Message
+	conn.PrintfLine("220 localhost mailtest")
+	for {
+		line, err := conn.ReadLine()
+		if err != nil {
+			return
+		}
+		verb, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(verb) {
+		case "EHLO", "HELO":
+			conn.PrintfLine("250-localhost")
+			conn.PrintfLine("250 8BITMIME")
+		case "MAIL":
+			msg = Message{From: address(arg)}
+			conn.PrintfLine("250 OK")
+		case "RCPT":
+			msg.To = append(msg.To, address(arg))
+			conn.PrintfLine("250 OK")
+		case "DATA":
+			conn.PrintfLine("354 End data with <CR><LF>.<CR><LF>")
+			data, err := io.ReadAll(conn.DotReader())
+			if err != nil {
+				return
+			}
+			if err := parse(&msg, data); err != nil {
+				conn.PrintfLine("554 %v", err)
+				continue
+			}
+			s.messages <- msg
+			conn.PrintfLine("250 OK")
+		case "RSET", "NOOP":
+			conn.PrintfLine("250 OK")
+		case "QUIT":
+			conn.PrintfLine("221 Bye")
+			return
+		default:
+			conn.PrintfLine("502 Command not implemented")
+		}
+	}
+}
+
+// address extracts the address of a MAIL FROM or RCPT TO argument
+func address(arg string) string {
+	start, end := strings.Index(arg, "<"), strings.Index(arg, ">")
+	if start < 0 || end < start {
+		return ""
+	}
+	return arg[start+1 : end]
+}
+
+// parse fills the headers, subject and body of msg from its data
+func parse(msg *Message, data []byte) error {
+	m, err := mail.ReadMessage(bufio.NewReader(strings.NewReader(string(data))))
+	if err != nil {
+		return err
+	}
+	body, err := io.ReadAll(m.Body)
+	if err != nil {
+		return err
+	}
+	subject, err := new(mime.WordDecoder).DecodeHeader(m.Header.Get("Subject"))
+	if err != nil {
+		return err
+	}
+	msg.Header = m.Header
+	msg.Subject = subject
+	msg.Body = strings.ReplaceAll(string(body), "\r\n", "\n")
+	return nil
+}
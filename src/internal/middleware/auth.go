@@ -2,14 +2,19 @@ package middleware
 
 import (
 	"gopan-server/config"
+	"gopan-server/internal/apierr"
 	"gopan-server/internal/auth"
-	"net/http"
+	"gopan-server/internal/pat"
+	"gopan-server/internal/permission"
+	"gopan-server/internal/session"
+	"strconv"
 	"strings"
 
 	"github.com/gin-gonic/gin"
 )
 
-// AuthMiddleware creates a JWT authentication middleware
+// AuthMiddleware creates an authentication middleware accepting session JWTs
+// and personal access tokens
 func AuthMiddleware(cfg *config.JWTConfig) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var token string
@@ -30,8 +35,13 @@ func AuthMiddleware(cfg *config.JWTConfig) gin.HandlerFunc {
 		}
 
 		if token == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization header or token parameter required"})
-			c.Abort()
+			apierr.Abort(c, apierr.Unauthenticated)
+			return
+		}
+
+		// Personal access tokens are opaque, everything else is a session JWT
+		if strings.HasPrefix(token, pat.Prefix) {
+			authenticateAccessToken(c, token)
 			return
 		}
 
@@ -39,19 +49,88 @@ func AuthMiddleware(cfg *config.JWTConfig) gin.HandlerFunc {
 		claims, err := auth.ValidateToken(token, cfg)
 		if err != nil {
 			if err == auth.ErrExpiredToken {
-				c.JSON(http.StatusUnauthorized, gin.H{"error": "Token expired"})
+				apierr.Abort(c, apierr.TokenExpired)
 			} else {
-				c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
+				apierr.Abort(c, apierr.InvalidToken)
 			}
-			c.Abort()
+			return
+		}
+
+		// Reject tokens of sessions that were logged out or revoked, and of disabled users
+		uid, err := strconv.Atoi(claims.UserID)
+		if err != nil || claims.SessionID == 0 {
+			apierr.Abort(c, apierr.InvalidToken)
+			return
+		}
+		u, err := session.ActiveUser(c.Request.Context(), claims.SessionID, uid)
+		if err != nil {
+			apierr.AbortInternal(c, "Failed to check session", err)
+			return
+		}
+		if u == nil {
+			apierr.Abort(c, apierr.SessionRevoked)
 			return
 		}
 
 		// Set user information in context
 		c.Set("userID", claims.UserID)
 		c.Set("username", claims.Username)
+		c.Set("sessionID", claims.SessionID)
+		c.Set("role", string(u.Role))
 
 		c.Next()
 	}
 }
 
+// authenticateAccessToken authenticates a request made with a personal access token
+func authenticateAccessToken(c *gin.Context, token string) {
+	ctx := c.Request.Context()
+
+	t, err := pat.Lookup(ctx, token)
+	if err != nil {
+		apierr.AbortInternal(c, "Failed to check token", err)
+		return
+	}
+	if t == nil {
+		apierr.Abort(c, apierr.InvalidToken)
+		return
+	}
+
+	if !pat.Allows(t, c.Request.Method, c.FullPath()) {
+		apierr.Abort(c, apierr.TokenScopeDenied)
+		return
+	}
+
+	if err := pat.Touch(ctx, t, c.ClientIP()); err != nil {
+		apierr.AbortInternal(c, "Failed to update token", err)
+		return
+	}
+
+	// Restrict node access to the token's folder
+	if t.FolderID != nil {
+		c.Request = c.Request.WithContext(permission.WithFolderScope(ctx, *t.FolderID))
+	}
+
+	// Set user information in context
+	u := t.Edges.User
+	c.Set("userID", strconv.Itoa(u.ID))
+	c.Set("username", u.Username)
+	c.Set("tokenID", t.ID)
+	c.Set("tokenScope", string(t.Scope))
+	c.Set("role", string(u.Role))
+
+	c.Next()
+}
+
+// SessionOnlyMiddleware rejects requests made with personal access tokens,
+// it must run after AuthMiddleware
+func SessionOnlyMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetInt("tokenID") != 0 {
+			apierr.Abort(c, apierr.SessionRequired)
+			return
+		}
+
+		c.Next()
+	}
+}
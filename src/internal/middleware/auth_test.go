@@ -0,0 +1,97 @@
+package middleware_test
+
+import (
+	"context"
+	"encoding/json"
+	"gopan-server/config"
+	"gopan-server/internal/apierr"
+	"gopan-server/internal/auth"
+	"gopan-server/internal/dbtest"
+	"gopan-server/internal/middleware"
+	"gopan-server/internal/session"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestMain(m *testing.M) {
+	gin.SetMode(gin.TestMode)
+	os.Exit(m.Run())
+}
+
+func TestAuthMiddlewareSessions(t *testing.T) {
+	client := dbtest.Open(t)
+	ctx := context.Background()
+	cfg := &config.JWTConfig{Secret: "test", Expiration: "15m"}
+	u := client.User.Create().SetUsername("alice").SetPasswordHash("x").SetTotalQuota(1 << 30).SaveX(ctx)
+	uid := strconv.Itoa(u.ID)
+
+	token := func(sessionID int) string {
+		token, err := auth.GenerateToken(uid, "alice", sessionID, cfg)
+		if err != nil {
+			t.Fatalf("GenerateToken: %v", err)
+		}
+		return token
+	}
+	start := func() int {
+		s, _, err := session.Create(ctx, u.ID, "192.0.2.1", "test", time.Hour)
+		if err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+		return s.ID
+	}
+	active := start()
+	loggedOut := start()
+	if _, err := session.Revoke(ctx, loggedOut, u.ID); err != nil {
+		t.Fatalf("Revoke: %v", err)
+	}
+
+	router := gin.New()
+	router.GET("/me", middleware.AuthMiddleware(cfg), func(c *gin.Context) {
+		c.String(http.StatusOK, c.GetString("userID"))
+	})
+
+	tests := []struct {
+		name   string
+		header string
+		status int
+		code   apierr.Code
+	}{
+		{"active session", "Bearer " + token(active), http.StatusOK, ""},
+		{"revoked session", "Bearer " + token(loggedOut), http.StatusUnauthorized, apierr.SessionRevoked},
+		{"unknown session", "Bearer " + token(active+loggedOut), http.StatusUnauthorized, apierr.SessionRevoked},
+		{"token without a session", "Bearer " + token(0), http.StatusUnauthorized, apierr.InvalidToken},
+		{"malformed token", "Bearer nonsense", http.StatusUnauthorized, apierr.InvalidToken},
+		{"no token", "", http.StatusUnauthorized, apierr.Unauthenticated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/me", nil)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.status {
+				t.Fatalf("HTTP %d, want %d: %s", w.Code, tt.status, w.Body)
+			}
+			if tt.code == "" {
+				if w.Body.String() != uid {
+					t.Errorf("handler saw user %q, want %s", w.Body, uid)
+				}
+				return
+			}
+			var resp apierr.Response
+			json.Unmarshal(w.Body.Bytes(), &resp)
+			if resp.Error.Code != tt.code {
+				t.Errorf("error code = %q, want %s", resp.Error.Code, tt.code)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package middleware
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"regexp"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequestIDHeader carries the ID of a request, in both directions
+const RequestIDHeader = "X-Request-ID"
+
+// requestIDPattern matches request IDs accepted from clients and proxies
+var requestIDPattern = regexp.MustCompile(`^[A-Za-z0-9._-]{1,64}$`)
+
+// RequestIDMiddleware gives every request an ID, reusing the one sent by a
+// client or reverse proxy when it looks sane. The ID is returned in the
+// X-Request-ID header and in error responses, so that reports can be matched
+// with the server logs.
+func RequestIDMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(RequestIDHeader)
+		if !requestIDPattern.MatchString(id) {
+			b := make([]byte, 8)
+			rand.Read(b)
+			id = hex.EncodeToString(b)
+		}
+
+		c.Set("requestID", id)
+		c.Header(RequestIDHeader, id)
+
+		c.Next()
+	}
+}
@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"gopan-server/ent/user"
+	"gopan-server/internal/apierr"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AdminMiddleware only lets administrators through, it must run after AuthMiddleware
+func AdminMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetString("role") != string(user.RoleAdmin) {
+			apierr.Abort(c, apierr.AdminRequired)
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// WriteAccessMiddleware rejects modifying requests of read-only users, it
+// must run after AuthMiddleware
+func WriteAccessMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		switch c.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+		default:
+			if c.GetString("role") == string(user.RoleReadonly) {
+				apierr.Abort(c, apierr.ReadOnly)
+				return
+			}
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,128 @@
+package pat
+
+import (
+	"context"
+	"gopan-server/ent"
+	"gopan-server/ent/accesstoken"
+	"gopan-server/ent/user"
+	"gopan-server/internal/auth"
+	"gopan-server/internal/database"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Prefix marks personal access tokens, so they are told apart from JWTs
+const Prefix = "gpt_"
+
+// touchInterval limits how often last-used information is written
+const touchInterval = time.Minute
+
+// uploadRoutes are the only routes an upload scoped token may call
+var uploadRoutes = map[string]bool{
+	"POST /api/files/upload":       true,
+	"POST /api/files/quick-upload": true,
+	"POST /api/files/folder":       true,
+	"PUT /api/fs/upload/*path":     true,
+	"POST /api/fs/mkdir/*path":     true,
+	"GET /api/user/capacity":       true,
+}
+
+// folderRoutes are the only routes a folder restricted token may call, as
+// every other route works across all files of the user
+var folderRoutes = map[string]bool{
+	"GET /api/files":                        true,
+	"POST /api/files/upload":                true,
+	"POST /api/files/folder":                true,
+	"POST /api/files/quick-upload":          true,
+	"GET /api/files/:id":                    true,
+	"GET /api/files/:id/download":           true,
+	"GET /api/files/:id/proxy":              true,
+	"PUT /api/files/:id":                    true,
+	"PUT /api/files/move":                   true,
+	"PUT /api/files/copy":                   true,
+	"DELETE /api/files/:id":                 true,
+	"GET /api/fs/stat/*path":                true,
+	"GET /api/fs/list/*path":                true,
+	"GET /api/fs/download/*path":            true,
+	"PUT /api/fs/upload/*path":              true,
+	"POST /api/fs/mkdir/*path":              true,
+	"POST /api/fs/move/*path":               true,
+	"DELETE /api/fs/delete/*path":           true,
+	"GET /api/user/capacity":                true,
+	"GET /api/preview/:id":                  true,
+	"GET /api/preview/kkfileview/:id":       true,
+	"GET /api/preview/kkfileview/:id/*path": true,
+}
+
+// Generate generates a new personal access token
+func Generate() (string, error) {
+	token, err := auth.GenerateOpaqueToken()
+	if err != nil {
+		return "", err
+	}
+	return Prefix + token, nil
+}
+
+// Lookup returns the unexpired token of an enabled user with its user edge
+// loaded, or nil when the token is unknown
+func Lookup(ctx context.Context, token string) (*ent.AccessToken, error) {
+	t, err := database.Client.AccessToken.Query().
+		Where(accesstoken.TokenHashEQ(auth.HashToken(token))).
+		Where(accesstoken.Or(
+			accesstoken.ExpiresAtIsNil(),
+			accesstoken.ExpiresAtGT(time.Now()),
+		)).
+		Where(accesstoken.HasUserWith(user.IsDisabledEQ(false))).
+		WithUser().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return t, err
+}
+
+// Active reports whether a token is still unexpired and its user enabled,
+// for requests that outlive the check made when they started
+func Active(ctx context.Context, tokenID int) (bool, error) {
+	return database.Client.AccessToken.Query().
+		Where(accesstoken.IDEQ(tokenID)).
+		Where(accesstoken.Or(
+			accesstoken.ExpiresAtIsNil(),
+			accesstoken.ExpiresAtGT(time.Now()),
+		)).
+		Where(accesstoken.HasUserWith(user.IsDisabledEQ(false))).
+		Exist(ctx)
+}
+
+// Touch records the use of a token, at most once per touchInterval
+func Touch(ctx context.Context, t *ent.AccessToken, ip string) error {
+	now := time.Now()
+	if t.LastUsedAt != nil && now.Sub(*t.LastUsedAt) < touchInterval {
+		return nil
+	}
+	return database.Client.AccessToken.UpdateOne(t).
+		SetLastUsedAt(now).
+		SetLastUsedIP(ip).
+		Exec(ctx)
+}
+
+// Allows reports whether the token may call the route (the registered path
+// pattern, as returned by gin's FullPath) with the method
+func Allows(t *ent.AccessToken, method, route string) bool {
+	key := method + " " + route
+	switch t.Scope {
+	case accesstoken.ScopeRead:
+		if method != http.MethodGet && method != http.MethodHead {
+			return false
+		}
+	case accesstoken.ScopeUpload:
+		if !uploadRoutes[key] {
+			return false
+		}
+	}
+	if t.FolderID != nil && !folderRoutes[strings.Replace(key, "HEAD ", "GET ", 1)] {
+		return false
+	}
+	return true
+}
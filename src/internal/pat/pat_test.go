@@ -0,0 +1,83 @@
+package pat_test
+
+import (
+	"gopan-server/config"
+	"gopan-server/ent"
+	"gopan-server/ent/accesstoken"
+	"gopan-server/internal/api"
+	"gopan-server/internal/dbtest"
+	"gopan-server/internal/pat"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestAllows(t *testing.T) {
+	folderID := 7
+	full := &ent.AccessToken{Scope: accesstoken.ScopeFull}
+	read := &ent.AccessToken{Scope: accesstoken.ScopeRead}
+	upload := &ent.AccessToken{Scope: accesstoken.ScopeUpload}
+	folder := &ent.AccessToken{Scope: accesstoken.ScopeFull, FolderID: &folderID}
+	readFolder := &ent.AccessToken{Scope: accesstoken.ScopeRead, FolderID: &folderID}
+	uploadFolder := &ent.AccessToken{Scope: accesstoken.ScopeUpload, FolderID: &folderID}
+
+	tests := []struct {
+		name   string
+		token  *ent.AccessToken
+		method string
+		route  string
+		want   bool
+	}{
+		{"full deletes", full, http.MethodDelete, "/api/files/:id", true},
+		{"full manages shares", full, http.MethodPost, "/api/shares", true},
+		{"read lists", read, http.MethodGet, "/api/files", true},
+		{"read downloads with HEAD", read, http.MethodHead, "/api/fs/download/*path", true},
+		{"read cannot upload", read, http.MethodPut, "/api/fs/upload/*path", false},
+		{"read cannot delete", read, http.MethodDelete, "/api/files/:id", false},
+		{"upload uploads", upload, http.MethodPost, "/api/files/upload", true},
+		{"upload uploads by path", upload, http.MethodPut, "/api/fs/upload/*path", true},
+		{"upload checks capacity", upload, http.MethodGet, "/api/user/capacity", true},
+		{"upload cannot list", upload, http.MethodGet, "/api/files", false},
+		{"upload cannot download", upload, http.MethodGet, "/api/files/:id/download", false},
+		{"upload cannot rename", upload, http.MethodPut, "/api/files/:id", false},
+		{"folder lists", folder, http.MethodGet, "/api/files", true},
+		{"folder moves", folder, http.MethodPut, "/api/files/move", true},
+		{"folder downloads with HEAD", folder, http.MethodHead, "/api/fs/download/*path", true},
+		{"folder cannot search", folder, http.MethodGet, "/api/files/search", false},
+		{"folder cannot list trash", folder, http.MethodGet, "/api/files/trash", false},
+		{"folder cannot share", folder, http.MethodPost, "/api/shares", false},
+		{"read folder lists", readFolder, http.MethodGet, "/api/fs/list/*path", true},
+		{"read folder cannot search", readFolder, http.MethodGet, "/api/files/search", false},
+		{"read folder cannot move", readFolder, http.MethodPost, "/api/fs/move/*path", false},
+		{"upload folder uploads", uploadFolder, http.MethodPost, "/api/fs/mkdir/*path", true},
+		{"upload folder cannot delete", uploadFolder, http.MethodDelete, "/api/fs/delete/*path", false},
+	}
+
+	// The routes must exist, or the tables name routes that were renamed
+	dbtest.Open(t)
+	gin.SetMode(gin.TestMode)
+	cfg, err := config.Parse([]byte(`{"jwt": {"secret": "test"}}`))
+	if err != nil {
+		t.Fatalf("parse config: %v", err)
+	}
+	registered := map[string]bool{}
+	for _, r := range api.SetupRouter(cfg).Routes() {
+		registered[r.Method+" "+r.Path] = true
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			method := tt.method
+			if method == http.MethodHead {
+				method = http.MethodGet
+			}
+			if !registered[method+" "+tt.route] {
+				t.Fatalf("%s %s is not registered", method, tt.route)
+			}
+			if got := pat.Allows(tt.token, tt.method, tt.route); got != tt.want {
+				t.Errorf("Allows(%s %s) = %v, want %v", tt.method, tt.route, got, tt.want)
+			}
+		})
+	}
+}
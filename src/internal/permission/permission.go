@@ -0,0 +1,226 @@
+package permission
+
+import (
+	"context"
+	"errors"
+	"gopan-server/ent"
+	"gopan-server/ent/group"
+	"gopan-server/ent/node"
+	"gopan-server/ent/nodepermission"
+	"gopan-server/ent/predicate"
+	"gopan-server/ent/user"
+)
+
+// Roles a user can have on a node, ordered from weakest to strongest
+const (
+	RoleNone  = ""
+	RoleRead  = "read"
+	RoleWrite = "write"
+	RoleOwner = "owner"
+)
+
+var (
+	ErrNotFound  = errors.New("node not found")
+	ErrForbidden = errors.New("permission denied")
+)
+
+var roleRank = map[string]int{
+	RoleNone:  0,
+	RoleRead:  1,
+	RoleWrite: 2,
+	RoleOwner: 3,
+}
+
+// Allows reports whether role have satisfies the required role want
+func Allows(have, want string) bool {
+	return roleRank[have] >= roleRank[want]
+}
+
+// IsValidRole reports whether role can be granted to another user
+func IsValidRole(role string) bool {
+	return role == RoleRead || role == RoleWrite
+}
+
+// Grantee matches permissions granted to the user directly or to any group
+// the user owns or belongs to
+func Grantee(userID int) predicate.NodePermission {
+	return nodepermission.Or(
+		nodepermission.HasUserWith(user.IDEQ(userID)),
+		nodepermission.HasGroupWith(group.Or(
+			group.HasOwnerWith(user.IDEQ(userID)),
+			group.HasMembersWith(user.IDEQ(userID)),
+		)),
+	)
+}
+
+// folderScopeKey is the context key of the folder a request is restricted to
+type folderScopeKey struct{}
+
+// WithFolderScope restricts node access in ctx to the subtree of a folder
+func WithFolderScope(ctx context.Context, folderID int) context.Context {
+	return context.WithValue(ctx, folderScopeKey{}, folderID)
+}
+
+// FolderScope returns the folder node access in ctx is restricted to
+func FolderScope(ctx context.Context) (int, bool) {
+	folderID, ok := ctx.Value(folderScopeKey{}).(int)
+	return folderID, ok
+}
+
+// ResolveRole returns the effective role of a user on a node.
+// The node must have its owner edge loaded. Permissions are inherited, so
+// a grant on any ancestor folder applies to the whole subtree. Nodes outside
+// the folder scope of ctx resolve to RoleNone, and so do nodes in the trash
+// for anyone but their owner.
+func ResolveRole(ctx context.Context, client *ent.Client, userID int, n *ent.Node) (string, error) {
+	folderID, scoped := FolderScope(ctx)
+	isOwner := n.Edges.Owner != nil && n.Edges.Owner.ID == userID
+	if isOwner && (!scoped || folderID == n.ID) {
+		return RoleOwner, nil
+	}
+
+	ids, err := ancestorIDs(ctx, client, n.ID)
+	if err != nil {
+		return RoleNone, err
+	}
+
+	if scoped {
+		inScope := false
+		for _, id := range ids {
+			if id == folderID {
+				inScope = true
+				break
+			}
+		}
+		if !inScope {
+			return RoleNone, nil
+		}
+		if isOwner {
+			return RoleOwner, nil
+		}
+	}
+
+	// Trashing a folder hides everything below it from other users
+	trashed, err := client.Node.Query().
+		Where(node.IDIn(ids...)).
+		Where(node.IsDeletedEQ(true)).
+		Exist(ctx)
+	if err != nil {
+		return RoleNone, err
+	}
+	if trashed {
+		return RoleNone, nil
+	}
+
+	perms, err := client.NodePermission.Query().
+		Where(nodepermission.HasNodeWith(node.IDIn(ids...))).
+		Where(Grantee(userID)).
+		All(ctx)
+	if err != nil {
+		return RoleNone, err
+	}
+
+	role := RoleNone
+	for _, p := range perms {
+		if roleRank[string(p.Role)] > roleRank[role] {
+			role = string(p.Role)
+		}
+	}
+	return role, nil
+}
+
+// Audience returns the users other than the owner who were granted access
+// to a node, directly or on one of its ancestors, including group members
+func Audience(ctx context.Context, client *ent.Client, ownerID, nodeID int) ([]int, error) {
+	// Most users share nothing, which spares walking up the tree
+	shared, err := client.NodePermission.Query().
+		Where(nodepermission.HasNodeWith(node.HasOwnerWith(user.IDEQ(ownerID)))).
+		Exist(ctx)
+	if err != nil || !shared {
+		return nil, err
+	}
+
+	ids, err := ancestorIDs(ctx, client, nodeID)
+	if err != nil {
+		return nil, err
+	}
+	perms, err := client.NodePermission.Query().
+		Where(nodepermission.HasNodeWith(node.IDIn(ids...))).
+		WithUser().
+		WithGroup(func(q *ent.GroupQuery) {
+			q.WithOwner().WithMembers()
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[int]bool{ownerID: true}
+	var userIDs []int
+	add := func(u *ent.User) {
+		if u != nil && !seen[u.ID] {
+			seen[u.ID] = true
+			userIDs = append(userIDs, u.ID)
+		}
+	}
+	for _, p := range perms {
+		add(p.Edges.User)
+		if g := p.Edges.Group; g != nil {
+			add(g.Edges.Owner)
+			for _, m := range g.Edges.Members {
+				add(m)
+			}
+		}
+	}
+	return userIDs, nil
+}
+
+// ancestorIDs returns the ID of a node followed by those of its ancestors
+func ancestorIDs(ctx context.Context, client *ent.Client, nodeID int) ([]int, error) {
+	ids := []int{nodeID}
+	currentID := nodeID
+	for depth := 0; depth < 256; depth++ {
+		parentID, err := client.Node.Query().
+			Where(node.HasChildrenWith(node.IDEQ(currentID))).
+			OnlyID(ctx)
+		if ent.IsNotFound(err) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, parentID)
+		currentID = parentID
+	}
+	return ids, nil
+}
+
+// GetNode loads a node the user can access with at least the wanted role.
+// The returned node has its owner and parent edges loaded.
+// ErrNotFound is returned when the user cannot see the node at all, and
+// ErrForbidden when the user can see it but lacks the wanted role.
+func GetNode(ctx context.Context, client *ent.Client, userID, nodeID int, want string) (*ent.Node, string, error) {
+	n, err := client.Node.Query().
+		Where(node.IDEQ(nodeID)).
+		WithOwner().
+		WithParent().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, RoleNone, ErrNotFound
+		}
+		return nil, RoleNone, err
+	}
+
+	role, err := ResolveRole(ctx, client, userID, n)
+	if err != nil {
+		return nil, RoleNone, err
+	}
+	if role == RoleNone {
+		return nil, RoleNone, ErrNotFound
+	}
+	if !Allows(role, want) {
+		return n, role, ErrForbidden
+	}
+	return n, role, nil
+}
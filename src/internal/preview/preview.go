@@ -2,11 +2,13 @@ package preview
 
 import (
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"gopan-server/config"
-	"gopan-server/ent/node"
-	"gopan-server/ent/user"
+	"gopan-server/internal/apierr"
 	"gopan-server/internal/database"
+	"gopan-server/internal/logger"
+	"gopan-server/internal/permission"
 	"gopan-server/internal/storage"
 	"io"
 	"net/http"
@@ -20,6 +22,17 @@ import (
 	"github.com/minio/minio-go/v7"
 )
 
+// Response tells how to show a file: inline text content, or a URL to open
+// in a viewer
+type Response struct {
+	Type     string  `json:"type"`              // text, office, pdf, kkfileview or url
+	URL      string  `json:"url,omitempty"`     // Viewer or file URL, for all types but text
+	Content  *string `json:"content,omitempty"` // File content, for text
+	MimeType string  `json:"mime_type"`
+	FileName string  `json:"file_name,omitempty"`
+	Editable bool    `json:"editable,omitempty"` // Whether the file may be edited in place
+}
+
 type PreviewHandler struct {
 	cfg *config.Config
 }
@@ -38,25 +51,20 @@ func (h *PreviewHandler) GetPreview(c *gin.Context) {
 	// Parse IDs
 	uid, err := strconv.Atoi(userID)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		apierr.Abort(c, apierr.Unauthenticated)
 		return
 	}
 
 	nodeID, err := strconv.Atoi(id)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid file ID"})
+		apierr.AbortInvalid(c, apierr.FieldError{Field: "id", Rule: "number"})
 		return
 	}
 
-	// Get file
-	n, err := database.Client.Node.Query().
-		Where(node.IDEQ(nodeID)).
-		Where(node.HasOwnerWith(user.IDEQ(uid))).
-		Where(node.TypeEQ(1)). // Only files
-		Where(node.IsDeletedEQ(false)).
-		Only(ctx)
-	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "File not found"})
+	// Get file (owned or shared with the user)
+	n, _, err := permission.GetNode(ctx, database.Client, uid, nodeID, permission.RoleRead)
+	if err != nil || n.Type != 1 || n.IsDeleted { // Only files
+		apierr.Abort(c, apierr.FileNotFound)
 		return
 	}
 
@@ -77,22 +85,23 @@ func (h *PreviewHandler) GetPreview(c *gin.Context) {
 	if isTextFile(mimeType) || ext == ".txt" || ext == ".md" {
 		object, err := storage.GetClient().GetObject(ctx, h.cfg.MinIO.BucketName, n.MinioObject, minio.GetObjectOptions{})
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get file"})
+			apierr.AbortInternal(c, "Failed to get file", err)
 			return
 		}
 		defer object.Close()
 
 		content, err := io.ReadAll(object)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to read file"})
+			apierr.AbortInternal(c, "Failed to read file", err)
 			return
 		}
 
-		c.JSON(http.StatusOK, gin.H{
-			"type":      "text",
-			"content":   string(content),
-			"mime_type": mimeType,
-			"editable":  true,
+		text := string(content)
+		c.JSON(http.StatusOK, Response{
+			Type:     "text",
+			Content:  &text,
+			MimeType: mimeType,
+			Editable: true,
 		})
 		return
 	}
@@ -100,12 +109,12 @@ func (h *PreviewHandler) GetPreview(c *gin.Context) {
 	// For Office documents (Word, Excel, PPT), use Office Online Viewer with proxy URL
 	if isOfficeDocument(ext) {
 		previewURL := h.getOfficePreviewURL(proxyURL, n.Name, ext)
-		c.JSON(http.StatusOK, gin.H{
-			"type":      "office",
-			"url":       previewURL,
-			"mime_type": mimeType,
-			"file_name": n.Name,
-			"editable":  true,
+		c.JSON(http.StatusOK, Response{
+			Type:     "office",
+			URL:      previewURL,
+			MimeType: mimeType,
+			FileName: n.Name,
+			Editable: true,
 		})
 		return
 	}
@@ -113,22 +122,22 @@ func (h *PreviewHandler) GetPreview(c *gin.Context) {
 	// For PDF, use PDF.js for preview with proxy URL
 	if ext == ".pdf" {
 		previewURL := h.getPDFPreviewURL(proxyURL, n.Name)
-		c.JSON(http.StatusOK, gin.H{
-			"type":      "pdf",
-			"url":       previewURL,
-			"mime_type": mimeType,
-			"file_name": n.Name,
+		c.JSON(http.StatusOK, Response{
+			Type:     "pdf",
+			URL:      previewURL,
+			MimeType: mimeType,
+			FileName: n.Name,
 		})
 		return
 	}
 
 	// For images, return direct proxy URL (browser can display directly)
 	if strings.HasPrefix(mimeType, "image/") {
-		c.JSON(http.StatusOK, gin.H{
-			"type":      "url",
-			"url":       proxyURL,
-			"mime_type": mimeType,
-			"file_name": n.Name,
+		c.JSON(http.StatusOK, Response{
+			Type:     "url",
+			URL:      proxyURL,
+			MimeType: mimeType,
+			FileName: n.Name,
 		})
 		return
 	}
@@ -137,21 +146,21 @@ func (h *PreviewHandler) GetPreview(c *gin.Context) {
 	if h.cfg.Preview.KKFileView.Enabled && h.cfg.Preview.KKFileView.BaseURL != "" {
 		// Use GoPan's kkFileView proxy endpoint
 		kkFileViewURL := h.getKKFileViewProxyURL(c, nodeID, n.Name)
-		c.JSON(http.StatusOK, gin.H{
-			"type":      "kkfileview",
-			"url":       kkFileViewURL,
-			"mime_type": mimeType,
-			"file_name": n.Name,
+		c.JSON(http.StatusOK, Response{
+			Type:     "kkfileview",
+			URL:      kkFileViewURL,
+			MimeType: mimeType,
+			FileName: n.Name,
 		})
 		return
 	}
 
 	// Fallback to proxy URL
-	c.JSON(http.StatusOK, gin.H{
-		"type":      "url",
-		"url":       proxyURL,
-		"mime_type": mimeType,
-		"file_name": n.Name,
+	c.JSON(http.StatusOK, Response{
+		Type:     "url",
+		URL:      proxyURL,
+		MimeType: mimeType,
+		FileName: n.Name,
 	})
 }
 
@@ -309,25 +318,20 @@ func (h *PreviewHandler) ProxyKKFileView(c *gin.Context) {
 	// Parse IDs
 	uid, err := strconv.Atoi(userID)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		apierr.Abort(c, apierr.Unauthenticated)
 		return
 	}
 
 	nodeID, err := strconv.Atoi(id)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid file ID"})
+		apierr.AbortInvalid(c, apierr.FieldError{Field: "id", Rule: "number"})
 		return
 	}
 
-	// Get file
-	n, err := database.Client.Node.Query().
-		Where(node.IDEQ(nodeID)).
-		Where(node.HasOwnerWith(user.IDEQ(uid))).
-		Where(node.TypeEQ(1)). // Only files
-		Where(node.IsDeletedEQ(false)).
-		Only(ctx)
-	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "File not found"})
+	// Get file (owned or shared with the user)
+	n, _, err := permission.GetNode(ctx, database.Client, uid, nodeID, permission.RoleRead)
+	if err != nil || n.Type != 1 || n.IsDeleted { // Only files
+		apierr.Abort(c, apierr.FileNotFound)
 		return
 	}
 
@@ -393,7 +397,7 @@ func (h *PreviewHandler) ProxyKKFileView(c *gin.Context) {
 	// Forward the request to kkFileView
 	req, err := http.NewRequestWithContext(ctx, c.Request.Method, kkFileViewURL, c.Request.Body)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create request"})
+		apierr.AbortInternal(c, "Failed to create request", err)
 		return
 	}
 
@@ -420,9 +424,13 @@ func (h *PreviewHandler) ProxyKKFileView(c *gin.Context) {
 	}
 	resp, err := client.Do(req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": fmt.Sprintf("Failed to proxy request to kkFileView: %v. kkFileView URL: %s", err, kkFileViewURL),
-		})
+		// The URL carries the user's token, only the kkFileView address is logged
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		logger.Error.Printf("Failed to proxy request to kkFileView at %s: %v (request %s)", h.cfg.Preview.KKFileView.BaseURL, err, c.GetString("requestID"))
+		apierr.Abort(c, apierr.PreviewUnavailable)
 		return
 	}
 	defer resp.Body.Close()
@@ -0,0 +1,290 @@
+package s3
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"gopan-server/ent"
+	"gopan-server/ent/s3key"
+	"gopan-server/ent/user"
+	"gopan-server/internal/database"
+	"gopan-server/internal/logger"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	signatureAlgorithm = "AWS4-HMAC-SHA256"
+	timestampFormat    = "20060102T150405Z"
+
+	// maxClockSkew is how far the request time may be from the server's
+	maxClockSkew = 15 * time.Minute
+
+	// maxPresignExpiry is the longest validity of a presigned URL
+	maxPresignExpiry = 7 * 24 * time.Hour
+
+	// touchInterval limits how often last-used information is written
+	touchInterval = time.Minute
+
+	emptySHA256     = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+	unsignedPayload = "UNSIGNED-PAYLOAD"
+)
+
+// accessKeyChars are the characters of access key IDs
+const accessKeyChars = "ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+// GenerateKey generates a new access key ID and secret access key
+func GenerateKey() (string, string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	accessKey := []byte("GP")
+	for _, c := range b {
+		accessKey = append(accessKey, accessKeyChars[int(c)%len(accessKeyChars)])
+	}
+
+	secret := make([]byte, 30)
+	if _, err := rand.Read(secret); err != nil {
+		return "", "", err
+	}
+	return string(accessKey), base64.StdEncoding.EncodeToString(secret), nil
+}
+
+// signature is a verified request signature, which signs the chunks of a
+// streaming upload in turn
+type signature struct {
+	key       []byte // Signing key derived from the secret
+	timestamp string
+	scope     string
+	seed      string // Signature of the request itself
+}
+
+// authenticate verifies the SigV4 signature of a request, in the
+// Authorization header or in the query of a presigned URL
+func (s *Server) authenticate(r *http.Request) (*ent.S3Key, *signature, error) {
+	auth := r.Header.Get("Authorization")
+	query := r.URL.Query()
+
+	var accessKey, scope, signedHeaders, signed, timestamp, payloadHash string
+	switch {
+	case strings.HasPrefix(auth, signatureAlgorithm+" "):
+		fields := map[string]string{}
+		for _, part := range strings.Split(strings.TrimPrefix(auth, signatureAlgorithm+" "), ",") {
+			name, value, _ := strings.Cut(strings.TrimSpace(part), "=")
+			fields[name] = value
+		}
+		accessKey, scope, _ = strings.Cut(fields["Credential"], "/")
+		signedHeaders = fields["SignedHeaders"]
+		signed = fields["Signature"]
+		timestamp = r.Header.Get("X-Amz-Date")
+		if timestamp == "" {
+			return nil, nil, errMissingSecurityHeader
+		}
+		payloadHash = r.Header.Get("X-Amz-Content-Sha256")
+		if payloadHash == "" {
+			payloadHash = emptySHA256
+		}
+
+		t, err := time.Parse(timestampFormat, timestamp)
+		if err != nil {
+			return nil, nil, errMalformedAuth
+		}
+		if skew := time.Since(t); skew > maxClockSkew || skew < -maxClockSkew {
+			return nil, nil, errRequestTimeTooSkewed
+		}
+
+	case query.Get("X-Amz-Algorithm") == signatureAlgorithm:
+		accessKey, scope, _ = strings.Cut(query.Get("X-Amz-Credential"), "/")
+		signedHeaders = query.Get("X-Amz-SignedHeaders")
+		signed = query.Get("X-Amz-Signature")
+		timestamp = query.Get("X-Amz-Date")
+		payloadHash = unsignedPayload
+
+		t, err := time.Parse(timestampFormat, timestamp)
+		if err != nil {
+			return nil, nil, errMalformedAuth
+		}
+		expires, err := strconv.Atoi(query.Get("X-Amz-Expires"))
+		if err != nil || expires < 0 || time.Duration(expires)*time.Second > maxPresignExpiry {
+			return nil, nil, errMalformedAuth
+		}
+		if time.Since(t) > time.Duration(expires)*time.Second {
+			return nil, nil, errRequestExpired
+		}
+		if time.Until(t) > maxClockSkew {
+			return nil, nil, errRequestTimeTooSkewed
+		}
+
+	case auth != "":
+		return nil, nil, errUnsupportedSignature
+	default:
+		return nil, nil, errAccessDenied
+	}
+
+	// Scope is date/region/service/aws4_request
+	scopeParts := strings.Split(scope, "/")
+	if accessKey == "" || signedHeaders == "" || signed == "" || len(scopeParts) != 4 ||
+		scopeParts[2] != "s3" || scopeParts[3] != "aws4_request" || !strings.HasPrefix(timestamp, scopeParts[0]) {
+		return nil, nil, errMalformedAuth
+	}
+
+	key, err := database.Client.S3Key.Query().
+		Where(s3key.AccessKeyEQ(accessKey)).
+		Where(s3key.HasUserWith(user.IsDisabledEQ(false))).
+		WithUser().
+		Only(r.Context())
+	if ent.IsNotFound(err) {
+		return nil, nil, errInvalidAccessKeyID
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	canonical := canonicalRequest(r, signedHeaders, payloadHash)
+	sig := &signature{
+		key:       signingKey(key.SecretKey, scopeParts[0], scopeParts[1]),
+		timestamp: timestamp,
+		scope:     scope,
+	}
+	sig.seed = sig.sign(signatureAlgorithm, hashHex([]byte(canonical)))
+	if !hmac.Equal([]byte(sig.seed), []byte(strings.ToLower(signed))) {
+		return nil, nil, errSignatureDoesNotMatch
+	}
+	return key, sig, nil
+}
+
+// sign signs a hash with the given algorithm line, as in the string to sign
+// of requests and of streaming chunks
+func (sig *signature) sign(algorithm string, lines ...string) string {
+	stringToSign := algorithm + "\n" + sig.timestamp + "\n" + sig.scope + "\n" + strings.Join(lines, "\n")
+	return hex.EncodeToString(hmacSHA256(sig.key, stringToSign))
+}
+
+// signingKey derives the SigV4 signing key of a day and region
+func signingKey(secret, date, region string) []byte {
+	k := hmacSHA256([]byte("AWS4"+secret), date)
+	k = hmacSHA256(k, region)
+	k = hmacSHA256(k, "s3")
+	return hmacSHA256(k, "aws4_request")
+}
+
+// canonicalRequest builds the canonical form of a request that is signed
+func canonicalRequest(r *http.Request, signedHeaders, payloadHash string) string {
+	var headers strings.Builder
+	for _, name := range strings.Split(signedHeaders, ";") {
+		var value string
+		switch name {
+		case "host":
+			value = r.Host
+		case "content-length":
+			// Not kept among the headers of server requests
+			value = strconv.FormatInt(r.ContentLength, 10)
+		default:
+			value = strings.Join(r.Header.Values(name), ",")
+		}
+		headers.WriteString(name + ":" + strings.Join(strings.Fields(value), " ") + "\n")
+	}
+
+	return strings.Join([]string{
+		r.Method,
+		encodePath(r.URL.Path),
+		canonicalQuery(r.URL.Query()),
+		headers.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+}
+
+// canonicalQuery encodes the query sorted by name, without the signature
+func canonicalQuery(query url.Values) string {
+	var pairs [][2]string
+	for name, values := range query {
+		if name == "X-Amz-Signature" {
+			continue
+		}
+		for _, value := range values {
+			pairs = append(pairs, [2]string{encode(name, true), encode(value, true)})
+		}
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i][0] != pairs[j][0] {
+			return pairs[i][0] < pairs[j][0]
+		}
+		return pairs[i][1] < pairs[j][1]
+	})
+
+	encoded := make([]string, len(pairs))
+	for i, pair := range pairs {
+		encoded[i] = pair[0] + "=" + pair[1]
+	}
+	return strings.Join(encoded, "&")
+}
+
+// encodePath URI-encodes a path the way S3 signs it
+func encodePath(p string) string {
+	if p == "" {
+		return "/"
+	}
+	return encode(p, false)
+}
+
+// encode URI-encodes s as SigV4 requires, leaving only unreserved
+// characters and, unless encodeSlash is set, slashes
+func encode(s string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~', c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			b.WriteString("%" + strings.ToUpper(hex.EncodeToString([]byte{c})))
+		}
+	}
+	return b.String()
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+func hashHex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// touch records the use of an access key, at most once per touchInterval
+func touch(ctx context.Context, key *ent.S3Key, ip string) {
+	now := time.Now()
+	if key.LastUsedAt != nil && now.Sub(*key.LastUsedAt) < touchInterval {
+		return
+	}
+	err := database.Client.S3Key.UpdateOne(key).
+		SetLastUsedAt(now).
+		SetLastUsedIP(ip).
+		Exec(ctx)
+	if err != nil {
+		logger.Error.Printf("Failed to update S3 key %s: %v", key.AccessKey, err)
+	}
+}
+
+// clientIP returns the address of the client
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
@@ -0,0 +1,208 @@
+package s3
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"hash"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// maxChunkSize bounds the chunks of streaming uploads, which are buffered
+// until their signature is checked
+const maxChunkSize = 16 * 1024 * 1024
+
+// body returns the verified request body with its length. Streaming uploads
+// are decoded and their chunk signatures checked, and the payload hash and
+// Content-MD5 are checked once the body has been read.
+func (req *request) body() (io.Reader, int64, error) {
+	r := req.r
+	payloadHash := r.Header.Get("X-Amz-Content-Sha256")
+
+	var body io.Reader = r.Body
+	size := r.ContentLength
+	switch payloadHash {
+	case "STREAMING-AWS4-HMAC-SHA256-PAYLOAD", "STREAMING-AWS4-HMAC-SHA256-PAYLOAD-TRAILER":
+		body = &chunkedReader{r: bufio.NewReader(r.Body), sig: req.sig, prev: req.sig.seed}
+		size = decodedLength(r.Header.Get("X-Amz-Decoded-Content-Length"))
+	case "STREAMING-UNSIGNED-PAYLOAD-TRAILER":
+		body = &chunkedReader{r: bufio.NewReader(r.Body)}
+		size = decodedLength(r.Header.Get("X-Amz-Decoded-Content-Length"))
+	case unsignedPayload:
+	case "":
+		// Presigned requests carry no payload hash
+		if !r.URL.Query().Has("X-Amz-Signature") {
+			body = &verifyingReader{r: body, h: sha256.New(), want: mustHex(emptySHA256), err: errContentSHA256Mismatch}
+		}
+	default:
+		want, err := hex.DecodeString(payloadHash)
+		if err != nil || len(want) != sha256.Size {
+			return nil, 0, errContentSHA256Mismatch
+		}
+		body = &verifyingReader{r: body, h: sha256.New(), want: want, err: errContentSHA256Mismatch}
+	}
+	if size < 0 {
+		return nil, 0, errMissingContentLength
+	}
+	body = &lengthReader{r: body, remaining: size}
+
+	if digest := r.Header.Get("Content-MD5"); digest != "" {
+		want, err := base64.StdEncoding.DecodeString(digest)
+		if err != nil || len(want) != md5.Size {
+			return nil, 0, errBadDigest
+		}
+		body = &verifyingReader{r: body, h: md5.New(), want: want, err: errBadDigest}
+	}
+	return &capturingReader{r: body}, size, nil
+}
+
+// decodedLength parses the length of a streaming upload, -1 when missing
+func decodedLength(s string) int64 {
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || n < 0 {
+		return -1
+	}
+	return n
+}
+
+func mustHex(s string) []byte {
+	b, _ := hex.DecodeString(s)
+	return b
+}
+
+// verifyingReader fails at the end of the body when its hash is not the
+// expected one
+type verifyingReader struct {
+	r    io.Reader
+	h    hash.Hash
+	want []byte
+	err  *Error
+}
+
+func (v *verifyingReader) Read(p []byte) (int, error) {
+	n, err := v.r.Read(p)
+	v.h.Write(p[:n])
+	if err == io.EOF && !bytes.Equal(v.h.Sum(nil), v.want) {
+		return n, v.err
+	}
+	return n, err
+}
+
+// lengthReader fails when the body is not exactly as long as announced
+type lengthReader struct {
+	r         io.Reader
+	remaining int64
+}
+
+func (l *lengthReader) Read(p []byte) (int, error) {
+	if l.remaining == 0 {
+		// Reading on to the end lets the inner readers verify the body
+		var extra [1]byte
+		n, err := l.r.Read(extra[:])
+		if n > 0 {
+			return 0, errIncompleteBody
+		}
+		return 0, err
+	}
+	if int64(len(p)) > l.remaining {
+		p = p[:l.remaining]
+	}
+	n, err := l.r.Read(p)
+	l.remaining -= int64(n)
+	if err == io.EOF {
+		if l.remaining > 0 {
+			return n, errIncompleteBody
+		}
+		err = nil
+	}
+	return n, err
+}
+
+// capturingReader remembers the first S3 error of the body, which storage
+// clients may not pass on as is
+type capturingReader struct {
+	r   io.Reader
+	err *Error
+}
+
+func (c *capturingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	if e, ok := err.(*Error); ok && c.err == nil {
+		c.err = e
+	}
+	return n, err
+}
+
+// bodyError returns the S3 error the body failed with, or else err
+func bodyError(body io.Reader, err error) error {
+	if c, ok := body.(*capturingReader); ok && c.err != nil {
+		return c.err
+	}
+	return err
+}
+
+// chunkedReader decodes an aws-chunked body, checking the signature of each
+// chunk unless the payload is unsigned. Trailers are ignored.
+type chunkedReader struct {
+	r     *bufio.Reader
+	sig   *signature // nil for unsigned payloads
+	prev  string     // Signature of the previous chunk
+	chunk []byte
+	err   error
+}
+
+func (c *chunkedReader) Read(p []byte) (int, error) {
+	for len(c.chunk) == 0 {
+		if c.err != nil {
+			return 0, c.err
+		}
+		c.err = c.next()
+	}
+	n := copy(p, c.chunk)
+	c.chunk = c.chunk[n:]
+	return n, nil
+}
+
+// next reads the next chunk, returning io.EOF after the last one
+func (c *chunkedReader) next() error {
+	line, err := c.r.ReadSlice('\n')
+	if err != nil {
+		return errIncompleteBody
+	}
+	sizeHex, extension, _ := strings.Cut(strings.TrimRight(string(line), "\r\n"), ";")
+	size, err := strconv.ParseInt(sizeHex, 16, 64)
+	if err != nil || size < 0 || size > maxChunkSize {
+		return errIncompleteBody
+	}
+
+	data := make([]byte, size)
+	if _, err := io.ReadFull(c.r, data); err != nil {
+		return errIncompleteBody
+	}
+	if size > 0 {
+		var crlf [2]byte
+		if _, err := io.ReadFull(c.r, crlf[:]); err != nil || string(crlf[:]) != "\r\n" {
+			return errIncompleteBody
+		}
+	}
+
+	if c.sig != nil {
+		signed, _ := strings.CutPrefix(extension, "chunk-signature=")
+		want := c.sig.sign("AWS4-HMAC-SHA256-PAYLOAD", c.prev, emptySHA256, hashHex(data))
+		if signed != want {
+			return errSignatureDoesNotMatch
+		}
+		c.prev = want
+	}
+
+	if size == 0 {
+		return io.EOF
+	}
+	c.chunk = data
+	return nil
+}
@@ -0,0 +1,311 @@
+package s3
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"gopan-server/config"
+	"gopan-server/ent"
+	"gopan-server/ent/s3upload"
+	"gopan-server/internal/database"
+	"gopan-server/internal/storage"
+	"io"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+)
+
+// maxPartNumber is the highest part number of a multipart upload
+const maxPartNumber = 10000
+
+type initiateMultipartUploadResult struct {
+	XMLName  xml.Name `xml:"InitiateMultipartUploadResult"`
+	Xmlns    string   `xml:"xmlns,attr"`
+	Bucket   string   `xml:"Bucket"`
+	Key      string   `xml:"Key"`
+	UploadID string   `xml:"UploadId"`
+}
+
+type completeMultipartUpload struct {
+	Parts []struct {
+		PartNumber int    `xml:"PartNumber"`
+		ETag       string `xml:"ETag"`
+	} `xml:"Part"`
+}
+
+type completeMultipartUploadResult struct {
+	XMLName  xml.Name `xml:"CompleteMultipartUploadResult"`
+	Xmlns    string   `xml:"xmlns,attr"`
+	Location string   `xml:"Location"`
+	Bucket   string   `xml:"Bucket"`
+	Key      string   `xml:"Key"`
+	ETag     string   `xml:"ETag"`
+}
+
+// partPrefix is where the parts of an upload are kept in MinIO until the
+// upload is completed
+func partPrefix(uploadID string) string {
+	return "s3-multipart/" + uploadID + "/"
+}
+
+// partObject is the MinIO object of an uploaded part
+func partObject(uploadID string, number int) string {
+	return fmt.Sprintf("%s%05d", partPrefix(uploadID), number)
+}
+
+// createMultipartUpload handles POST /bucket/key?uploads - Start a multipart upload
+func (s *Server) createMultipartUpload(req *request) error {
+	if _, err := objectPath(req.bucket, req.key); err != nil || strings.HasSuffix(req.key, "/") {
+		return errInvalidObjectName
+	}
+	if err := req.checkBucket(); err != nil {
+		return err
+	}
+
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return err
+	}
+	upload, err := database.Client.S3Upload.Create().
+		SetUploadID(hex.EncodeToString(id)).
+		SetUserID(req.user.ID).
+		SetBucket(req.bucket).
+		SetKey(req.key).
+		Save(req.r.Context())
+	if err != nil {
+		return err
+	}
+
+	writeXML(req.w, http.StatusOK, initiateMultipartUploadResult{
+		Xmlns:    xmlns,
+		Bucket:   req.bucket,
+		Key:      req.key,
+		UploadID: upload.UploadID,
+	})
+	return nil
+}
+
+// getUpload returns the multipart upload the request refers to
+func (req *request) getUpload() (*ent.S3Upload, error) {
+	upload, err := database.Client.S3Upload.Query().
+		Where(s3upload.UploadIDEQ(req.r.URL.Query().Get("uploadId"))).
+		Where(s3upload.UserIDEQ(req.user.ID)).
+		Where(s3upload.BucketEQ(req.bucket)).
+		Where(s3upload.KeyEQ(req.key)).
+		Only(req.r.Context())
+	if ent.IsNotFound(err) {
+		return nil, errNoSuchUpload
+	}
+	return upload, err
+}
+
+// uploadPart handles PUT /bucket/key?partNumber&uploadId - Upload a part
+// Parts are kept in MinIO as they are, and only go through deduplication
+// once the upload is completed.
+func (s *Server) uploadPart(req *request) error {
+	ctx := req.r.Context()
+	number, err := strconv.Atoi(req.r.URL.Query().Get("partNumber"))
+	if err != nil || number < 1 || number > maxPartNumber {
+		return errInvalidArgument
+	}
+	upload, err := req.getUpload()
+	if err != nil {
+		return err
+	}
+	body, size, err := req.body()
+	if err != nil {
+		return err
+	}
+
+	// The parts together must fit in the quota
+	available, err := req.fs.Available(ctx)
+	if err != nil {
+		return err
+	}
+	parts, err := listParts(ctx, s.cfg, upload.UploadID)
+	if err != nil {
+		return err
+	}
+	stored := size
+	for n, part := range parts {
+		if n != number {
+			stored += part.Size
+		}
+	}
+	if stored > available {
+		return errQuotaExceeded
+	}
+
+	h := md5.New()
+	_, err = storage.GetClient().PutObject(ctx, s.cfg.MinIO.BucketName, partObject(upload.UploadID, number),
+		io.TeeReader(body, h), size, minio.PutObjectOptions{ContentType: "application/octet-stream"})
+	if err != nil {
+		return bodyError(body, err)
+	}
+
+	req.w.Header().Set("ETag", `"`+hex.EncodeToString(h.Sum(nil))+`"`)
+	req.w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+// completeMultipartUpload handles POST /bucket/key?uploadId - Complete a multipart upload
+// The parts are streamed into the file in order, which stores the content
+// like any other upload.
+func (s *Server) completeMultipartUpload(req *request) error {
+	ctx := req.r.Context()
+	upload, err := req.getUpload()
+	if err != nil {
+		return err
+	}
+	var complete completeMultipartUpload
+	if err := req.readXML(&complete); err != nil {
+		return err
+	}
+	if len(complete.Parts) == 0 {
+		return errMalformedXML
+	}
+
+	parts, err := listParts(ctx, s.cfg, upload.UploadID)
+	if err != nil {
+		return err
+	}
+	var size int64
+	for i, part := range complete.Parts {
+		if i > 0 && part.PartNumber <= complete.Parts[i-1].PartNumber {
+			return errInvalidPartOrder
+		}
+		stored, ok := parts[part.PartNumber]
+		if !ok {
+			return errInvalidPart
+		}
+		size += stored.Size
+	}
+
+	if err := req.checkBucket(); err != nil {
+		return err
+	}
+	p, err := objectPath(req.bucket, req.key)
+	if err != nil {
+		return err
+	}
+	if _, err := req.fs.MkdirAll(ctx, path.Dir(p)); err != nil {
+		return parentError(err)
+	}
+	up, err := req.fs.Create(ctx, p, size)
+	if err != nil {
+		return err
+	}
+	for _, part := range complete.Parts {
+		if err := s.copyPart(ctx, up, partObject(upload.UploadID, part.PartNumber)); err != nil {
+			up.Abort()
+			return err
+		}
+	}
+	n, err := up.Commit()
+	if err != nil {
+		return err
+	}
+	if err := removeUpload(ctx, s.cfg, upload); err != nil {
+		return err
+	}
+
+	writeXML(req.w, http.StatusOK, completeMultipartUploadResult{
+		Xmlns:    xmlns,
+		Location: "/" + req.bucket + "/" + req.key,
+		Bucket:   req.bucket,
+		Key:      req.key,
+		ETag:     etag(n),
+	})
+	return nil
+}
+
+// copyPart writes the content of a part to w
+func (s *Server) copyPart(ctx context.Context, w io.Writer, object string) error {
+	obj, err := storage.GetClient().GetObject(ctx, s.cfg.MinIO.BucketName, object, minio.GetObjectOptions{})
+	if err != nil {
+		return err
+	}
+	defer obj.Close()
+	_, err = io.Copy(w, obj)
+	return err
+}
+
+// abortMultipartUpload handles DELETE /bucket/key?uploadId - Discard a multipart upload
+func (s *Server) abortMultipartUpload(req *request) error {
+	upload, err := req.getUpload()
+	if err != nil {
+		return err
+	}
+	if err := removeUpload(req.r.Context(), s.cfg, upload); err != nil {
+		return err
+	}
+	req.w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// listParts returns the stored parts of an upload by part number
+func listParts(ctx context.Context, cfg *config.Config, uploadID string) (map[int]minio.ObjectInfo, error) {
+	parts := map[int]minio.ObjectInfo{}
+	prefix := partPrefix(uploadID)
+	objects := storage.GetClient().ListObjects(ctx, cfg.MinIO.BucketName, minio.ListObjectsOptions{
+		Prefix:    prefix,
+		Recursive: true,
+	})
+	for obj := range objects {
+		if obj.Err != nil {
+			return nil, obj.Err
+		}
+		number, err := strconv.Atoi(strings.TrimPrefix(obj.Key, prefix))
+		if err != nil {
+			continue
+		}
+		parts[number] = obj
+	}
+	return parts, nil
+}
+
+// removeUpload deletes the parts and the record of a multipart upload
+func removeUpload(ctx context.Context, cfg *config.Config, upload *ent.S3Upload) error {
+	parts, err := listParts(ctx, cfg, upload.UploadID)
+	if err != nil {
+		return err
+	}
+	for _, part := range parts {
+		err := storage.GetClient().RemoveObject(ctx, cfg.MinIO.BucketName, part.Key, minio.RemoveObjectOptions{})
+		if err != nil {
+			return err
+		}
+	}
+	err = database.Client.S3Upload.DeleteOne(upload).Exec(ctx)
+	if ent.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// PurgeUploads discards multipart uploads started before cutoff, which
+// clients have given up on
+func PurgeUploads(ctx context.Context, cfg *config.Config, cutoff time.Time) (int, error) {
+	uploads, err := database.Client.S3Upload.Query().
+		Where(s3upload.CreatedAtLT(cutoff)).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	for _, upload := range uploads {
+		if err := removeUpload(ctx, cfg, upload); err != nil {
+			return purged, fmt.Errorf("upload %s: %w", upload.UploadID, err)
+		}
+		purged++
+	}
+	return purged, nil
+}
@@ -0,0 +1,650 @@
+package s3
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"gopan-server/ent"
+	"gopan-server/internal/drive"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	xmlns = "http://s3.amazonaws.com/doc/2006-03-01/"
+
+	// maxKeys is the most keys listed or deleted in one request
+	maxKeys = 1000
+
+	// maxXMLBody bounds XML request bodies
+	maxXMLBody = 2 * 1024 * 1024
+
+	// emptyETag is the ETag of empty objects, such as folder markers
+	emptyETag = `"d41d8cd98f00b204e9800998ecf8427e"`
+)
+
+type owner struct {
+	ID          string `xml:"ID"`
+	DisplayName string `xml:"DisplayName"`
+}
+
+type bucketEntry struct {
+	Name         string `xml:"Name"`
+	CreationDate string `xml:"CreationDate"`
+}
+
+type listAllMyBucketsResult struct {
+	XMLName xml.Name      `xml:"ListAllMyBucketsResult"`
+	Xmlns   string        `xml:"xmlns,attr"`
+	Owner   owner         `xml:"Owner"`
+	Buckets []bucketEntry `xml:"Buckets>Bucket"`
+}
+
+type objectEntry struct {
+	Key          string `xml:"Key"`
+	LastModified string `xml:"LastModified"`
+	ETag         string `xml:"ETag"`
+	Size         int64  `xml:"Size"`
+	StorageClass string `xml:"StorageClass"`
+}
+
+type commonPrefix struct {
+	Prefix string `xml:"Prefix"`
+}
+
+type listBucketResult struct {
+	XMLName               xml.Name       `xml:"ListBucketResult"`
+	Xmlns                 string         `xml:"xmlns,attr"`
+	Name                  string         `xml:"Name"`
+	Prefix                string         `xml:"Prefix"`
+	Marker                *string        `xml:"Marker"`
+	NextMarker            string         `xml:"NextMarker,omitempty"`
+	StartAfter            string         `xml:"StartAfter,omitempty"`
+	ContinuationToken     string         `xml:"ContinuationToken,omitempty"`
+	NextContinuationToken string         `xml:"NextContinuationToken,omitempty"`
+	KeyCount              *int           `xml:"KeyCount"`
+	MaxKeys               int            `xml:"MaxKeys"`
+	Delimiter             string         `xml:"Delimiter,omitempty"`
+	EncodingType          string         `xml:"EncodingType,omitempty"`
+	IsTruncated           bool           `xml:"IsTruncated"`
+	Contents              []objectEntry  `xml:"Contents"`
+	CommonPrefixes        []commonPrefix `xml:"CommonPrefixes"`
+}
+
+type locationConstraint struct {
+	XMLName xml.Name `xml:"LocationConstraint"`
+	Xmlns   string   `xml:"xmlns,attr"`
+	Region  string   `xml:",chardata"`
+}
+
+type copyObjectResult struct {
+	XMLName      xml.Name `xml:"CopyObjectResult"`
+	Xmlns        string   `xml:"xmlns,attr"`
+	LastModified string   `xml:"LastModified"`
+	ETag         string   `xml:"ETag"`
+}
+
+type deleteRequest struct {
+	Quiet   bool `xml:"Quiet"`
+	Objects []struct {
+		Key string `xml:"Key"`
+	} `xml:"Object"`
+}
+
+type deletedEntry struct {
+	Key string `xml:"Key"`
+}
+
+type deleteErrorEntry struct {
+	Key     string `xml:"Key"`
+	Code    string `xml:"Code"`
+	Message string `xml:"Message"`
+}
+
+type deleteResult struct {
+	XMLName xml.Name           `xml:"DeleteResult"`
+	Xmlns   string             `xml:"xmlns,attr"`
+	Deleted []deletedEntry     `xml:"Deleted"`
+	Errors  []deleteErrorEntry `xml:"Error"`
+}
+
+// etag returns the ETag of a file. Content is identified by its SHA-256
+// rather than its MD5, so the ETag takes the form of a multipart upload's,
+// which clients do not compare with the MD5 of the content.
+func etag(n *ent.Node) string {
+	if len(n.FileHash) < 32 {
+		return emptyETag
+	}
+	return `"` + n.FileHash[:32] + `-1"`
+}
+
+// objectPath returns the drive path of a key, rejecting keys that are not
+// valid file paths
+func objectPath(bucket, key string) (string, error) {
+	key = strings.TrimSuffix(key, "/")
+	if key == "" {
+		return "", errInvalidObjectName
+	}
+	for _, name := range strings.Split(key, "/") {
+		if !drive.ValidName(name) {
+			return "", errInvalidObjectName
+		}
+	}
+	return bucket + "/" + key, nil
+}
+
+// checkBucket returns an error unless the bucket exists
+func (req *request) checkBucket() error {
+	n, err := req.fs.Stat(req.r.Context(), req.bucket)
+	if errors.Is(err, drive.ErrNotFound) || (err == nil && n.Type != drive.TypeFolder) {
+		return errNoSuchBucket
+	}
+	return err
+}
+
+// notFound returns the error for a missing key, telling a missing bucket apart
+func (req *request) notFound() error {
+	if err := req.checkBucket(); err != nil {
+		return err
+	}
+	return errNoSuchKey
+}
+
+// readXML decodes the XML request body
+func (req *request) readXML(v any) error {
+	body, _, err := req.body()
+	if err != nil {
+		return err
+	}
+	data, err := io.ReadAll(io.LimitReader(body, maxXMLBody+1))
+	if err != nil {
+		return bodyError(body, err)
+	}
+	if len(data) > maxXMLBody || xml.Unmarshal(data, v) != nil {
+		return errMalformedXML
+	}
+	return nil
+}
+
+// listBuckets handles GET / - List the top-level folders as buckets
+func (s *Server) listBuckets(req *request) error {
+	nodes, err := req.fs.List(req.r.Context(), "")
+	if err != nil {
+		return err
+	}
+
+	result := listAllMyBucketsResult{
+		Xmlns: xmlns,
+		Owner: owner{ID: strconv.Itoa(req.user.ID), DisplayName: req.user.Username},
+	}
+	for _, n := range nodes {
+		if n.Type == drive.TypeFolder {
+			result.Buckets = append(result.Buckets, bucketEntry{Name: n.Name, CreationDate: formatTime(n.CreatedAt)})
+		}
+	}
+	writeXML(req.w, http.StatusOK, result)
+	return nil
+}
+
+// getBucketLocation handles GET /bucket?location
+func (s *Server) getBucketLocation(req *request) error {
+	if err := req.checkBucket(); err != nil {
+		return err
+	}
+	writeXML(req.w, http.StatusOK, locationConstraint{Xmlns: xmlns, Region: s.cfg.S3.Region})
+	return nil
+}
+
+// headBucket handles HEAD /bucket
+func (s *Server) headBucket(req *request) error {
+	if err := req.checkBucket(); err != nil {
+		return err
+	}
+	req.w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+// createBucket handles PUT /bucket - Create a top-level folder
+func (s *Server) createBucket(req *request) error {
+	_, err := req.fs.Mkdir(req.r.Context(), req.bucket)
+	if errors.Is(err, drive.ErrExist) {
+		return errBucketAlreadyOwned
+	}
+	if err != nil {
+		return err
+	}
+	req.w.Header().Set("Location", "/"+req.bucket)
+	req.w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+// deleteBucket handles DELETE /bucket - Move an empty top-level folder to the trash
+func (s *Server) deleteBucket(req *request) error {
+	ctx := req.r.Context()
+	if err := req.checkBucket(); err != nil {
+		return err
+	}
+	nodes, err := req.fs.List(ctx, req.bucket)
+	if err != nil {
+		return err
+	}
+	if len(nodes) > 0 {
+		return errBucketNotEmpty
+	}
+	if err := req.fs.Remove(ctx, req.bucket); err != nil {
+		return err
+	}
+	req.w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// listEntry is a key or common prefix of a listing
+type listEntry struct {
+	key  string
+	node *ent.Node // nil for a common prefix
+}
+
+// listObjects handles GET /bucket - ListObjects and ListObjectsV2
+func (s *Server) listObjects(req *request) error {
+	q := req.r.URL.Query()
+	v2 := q.Get("list-type") == "2"
+	prefix := q.Get("prefix")
+	delimiter := q.Get("delimiter")
+
+	limit := maxKeys
+	if v := q.Get("max-keys"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			return errInvalidArgument
+		}
+		limit = min(n, maxKeys)
+	}
+
+	after := q.Get("marker")
+	if v2 {
+		after = q.Get("start-after")
+		if token := q.Get("continuation-token"); token != "" {
+			decoded, err := base64.RawURLEncoding.DecodeString(token)
+			if err != nil {
+				return errInvalidArgument
+			}
+			after = string(decoded)
+		}
+	}
+
+	if err := req.checkBucket(); err != nil {
+		return err
+	}
+	entries, err := collect(req.r.Context(), req.fs, req.bucket, prefix, delimiter)
+	if err != nil {
+		return err
+	}
+	start := sort.Search(len(entries), func(i int) bool { return entries[i].key > after })
+	entries = entries[start:]
+	truncated := len(entries) > limit
+	entries = entries[:min(len(entries), limit)]
+
+	enc := func(s string) string { return s }
+	if q.Get("encoding-type") == "url" {
+		enc = func(s string) string { return encode(s, false) }
+	}
+
+	result := listBucketResult{
+		Xmlns:        xmlns,
+		Name:         req.bucket,
+		Prefix:       enc(prefix),
+		MaxKeys:      limit,
+		Delimiter:    enc(delimiter),
+		EncodingType: q.Get("encoding-type"),
+		IsTruncated:  truncated,
+	}
+	for _, e := range entries {
+		if e.node == nil {
+			result.CommonPrefixes = append(result.CommonPrefixes, commonPrefix{Prefix: enc(e.key)})
+			continue
+		}
+		result.Contents = append(result.Contents, objectEntry{
+			Key:          enc(e.key),
+			LastModified: formatTime(e.node.UpdatedAt),
+			ETag:         etag(e.node),
+			Size:         e.node.Size,
+			StorageClass: "STANDARD",
+		})
+	}
+
+	var next string
+	if truncated && len(entries) > 0 {
+		next = entries[len(entries)-1].key
+	}
+	if v2 {
+		count := len(entries)
+		result.KeyCount = &count
+		result.StartAfter = enc(q.Get("start-after"))
+		result.ContinuationToken = q.Get("continuation-token")
+		if next != "" {
+			result.NextContinuationToken = base64.RawURLEncoding.EncodeToString([]byte(next))
+		}
+	} else {
+		marker := enc(q.Get("marker"))
+		result.Marker = &marker
+		result.NextMarker = enc(next)
+	}
+	writeXML(req.w, http.StatusOK, result)
+	return nil
+}
+
+// collect returns the keys and common prefixes of a bucket below prefix,
+// sorted by key. Only the folders the prefix leads into are walked, and with
+// the "/" delimiter subfolders become common prefixes without being walked.
+func collect(ctx context.Context, fs *drive.FS, bucket, prefix, delimiter string) ([]listEntry, error) {
+	// Start at the deepest folder named by the prefix
+	dir := ""
+	if i := strings.LastIndex(prefix, "/"); i >= 0 {
+		dir = prefix[:i+1]
+		p, err := objectPath(bucket, dir)
+		if err != nil {
+			return nil, nil
+		}
+		n, err := fs.Stat(ctx, p)
+		if errors.Is(err, drive.ErrNotFound) || (err == nil && n.Type != drive.TypeFolder) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var entries []listEntry
+	prefixes := map[string]bool{}
+	var walk func(p, keyPrefix string) error
+	walk = func(p, keyPrefix string) error {
+		nodes, err := fs.List(ctx, p)
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			key := keyPrefix + n.Name
+			if n.Type != drive.TypeFolder {
+				if !strings.HasPrefix(key, prefix) {
+					continue
+				}
+				if delimiter != "" {
+					if i := strings.Index(key[len(prefix):], delimiter); i >= 0 {
+						prefixes[key[:len(prefix)+i+len(delimiter)]] = true
+						continue
+					}
+				}
+				entries = append(entries, listEntry{key: key, node: n})
+				continue
+			}
+
+			dirKey := key + "/"
+			if delimiter == "/" && strings.HasPrefix(dirKey, prefix) {
+				prefixes[dirKey] = true
+				continue
+			}
+			if strings.HasPrefix(dirKey, prefix) || strings.HasPrefix(prefix, dirKey) {
+				if err := walk(p+"/"+n.Name, dirKey); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	if err := walk(path.Join(bucket, dir), dir); err != nil {
+		return nil, err
+	}
+
+	for p := range prefixes {
+		entries = append(entries, listEntry{key: p})
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].key < entries[j].key })
+	return entries, nil
+}
+
+// getObject handles GET and HEAD /bucket/key - Download a file, with ranges
+func (s *Server) getObject(req *request) error {
+	ctx := req.r.Context()
+	p, err := objectPath(req.bucket, req.key)
+	if err != nil {
+		return err
+	}
+	n, err := req.fs.Stat(ctx, p)
+	if errors.Is(err, drive.ErrNotFound) {
+		return req.notFound()
+	}
+	if err != nil {
+		return err
+	}
+
+	// Folders are only visible as folder markers, i.e. keys ending in "/"
+	isMarker := strings.HasSuffix(req.key, "/")
+	if (n.Type == drive.TypeFolder) != isMarker {
+		return errNoSuchKey
+	}
+	if isMarker {
+		req.w.Header().Set("ETag", emptyETag)
+		req.w.Header().Set("Last-Modified", n.UpdatedAt.UTC().Format(http.TimeFormat))
+		req.w.Header().Set("Content-Type", "application/x-directory")
+		req.w.Header().Set("Content-Length", "0")
+		req.w.WriteHeader(http.StatusOK)
+		return nil
+	}
+
+	r, err := req.fs.Open(ctx, n)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	header := req.w.Header()
+	header.Set("ETag", etag(n))
+	header.Set("Content-Type", "application/octet-stream")
+	if n.MimeType != "" {
+		header.Set("Content-Type", n.MimeType)
+	}
+	q := req.r.URL.Query()
+	for _, name := range []string{"Content-Type", "Content-Disposition", "Content-Encoding", "Content-Language", "Cache-Control", "Expires"} {
+		if v := q.Get("response-" + strings.ToLower(name)); v != "" {
+			header.Set(name, v)
+		}
+	}
+	http.ServeContent(req.w, req.r, "", n.UpdatedAt, r)
+	return nil
+}
+
+// putObject handles PUT /bucket/key - Upload a file, creating missing folders
+func (s *Server) putObject(req *request) error {
+	ctx := req.r.Context()
+	p, err := objectPath(req.bucket, req.key)
+	if err != nil {
+		return err
+	}
+	body, size, err := req.body()
+	if err != nil {
+		return err
+	}
+	if err := req.checkBucket(); err != nil {
+		return err
+	}
+
+	// Keys ending in "/" create folders
+	if strings.HasSuffix(req.key, "/") {
+		if size > 0 {
+			return errInvalidObjectName
+		}
+		if _, err := io.Copy(io.Discard, body); err != nil {
+			return bodyError(body, err)
+		}
+		if _, err := req.fs.MkdirAll(ctx, p); err != nil {
+			return parentError(err)
+		}
+		req.w.Header().Set("ETag", emptyETag)
+		req.w.WriteHeader(http.StatusOK)
+		return nil
+	}
+
+	if _, err := req.fs.MkdirAll(ctx, path.Dir(p)); err != nil {
+		return parentError(err)
+	}
+	up, err := req.fs.Create(ctx, p, size)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(up, body); err != nil {
+		up.Abort()
+		return bodyError(body, err)
+	}
+	n, err := up.Commit()
+	if err != nil {
+		return err
+	}
+
+	req.w.Header().Set("ETag", etag(n))
+	req.w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+// parentError reports a file in the way of the folders of a key
+func parentError(err error) error {
+	if errors.Is(err, drive.ErrNotFolder) {
+		return errParentIsFile
+	}
+	return err
+}
+
+// copyObject handles PUT /bucket/key with x-amz-copy-source - Copy a file
+// The copy shares the stored content of the source.
+func (s *Server) copyObject(req *request) error {
+	ctx := req.r.Context()
+	p, err := objectPath(req.bucket, req.key)
+	if err != nil {
+		return err
+	}
+
+	source, _, _ := strings.Cut(req.r.Header.Get("x-amz-copy-source"), "?")
+	source, err = url.PathUnescape(source)
+	if err != nil {
+		return errInvalidArgument
+	}
+	srcBucket, srcKey, _ := strings.Cut(strings.TrimPrefix(source, "/"), "/")
+	srcPath, err := objectPath(srcBucket, srcKey)
+	if err != nil || strings.HasSuffix(srcKey, "/") {
+		return errInvalidArgument
+	}
+	src, err := req.fs.Stat(ctx, srcPath)
+	if errors.Is(err, drive.ErrNotFound) || (err == nil && src.Type != drive.TypeFile) {
+		return errNoSuchKey
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := req.checkBucket(); err != nil {
+		return err
+	}
+	n := src
+	if srcPath != p {
+		if _, err := req.fs.MkdirAll(ctx, path.Dir(p)); err != nil {
+			return parentError(err)
+		}
+		up, err := req.fs.Create(ctx, p, src.Size)
+		if err != nil {
+			return err
+		}
+		if err := up.CopyOf(src); err != nil {
+			up.Abort()
+			return err
+		}
+		if n, err = up.Commit(); err != nil {
+			return err
+		}
+	}
+
+	writeXML(req.w, http.StatusOK, copyObjectResult{
+		Xmlns:        xmlns,
+		LastModified: formatTime(n.UpdatedAt),
+		ETag:         etag(n),
+	})
+	return nil
+}
+
+// deleteObject handles DELETE /bucket/key - Move a file to the trash
+func (s *Server) deleteObject(req *request) error {
+	if err := req.checkBucket(); err != nil {
+		return err
+	}
+	if err := req.deleteKey(req.key); err != nil {
+		return err
+	}
+	req.w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// deleteObjects handles POST /bucket?delete - Move several files to the trash
+func (s *Server) deleteObjects(req *request) error {
+	var del deleteRequest
+	if err := req.readXML(&del); err != nil {
+		return err
+	}
+	if len(del.Objects) > maxKeys {
+		return errMalformedXML
+	}
+	if err := req.checkBucket(); err != nil {
+		return err
+	}
+
+	result := deleteResult{Xmlns: xmlns}
+	for _, obj := range del.Objects {
+		if err := req.deleteKey(obj.Key); err != nil {
+			e := toError(err)
+			result.Errors = append(result.Errors, deleteErrorEntry{Key: obj.Key, Code: e.Code, Message: e.Message})
+			if e == errInternal {
+				return err
+			}
+			continue
+		}
+		if !del.Quiet {
+			result.Deleted = append(result.Deleted, deletedEntry{Key: obj.Key})
+		}
+	}
+	writeXML(req.w, http.StatusOK, result)
+	return nil
+}
+
+// deleteKey moves the file of a key to the trash. As in S3, deleting a key
+// that does not exist succeeds, and a folder marker is only removed along
+// with its folder when the folder is empty.
+func (req *request) deleteKey(key string) error {
+	ctx := req.r.Context()
+	p, err := objectPath(req.bucket, key)
+	if err != nil {
+		return nil
+	}
+	n, err := req.fs.Stat(ctx, p)
+	if errors.Is(err, drive.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if strings.HasSuffix(key, "/") {
+		if n.Type != drive.TypeFolder {
+			return nil
+		}
+		children, err := req.fs.List(ctx, p)
+		if err != nil || len(children) > 0 {
+			return err
+		}
+	} else if n.Type != drive.TypeFile {
+		return nil
+	}
+	return req.fs.Remove(ctx, p)
+}
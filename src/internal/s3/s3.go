@@ -0,0 +1,275 @@
+// Package s3 serves the files of each user through an S3 compatible API.
+// Buckets are the top-level folders of the user and object keys are paths
+// below them. Content goes through the drive, so it is deduplicated and
+// counted against the quota like any other upload.
+package s3
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"gopan-server/config"
+	"gopan-server/ent"
+	"gopan-server/ent/user"
+	"gopan-server/internal/drive"
+	"gopan-server/internal/logger"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// timeFormat is the format of timestamps in S3 responses
+const timeFormat = "2006-01-02T15:04:05.000Z"
+
+// Server handles S3 requests
+type Server struct {
+	cfg *config.Config
+}
+
+// New creates an S3 gateway server
+func New(cfg *config.Config) *Server {
+	return &Server{cfg: cfg}
+}
+
+// request is an authenticated S3 request
+type request struct {
+	w      http.ResponseWriter
+	r      *http.Request
+	user   *ent.User
+	fs     *drive.FS
+	sig    *signature
+	bucket string
+	key    string
+}
+
+// ServeHTTP implements http.Handler. Only path-style addressing is
+// supported, i.e. /bucket/key.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id := requestID()
+	w.Header().Set("x-amz-request-id", id)
+	w.Header().Set("Server", "GoPan")
+
+	key, sig, err := s.authenticate(r)
+	if err != nil {
+		s.writeError(w, r, id, err)
+		return
+	}
+	touch(r.Context(), key, clientIP(r))
+
+	u := key.Edges.User
+	readOnly := key.ReadOnly || u.Role == user.RoleReadonly
+	if readOnly && r.Method != http.MethodGet && r.Method != http.MethodHead {
+		s.writeError(w, r, id, errAccessDenied)
+		return
+	}
+
+	req := &request{
+		w:    w,
+		r:    r,
+		user: u,
+		fs:   drive.New(s.cfg, u.ID, nil, readOnly),
+		sig:  sig,
+	}
+	req.bucket, req.key, _ = strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+
+	if err := s.route(req); err != nil {
+		s.writeError(w, r, id, err)
+	}
+}
+
+// route dispatches a request to its operation
+func (s *Server) route(req *request) error {
+	q := req.r.URL.Query()
+	method := req.r.Method
+
+	if req.bucket == "" {
+		if method == http.MethodGet {
+			return s.listBuckets(req)
+		}
+		return errMethodNotAllowed
+	}
+	if !drive.ValidName(req.bucket) {
+		return errInvalidBucketName
+	}
+
+	if req.key == "" {
+		switch {
+		case method == http.MethodGet && q.Has("location"):
+			return s.getBucketLocation(req)
+		case method == http.MethodGet && hasSubresource(q):
+			return errNotImplemented
+		case method == http.MethodGet:
+			return s.listObjects(req)
+		case method == http.MethodHead:
+			return s.headBucket(req)
+		case method == http.MethodPut && !hasSubresource(q):
+			return s.createBucket(req)
+		case method == http.MethodDelete && !hasSubresource(q):
+			return s.deleteBucket(req)
+		case method == http.MethodPost && q.Has("delete"):
+			return s.deleteObjects(req)
+		}
+		return errNotImplemented
+	}
+
+	switch {
+	case method == http.MethodPost && q.Has("uploads"):
+		return s.createMultipartUpload(req)
+	case method == http.MethodPost && q.Has("uploadId"):
+		return s.completeMultipartUpload(req)
+	case method == http.MethodPut && q.Has("uploadId"):
+		if req.r.Header.Get("x-amz-copy-source") != "" {
+			return errNotImplemented
+		}
+		return s.uploadPart(req)
+	case method == http.MethodDelete && q.Has("uploadId"):
+		return s.abortMultipartUpload(req)
+	case hasSubresource(q):
+		return errNotImplemented
+	case method == http.MethodGet, method == http.MethodHead:
+		return s.getObject(req)
+	case method == http.MethodPut && req.r.Header.Get("x-amz-copy-source") != "":
+		return s.copyObject(req)
+	case method == http.MethodPut:
+		return s.putObject(req)
+	case method == http.MethodDelete:
+		return s.deleteObject(req)
+	}
+	return errMethodNotAllowed
+}
+
+// subresources are query parameters selecting operations that are not
+// supported, such as ACLs or tagging
+var subresources = []string{
+	"acl", "cors", "lifecycle", "policy", "tagging", "versioning", "versions",
+	"website", "uploads", "uploadId", "object-lock", "retention", "legal-hold",
+	"encryption", "replication", "notification", "logging", "accelerate",
+}
+
+// hasSubresource reports whether the query selects a subresource
+func hasSubresource(q map[string][]string) bool {
+	for _, name := range subresources {
+		if _, ok := q[name]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Error is an S3 error response
+type Error struct {
+	Status  int
+	Code    string
+	Message string
+}
+
+func (e *Error) Error() string {
+	return e.Code + ": " + e.Message
+}
+
+var (
+	errAccessDenied          = &Error{http.StatusForbidden, "AccessDenied", "Access Denied"}
+	errBadDigest             = &Error{http.StatusBadRequest, "BadDigest", "The Content-MD5 you specified did not match what was received"}
+	errBucketAlreadyOwned    = &Error{http.StatusConflict, "BucketAlreadyOwnedByYou", "The bucket you tried to create already exists, and you own it"}
+	errBucketNotEmpty        = &Error{http.StatusConflict, "BucketNotEmpty", "The bucket you tried to delete is not empty"}
+	errContentSHA256Mismatch = &Error{http.StatusBadRequest, "XAmzContentSHA256Mismatch", "The provided 'x-amz-content-sha256' header does not match what was computed"}
+	errIncompleteBody        = &Error{http.StatusBadRequest, "IncompleteBody", "You did not provide the number of bytes specified by the Content-Length HTTP header"}
+	errInternal              = &Error{http.StatusInternalServerError, "InternalError", "We encountered an internal error, please try again"}
+	errInvalidAccessKeyID    = &Error{http.StatusForbidden, "InvalidAccessKeyId", "The access key ID you provided does not exist in our records"}
+	errInvalidArgument       = &Error{http.StatusBadRequest, "InvalidArgument", "Invalid argument"}
+	errInvalidBucketName     = &Error{http.StatusBadRequest, "InvalidBucketName", "The specified bucket is not valid"}
+	errInvalidObjectName     = &Error{http.StatusBadRequest, "InvalidArgument", "The specified key is not a valid file path"}
+	errInvalidPart           = &Error{http.StatusBadRequest, "InvalidPart", "One or more of the specified parts could not be found"}
+	errInvalidPartOrder      = &Error{http.StatusBadRequest, "InvalidPartOrder", "The list of parts was not in ascending order"}
+	errKeyIsFolder           = &Error{http.StatusConflict, "InvalidRequest", "The specified key is a folder"}
+	errParentIsFile          = &Error{http.StatusConflict, "InvalidRequest", "A parent of the specified key is a file"}
+	errMalformedXML          = &Error{http.StatusBadRequest, "MalformedXML", "The XML you provided was not well-formed or did not validate against our published schema"}
+	errMethodNotAllowed      = &Error{http.StatusMethodNotAllowed, "MethodNotAllowed", "The specified method is not allowed against this resource"}
+	errMissingContentLength  = &Error{http.StatusLengthRequired, "MissingContentLength", "You must provide the Content-Length HTTP header"}
+	errMissingSecurityHeader = &Error{http.StatusBadRequest, "MissingSecurityHeader", "Your request is missing a required header"}
+	errNoSuchBucket          = &Error{http.StatusNotFound, "NoSuchBucket", "The specified bucket does not exist"}
+	errNoSuchKey             = &Error{http.StatusNotFound, "NoSuchKey", "The specified key does not exist"}
+	errNoSuchUpload          = &Error{http.StatusNotFound, "NoSuchUpload", "The specified multipart upload does not exist"}
+	errNotImplemented        = &Error{http.StatusNotImplemented, "NotImplemented", "A header or query you provided implies functionality that is not implemented"}
+	errQuotaExceeded         = &Error{http.StatusForbidden, "QuotaExceeded", "Insufficient storage capacity"}
+	errRequestExpired        = &Error{http.StatusForbidden, "AccessDenied", "Request has expired"}
+	errRequestTimeTooSkewed  = &Error{http.StatusForbidden, "RequestTimeTooSkewed", "The difference between the request time and the server's time is too large"}
+	errSignatureDoesNotMatch = &Error{http.StatusForbidden, "SignatureDoesNotMatch", "The request signature we calculated does not match the signature you provided"}
+	errUnsupportedSignature  = &Error{http.StatusBadRequest, "InvalidRequest", "Only AWS Signature Version 4 is supported"}
+	errMalformedAuth         = &Error{http.StatusBadRequest, "AuthorizationHeaderMalformed", "The authorization header is malformed"}
+)
+
+// errorResponse is the body of an S3 error
+type errorResponse struct {
+	XMLName   xml.Name `xml:"Error"`
+	Code      string   `xml:"Code"`
+	Message   string   `xml:"Message"`
+	Resource  string   `xml:"Resource"`
+	RequestID string   `xml:"RequestId"`
+}
+
+// toError translates drive errors to S3 errors
+func toError(err error) *Error {
+	var e *Error
+	switch {
+	case errors.As(err, &e):
+		return e
+	case errors.Is(err, drive.ErrNotFound), errors.Is(err, drive.ErrNotFolder):
+		return errNoSuchKey
+	case errors.Is(err, drive.ErrQuotaExceeded):
+		return errQuotaExceeded
+	case errors.Is(err, drive.ErrReadOnly), errors.Is(err, drive.ErrRoot):
+		return errAccessDenied
+	case errors.Is(err, drive.ErrInvalidName):
+		return errInvalidObjectName
+	case errors.Is(err, drive.ErrIsFolder), errors.Is(err, drive.ErrExist):
+		return errKeyIsFolder
+	}
+	return errInternal
+}
+
+// writeError writes an S3 error response
+func (s *Server) writeError(w http.ResponseWriter, r *http.Request, id string, err error) {
+	e := toError(err)
+	if e == errInternal {
+		logger.Error.Printf("S3 %s %s failed: %v", r.Method, r.URL.Path, err)
+	}
+
+	if r.Method == http.MethodHead {
+		w.WriteHeader(e.Status)
+		return
+	}
+	writeXML(w, e.Status, errorResponse{
+		Code:      e.Code,
+		Message:   e.Message,
+		Resource:  r.URL.Path,
+		RequestID: id,
+	})
+}
+
+// writeXML writes an XML response body
+func writeXML(w http.ResponseWriter, status int, v any) {
+	body, err := xml.Marshal(v)
+	if err != nil {
+		logger.Error.Printf("Failed to encode S3 response: %v", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	w.Write([]byte(xml.Header))
+	w.Write(body)
+}
+
+// requestID returns a random request ID
+func requestID() string {
+	b := make([]byte, 8)
+	rand.Read(b)
+	return strings.ToUpper(hex.EncodeToString(b))
+}
+
+// formatTime formats a timestamp for S3 responses
+func formatTime(t time.Time) string {
+	return t.UTC().Format(timeFormat)
+}
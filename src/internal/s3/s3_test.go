@@ -0,0 +1,108 @@
+package s3_test
+
+import (
+	"bytes"
+	"context"
+	"gopan-server/config"
+	"gopan-server/internal/dbtest"
+	"gopan-server/internal/s3"
+	"gopan-server/internal/storage/storagetest"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// newGateway serves the S3 gateway for a user with an access key and
+// returns a client signing with secret
+func newGateway(t *testing.T, secret string) *minio.Client {
+	t.Helper()
+	client := dbtest.Open(t)
+	_, minioCfg := storagetest.Open(t)
+	cfg, err := config.Parse([]byte(`{"jwt": {"secret": "test"}, "s3": {"enabled": true}}`))
+	if err != nil {
+		t.Fatalf("parse config: %v", err)
+	}
+	cfg.MinIO = minioCfg
+
+	ctx := context.Background()
+	u := client.User.Create().SetUsername("alice").SetPasswordHash("x").SetTotalQuota(1 << 30).SaveX(ctx)
+	client.S3Key.Create().
+		SetUserID(u.ID).
+		SetName("test").
+		SetAccessKey("GPTESTKEY").
+		SetSecretKey("test-secret").
+		SaveX(ctx)
+
+	srv := httptest.NewServer(s3.New(cfg))
+	t.Cleanup(srv.Close)
+	c, err := minio.New(strings.TrimPrefix(srv.URL, "http://"), &minio.Options{
+		Creds:  credentials.NewStaticV4("GPTESTKEY", secret, ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatalf("create client: %v", err)
+	}
+	return c
+}
+
+func TestPutAndGetObjects(t *testing.T) {
+	c := newGateway(t, "test-secret")
+	ctx := context.Background()
+
+	if err := c.MakeBucket(ctx, "docs", minio.MakeBucketOptions{}); err != nil {
+		t.Fatalf("MakeBucket: %v", err)
+	}
+
+	// A single request, and a multipart upload of three parts
+	small := []byte("some notes")
+	large := bytes.Repeat([]byte("0123456789abcdef"), 11<<20/16)
+	objects := map[string][]byte{
+		"notes.txt":        small,
+		"archive/data.bin": large,
+	}
+	for key, data := range objects {
+		_, err := c.PutObject(ctx, "docs", key, bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{PartSize: 5 << 20})
+		if err != nil {
+			t.Fatalf("PutObject %s: %v", key, err)
+		}
+	}
+
+	for key, data := range objects {
+		obj, err := c.GetObject(ctx, "docs", key, minio.GetObjectOptions{})
+		if err != nil {
+			t.Fatalf("GetObject %s: %v", key, err)
+		}
+		got, err := io.ReadAll(obj)
+		obj.Close()
+		if err != nil {
+			t.Fatalf("read %s: %v", key, err)
+		}
+		if !bytes.Equal(got, data) {
+			t.Errorf("%s read back %d bytes, want the %d written", key, len(got), len(data))
+		}
+	}
+
+	var keys []string
+	for obj := range c.ListObjects(ctx, "docs", minio.ListObjectsOptions{Recursive: true}) {
+		if obj.Err != nil {
+			t.Fatalf("ListObjects: %v", obj.Err)
+		}
+		keys = append(keys, obj.Key)
+	}
+	if got := strings.Join(keys, " "); got != "archive/data.bin notes.txt" {
+		t.Errorf("listed %s", got)
+	}
+}
+
+func TestWrongSecret(t *testing.T) {
+	c := newGateway(t, "wrong-secret")
+
+	err := c.MakeBucket(context.Background(), "docs", minio.MakeBucketOptions{})
+	if code := minio.ToErrorResponse(err).Code; code != "SignatureDoesNotMatch" {
+		t.Errorf("MakeBucket with a wrong secret: err = %v, want SignatureDoesNotMatch", err)
+	}
+}
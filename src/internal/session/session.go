@@ -0,0 +1,148 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"gopan-server/ent"
+	"gopan-server/ent/session"
+	"gopan-server/ent/user"
+	"gopan-server/internal/auth"
+	"gopan-server/internal/database"
+	"time"
+)
+
+var (
+	ErrInvalidToken = errors.New("invalid refresh token")
+	ErrTokenReused  = errors.New("refresh token reused")
+)
+
+// Create starts a session for a user and returns it with its refresh token
+func Create(ctx context.Context, userID int, ip, userAgent string, ttl time.Duration) (*ent.Session, string, error) {
+	token, err := auth.GenerateOpaqueToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(userAgent) > 512 {
+		userAgent = userAgent[:512]
+	}
+
+	s, err := database.Client.Session.Create().
+		SetUserID(userID).
+		SetRefreshTokenHash(auth.HashToken(token)).
+		SetIP(ip).
+		SetUserAgent(userAgent).
+		SetExpiresAt(time.Now().Add(ttl)).
+		Save(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	return s, token, nil
+}
+
+// Rotate exchanges a refresh token for a new one.
+// Presenting a refresh token that was already rotated away means it leaked,
+// so the whole session is revoked and ErrTokenReused is returned.
+func Rotate(ctx context.Context, token, ip string) (*ent.Session, string, error) {
+	hash := auth.HashToken(token)
+	now := time.Now()
+
+	s, err := database.Client.Session.Query().
+		Where(session.RefreshTokenHashEQ(hash)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		reused, err := database.Client.Session.Update().
+			Where(session.PreviousTokenHashEQ(hash)).
+			Where(session.RevokedAtIsNil()).
+			SetRevokedAt(now).
+			Save(ctx)
+		if err != nil {
+			return nil, "", err
+		}
+		if reused > 0 {
+			return nil, "", ErrTokenReused
+		}
+		return nil, "", ErrInvalidToken
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	if s.RevokedAt != nil || s.ExpiresAt.Before(now) {
+		return nil, "", ErrInvalidToken
+	}
+
+	next, err := auth.GenerateOpaqueToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Only the request that still sees the old hash wins a concurrent rotation
+	n, err := database.Client.Session.Update().
+		Where(session.IDEQ(s.ID)).
+		Where(session.RefreshTokenHashEQ(hash)).
+		SetRefreshTokenHash(auth.HashToken(next)).
+		SetPreviousTokenHash(hash).
+		SetIP(ip).
+		SetLastUsedAt(now).
+		Save(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	if n == 0 {
+		return nil, "", ErrInvalidToken
+	}
+
+	s, err = database.Client.Session.Get(ctx, s.ID)
+	if err != nil {
+		return nil, "", err
+	}
+	return s, next, nil
+}
+
+// ActiveUser returns the user of a session that is neither revoked nor
+// expired, or nil when the session is inactive or the user is disabled
+func ActiveUser(ctx context.Context, sessionID, userID int) (*ent.User, error) {
+	u, err := database.Client.Session.Query().
+		Where(session.IDEQ(sessionID)).
+		Where(session.UserIDEQ(userID)).
+		Where(session.RevokedAtIsNil()).
+		Where(session.ExpiresAtGT(time.Now())).
+		QueryUser().
+		Where(user.IsDisabledEQ(false)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return u, err
+}
+
+// Revoke revokes one session of a user
+func Revoke(ctx context.Context, sessionID, userID int) (bool, error) {
+	n, err := database.Client.Session.Update().
+		Where(session.IDEQ(sessionID)).
+		Where(session.UserIDEQ(userID)).
+		Where(session.RevokedAtIsNil()).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+	return n > 0, err
+}
+
+// RevokeAll revokes every session of a user except keepID (0 revokes all)
+func RevokeAll(ctx context.Context, userID, keepID int) (int, error) {
+	return database.Client.Session.Update().
+		Where(session.UserIDEQ(userID)).
+		Where(session.IDNEQ(keepID)).
+		Where(session.RevokedAtIsNil()).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+}
+
+// Purge deletes sessions that expired or were revoked before cutoff
+func Purge(ctx context.Context, cutoff time.Time) (int, error) {
+	return database.Client.Session.Delete().
+		Where(session.Or(
+			session.ExpiresAtLT(cutoff),
+			session.RevokedAtLT(cutoff),
+		)).
+		Exec(ctx)
+}
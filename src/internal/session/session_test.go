@@ -0,0 +1,142 @@
+package session_test
+
+import (
+	"context"
+	"errors"
+	"gopan-server/ent"
+	"gopan-server/internal/dbtest"
+	"gopan-server/internal/session"
+	"testing"
+	"time"
+)
+
+// newUser creates a user to start sessions for
+func newUser(t *testing.T, client *ent.Client, username string) *ent.User {
+	t.Helper()
+	return client.User.Create().SetUsername(username).SetPasswordHash("x").SetTotalQuota(1 << 30).SaveX(context.Background())
+}
+
+func TestRotate(t *testing.T) {
+	client := dbtest.Open(t)
+	u := newUser(t, client, "alice")
+	ctx := context.Background()
+
+	s, first, err := session.Create(ctx, u.ID, "192.0.2.1", "test", time.Hour)
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	rotated, second, err := session.Rotate(ctx, first, "192.0.2.2")
+	if err != nil {
+		t.Fatalf("Rotate: %v", err)
+	}
+	if rotated.ID != s.ID || second == first || rotated.IP != "192.0.2.2" {
+		t.Errorf("rotated session %+v, token changed = %v", rotated, second != first)
+	}
+	third, _, err := session.Rotate(ctx, second, "192.0.2.2")
+	if err != nil || third.ID != s.ID {
+		t.Fatalf("Rotate of the new token = %v, %v", third, err)
+	}
+
+	if _, _, err := session.Rotate(ctx, "unknown", "192.0.2.1"); !errors.Is(err, session.ErrInvalidToken) {
+		t.Errorf("Rotate of an unknown token: err = %v, want ErrInvalidToken", err)
+	}
+}
+
+func TestRotateReuseRevokes(t *testing.T) {
+	client := dbtest.Open(t)
+	u := newUser(t, client, "alice")
+	ctx := context.Background()
+
+	s, first, err := session.Create(ctx, u.ID, "192.0.2.1", "test", time.Hour)
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	_, second, err := session.Rotate(ctx, first, "192.0.2.1")
+	if err != nil {
+		t.Fatalf("Rotate: %v", err)
+	}
+
+	// The rotated away token comes back: it leaked, the session ends
+	if _, _, err := session.Rotate(ctx, first, "203.0.113.1"); !errors.Is(err, session.ErrTokenReused) {
+		t.Fatalf("Rotate of a used token: err = %v, want ErrTokenReused", err)
+	}
+	if client.Session.GetX(ctx, s.ID).RevokedAt == nil {
+		t.Error("session is not revoked after the reuse")
+	}
+	if _, _, err := session.Rotate(ctx, second, "192.0.2.1"); !errors.Is(err, session.ErrInvalidToken) {
+		t.Errorf("Rotate of the current token after the reuse: err = %v, want ErrInvalidToken", err)
+	}
+	if u, err := session.ActiveUser(ctx, s.ID, u.ID); err != nil || u != nil {
+		t.Errorf("ActiveUser after the reuse = %v, %v, want none", u, err)
+	}
+}
+
+func TestActiveUser(t *testing.T) {
+	client := dbtest.Open(t)
+	alice := newUser(t, client, "alice")
+	bob := newUser(t, client, "bob")
+	ctx := context.Background()
+
+	create := func(u *ent.User, ttl time.Duration) *ent.Session {
+		s, _, err := session.Create(ctx, u.ID, "192.0.2.1", "test", ttl)
+		if err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+		return s
+	}
+	active := create(alice, time.Hour)
+	expired := create(alice, -time.Minute)
+	revoked := create(alice, time.Hour)
+	if ok, err := session.Revoke(ctx, revoked.ID, alice.ID); !ok || err != nil {
+		t.Fatalf("Revoke = %v, %v", ok, err)
+	}
+	disabled := create(bob, time.Hour)
+	client.User.UpdateOneID(bob.ID).SetIsDisabled(true).ExecX(ctx)
+
+	tests := []struct {
+		name   string
+		s      *ent.Session
+		userID int
+		want   bool
+	}{
+		{"active", active, alice.ID, true},
+		{"other user", active, bob.ID, false},
+		{"expired", expired, alice.ID, false},
+		{"revoked", revoked, alice.ID, false},
+		{"disabled user", disabled, bob.ID, false},
+	}
+	for _, tt := range tests {
+		u, err := session.ActiveUser(ctx, tt.s.ID, tt.userID)
+		if err != nil {
+			t.Fatalf("%s: ActiveUser: %v", tt.name, err)
+		}
+		if (u != nil) != tt.want {
+			t.Errorf("%s: ActiveUser = %v, want active %v", tt.name, u, tt.want)
+		}
+	}
+}
+
+func TestRevokeAll(t *testing.T) {
+	client := dbtest.Open(t)
+	u := newUser(t, client, "alice")
+	ctx := context.Background()
+
+	var sessions []*ent.Session
+	for range 3 {
+		s, _, err := session.Create(ctx, u.ID, "192.0.2.1", "test", time.Hour)
+		if err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+		sessions = append(sessions, s)
+	}
+
+	if n, err := session.RevokeAll(ctx, u.ID, sessions[0].ID); n != 2 || err != nil {
+		t.Fatalf("RevokeAll = %d, %v, want 2", n, err)
+	}
+	for i, s := range sessions {
+		active, _ := session.ActiveUser(ctx, s.ID, u.ID)
+		if (active != nil) != (i == 0) {
+			t.Errorf("session %d active = %v after revoking the others", i, active != nil)
+		}
+	}
+}
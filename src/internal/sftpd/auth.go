@@ -0,0 +1,200 @@
+package sftpd
+
+import (
+	"context"
+	"errors"
+	"gopan-server/ent"
+	"gopan-server/ent/accesstoken"
+	"gopan-server/ent/authfailure"
+	"gopan-server/ent/sshkey"
+	"gopan-server/ent/user"
+	"gopan-server/internal/account"
+	"gopan-server/internal/database"
+	"gopan-server/internal/drive"
+	"gopan-server/internal/logger"
+	"gopan-server/internal/pat"
+	"gopan-server/internal/throttle"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// Permission extensions passing the outcome of authentication on to the session
+const (
+	extUserID   = "gopan-user-id"
+	extRootID   = "gopan-root-id"
+	extReadOnly = "gopan-read-only"
+	extSSHKeyID = "gopan-ssh-key-id"
+)
+
+var (
+	errAuthFailed = errors.New("authentication failed")
+	errThrottled  = errors.New("too many failed attempts")
+)
+
+// checkPassword authenticates with the account password, throttled like the
+// login form, or with a personal access token
+func (s *Server) checkPassword(conn ssh.ConnMetadata, password []byte) (*ssh.Permissions, error) {
+	ctx := context.Background()
+	username := conn.User()
+	ip := remoteIP(conn)
+
+	if strings.HasPrefix(string(password), pat.Prefix) {
+		return s.checkToken(ctx, username, string(password), ip)
+	}
+	if s.cfg.SFTP.DisablePassword {
+		return nil, errAuthFailed
+	}
+
+	limits := s.throttle.LoginLimits(username, ip)
+	attempt := throttle.Attempt{
+		Kind:      authfailure.KindLogin,
+		Subject:   username,
+		IP:        ip,
+		UserAgent: string(conn.ClientVersion()),
+	}
+	block, err := s.throttle.Check(ctx, limits)
+	if err != nil {
+		// Don't lock everyone out when the throttle state is unavailable
+		logger.Error.Printf("Failed to check throttle: %v", err)
+	} else if block != nil {
+		attempt.Reason = authfailure.ReasonThrottled
+		if block.Locked {
+			attempt.Reason = authfailure.ReasonLocked
+		}
+		throttle.Audit(ctx, attempt)
+		return nil, errThrottled
+	}
+
+	u, err := account.Authenticate(ctx, s.cfg, username, string(password))
+	if errors.Is(err, account.ErrInvalidCredentials) {
+		attempt.Reason = authfailure.ReasonInvalidCredentials
+		throttle.Audit(ctx, attempt)
+		if err := s.throttle.Fail(ctx, limits); err != nil {
+			logger.Error.Printf("Failed to record failed attempt: %v", err)
+		}
+		return nil, errAuthFailed
+	}
+	if errors.Is(err, account.ErrUserDisabled) || (err == nil && u.IsDisabled) {
+		return nil, errAuthFailed
+	}
+	if err != nil {
+		logger.Error.Printf("Authentication of %s failed: %v", username, err)
+		return nil, err
+	}
+
+	// A password alone must not get around the second factor
+	if u.TotpEnabled {
+		return nil, errAuthFailed
+	}
+
+	if err := s.throttle.Reset(ctx, throttle.UserKey(username)); err != nil {
+		logger.Error.Printf("Failed to reset throttle: %v", err)
+	}
+	return permissions(u.ID, nil, false, 0), nil
+}
+
+// checkToken authenticates with a personal access token of the user
+func (s *Server) checkToken(ctx context.Context, username, token, ip string) (*ssh.Permissions, error) {
+	t, err := pat.Lookup(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if t == nil || t.Edges.User.Username != username || t.Scope == accesstoken.ScopeUpload {
+		return nil, errAuthFailed
+	}
+	if err := pat.Touch(ctx, t, ip); err != nil {
+		return nil, err
+	}
+
+	// Folder restricted tokens see their folder as the root
+	return permissions(t.UserID, t.FolderID, t.Scope == accesstoken.ScopeRead, 0), nil
+}
+
+// checkPublicKey authenticates with one of the SSH keys of the user. Clients
+// may offer keys before proving they hold them, so the use of the key is
+// only recorded once the session starts.
+func (s *Server) checkPublicKey(conn ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+	k, err := database.Client.SSHKey.Query().
+		Where(sshkey.FingerprintEQ(ssh.FingerprintSHA256(key))).
+		Where(sshkey.HasUserWith(
+			user.UsernameEQ(conn.User()),
+			user.IsDisabledEQ(false),
+		)).
+		Only(context.Background())
+	if ent.IsNotFound(err) {
+		return nil, errAuthFailed
+	}
+	if err != nil {
+		return nil, err
+	}
+	return permissions(k.UserID, nil, false, k.ID), nil
+}
+
+// permissions records who signed in and with which access
+func permissions(userID int, rootID *int, readOnly bool, sshKeyID int) *ssh.Permissions {
+	ext := map[string]string{
+		extUserID:   strconv.Itoa(userID),
+		extReadOnly: strconv.FormatBool(readOnly),
+	}
+	if rootID != nil {
+		ext[extRootID] = strconv.Itoa(*rootID)
+	}
+	if sshKeyID != 0 {
+		ext[extSSHKeyID] = strconv.Itoa(sshKeyID)
+	}
+	return &ssh.Permissions{Extensions: ext}
+}
+
+// session returns the file tree of an authenticated connection
+func (s *Server) session(ctx context.Context, conn *ssh.ServerConn) (*drive.FS, error) {
+	ext := conn.Permissions.Extensions
+	uid, err := strconv.Atoi(ext[extUserID])
+	if err != nil {
+		return nil, err
+	}
+	u, err := database.Client.User.Get(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	if u.IsDisabled {
+		return nil, account.ErrUserDisabled
+	}
+
+	if v, ok := ext[extSSHKeyID]; ok {
+		keyID, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, err
+		}
+		err = database.Client.SSHKey.UpdateOneID(keyID).
+			SetLastUsedAt(time.Now()).
+			SetLastUsedIP(remoteIP(conn)).
+			Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var rootID *int
+	if v, ok := ext[extRootID]; ok {
+		id, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, err
+		}
+		rootID = &id
+	}
+	readOnly := ext[extReadOnly] == "true" || u.Role == user.RoleReadonly
+	return drive.New(s.cfg, u.ID, rootID, readOnly), nil
+}
+
+// remoteIP returns the IP address of the client
+func remoteIP(conn ssh.ConnMetadata) string {
+	host, _, err := net.SplitHostPort(conn.RemoteAddr().String())
+	if err != nil {
+		return conn.RemoteAddr().String()
+	}
+	return host
+}
@@ -0,0 +1,165 @@
+package sftpd
+
+import (
+	"bytes"
+	"errors"
+	"gopan-server/internal/drive"
+	"io"
+	"sync"
+)
+
+// readWindow is how much of a file is kept behind the read position.
+// Clients keep many reads in flight, which may be served out of order.
+const readWindow = 4 * 1024 * 1024
+
+// maxPending bounds the writes buffered while an earlier one is missing
+const maxPending = 64 * 1024 * 1024
+
+var (
+	errNotSequential = errors.New("files can only be written from start to end")
+	errTooManyWrites = errors.New("too many writes out of order")
+)
+
+// reader serves the reads of a client from one sequential read of the
+// file, requesting the content again only when the client jumps
+type reader struct {
+	mu    sync.Mutex
+	r     *drive.Reader
+	buf   []byte // Content from start up to the read position
+	start int64
+	reset bool // The read position is unknown after a failed read
+}
+
+func (r *reader) ReadAt(p []byte, off int64) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	size := r.r.Node().Size
+	if off >= size {
+		return 0, io.EOF
+	}
+
+	end := r.start + int64(len(r.buf))
+	if r.reset || off < r.start || off > end+readWindow {
+		if _, err := r.r.Seek(off, io.SeekStart); err != nil {
+			return 0, err
+		}
+		r.start, r.buf, r.reset = off, r.buf[:0], false
+		end = off
+	}
+
+	// Read on up to the end of the request
+	if want := min(off+int64(len(p)), size); want > end {
+		read := len(r.buf)
+		r.buf = append(r.buf, make([]byte, want-end)...)
+		if _, err := io.ReadFull(r.r, r.buf[read:]); err != nil {
+			r.buf, r.reset = r.buf[:read], true
+			return 0, err
+		}
+	}
+
+	n := copy(p, r.buf[off-r.start:])
+
+	// Drop what is behind the window, now and then to keep copying cheap
+	if len(r.buf) > 2*readWindow {
+		drop := len(r.buf) - readWindow
+		r.buf = append(r.buf[:0], r.buf[drop:]...)
+		r.start += int64(drop)
+	}
+
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+func (r *reader) Close() error {
+	return r.r.Close()
+}
+
+// writer turns the writes of a client, which may arrive out of order, into
+// a sequential upload
+type writer struct {
+	mu      sync.Mutex
+	upload  *drive.Upload
+	offset  int64            // Where the next write in sequence starts
+	pending map[int64][]byte // Writes ahead of offset
+	held    int64            // Bytes in pending
+	err     error            // First failure, which discards the upload on Close
+}
+
+func (w *writer) WriteAt(p []byte, off int64) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.err != nil {
+		return 0, w.err
+	}
+	if off < w.offset {
+		w.err = errNotSequential
+		return 0, w.err
+	}
+	if off > w.offset {
+		if w.held+int64(len(p)) > maxPending {
+			w.err = errTooManyWrites
+			return 0, w.err
+		}
+		w.pending[off] = bytes.Clone(p)
+		w.held += int64(len(p))
+		return len(p), nil
+	}
+
+	if err := w.write(p); err != nil {
+		return 0, err
+	}
+	// Write what now follows on
+	for {
+		next, ok := w.pending[w.offset]
+		if !ok {
+			break
+		}
+		delete(w.pending, w.offset)
+		w.held -= int64(len(next))
+		if err := w.write(next); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+// write appends to the upload
+func (w *writer) write(p []byte) error {
+	if _, err := w.upload.Write(p); err != nil {
+		w.err = sftpError(err)
+		return w.err
+	}
+	w.offset += int64(len(p))
+	return nil
+}
+
+// TransferError implements sftp.TransferError, called when the connection
+// breaks during the upload
+func (w *writer) TransferError(err error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.err == nil {
+		w.err = err
+	}
+}
+
+// Close stores the file, unless the upload failed or has gaps
+func (w *writer) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.err == nil && len(w.pending) > 0 {
+		w.err = errNotSequential
+	}
+	if w.err != nil {
+		w.upload.Abort()
+		return w.err
+	}
+	_, err := w.upload.Commit()
+	return sftpError(err)
+}
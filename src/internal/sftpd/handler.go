@@ -0,0 +1,210 @@
+package sftpd
+
+import (
+	"context"
+	"errors"
+	"gopan-server/ent"
+	"gopan-server/internal/drive"
+	"gopan-server/internal/logger"
+	"io"
+	"os"
+	"time"
+
+	"github.com/pkg/sftp"
+)
+
+var (
+	errNotEmpty  = errors.New("folder is not empty")
+	errIsFolder  = errors.New("is a folder")
+	errNotFolder = errors.New("not a folder")
+)
+
+// handler implements the SFTP requests on top of a drive
+type handler struct {
+	ctx context.Context // Lives as long as the connection
+	fs  *drive.FS
+}
+
+func newHandler(ctx context.Context, fs *drive.FS) *handler {
+	return &handler{ctx: ctx, fs: fs}
+}
+
+// Fileread implements sftp.FileReader
+func (h *handler) Fileread(r *sftp.Request) (io.ReaderAt, error) {
+	n, err := h.fs.Stat(h.ctx, r.Filepath)
+	if err != nil {
+		return nil, sftpError(err)
+	}
+	if n.Type != drive.TypeFile {
+		return nil, errIsFolder
+	}
+	rd, err := h.fs.Open(h.ctx, n)
+	if err != nil {
+		return nil, sftpError(err)
+	}
+	return &reader{r: rd}, nil
+}
+
+// Filewrite implements sftp.FileWriter. Stored content cannot be changed in
+// place, so files are always written from the start and replace the
+// previous content when closed.
+func (h *handler) Filewrite(r *sftp.Request) (io.WriterAt, error) {
+	flags := r.Pflags()
+	if flags.Append {
+		return nil, sftp.ErrSSHFxOpUnsupported
+	}
+	if !flags.Trunc || flags.Excl {
+		n, err := h.fs.Stat(h.ctx, r.Filepath)
+		if err != nil && !errors.Is(err, drive.ErrNotFound) {
+			return nil, sftpError(err)
+		}
+		if err == nil {
+			if flags.Excl {
+				return nil, os.ErrExist
+			}
+			if n.Type == drive.TypeFile && n.Size > 0 {
+				// Resuming or patching a file
+				return nil, sftp.ErrSSHFxOpUnsupported
+			}
+		}
+	}
+
+	up, err := h.fs.Create(h.ctx, r.Filepath, -1)
+	if err != nil {
+		return nil, sftpError(err)
+	}
+	return &writer{upload: up, pending: make(map[int64][]byte)}, nil
+}
+
+// Filecmd implements sftp.FileCmder
+func (h *handler) Filecmd(r *sftp.Request) error {
+	switch r.Method {
+	case "Setstat":
+		// Permissions, owners and times are not kept
+		return nil
+	case "Rename":
+		return sftpError(h.fs.Move(h.ctx, r.Filepath, r.Target))
+	case "Mkdir":
+		_, err := h.fs.Mkdir(h.ctx, r.Filepath)
+		return sftpError(err)
+	case "Rmdir":
+		n, err := h.fs.Stat(h.ctx, r.Filepath)
+		if err != nil {
+			return sftpError(err)
+		}
+		if n.Type != drive.TypeFolder {
+			return errNotFolder
+		}
+		children, err := h.fs.List(h.ctx, r.Filepath)
+		if err != nil {
+			return sftpError(err)
+		}
+		if len(children) > 0 {
+			return errNotEmpty
+		}
+		return sftpError(h.fs.Remove(h.ctx, r.Filepath))
+	case "Remove":
+		n, err := h.fs.Stat(h.ctx, r.Filepath)
+		if err != nil {
+			return sftpError(err)
+		}
+		if n.Type != drive.TypeFile {
+			return errIsFolder
+		}
+		return sftpError(h.fs.Remove(h.ctx, r.Filepath))
+	}
+	return sftp.ErrSSHFxOpUnsupported
+}
+
+// PosixRename implements sftp.PosixRenameFileCmder. Unlike Rename it
+// replaces an existing file, which is moved to the trash.
+func (h *handler) PosixRename(r *sftp.Request) error {
+	n, err := h.fs.Stat(h.ctx, r.Target)
+	if err == nil && n.Type == drive.TypeFile {
+		if err := h.fs.Remove(h.ctx, r.Target); err != nil {
+			return sftpError(err)
+		}
+	} else if err != nil && !errors.Is(err, drive.ErrNotFound) {
+		return sftpError(err)
+	}
+	return sftpError(h.fs.Move(h.ctx, r.Filepath, r.Target))
+}
+
+// Filelist implements sftp.FileLister
+func (h *handler) Filelist(r *sftp.Request) (sftp.ListerAt, error) {
+	switch r.Method {
+	case "List":
+		nodes, err := h.fs.List(h.ctx, r.Filepath)
+		if err != nil {
+			return nil, sftpError(err)
+		}
+		infos := make(listerAt, len(nodes))
+		for i, n := range nodes {
+			infos[i] = fileInfo{n}
+		}
+		return infos, nil
+	case "Stat":
+		n, err := h.fs.Stat(h.ctx, r.Filepath)
+		if err != nil {
+			return nil, sftpError(err)
+		}
+		return listerAt{fileInfo{n}}, nil
+	}
+	return nil, sftp.ErrSSHFxOpUnsupported
+}
+
+// sftpError translates drive errors to SFTP status codes. Other drive
+// errors are passed on as failures with their message, while unexpected
+// errors are logged and not shown to the client.
+func sftpError(err error) error {
+	clientErrors := []error{
+		drive.ErrExist, drive.ErrIsFolder, drive.ErrInvalidName, drive.ErrInvalidMove, drive.ErrQuotaExceeded,
+	}
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, drive.ErrNotFound), errors.Is(err, drive.ErrNotFolder):
+		return os.ErrNotExist
+	case errors.Is(err, drive.ErrReadOnly), errors.Is(err, drive.ErrRoot):
+		return sftp.ErrSSHFxPermissionDenied
+	}
+	for _, e := range clientErrors {
+		if errors.Is(err, e) {
+			return e
+		}
+	}
+	logger.Error.Printf("SFTP request failed: %v", err)
+	return sftp.ErrSSHFxFailure
+}
+
+// listerAt implements sftp.ListerAt for a list of files
+type listerAt []os.FileInfo
+
+func (l listerAt) ListAt(infos []os.FileInfo, offset int64) (int, error) {
+	if offset >= int64(len(l)) {
+		return 0, io.EOF
+	}
+	n := copy(infos, l[offset:])
+	if n < len(infos) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// fileInfo implements os.FileInfo for a node
+type fileInfo struct {
+	node *ent.Node
+}
+
+func (fi fileInfo) Name() string       { return fi.node.Name }
+func (fi fileInfo) Size() int64        { return fi.node.Size }
+func (fi fileInfo) ModTime() time.Time { return fi.node.UpdatedAt }
+func (fi fileInfo) IsDir() bool        { return fi.node.Type == drive.TypeFolder }
+func (fi fileInfo) Sys() any           { return nil }
+
+func (fi fileInfo) Mode() os.FileMode {
+	if fi.IsDir() {
+		return os.ModeDir | 0755
+	}
+	return 0644
+}
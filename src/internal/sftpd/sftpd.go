@@ -0,0 +1,228 @@
+// Package sftpd serves the files of each user over SFTP. Users sign in with
+// their password, a personal access token or one of their SSH public keys,
+// and see the same tree as through WebDAV.
+package sftpd
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"gopan-server/config"
+	"gopan-server/internal/logger"
+	"gopan-server/internal/throttle"
+	"io"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+)
+
+// handshakeTimeout bounds the SSH handshake including authentication
+const handshakeTimeout = 30 * time.Second
+
+// ErrServerClosed is returned by ListenAndServe after Close
+var ErrServerClosed = errors.New("sftp: server closed")
+
+// Server is the SFTP server
+type Server struct {
+	cfg      *config.Config
+	throttle *throttle.Throttler
+	ssh      *ssh.ServerConfig
+
+	mu       sync.Mutex
+	listener net.Listener
+	conns    map[net.Conn]struct{}
+	closed   bool
+}
+
+// New creates the SFTP server, generating its host key on first start
+func New(cfg *config.Config) (*Server, error) {
+	hostKey, err := loadHostKey(cfg.SFTP.HostKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load host key: %w", err)
+	}
+
+	s := &Server{
+		cfg:      cfg,
+		throttle: throttle.New(&cfg.Security),
+		conns:    make(map[net.Conn]struct{}),
+	}
+	s.ssh = &ssh.ServerConfig{
+		PasswordCallback:  s.checkPassword,
+		PublicKeyCallback: s.checkPublicKey,
+		ServerVersion:     "SSH-2.0-GoPan",
+	}
+	s.ssh.AddHostKey(hostKey)
+	return s, nil
+}
+
+// loadHostKey reads the private host key, generating an Ed25519 key when
+// the file does not exist yet. The key must stay the same across restarts,
+// as clients remember it.
+func loadHostKey(file string) (ssh.Signer, error) {
+	data, err := os.ReadFile(file)
+	if errors.Is(err, os.ErrNotExist) {
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		block, err := ssh.MarshalPrivateKey(key, "GoPan SFTP host key")
+		if err != nil {
+			return nil, err
+		}
+		data = pem.EncodeToMemory(block)
+		if err := os.WriteFile(file, data, 0600); err != nil {
+			return nil, err
+		}
+		logger.Info.Printf("Generated SFTP host key %s", file)
+	} else if err != nil {
+		return nil, err
+	}
+	return ssh.ParsePrivateKey(data)
+}
+
+// ListenAndServe accepts connections until Close is called
+func (s *Server) ListenAndServe() error {
+	l, err := net.Listen("tcp", fmt.Sprintf("%s:%d", s.cfg.SFTP.Host, s.cfg.SFTP.Port))
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		l.Close()
+		return ErrServerClosed
+	}
+	s.listener = l
+	s.mu.Unlock()
+
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			s.mu.Lock()
+			closed := s.closed
+			s.mu.Unlock()
+			if closed {
+				return ErrServerClosed
+			}
+			return err
+		}
+		go s.serveConn(conn)
+	}
+}
+
+// Close stops accepting connections and closes the open ones, which
+// discards unfinished uploads
+func (s *Server) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.closed = true
+	var err error
+	if s.listener != nil {
+		err = s.listener.Close()
+	}
+	for conn := range s.conns {
+		conn.Close()
+	}
+	return err
+}
+
+// track adds or removes an open connection, returning false when the
+// server is closed
+func (s *Server) track(conn net.Conn, add bool) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !add {
+		delete(s.conns, conn)
+		return true
+	}
+	if s.closed {
+		return false
+	}
+	s.conns[conn] = struct{}{}
+	return true
+}
+
+// serveConn runs the SSH connection of a client
+func (s *Server) serveConn(conn net.Conn) {
+	defer conn.Close()
+	if !s.track(conn, true) {
+		return
+	}
+	defer s.track(conn, false)
+
+	// Failed handshakes are mostly scanners and wrong passwords, which
+	// are audited already
+	conn.SetDeadline(time.Now().Add(handshakeTimeout))
+	sconn, chans, reqs, err := ssh.NewServerConn(conn, s.ssh)
+	if err != nil {
+		return
+	}
+	defer sconn.Close()
+	conn.SetDeadline(time.Time{})
+	go ssh.DiscardRequests(reqs)
+
+	// Uploads live as long as the connection
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	fs, err := s.session(ctx, sconn)
+	if err != nil {
+		logger.Error.Printf("SFTP session of %s failed: %v", sconn.User(), err)
+		return
+	}
+
+	for newChan := range chans {
+		if newChan.ChannelType() != "session" {
+			newChan.Reject(ssh.UnknownChannelType, "Only SFTP sessions are supported")
+			continue
+		}
+		ch, requests, err := newChan.Accept()
+		if err != nil {
+			continue
+		}
+		go s.serveSession(ctx, newHandler(ctx, fs), ch, requests)
+	}
+}
+
+// serveSession runs the SFTP subsystem on a session channel. Shells,
+// commands (including legacy scp) and forwarding are refused.
+func (s *Server) serveSession(ctx context.Context, h *handler, ch ssh.Channel, requests <-chan *ssh.Request) {
+	defer ch.Close()
+
+	for req := range requests {
+		var subsystem struct{ Name string }
+		if req.Type != "subsystem" || ssh.Unmarshal(req.Payload, &subsystem) != nil || subsystem.Name != "sftp" {
+			req.Reply(false, nil)
+			continue
+		}
+		req.Reply(true, nil)
+		go ssh.DiscardRequests(requests)
+
+		server := sftp.NewRequestServer(ch, sftp.Handlers{
+			FileGet:  h,
+			FilePut:  h,
+			FileCmd:  h,
+			FileList: h,
+		})
+		var status uint32
+		if err := server.Serve(); err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) && ctx.Err() == nil {
+			logger.Error.Printf("SFTP session failed: %v", err)
+			status = 1
+		}
+
+		// scp fails without an exit status, even after a complete transfer
+		ch.SendRequest("exit-status", false, ssh.Marshal(struct{ Status uint32 }{status}))
+		server.Close()
+		return
+	}
+}
@@ -0,0 +1,285 @@
+package sftpd_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"gopan-server/config"
+	"gopan-server/ent"
+	"gopan-server/ent/accesstoken"
+	"gopan-server/internal/auth"
+	"gopan-server/internal/dbtest"
+	"gopan-server/internal/drive"
+	"gopan-server/internal/pat"
+	"gopan-server/internal/sftpd"
+	"gopan-server/internal/storage/storagetest"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+)
+
+// testServer is a running SFTP server with a user alice, whose password is
+// alice-password and whose files are /docs/notes.txt and /other.txt
+type testServer struct {
+	addr  string
+	db    *ent.Client
+	alice *ent.User
+	docs  *ent.Node
+}
+
+func newTestServer(t *testing.T) *testServer {
+	t.Helper()
+	db := dbtest.Open(t)
+	_, minioCfg := storagetest.Open(t)
+	cfg, err := config.Parse([]byte(`{"jwt": {"secret": "test"}, "sftp": {"enabled": true, "host": "127.0.0.1"}}`))
+	if err != nil {
+		t.Fatalf("parse config: %v", err)
+	}
+	cfg.MinIO = minioCfg
+	cfg.SFTP.HostKey = filepath.Join(t.TempDir(), "host_key")
+
+	// Find a free port for the server to listen on
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg.SFTP.Port = l.Addr().(*net.TCPAddr).Port
+	l.Close()
+
+	ctx := context.Background()
+	hash, err := auth.HashPassword("alice-password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &testServer{addr: l.Addr().String(), db: db}
+	s.alice = db.User.Create().SetUsername("alice").SetPasswordHash(hash).SetTotalQuota(1 << 30).SaveX(ctx)
+	fs := drive.New(cfg, s.alice.ID, nil, false)
+	if s.docs, err = fs.Mkdir(ctx, "/docs"); err != nil {
+		t.Fatalf("Mkdir: %v", err)
+	}
+	for p, content := range map[string]string{"/docs/notes.txt": "notes", "/other.txt": "other"} {
+		up, err := fs.Create(ctx, p, int64(len(content)))
+		if err != nil {
+			t.Fatalf("Create %s: %v", p, err)
+		}
+		up.Write([]byte(content))
+		if _, err := up.Commit(); err != nil {
+			t.Fatalf("Commit %s: %v", p, err)
+		}
+	}
+
+	srv, err := sftpd.New(cfg)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	go srv.ListenAndServe()
+	t.Cleanup(func() { srv.Close() })
+	for range 100 {
+		if conn, err := net.Dial("tcp", s.addr); err == nil {
+			conn.Close()
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return s
+}
+
+// newToken creates a personal access token of alice
+func (s *testServer) newToken(t *testing.T, scope accesstoken.Scope, folderID *int) string {
+	t.Helper()
+	token, err := pat.Generate()
+	if err != nil {
+		t.Fatalf("Generate: %v", err)
+	}
+	s.db.AccessToken.Create().
+		SetUser(s.alice).
+		SetName(string(scope)).
+		SetTokenHash(auth.HashToken(token)).
+		SetTokenPrefix(token[:8]).
+		SetScope(scope).
+		SetNillableFolderID(folderID).
+		SaveX(context.Background())
+	return token
+}
+
+// dial signs in as username and opens an SFTP session
+func (s *testServer) dial(username string, method ssh.AuthMethod) (*sftp.Client, error) {
+	conn, err := ssh.Dial("tcp", s.addr, &ssh.ClientConfig{
+		User:            username,
+		Auth:            []ssh.AuthMethod{method},
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+		Timeout:         5 * time.Second,
+	})
+	if err != nil {
+		return nil, err
+	}
+	c, err := sftp.NewClient(conn)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return c, nil
+}
+
+// readFile returns the content of a file, or the error in its place
+func readFile(c *sftp.Client, p string) string {
+	f, err := c.Open(p)
+	if err != nil {
+		return "error: " + err.Error()
+	}
+	defer f.Close()
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return "error: " + err.Error()
+	}
+	return string(data)
+}
+
+// writeFile creates or replaces a file
+func writeFile(c *sftp.Client, p, content string) error {
+	f, err := c.Create(p)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write([]byte(content)); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func TestReadWrite(t *testing.T) {
+	s := newTestServer(t)
+	c, err := s.dial("alice", ssh.Password("alice-password"))
+	if err != nil {
+		t.Fatalf("sign in with the password: %v", err)
+	}
+	defer c.Close()
+
+	if got := readFile(c, "/docs/notes.txt"); got != "notes" {
+		t.Errorf("read /docs/notes.txt = %q", got)
+	}
+	if err := c.MkdirAll("/docs/2024"); err != nil {
+		t.Fatalf("MkdirAll: %v", err)
+	}
+	if err := writeFile(c, "/docs/2024/report.txt", "first report"); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	if err := writeFile(c, "/docs/2024/report.txt", "second report"); err != nil {
+		t.Fatalf("overwrite: %v", err)
+	}
+	if got := readFile(c, "/docs/2024/report.txt"); got != "second report" {
+		t.Errorf("read back %q", got)
+	}
+	if info, err := c.Stat("/docs/2024/report.txt"); err != nil || info.Size() != 13 || info.IsDir() {
+		t.Errorf("Stat = %v, %v", info, err)
+	}
+
+	if err := c.Rename("/docs/2024/report.txt", "/report.txt"); err != nil {
+		t.Fatalf("Rename: %v", err)
+	}
+	infos, err := c.ReadDir("/")
+	if err != nil {
+		t.Fatalf("ReadDir: %v", err)
+	}
+	var names []string
+	for _, info := range infos {
+		names = append(names, info.Name())
+	}
+	sort.Strings(names)
+	if got := strings.Join(names, " "); got != "docs other.txt report.txt" {
+		t.Errorf("ReadDir / = %s", got)
+	}
+
+	if err := c.Remove("/report.txt"); err != nil {
+		t.Fatalf("Remove: %v", err)
+	}
+	if _, err := c.Stat("/report.txt"); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("Stat after removing: err = %v, want not exist", err)
+	}
+}
+
+func TestAuthentication(t *testing.T) {
+	s := newTestServer(t)
+
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := ssh.NewSignerFromKey(private)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.db.SSHKey.Create().
+		SetUser(s.alice).
+		SetName("laptop").
+		SetPublicKey(string(ssh.MarshalAuthorizedKey(signer.PublicKey()))).
+		SetFingerprint(ssh.FingerprintSHA256(signer.PublicKey())).
+		SaveX(context.Background())
+	_, otherKey, _ := ed25519.GenerateKey(rand.Reader)
+	otherSigner, _ := ssh.NewSignerFromKey(otherKey)
+
+	full := s.newToken(t, accesstoken.ScopeFull, nil)
+	read := s.newToken(t, accesstoken.ScopeRead, nil)
+	upload := s.newToken(t, accesstoken.ScopeUpload, nil)
+	folder := s.newToken(t, accesstoken.ScopeFull, &s.docs.ID)
+
+	tests := []struct {
+		name     string
+		username string
+		method   ssh.AuthMethod
+		signIn   bool
+		read     string // Path that must be readable
+		hidden   string // Path that must not be visible
+		writable bool
+	}{
+		{"password", "alice", ssh.Password("alice-password"), true, "/other.txt", "", true},
+		{"wrong password", "alice", ssh.Password("wrong"), false, "", "", false},
+		{"public key", "alice", ssh.PublicKeys(signer), true, "/other.txt", "", true},
+		{"unknown public key", "alice", ssh.PublicKeys(otherSigner), false, "", "", false},
+		{"public key of another user", "bob", ssh.PublicKeys(signer), false, "", "", false},
+		{"token", "alice", ssh.Password(full), true, "/other.txt", "", true},
+		{"token of another user", "bob", ssh.Password(full), false, "", "", false},
+		{"read token", "alice", ssh.Password(read), true, "/other.txt", "", false},
+		{"upload token", "alice", ssh.Password(upload), false, "", "", false},
+		{"folder token", "alice", ssh.Password(folder), true, "/notes.txt", "/other.txt", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := s.dial(tt.username, tt.method)
+			if !tt.signIn {
+				if err == nil {
+					c.Close()
+					t.Fatal("signed in, want the sign in to fail")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("sign in: %v", err)
+			}
+			defer c.Close()
+
+			if got := readFile(c, tt.read); strings.HasPrefix(got, "error: ") {
+				t.Errorf("read %s: %s", tt.read, got)
+			}
+			if tt.hidden != "" {
+				if _, err := c.Stat(tt.hidden); !errors.Is(err, os.ErrNotExist) {
+					t.Errorf("Stat %s: err = %v, want not exist", tt.hidden, err)
+				}
+			}
+			err = writeFile(c, "/"+strings.ReplaceAll(tt.name, " ", "-")+".txt", "data")
+			if (err == nil) != tt.writable {
+				t.Errorf("write: err = %v, want writable %v", err, tt.writable)
+			}
+		})
+	}
+}
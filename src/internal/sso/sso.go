@@ -0,0 +1,222 @@
+package sso
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gopan-server/config"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"golang.org/x/oauth2"
+)
+
+var (
+	ErrDisabled      = errors.New("single sign-on is not enabled")
+	ErrInvalidNonce  = errors.New("ID token nonce does not match")
+	ErrMissingClaims = errors.New("identity provider returned no subject")
+)
+
+// rolePriority orders mapped roles, the highest privilege wins when several match
+var rolePriority = map[string]int{"readonly": 1, "user": 2, "admin": 3}
+
+// Identity is an account authenticated by the identity provider with its
+// claims mapped to user fields
+type Identity struct {
+	Issuer        string
+	Subject       string
+	Username      string
+	Email         string
+	EmailVerified bool
+	Role          string // Empty when no role claim matched
+	Quota         *int64 // Nil when the quota claim is missing
+}
+
+// Provider performs the OpenID Connect authorization code flow with PKCE
+type Provider struct {
+	cfg *config.OIDCConfig
+
+	mu       sync.Mutex
+	provider *oidc.Provider
+}
+
+// NewProvider creates a provider for cfg. Discovery happens on first use, so
+// the server starts even when the identity provider is unreachable.
+func NewProvider(cfg *config.OIDCConfig) *Provider {
+	return &Provider{cfg: cfg}
+}
+
+// Enabled reports whether single sign-on is configured
+func (p *Provider) Enabled() bool {
+	return p.cfg.Enabled && p.cfg.Issuer != "" && p.cfg.ClientID != ""
+}
+
+// discover fetches the provider metadata once it is reachable
+func (p *Provider) discover(ctx context.Context) (*oidc.Provider, error) {
+	if !p.Enabled() {
+		return nil, ErrDisabled
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.provider == nil {
+		// Keep the discovered key set alive beyond the request that triggered discovery
+		provider, err := oidc.NewProvider(context.WithoutCancel(ctx), p.cfg.Issuer)
+		if err != nil {
+			return nil, fmt.Errorf("discover %s: %w", p.cfg.Issuer, err)
+		}
+		p.provider = provider
+	}
+	return p.provider, nil
+}
+
+// oauth2Config returns the OAuth2 client configuration for provider
+func (p *Provider) oauth2Config(provider *oidc.Provider) *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:     p.cfg.ClientID,
+		ClientSecret: p.cfg.ClientSecret,
+		RedirectURL:  p.cfg.RedirectURL,
+		Endpoint:     provider.Endpoint(),
+		Scopes:       p.cfg.Scopes,
+	}
+}
+
+// AuthCodeURL returns the authorization endpoint URL the user is sent to
+func (p *Provider) AuthCodeURL(ctx context.Context, state, nonce, verifier string) (string, error) {
+	provider, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return p.oauth2Config(provider).AuthCodeURL(state,
+		oidc.Nonce(nonce),
+		oauth2.S256ChallengeOption(verifier),
+	), nil
+}
+
+// Exchange redeems the authorization code, verifies the ID token and maps its claims
+func (p *Provider) Exchange(ctx context.Context, code, verifier, nonce string) (*Identity, error) {
+	provider, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	oauthCfg := p.oauth2Config(provider)
+	token, err := oauthCfg.Exchange(ctx, code, oauth2.VerifierOption(verifier))
+	if err != nil {
+		return nil, fmt.Errorf("exchange code: %w", err)
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		return nil, errors.New("token response has no id_token")
+	}
+	idToken, err := provider.Verifier(&oidc.Config{ClientID: p.cfg.ClientID}).Verify(ctx, rawIDToken)
+	if err != nil {
+		return nil, fmt.Errorf("verify ID token: %w", err)
+	}
+	if idToken.Nonce != nonce {
+		return nil, ErrInvalidNonce
+	}
+
+	claims := map[string]interface{}{}
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, fmt.Errorf("parse ID token claims: %w", err)
+	}
+
+	// Claims missing from the ID token may still be served by the userinfo endpoint
+	if provider.UserInfoEndpoint() != "" {
+		info, err := provider.UserInfo(ctx, oauthCfg.TokenSource(ctx, token))
+		if err == nil && info.Subject == idToken.Subject {
+			extra := map[string]interface{}{}
+			if err := info.Claims(&extra); err == nil {
+				for k, v := range extra {
+					if _, exists := claims[k]; !exists {
+						claims[k] = v
+					}
+				}
+			}
+		}
+	}
+
+	return p.mapClaims(idToken.Issuer, idToken.Subject, claims)
+}
+
+// mapClaims maps claims to an identity according to the configured claim names
+func (p *Provider) mapClaims(issuer, subject string, claims map[string]interface{}) (*Identity, error) {
+	if subject == "" {
+		return nil, ErrMissingClaims
+	}
+
+	id := &Identity{
+		Issuer:  issuer,
+		Subject: subject,
+	}
+	id.Username, _ = claims[p.cfg.Claims.Username].(string)
+	id.Email, _ = claims[p.cfg.Claims.Email].(string)
+	id.EmailVerified = claimBool(claims["email_verified"])
+
+	if p.cfg.Claims.Role != "" {
+		for _, value := range claimStrings(claims[p.cfg.Claims.Role]) {
+			role, ok := p.cfg.Claims.RoleMap[value]
+			if !ok {
+				// Values naming a role directly need no mapping
+				role = value
+			}
+			if rolePriority[role] > rolePriority[id.Role] {
+				id.Role = role
+			}
+		}
+	}
+
+	if p.cfg.Claims.Quota != "" {
+		if quota, ok := claimInt64(claims[p.cfg.Claims.Quota]); ok && quota >= 0 {
+			id.Quota = &quota
+		}
+	}
+
+	return id, nil
+}
+
+// claimStrings reads a string or string array claim
+func claimStrings(v interface{}) []string {
+	switch v := v.(type) {
+	case string:
+		return strings.Fields(v)
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	}
+	return nil
+}
+
+// claimInt64 reads a numeric claim, which some providers send as a string
+func claimInt64(v interface{}) (int64, bool) {
+	switch v := v.(type) {
+	case float64:
+		return int64(v), true
+	case string:
+		n, err := strconv.ParseInt(v, 10, 64)
+		return n, err == nil
+	}
+	return 0, false
+}
+
+// claimBool reads a boolean claim, which some providers send as a string
+func claimBool(v interface{}) bool {
+	switch v := v.(type) {
+	case bool:
+		return v
+	case string:
+		return v == "true"
+	}
+	return false
+}
@@ -0,0 +1,169 @@
+package sso_test
+
+import (
+	"context"
+	"errors"
+	"gopan-server/config"
+	"gopan-server/internal/sso"
+	"gopan-server/internal/sso/ssotest"
+	"testing"
+
+	"golang.org/x/oauth2"
+)
+
+const redirectURL = "https://pan.example.com/api/auth/oidc/callback"
+
+func newProvider(idp *ssotest.Server, claims config.OIDCClaimsConfig) *sso.Provider {
+	if claims.Username == "" {
+		claims.Username = "preferred_username"
+	}
+	if claims.Email == "" {
+		claims.Email = "email"
+	}
+	return sso.NewProvider(&config.OIDCConfig{
+		Enabled:      true,
+		Issuer:       idp.URL,
+		ClientID:     ssotest.ClientID,
+		ClientSecret: ssotest.ClientSecret,
+		RedirectURL:  redirectURL,
+		Scopes:       []string{"openid", "profile", "email"},
+		Claims:       claims,
+	})
+}
+
+// login runs the authorization step and returns the code and state sent to the callback
+func login(t *testing.T, idp *ssotest.Server, p *sso.Provider, state, nonce, verifier string, claims map[string]any) (string, string) {
+	t.Helper()
+	authURL, err := p.AuthCodeURL(context.Background(), state, nonce, verifier)
+	if err != nil {
+		t.Fatalf("AuthCodeURL: %v", err)
+	}
+	callback := idp.Authorize(t, authURL, claims)
+	return callback.Query().Get("code"), callback.Query().Get("state")
+}
+
+func TestExchange(t *testing.T) {
+	idp := ssotest.NewServer(t)
+	p := newProvider(idp, config.OIDCClaimsConfig{})
+	ctx := context.Background()
+
+	verifier := oauth2.GenerateVerifier()
+	code, state := login(t, idp, p, "state-1", "nonce-1", verifier, map[string]any{
+		"sub":                "alice-id",
+		"preferred_username": "alice",
+		"email":              "alice@example.com",
+		"email_verified":     true,
+	})
+	if state != "state-1" {
+		t.Fatalf("state = %q, want state-1", state)
+	}
+
+	id, err := p.Exchange(ctx, code, verifier, "nonce-1")
+	if err != nil {
+		t.Fatalf("Exchange: %v", err)
+	}
+	if id.Issuer != idp.URL || id.Subject != "alice-id" || id.Username != "alice" ||
+		id.Email != "alice@example.com" || !id.EmailVerified {
+		t.Errorf("identity = %+v", id)
+	}
+
+	// Codes are single use
+	if _, err := p.Exchange(ctx, code, verifier, "nonce-1"); err == nil {
+		t.Error("redeeming a code twice succeeded")
+	}
+}
+
+func TestExchangeRejectsWrongVerifier(t *testing.T) {
+	idp := ssotest.NewServer(t)
+	p := newProvider(idp, config.OIDCClaimsConfig{})
+
+	code, _ := login(t, idp, p, "state", "nonce", oauth2.GenerateVerifier(), map[string]any{"sub": "alice-id"})
+	if _, err := p.Exchange(context.Background(), code, oauth2.GenerateVerifier(), "nonce"); err == nil {
+		t.Fatal("Exchange with another PKCE verifier succeeded")
+	}
+}
+
+func TestExchangeRejectsWrongNonce(t *testing.T) {
+	idp := ssotest.NewServer(t)
+	p := newProvider(idp, config.OIDCClaimsConfig{})
+
+	verifier := oauth2.GenerateVerifier()
+	code, _ := login(t, idp, p, "state", "nonce-1", verifier, map[string]any{"sub": "alice-id"})
+	_, err := p.Exchange(context.Background(), code, verifier, "nonce-2")
+	if !errors.Is(err, sso.ErrInvalidNonce) {
+		t.Fatalf("Exchange error = %v, want ErrInvalidNonce", err)
+	}
+}
+
+func TestExchangeMapsClaims(t *testing.T) {
+	claimsCfg := config.OIDCClaimsConfig{
+		Role:    "groups",
+		RoleMap: map[string]string{"pan-admins": "admin", "pan-guests": "readonly"},
+		Quota:   "pan_quota",
+	}
+	tests := []struct {
+		name      string
+		claims    map[string]any
+		wantRole  string
+		wantQuota int64 // -1 for none
+	}{
+		{"no role claim", map[string]any{}, "", -1},
+		{"unmapped value", map[string]any{"groups": []any{"staff"}}, "", -1},
+		{"mapped value", map[string]any{"groups": []any{"pan-guests"}}, "readonly", -1},
+		{"highest role wins", map[string]any{"groups": []any{"pan-guests", "pan-admins", "user"}}, "admin", -1},
+		{"role named directly", map[string]any{"groups": "user"}, "user", -1},
+		{"space separated string", map[string]any{"groups": "staff pan-admins"}, "admin", -1},
+		{"numeric quota", map[string]any{"pan_quota": 1024}, "", 1024},
+		{"string quota", map[string]any{"pan_quota": "2048"}, "", 2048},
+		{"negative quota ignored", map[string]any{"pan_quota": -1}, "", -1},
+	}
+
+	idp := ssotest.NewServer(t)
+	p := newProvider(idp, claimsCfg)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims := map[string]any{"sub": "alice-id"}
+			for k, v := range tt.claims {
+				claims[k] = v
+			}
+			verifier := oauth2.GenerateVerifier()
+			code, _ := login(t, idp, p, "state", "nonce", verifier, claims)
+
+			id, err := p.Exchange(context.Background(), code, verifier, "nonce")
+			if err != nil {
+				t.Fatalf("Exchange: %v", err)
+			}
+			if id.Role != tt.wantRole {
+				t.Errorf("role = %q, want %q", id.Role, tt.wantRole)
+			}
+			switch {
+			case tt.wantQuota < 0 && id.Quota != nil:
+				t.Errorf("quota = %d, want none", *id.Quota)
+			case tt.wantQuota >= 0 && (id.Quota == nil || *id.Quota != tt.wantQuota):
+				t.Errorf("quota = %v, want %d", id.Quota, tt.wantQuota)
+			}
+		})
+	}
+}
+
+func TestStateRoundTrip(t *testing.T) {
+	value, err := sso.SignState(&sso.State{State: "s", Nonce: "n", Verifier: "v", LinkUserID: 7}, "secret")
+	if err != nil {
+		t.Fatalf("SignState: %v", err)
+	}
+
+	s, err := sso.ParseState(value, "secret")
+	if err != nil {
+		t.Fatalf("ParseState: %v", err)
+	}
+	if s.State != "s" || s.Nonce != "n" || s.Verifier != "v" || s.LinkUserID != 7 {
+		t.Errorf("state = %+v", s)
+	}
+
+	if _, err := sso.ParseState(value, "other secret"); !errors.Is(err, sso.ErrInvalidState) {
+		t.Errorf("ParseState with another secret: err = %v, want ErrInvalidState", err)
+	}
+	if _, err := sso.ParseState(value+"x", "secret"); !errors.Is(err, sso.ErrInvalidState) {
+		t.Errorf("ParseState of a tampered value: err = %v, want ErrInvalidState", err)
+	}
+}
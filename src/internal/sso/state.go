@@ -0,0 +1,49 @@
+package sso
+
+import (
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// StateCookie is the cookie carrying the login state between redirect and callback
+const StateCookie = "gopan_oidc_state"
+
+// StateTTL is how long a user may take to log in at the identity provider
+const StateTTL = 10 * time.Minute
+
+var ErrInvalidState = errors.New("invalid login state")
+
+// State is the login attempt state kept in a signed cookie
+type State struct {
+	State      string `json:"state"`
+	Nonce      string `json:"nonce"`
+	Verifier   string `json:"verifier"`
+	LinkUserID int    `json:"link_uid,omitempty"` // Set when an authenticated user links an identity
+	jwt.RegisteredClaims
+}
+
+// SignState serializes the state into a cookie value signed with secret
+func SignState(s *State, secret string) (string, error) {
+	s.ExpiresAt = jwt.NewNumericDate(time.Now().Add(StateTTL))
+	s.Subject = "oidc-state"
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, s)
+	return token.SignedString([]byte(secret))
+}
+
+// ParseState verifies a cookie value produced by SignState
+func ParseState(value, secret string) (*State, error) {
+	s := &State{}
+	token, err := jwt.ParseWithClaims(value, s, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, ErrInvalidState
+		}
+		return []byte(secret), nil
+	})
+	if err != nil || !token.Valid || s.Subject != "oidc-state" {
+		return nil, ErrInvalidState
+	}
+	return s, nil
+}
@@ -0,0 +1,253 @@
+// Package storagetest runs an in-memory object store for tests. It speaks
+// enough of the S3 API for the MinIO client as GoPan uses it: buckets,
+// simple and multipart uploads, ranged downloads, listings and deletes.
+package storagetest
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"gopan-server/config"
+	"gopan-server/internal/storage"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// Bucket is the bucket of the configuration returned by Open
+const Bucket = "gopan"
+
+// Server is an in-memory object store
+type Server struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	objects map[string][]byte         // By bucket/key
+	uploads map[string]map[int][]byte // Parts by upload ID
+	nextID  int
+}
+
+// Open starts an object store, points the storage package at it and
+// returns its configuration. Both are undone when the test ends.
+func Open(t testing.TB) (*Server, config.MinIOConfig) {
+	t.Helper()
+	s := &Server{objects: map[string][]byte{}, uploads: map[string]map[int][]byte{}}
+	s.Server = httptest.NewServer(s)
+	t.Cleanup(s.Close)
+
+	cfg := config.MinIOConfig{
+		Endpoint:        strings.TrimPrefix(s.URL, "http://"),
+		AccessKeyID:     "test",
+		SecretAccessKey: "test-secret",
+		BucketName:      Bucket,
+	}
+	previous := storage.Client
+	t.Cleanup(func() { storage.Client = previous })
+	if err := storage.Init(&cfg); err != nil {
+		t.Fatalf("init storage: %v", err)
+	}
+	return s, cfg
+}
+
+// Object returns the content of an object in Bucket
+func (s *Server) Object(key string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data, ok := s.objects[Bucket+"/"+key]
+	return data, ok
+}
+
+// Len returns the number of objects stored
+func (s *Server) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.objects)
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var body []byte
+	if r.Method == http.MethodPut || r.Method == http.MethodPost {
+		body = readBody(r)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path := strings.TrimPrefix(r.URL.Path, "/")
+	bucket, key, _ := strings.Cut(path, "/")
+	if key == "" {
+		s.serveBucket(w, r, bucket)
+		return
+	}
+
+	q := r.URL.Query()
+	switch r.Method {
+	case http.MethodPut:
+		if id := q.Get("uploadId"); id != "" {
+			number, _ := strconv.Atoi(q.Get("partNumber"))
+			s.uploads[id][number] = body
+			w.Header().Set("ETag", etag(body))
+			return
+		}
+		s.objects[path] = body
+		w.Header().Set("ETag", etag(body))
+	case http.MethodPost:
+		if q.Has("uploads") {
+			s.nextID++
+			id := strconv.Itoa(s.nextID)
+			s.uploads[id] = map[int][]byte{}
+			writeXML(w, struct {
+				XMLName  xml.Name `xml:"InitiateMultipartUploadResult"`
+				Bucket   string
+				Key      string
+				UploadId string
+			}{Bucket: bucket, Key: key, UploadId: id})
+			return
+		}
+		id := q.Get("uploadId")
+		var numbers []int
+		for n := range s.uploads[id] {
+			numbers = append(numbers, n)
+		}
+		sort.Ints(numbers)
+		var data []byte
+		for _, n := range numbers {
+			data = append(data, s.uploads[id][n]...)
+		}
+		s.objects[path] = data
+		delete(s.uploads, id)
+		writeXML(w, struct {
+			XMLName xml.Name `xml:"CompleteMultipartUploadResult"`
+			Bucket  string
+			Key     string
+			ETag    string
+		}{Bucket: bucket, Key: key, ETag: etag(data)})
+	case http.MethodDelete:
+		if id := q.Get("uploadId"); id != "" {
+			delete(s.uploads, id)
+		} else {
+			delete(s.objects, path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodGet, http.MethodHead:
+		data, ok := s.objects[path]
+		if !ok {
+			w.Header().Set("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusNotFound)
+			if r.Method == http.MethodGet {
+				io.WriteString(w, `<Error><Code>NoSuchKey</Code><Message>The specified key does not exist.</Message></Error>`)
+			}
+			return
+		}
+		serveObject(w, r, data)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// serveBucket answers requests on a bucket, which always exists
+func (s *Server) serveBucket(w http.ResponseWriter, r *http.Request, bucket string) {
+	q := r.URL.Query()
+	switch {
+	case r.Method == http.MethodGet && q.Has("location"):
+		writeXML(w, struct {
+			XMLName xml.Name `xml:"LocationConstraint"`
+			Region  string   `xml:",chardata"`
+		}{Region: "us-east-1"})
+	case r.Method == http.MethodGet && q.Get("list-type") == "2":
+		type content struct {
+			Key          string
+			Size         int
+			ETag         string
+			LastModified string
+		}
+		result := struct {
+			XMLName     xml.Name `xml:"ListBucketResult"`
+			Name        string
+			IsTruncated bool
+			Contents    []content
+		}{Name: bucket}
+		for path, data := range s.objects {
+			key, ok := strings.CutPrefix(path, bucket+"/")
+			if ok && strings.HasPrefix(key, q.Get("prefix")) {
+				result.Contents = append(result.Contents, content{key, len(data), etag(data), "2024-01-01T00:00:00.000Z"})
+			}
+		}
+		sort.Slice(result.Contents, func(i, j int) bool { return result.Contents[i].Key < result.Contents[j].Key })
+		writeXML(w, result)
+	}
+}
+
+// serveObject writes an object, or the part a Range header asks for
+func serveObject(w http.ResponseWriter, r *http.Request, data []byte) {
+	w.Header().Set("ETag", etag(data))
+	w.Header().Set("Last-Modified", "Mon, 01 Jan 2024 00:00:00 GMT")
+	w.Header().Set("Content-Type", "application/octet-stream")
+
+	start, end := 0, len(data)-1
+	status := http.StatusOK
+	if spec, ok := strings.CutPrefix(r.Header.Get("Range"), "bytes="); ok {
+		first, last, _ := strings.Cut(spec, "-")
+		start, _ = strconv.Atoi(first)
+		if last != "" {
+			end, _ = strconv.Atoi(last)
+		}
+		end = min(end, len(data)-1)
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, end, len(data)))
+		status = http.StatusPartialContent
+	}
+	w.Header().Set("Content-Length", strconv.Itoa(end-start+1))
+	w.WriteHeader(status)
+	if r.Method == http.MethodGet && len(data) > 0 {
+		w.Write(data[start : end+1])
+	}
+}
+
+// readBody returns the body of a request, decoding the chunks of streaming
+// signatures
+func readBody(r *http.Request) []byte {
+	raw, _ := io.ReadAll(r.Body)
+	if !strings.HasPrefix(r.Header.Get("X-Amz-Content-Sha256"), "STREAMING-") {
+		return raw
+	}
+
+	// Each chunk is "<hex size>;chunk-signature=...\r\n<data>\r\n"
+	br := bufio.NewReader(bytes.NewReader(raw))
+	var data []byte
+	for {
+		line, err := br.ReadString('\n')
+		if err != nil {
+			return data
+		}
+		sizeHex, _, _ := strings.Cut(strings.TrimSpace(line), ";")
+		size, _ := strconv.ParseInt(sizeHex, 16, 64)
+		if size == 0 {
+			return data
+		}
+		chunk := make([]byte, size)
+		if _, err := io.ReadFull(br, chunk); err != nil {
+			return data
+		}
+		data = append(data, chunk...)
+		br.ReadString('\n')
+	}
+}
+
+func etag(data []byte) string {
+	sum := md5.Sum(data)
+	return `"` + hex.EncodeToString(sum[:]) + `"`
+}
+
+func writeXML(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/xml")
+	io.WriteString(w, xml.Header)
+	xml.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,262 @@
+package throttle
+
+import (
+	"context"
+	"gopan-server/config"
+	"gopan-server/ent"
+	"gopan-server/ent/authfailure"
+	"gopan-server/ent/auththrottle"
+	"gopan-server/internal/database"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Block tells why and until when attempts are rejected
+type Block struct {
+	Until  time.Time
+	Locked bool // Lockout of the account rather than a backoff delay
+}
+
+// RetryAfter returns the whole seconds until the block ends
+func (b *Block) RetryAfter() int {
+	return int(time.Until(b.Until).Seconds()) + 1
+}
+
+// Attempt describes one failed attempt for auditing
+type Attempt struct {
+	Kind      authfailure.Kind
+	Subject   string
+	UserID    *int
+	Reason    authfailure.Reason
+	IP        string
+	UserAgent string
+}
+
+// Limit is a throttled key with the number of failures allowed before backoff
+type Limit struct {
+	Key          string
+	FreeAttempts int
+	Lockout      bool // Whether failures of the key may lock it
+}
+
+// Throttler applies backoff and lockout to failed attempts
+type Throttler struct {
+	cfg *config.SecurityConfig
+}
+
+// New creates a throttler for cfg
+func New(cfg *config.SecurityConfig) *Throttler {
+	return &Throttler{cfg: cfg}
+}
+
+// UserKey returns the key throttling attempts for a username
+func UserKey(username string) string {
+	return "user:" + strings.ToLower(username)
+}
+
+// IPKey returns the key throttling attempts from a client IP
+func IPKey(ip string) string {
+	return "ip:" + ip
+}
+
+// ShareKey returns the key throttling password attempts for a share
+func ShareKey(shareID int) string {
+	return "share:" + strconv.Itoa(shareID)
+}
+
+// ShareLookupKey returns the key throttling unknown share codes looked up
+// from a client IP
+func ShareLookupKey(ip string) string {
+	return "share-lookup:" + ip
+}
+
+// LoginLimits returns the limits of a login attempt for username from ip
+func (t *Throttler) LoginLimits(username, ip string) []Limit {
+	return []Limit{
+		{Key: UserKey(username), FreeAttempts: t.cfg.UserFreeAttempts, Lockout: true},
+		{Key: IPKey(ip), FreeAttempts: t.cfg.IPFreeAttempts},
+	}
+}
+
+// ShareLimits returns the limits of a share password attempt from ip
+func (t *Throttler) ShareLimits(shareID int, ip string) []Limit {
+	return []Limit{
+		{Key: ShareKey(shareID), FreeAttempts: t.cfg.UserFreeAttempts},
+		{Key: IPKey(ip), FreeAttempts: t.cfg.IPFreeAttempts},
+	}
+}
+
+// ShareLookupLimits returns the limits of looking up unknown share codes
+// from ip, which keep share links from being enumerated
+func (t *Throttler) ShareLookupLimits(ip string) []Limit {
+	return []Limit{
+		{Key: ShareLookupKey(ip), FreeAttempts: t.cfg.IPFreeAttempts},
+	}
+}
+
+// Check returns the longest active block among limits, or nil when an attempt is allowed
+func (t *Throttler) Check(ctx context.Context, limits []Limit) (*Block, error) {
+	if t.cfg.DisableThrottling {
+		return nil, nil
+	}
+
+	throttles, err := database.Client.AuthThrottle.Query().
+		Where(auththrottle.KeyIn(keys(limits)...)).
+		Where(auththrottle.BlockedUntilGT(time.Now())).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var block *Block
+	for _, th := range throttles {
+		if block == nil || th.BlockedUntil.After(block.Until) {
+			block = &Block{Until: *th.BlockedUntil, Locked: th.Locked}
+		} else if th.Locked {
+			block.Locked = true
+		}
+	}
+	return block, nil
+}
+
+// Fail counts a failed attempt against limits and blocks the keys that
+// exceeded their free attempts
+func (t *Throttler) Fail(ctx context.Context, limits []Limit) error {
+	if t.cfg.DisableThrottling {
+		return nil
+	}
+
+	for _, l := range limits {
+		if err := t.fail(ctx, l); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fail counts a failure of one key. The counter is incremented in the
+// database, so concurrent failures on several instances are all counted.
+func (t *Throttler) fail(ctx context.Context, l Limit) error {
+	now := time.Now()
+
+	th, err := database.Client.AuthThrottle.Query().
+		Where(auththrottle.KeyEQ(l.Key)).
+		Only(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		th, err = database.Client.AuthThrottle.Create().
+			SetKey(l.Key).
+			SetFailures(1).
+			SetLastFailureAt(now).
+			Save(ctx)
+		if ent.IsConstraintError(err) {
+			// Created concurrently by another attempt
+			return t.fail(ctx, l)
+		}
+	case err == nil && now.Sub(th.LastFailureAt) > t.cfg.GetResetAfter():
+		// Old failures are forgotten
+		th, err = th.Update().
+			SetFailures(1).
+			SetLastFailureAt(now).
+			ClearBlockedUntil().
+			SetLocked(false).
+			Save(ctx)
+	case err == nil:
+		_, err = database.Client.AuthThrottle.Update().
+			Where(auththrottle.IDEQ(th.ID)).
+			AddFailures(1).
+			SetLastFailureAt(now).
+			Save(ctx)
+		if err == nil {
+			th, err = database.Client.AuthThrottle.Get(ctx, th.ID)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	until, locked := t.blockFor(th.Failures, l, now)
+	if until.IsZero() {
+		return nil
+	}
+	return th.Update().
+		SetBlockedUntil(until).
+		SetLocked(locked).
+		Exec(ctx)
+}
+
+// blockFor returns until when a key with failures is blocked, zero if it is not
+func (t *Throttler) blockFor(failures int, l Limit, now time.Time) (time.Time, bool) {
+	if l.Lockout && t.cfg.LockoutThreshold > 0 && failures >= t.cfg.LockoutThreshold {
+		return now.Add(t.cfg.GetLockoutDuration()), true
+	}
+	if failures <= l.FreeAttempts {
+		return time.Time{}, false
+	}
+
+	// Exponential backoff: base, 2*base, 4*base ... capped at the max delay
+	delay := t.cfg.GetBaseDelay()
+	for i := l.FreeAttempts + 1; i < failures && delay < t.cfg.GetMaxDelay(); i++ {
+		delay *= 2
+	}
+	if delay > t.cfg.GetMaxDelay() {
+		delay = t.cfg.GetMaxDelay()
+	}
+	return now.Add(delay), false
+}
+
+// Reset forgets the failures of keys, e.g. after a successful login
+func (t *Throttler) Reset(ctx context.Context, keys ...string) error {
+	_, err := database.Client.AuthThrottle.Delete().
+		Where(auththrottle.KeyIn(keys...)).
+		Exec(ctx)
+	return err
+}
+
+// Audit records a failed or throttled attempt. Failures are ignored so
+// auditing never changes the response.
+func Audit(ctx context.Context, a Attempt) {
+	if len(a.UserAgent) > 512 {
+		a.UserAgent = a.UserAgent[:512]
+	}
+
+	database.Client.AuthFailure.Create().
+		SetKind(a.Kind).
+		SetSubject(a.Subject).
+		SetNillableUserID(a.UserID).
+		SetReason(a.Reason).
+		SetIP(a.IP).
+		SetUserAgent(a.UserAgent).
+		Save(ctx)
+}
+
+// Purge deletes throttles whose failures are forgotten and audit entries older than the retention
+func (t *Throttler) Purge(ctx context.Context) (int, int, error) {
+	now := time.Now()
+
+	throttles, err := database.Client.AuthThrottle.Delete().
+		Where(auththrottle.LastFailureAtLT(now.Add(-t.cfg.GetResetAfter()))).
+		Where(auththrottle.Or(
+			auththrottle.BlockedUntilIsNil(),
+			auththrottle.BlockedUntilLT(now),
+		)).
+		Exec(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	failures, err := database.Client.AuthFailure.Delete().
+		Where(authfailure.CreatedAtLT(now.Add(-t.cfg.GetAuditRetention()))).
+		Exec(ctx)
+	return throttles, failures, err
+}
+
+// keys returns the keys of limits
+func keys(limits []Limit) []string {
+	result := make([]string, len(limits))
+	for i, l := range limits {
+		result[i] = l.Key
+	}
+	return result
+}
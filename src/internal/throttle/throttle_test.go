@@ -0,0 +1,181 @@
+package throttle_test
+
+import (
+	"context"
+	"gopan-server/config"
+	"gopan-server/ent/auththrottle"
+	"gopan-server/internal/dbtest"
+	"gopan-server/internal/throttle"
+	"testing"
+	"time"
+)
+
+// newThrottler returns a throttler with small limits: backoff after 2
+// failures per username and 4 per IP, starting at 1m and capped at 4m, and
+// a 30m lockout after 5 failures of a username
+func newThrottler(t *testing.T, lockoutThreshold int) *throttle.Throttler {
+	t.Helper()
+	cfg, err := config.Parse([]byte(`{"jwt": {"secret": "test"}, "security": {
+		"user_free_attempts": 2, "ip_free_attempts": 4,
+		"base_delay": "1m", "max_delay": "4m",
+		"lockout_duration": "30m", "reset_after": "1h"
+	}}`))
+	if err != nil {
+		t.Fatalf("parse config: %v", err)
+	}
+	cfg.Security.LockoutThreshold = lockoutThreshold
+	return throttle.New(&cfg.Security)
+}
+
+// checkBlock fails the test unless block ends about delay from now and
+// has the locked state, or is nil for a zero delay
+func checkBlock(t *testing.T, block *throttle.Block, delay time.Duration, locked bool) {
+	t.Helper()
+	if delay == 0 {
+		if block != nil {
+			t.Errorf("blocked until %s, want no block", block.Until)
+		}
+		return
+	}
+	if block == nil {
+		t.Fatalf("not blocked, want a block of %s", delay)
+	}
+	if got := time.Until(block.Until); got < delay-time.Minute/2 || got > delay {
+		t.Errorf("blocked for %s, want %s", got, delay)
+	}
+	if block.Locked != locked {
+		t.Errorf("locked = %v, want %v", block.Locked, locked)
+	}
+}
+
+func TestBackoffAndLockout(t *testing.T) {
+	tests := []struct {
+		name      string
+		threshold int
+		blocks    []time.Duration // After each failure
+		locked    int             // Failures from which the block is a lockout, 0 for never
+	}{
+		{"backoff doubles up to the max delay", -1, []time.Duration{0, 0, time.Minute, 2 * time.Minute, 4 * time.Minute, 4 * time.Minute}, 0},
+		{"lockout after the threshold", 5, []time.Duration{0, 0, time.Minute, 2 * time.Minute, 30 * time.Minute, 30 * time.Minute}, 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dbtest.Open(t)
+			th := newThrottler(t, tt.threshold)
+			ctx := context.Background()
+			limits := th.LoginLimits("alice", "192.0.2.1")
+
+			for i, delay := range tt.blocks {
+				if err := th.Fail(ctx, limits); err != nil {
+					t.Fatalf("Fail: %v", err)
+				}
+				block, err := th.Check(ctx, limits)
+				if err != nil {
+					t.Fatalf("Check: %v", err)
+				}
+				failures := i + 1
+				checkBlock(t, block, delay, tt.locked > 0 && failures >= tt.locked)
+			}
+		})
+	}
+}
+
+func TestUsernameAndIPKeys(t *testing.T) {
+	dbtest.Open(t)
+	th := newThrottler(t, -1)
+	ctx := context.Background()
+
+	// Three failures block the username from any address, but not the address
+	for range 3 {
+		if err := th.Fail(ctx, th.LoginLimits("alice", "192.0.2.1")); err != nil {
+			t.Fatalf("Fail: %v", err)
+		}
+	}
+	tests := []struct {
+		username, ip string
+		blocked      bool
+	}{
+		{"alice", "192.0.2.1", true},
+		{"ALICE", "192.0.2.2", true},
+		{"bob", "192.0.2.1", false},
+	}
+	for _, tt := range tests {
+		block, err := th.Check(ctx, th.LoginLimits(tt.username, tt.ip))
+		if err != nil {
+			t.Fatalf("Check: %v", err)
+		}
+		if (block != nil) != tt.blocked {
+			t.Errorf("%s from %s: blocked = %v, want %v", tt.username, tt.ip, block != nil, tt.blocked)
+		}
+	}
+
+	// Two more failures of other users block the address for everyone
+	for _, username := range []string{"bob", "carol"} {
+		if err := th.Fail(ctx, th.LoginLimits(username, "192.0.2.1")); err != nil {
+			t.Fatalf("Fail: %v", err)
+		}
+	}
+	block, err := th.Check(ctx, th.LoginLimits("dave", "192.0.2.1"))
+	if err != nil {
+		t.Fatalf("Check: %v", err)
+	}
+	checkBlock(t, block, time.Minute, false)
+	if block, _ := th.Check(ctx, th.LoginLimits("dave", "192.0.2.3")); block != nil {
+		t.Errorf("dave from another address is blocked until %s", block.Until)
+	}
+}
+
+func TestReset(t *testing.T) {
+	client := dbtest.Open(t)
+	th := newThrottler(t, -1)
+	ctx := context.Background()
+	limits := th.LoginLimits("alice", "192.0.2.1")
+
+	for range 3 {
+		if err := th.Fail(ctx, limits); err != nil {
+			t.Fatalf("Fail: %v", err)
+		}
+	}
+
+	// A success forgets the failures
+	if err := th.Reset(ctx, throttle.UserKey("alice"), throttle.IPKey("192.0.2.1")); err != nil {
+		t.Fatalf("Reset: %v", err)
+	}
+	if block, _ := th.Check(ctx, limits); block != nil {
+		t.Errorf("blocked until %s after the reset", block.Until)
+	}
+	for range 2 {
+		th.Fail(ctx, limits)
+	}
+	if block, _ := th.Check(ctx, limits); block != nil {
+		t.Errorf("blocked until %s within the free attempts after the reset", block.Until)
+	}
+
+	// So does waiting longer than reset_after
+	th.Fail(ctx, limits)
+	client.AuthThrottle.Update().
+		Where(auththrottle.KeyEQ(throttle.UserKey("alice"))).
+		SetLastFailureAt(time.Now().Add(-2 * time.Hour)).
+		SetBlockedUntil(time.Now().Add(-time.Hour)).
+		ExecX(ctx)
+	th.Fail(ctx, limits)
+	if block, _ := th.Check(ctx, limits); block != nil {
+		t.Errorf("blocked until %s after old failures were forgotten", block.Until)
+	}
+}
+
+func TestDisableThrottling(t *testing.T) {
+	dbtest.Open(t)
+	th := throttle.New(&config.SecurityConfig{DisableThrottling: true})
+	ctx := context.Background()
+	limits := th.LoginLimits("alice", "192.0.2.1")
+
+	for range 10 {
+		if err := th.Fail(ctx, limits); err != nil {
+			t.Fatalf("Fail: %v", err)
+		}
+	}
+	if block, _ := th.Check(ctx, limits); block != nil {
+		t.Errorf("blocked until %s with throttling disabled", block.Until)
+	}
+}
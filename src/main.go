@@ -5,9 +5,15 @@ import (
 	"embed"
 	"fmt"
 	"gopan-server/config"
+	"gopan-server/internal/account"
 	"gopan-server/internal/api"
+	"gopan-server/internal/apierr"
 	"gopan-server/internal/database"
+	"gopan-server/internal/events"
+	"gopan-server/internal/jobs"
 	"gopan-server/internal/logger"
+	"gopan-server/internal/s3"
+	"gopan-server/internal/sftpd"
 	"gopan-server/internal/storage"
 	"net/http"
 	"os"
@@ -43,11 +49,40 @@ func main() {
 		logger.Error.Fatalf("Failed to run migrations: %v", err)
 	}
 
+	// Run a management command instead of the server, e.g.
+	// gopan create-admin -username admin -password secret
+	if len(os.Args) > 1 {
+		if err := runCommand(ctx, cfg, os.Args[1:]); err != nil {
+			logger.Error.Fatalf("Command failed: %v", err)
+		}
+		return
+	}
+
+	// Bootstrap the first admin from config
+	if err := account.EnsureAdmin(ctx, cfg); err != nil {
+		logger.Error.Fatalf("Failed to create admin user: %v", err)
+	}
+
+	// Initialize event delivery
+	if err := events.Init(cfg, database.DB); err != nil {
+		logger.Error.Fatalf("Failed to initialize events: %v", err)
+	}
+
 	// Initialize MinIO
 	if err := storage.Init(&cfg.MinIO); err != nil {
 		logger.Error.Fatalf("Failed to initialize MinIO: %v", err)
 	}
 
+	// Start background jobs
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+	jobs.StartShareSweeper(jobsCtx, &cfg.Share)
+	jobs.StartSessionSweeper(jobsCtx)
+	jobs.StartChangeSweeper(jobsCtx)
+	jobs.StartThrottleSweeper(jobsCtx, &cfg.Security)
+	jobs.StartDirectorySync(jobsCtx, &cfg.LDAP)
+	jobs.StartS3UploadSweeper(jobsCtx, cfg)
+
 	// Setup router
 	router := setupRouter(cfg)
 
@@ -68,17 +103,66 @@ func main() {
 		}
 	}()
 
+	// Start the S3 gateway on its own port. Transfers are not bounded in
+	// time, as objects may be large.
+	var s3Srv *http.Server
+	if cfg.S3.Enabled {
+		s3Srv = &http.Server{
+			Addr:              fmt.Sprintf("%s:%d", cfg.S3.Host, cfg.S3.Port),
+			Handler:           s3.New(cfg),
+			ReadHeaderTimeout: 15 * time.Second,
+			IdleTimeout:       60 * time.Second,
+		}
+		go func() {
+			logger.Info.Printf("S3 gateway starting on %s:%d", cfg.S3.Host, cfg.S3.Port)
+			if err := s3Srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				logger.Error.Fatalf("Failed to start S3 gateway: %v", err)
+			}
+		}()
+	}
+
+	// Start the SFTP server on its own port
+	var sftpSrv *sftpd.Server
+	if cfg.SFTP.Enabled {
+		sftpSrv, err = sftpd.New(cfg)
+		if err != nil {
+			logger.Error.Fatalf("Failed to initialize SFTP server: %v", err)
+		}
+		go func() {
+			logger.Info.Printf("SFTP server starting on %s:%d", cfg.SFTP.Host, cfg.SFTP.Port)
+			if err := sftpSrv.ListenAndServe(); err != nil && err != sftpd.ErrServerClosed {
+				logger.Error.Fatalf("Failed to start SFTP server: %v", err)
+			}
+		}()
+	}
+
 	// Wait for interrupt signal for graceful shutdown
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
 	logger.Info.Println("Shutting down server...")
+	stopJobs()
+
+	// End event streams and long polls, which would hold up the shutdown
+	if err := events.Close(); err != nil {
+		logger.Error.Printf("Failed to close events: %v", err)
+	}
 
 	// Graceful shutdown with timeout
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
+	if sftpSrv != nil {
+		if err := sftpSrv.Close(); err != nil {
+			logger.Error.Printf("Failed to close SFTP server: %v", err)
+		}
+	}
+	if s3Srv != nil {
+		if err := s3Srv.Shutdown(ctx); err != nil {
+			logger.Error.Printf("S3 gateway forced to shutdown: %v", err)
+		}
+	}
 	if err := srv.Shutdown(ctx); err != nil {
 		logger.Error.Fatalf("Server forced to shutdown: %v", err)
 	}
@@ -90,6 +174,12 @@ func setupRouter(cfg *config.Config) *gin.Engine {
 	// Setup API routes
 	router := api.SetupRouter(cfg)
 
+	// Server-rendered share landing page with link preview metadata
+	sharePage, err := webFS.ReadFile("web/share.html")
+	if err == nil {
+		router.GET("/s/:code", api.NewSharePageHandler(cfg, sharePage).RenderSharePage)
+	}
+
 	// Serve static files from embedded FS
 	fileServer := http.FileServer(http.FS(webFS))
 
@@ -117,7 +207,7 @@ func setupRouter(cfg *config.Config) *gin.Engine {
 			}
 		}
 
-		c.JSON(http.StatusNotFound, gin.H{"error": "Not found"})
+		apierr.Abort(c, apierr.NotFound)
 	})
 
 	return router
@@ -1,6 +1,54 @@
 // API Utility Module - Unified API request handling
 const API_BASE = window.location.origin + '/api';
 
+let refreshPromise = null;
+
+/**
+ * Exchange the stored refresh token for a new token pair.
+ * Concurrent callers share one refresh, as every refresh token is single use.
+ * @returns {Promise<boolean>} - Whether the session was refreshed
+ */
+function refreshSession() {
+    if (!refreshPromise) {
+        refreshPromise = (async () => {
+            const refreshToken = localStorage.getItem('refresh_token');
+            if (!refreshToken) {
+                return false;
+            }
+            try {
+                const response = await fetch(`${API_BASE}/auth/refresh`, {
+                    method: 'POST',
+                    headers: { 'Content-Type': 'application/json' },
+                    body: JSON.stringify({ refresh_token: refreshToken })
+                });
+                if (!response.ok) {
+                    return false;
+                }
+                const data = await response.json();
+                localStorage.setItem('token', data.token);
+                localStorage.setItem('refresh_token', data.refresh_token);
+                localStorage.setItem('token_expires_in', data.expires_in);
+                return true;
+            } catch (error) {
+                return false;
+            }
+        })().finally(() => {
+            refreshPromise = null;
+        });
+    }
+    return refreshPromise;
+}
+
+/**
+ * Remove stored tokens and go back to the login page
+ */
+function clearSession() {
+    localStorage.removeItem('token');
+    localStorage.removeItem('refresh_token');
+    localStorage.removeItem('token_expires_in');
+    window.location.href = '/index.html';
+}
+
 /**
  * Unified API request function
  * @param {string} endpoint - API endpoint (e.g., '/files', '/auth/me')
@@ -47,10 +95,12 @@ async function apiCall(endpoint, options = {}) {
     try {
         const response = await fetch(`${API_BASE}${endpoint}`, requestOptions);
 
-        // Handle 401 Unauthorized
+        // Handle 401 Unauthorized: refresh the session once and retry
         if (response.status === 401) {
-            localStorage.removeItem('token');
-            window.location.href = '/index.html';
+            if (!options._retried && await refreshSession()) {
+                return apiCall(endpoint, { ...options, _retried: true });
+            }
+            clearSession();
             throw new Error('Unauthorized');
         }
 
@@ -130,8 +180,8 @@ async function uploadFile(file, parentId = null, onProgress = null) {
                     resolve({ id: xhr.responseText });
                 }
             } else {
-                const error = JSON.parse(xhr.responseText || '{"error": "Upload failed"}');
-                reject(new Error(error.error || 'Upload failed'));
+                const error = JSON.parse(xhr.responseText || '{}');
+                reject(new Error(error.error?.message || 'Upload failed'));
             }
         };
 
@@ -163,6 +213,8 @@ async function downloadFile(fileId) {
 // Export functions for use in other modules
 window.APIUtils = {
     apiCall,
+    refreshSession,
+    clearSession,
     get,
     post,
     put,
@@ -9,7 +9,7 @@ async function getCapacity() {
         const response = await window.APIUtils.get('/user/capacity');
         if (!response.ok) {
             const error = await response.json();
-            throw new Error(error.error || 'Failed to get capacity');
+            throw new Error(error.error?.message || 'Failed to get capacity');
         }
         return await response.json();
     } catch (error) {
@@ -117,7 +117,7 @@ async function recalculateCapacity() {
         const response = await window.APIUtils.post('/user/recalculate');
         if (!response.ok) {
             const error = await response.json();
-            throw new Error(error.error || 'Failed to recalculate');
+            throw new Error(error.error?.message || 'Failed to recalculate');
         }
         showToast('容量已重新计算', 'success');
         return await response.json();